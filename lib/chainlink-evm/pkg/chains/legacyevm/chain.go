@@ -32,7 +32,9 @@ import (
 	"github.com/smartcontractkit/chainlink-evm/pkg/log"
 	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
 	"github.com/smartcontractkit/chainlink-evm/pkg/monitor"
+	"github.com/smartcontractkit/chainlink-evm/pkg/reorgs"
 	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr"
+	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr/lifecycle"
 	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
 	trontxm "github.com/smartcontractkit/chainlink-tron/relayer/txm"
 )
@@ -50,7 +52,12 @@ type Chain interface {
 	Logger() logger.Logger
 	BalanceMonitor() monitor.BalanceMonitor
 	LogPoller() logpoller.LogPoller
+	ReorgJournal() reorgs.Journal
 	GasEstimator() gas.EvmFeeEstimator
+	// TxLifecycleEmitter returns nil unless transaction lifecycle events are enabled for the chain.
+	TxLifecycleEmitter() *lifecycle.Emitter
+	// TxBatcher returns nil unless transaction batching is enabled for the chain.
+	TxBatcher() *txmgr.Batcher
 }
 
 // ChainTronSupport is an Chain interface extension for Tron support.
@@ -110,8 +117,11 @@ type chain struct {
 	headTracker     heads.Tracker
 	logBroadcaster  log.Broadcaster
 	logPoller       logpoller.LogPoller
+	reorgJournal    reorgs.Journal
 	balanceMonitor  monitor.BalanceMonitor
 	gasEstimator    gas.EvmFeeEstimator
+	txLifecycle     *lifecycle.Emitter
+	txBatcher       *txmgr.Batcher
 
 	// Extends with support for the Tron TXM
 	tronTxm *trontxm.TronTxm
@@ -230,6 +240,9 @@ func newChain(cfg *config.ChainScoped, nodes []*toml.Node, opts ChainRelayOpts,
 		headTracker = opts.GenHeadTracker(chainID, headBroadcaster)
 	}
 
+	reorgJournal := reorgs.NewJournal(reorgs.NewORM(*chainID, opts.DS), chainID, l)
+	headBroadcaster.Subscribe(reorgJournal)
+
 	logPoller := logpoller.LogPollerDisabled
 	if opts.FeatureConfig.LogPoller() {
 		if opts.GenLogPoller != nil {
@@ -245,6 +258,7 @@ func newChain(cfg *config.ChainScoped, nodes []*toml.Node, opts ChainRelayOpts,
 				LogPrunePageSize:         int64(cfg.EVM().LogPrunePageSize()),
 				BackupPollerBlockDelay:   int64(cfg.EVM().BackupLogPollerBlockDelay()),
 				ClientErrors:             cfg.EVM().NodePool().Errors(),
+				ReorgJournal:             reorgJournal,
 			}
 
 			lpORM, err := logpoller.NewObservedORM(chainID, opts.DS, l)
@@ -262,8 +276,17 @@ func newChain(cfg *config.ChainScoped, nodes []*toml.Node, opts ChainRelayOpts,
 		return nil, fmt.Errorf("failed to instantiate gas estimator for chain with ID %s: %w", chainID, err)
 	}
 
+	var txLifecycle *lifecycle.Emitter
+	if opts.ChainConfigs.RPCEnabled() && cfg.EVM().ChainType() != chaintype.ChainTron && cfg.EVM().Transactions().Enabled() {
+		txLifecycle, err = newTxLifecycleEmitter(cfg.EVM().Transactions().LifecycleEvents(), l, chainID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to instantiate transaction lifecycle emitter for chain with ID %s: %w", chainID, err)
+		}
+	}
+
 	// note: gas estimator is started as a part of the txm
 	var txm txmgr.TxManager
+	var txBatcher *txmgr.Batcher
 	//nolint:gocritic // ignoring suggestion to convert to switch statement
 	if !opts.ChainConfigs.RPCEnabled() {
 		txm = &txmgr.NullTxManager{ErrMsg: fmt.Sprintf("Ethereum is disabled for chain %d", chainID)}
@@ -272,17 +295,18 @@ func newChain(cfg *config.ChainScoped, nodes []*toml.Node, opts ChainRelayOpts,
 	} else if !cfg.EVM().Transactions().Enabled() {
 		txm = &txmgr.NullTxManager{ErrMsg: fmt.Sprintf("TXM disabled for chain %d", chainID)}
 	} else {
-		txm, err = newEvmTxm(opts.DS, cfg.EVM(), opts.DatabaseConfig, opts.ListenerConfig, cl, l, logPoller, opts, headTracker, gasEstimator)
+		txm, err = newEvmTxm(opts.DS, cfg.EVM(), opts.DatabaseConfig, opts.ListenerConfig, cl, l, logPoller, opts, headTracker, gasEstimator, txLifecycle)
 		if err != nil {
 			return nil, fmt.Errorf("failed to instantiate EvmTxm for chain with ID %s: %w", chainID, err)
 		}
+		txBatcher = newTxBatcher(cfg.EVM().Transactions().Batching(), l, txm, cl)
 	}
 
 	headBroadcaster.Subscribe(txm)
 
 	var balanceMonitor monitor.BalanceMonitor
 	if opts.ChainConfigs.RPCEnabled() && cfg.EVM().BalanceMonitor().Enabled() {
-		balanceMonitor = monitor.NewBalanceMonitor(cl, opts.KeyStore, l)
+		balanceMonitor = monitor.NewBalanceMonitor(cl, opts.KeyStore, cfg.EVM().BalanceMonitor(), l)
 		headBroadcaster.Subscribe(balanceMonitor)
 	}
 
@@ -324,8 +348,11 @@ func newChain(cfg *config.ChainScoped, nodes []*toml.Node, opts ChainRelayOpts,
 		headTracker:     headTracker,
 		logBroadcaster:  logBroadcaster,
 		logPoller:       logPoller,
+		reorgJournal:    reorgJournal,
 		balanceMonitor:  balanceMonitor,
 		gasEstimator:    gasEstimator,
+		txLifecycle:     txLifecycle,
+		txBatcher:       txBatcher,
 
 		// Extends with support for the Tron TXM
 		tronTxm: tronTxm,
@@ -347,9 +374,21 @@ func (c *chain) Start(ctx context.Context) error {
 		// We do not start the log poller here, it gets
 		// started after the jobs so they have a chance to apply their filters.
 		var ms services.MultiStart
+		if c.txLifecycle != nil {
+			// started before the txm so no event is emitted to an unstarted emitter
+			if err := ms.Start(ctx, c.txLifecycle); err != nil {
+				return err
+			}
+		}
 		if err := ms.Start(ctx, c.txm, c.headBroadcaster, c.headTracker, c.logBroadcaster); err != nil {
 			return err
 		}
+		if c.txBatcher != nil {
+			// started after the txm, which it sends the batches to
+			if err := ms.Start(ctx, c.txBatcher); err != nil {
+				return err
+			}
+		}
 
 		if c.cfg.EVM().ChainType() == chaintype.ChainTron {
 			c.gasEstimator.Start(ctx) // Still need gas estimator to be working for the OCR2 plugin
@@ -380,8 +419,16 @@ func (c *chain) Close() error {
 		merr = multierr.Combine(merr, c.headTracker.Close())
 		c.logger.Debug("Chain: stopping headBroadcaster")
 		merr = multierr.Combine(merr, c.headBroadcaster.Close())
+		if c.txBatcher != nil {
+			c.logger.Debug("Chain: stopping transaction batcher")
+			merr = multierr.Combine(merr, c.txBatcher.Close())
+		}
 		c.logger.Debug("Chain: stopping evmTxm")
 		merr = multierr.Combine(merr, c.txm.Close())
+		if c.txLifecycle != nil {
+			c.logger.Debug("Chain: stopping transaction lifecycle emitter")
+			merr = multierr.Combine(merr, c.txLifecycle.Close())
+		}
 
 		// Tron doesn't use the EVM TXM but still uses the gas estimator, we'll close it here
 		if c.cfg.EVM().ChainType() == chaintype.ChainTron {
@@ -407,6 +454,12 @@ func (c *chain) Ready() (merr error) {
 	if c.balanceMonitor != nil {
 		merr = multierr.Combine(merr, c.balanceMonitor.Ready())
 	}
+	if c.txLifecycle != nil {
+		merr = multierr.Combine(merr, c.txLifecycle.Ready())
+	}
+	if c.txBatcher != nil {
+		merr = multierr.Combine(merr, c.txBatcher.Ready())
+	}
 	return
 }
 
@@ -424,6 +477,12 @@ func (c *chain) HealthReport() map[string]error {
 	if c.balanceMonitor != nil {
 		services.CopyHealth(report, c.balanceMonitor.HealthReport())
 	}
+	if c.txLifecycle != nil {
+		services.CopyHealth(report, c.txLifecycle.HealthReport())
+	}
+	if c.txBatcher != nil {
+		services.CopyHealth(report, c.txBatcher.HealthReport())
+	}
 
 	return report
 }
@@ -544,11 +603,14 @@ func (c *chain) Client() client.Client                  { return c.client }
 func (c *chain) Config() config.ChainScopedConfig       { return c.cfg }
 func (c *chain) LogBroadcaster() log.Broadcaster        { return c.logBroadcaster }
 func (c *chain) LogPoller() logpoller.LogPoller         { return c.logPoller }
+func (c *chain) ReorgJournal() reorgs.Journal           { return c.reorgJournal }
 func (c *chain) HeadBroadcaster() heads.Broadcaster     { return c.headBroadcaster }
 func (c *chain) TxManager() txmgr.TxManager             { return c.txm }
 func (c *chain) HeadTracker() heads.Tracker             { return c.headTracker }
 func (c *chain) Logger() logger.Logger                  { return c.logger }
 func (c *chain) BalanceMonitor() monitor.BalanceMonitor { return c.balanceMonitor }
+func (c *chain) TxLifecycleEmitter() *lifecycle.Emitter { return c.txLifecycle }
+func (c *chain) TxBatcher() *txmgr.Batcher              { return c.txBatcher }
 func (c *chain) GasEstimator() gas.EvmFeeEstimator      { return c.gasEstimator }
 
 // Add ChainTronSupport
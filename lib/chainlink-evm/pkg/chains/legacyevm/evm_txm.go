@@ -2,6 +2,7 @@ package legacyevm
 
 import (
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -14,6 +15,7 @@ import (
 	evmheads "github.com/smartcontractkit/chainlink-evm/pkg/heads"
 	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
 	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr"
+	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr/lifecycle"
 )
 
 func newEvmTxm(
@@ -27,6 +29,7 @@ func newEvmTxm(
 	opts ChainRelayOpts,
 	headTracker evmheads.Tracker,
 	estimator gas.EvmFeeEstimator,
+	lifecycleEmitter *lifecycle.Emitter,
 ) (txm txmgr.TxManager,
 	err error,
 ) {
@@ -60,6 +63,7 @@ func newEvmTxm(
 				logPoller,
 				opts.KeyStore,
 				estimator,
+				lifecycleEmitter,
 			)
 			if cfg.Transactions().TransactionManagerV2().DualBroadcast() == nil || !*cfg.Transactions().TransactionManagerV2().DualBroadcast() {
 				return txmv2, err
@@ -79,13 +83,49 @@ func newEvmTxm(
 			opts.KeyStore,
 			estimator,
 			headTracker,
-			txmv2)
+			txmv2,
+			lifecycleEmitter)
 	} else {
 		txm = opts.GenTxManager(chainID)
 	}
 	return
 }
 
+// newTxLifecycleEmitter returns nil if lifecycle events are disabled for the chain.
+func newTxLifecycleEmitter(cfg evmconfig.LifecycleEvents, lggr logger.Logger, chainID *big.Int) (*lifecycle.Emitter, error) {
+	if !cfg.Enabled() {
+		return nil, nil
+	}
+	var sinks []lifecycle.Sink
+	if u := cfg.WebhookURL(); u != nil {
+		sinks = append(sinks, lifecycle.NewWebhookSink(u, cfg.WebhookSecret()))
+	}
+	if path := cfg.FilePath(); path != "" {
+		fileSink, err := lifecycle.NewFileSink(path)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, fileSink)
+	}
+	return lifecycle.NewEmitter(lggr, chainID, sinks...), nil
+}
+
+// txBatcherPollInterval is how often the batcher checks whether the transactions it sent were mined.
+const txBatcherPollInterval = time.Second
+
+// newTxBatcher returns nil if batching is disabled for the chain.
+func newTxBatcher(cfg evmconfig.Batching, lggr logger.Logger, txm txmgr.TxManager, client evmclient.Client) *txmgr.Batcher {
+	if !cfg.Enabled() {
+		return nil
+	}
+	return txmgr.NewBatcher(lggr, txm, client, txmgr.BatcherConfig{
+		Window:            cfg.Window(),
+		MaxBatchSize:      int(cfg.MaxBatchSize()),
+		Multicall3Address: cfg.Multicall3Address(),
+		PollInterval:      txBatcherPollInterval,
+	})
+}
+
 const maximumConfirmationTimeout = time.Second * 600
 
 func validateConfirmationTimeout(cfg evmconfig.EVM) error {
@@ -24,6 +24,7 @@ import (
 const BALANCE_OF_ADDRESS_FUNCTION_SELECTOR = "0x70a08231"
 
 var _ Client = (*chainClient)(nil)
+var _ NodeScorer = (*chainClient)(nil)
 
 // Client is the interface used to interact with an ethereum node.
 type Client interface {
@@ -112,6 +113,8 @@ type chainClient struct {
 	logger       logger.SugaredLogger
 	chainType    chaintype.ChainType
 	clientErrors evmconfig.ClientErrors
+	// prober is nil unless NodePool.ConsistencyProbe is enabled
+	prober *consistencyProber
 }
 
 func NewChainClient(
@@ -126,6 +129,21 @@ func NewChainClient(
 	deathDeclarationDelay time.Duration,
 	chainType chaintype.ChainType,
 ) Client {
+	return newChainClient(lggr, metrics, selectionMode, leaseDuration, nodes, sendonlys, chainID, clientErrors, deathDeclarationDelay, chainType)
+}
+
+func newChainClient(
+	lggr logger.Logger,
+	metrics metrics.GenericMultiNodeMetrics,
+	selectionMode string,
+	leaseDuration time.Duration,
+	nodes []multinode.Node[*big.Int, *RPCClient],
+	sendonlys []multinode.SendOnlyNode[*big.Int, *RPCClient],
+	chainID *big.Int,
+	clientErrors evmconfig.ClientErrors,
+	deathDeclarationDelay time.Duration,
+	chainType chaintype.ChainType,
+) *chainClient {
 	chainFamily := "EVM"
 	multiNode := multinode.NewMultiNode[*big.Int, *RPCClient](
 		lggr,
@@ -285,6 +303,9 @@ func (c *chainClient) PendingCallContract(ctx context.Context, msg ethereum.Call
 }
 
 func (c *chainClient) Close() {
+	if c.prober != nil {
+		_ = c.prober.Close()
+	}
 	_ = c.txSender.Close()
 	_ = c.multiNode.Close()
 }
@@ -306,7 +327,11 @@ func (c *chainClient) Dial(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	return c.txSender.Start(ctx)
+	err = c.txSender.Start(ctx)
+	if err != nil || c.prober == nil {
+		return err
+	}
+	return c.prober.Start(ctx)
 }
 
 func (c *chainClient) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
@@ -405,6 +430,14 @@ func (c *chainClient) NodeStates() map[string]string {
 	return c.multiNode.NodeStates()
 }
 
+// NodeScores returns the consistency scores of the primary RPCs, or nil if NodePool.ConsistencyProbe is disabled.
+func (c *chainClient) NodeScores() []NodeScore {
+	if c.prober == nil {
+		return nil
+	}
+	return c.prober.NodeScores()
+}
+
 func (c *chainClient) PendingCodeAt(ctx context.Context, account common.Address) (b []byte, err error) {
 	r, err := c.multiNode.SelectRPC(ctx)
 	if err != nil {
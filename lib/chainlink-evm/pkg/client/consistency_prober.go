@@ -0,0 +1,327 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	evmconfig "github.com/smartcontractkit/chainlink-evm/pkg/config"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+)
+
+// ErrRPCQuarantined is returned by an RPC which the consistency prober keeps out of the pool.
+var ErrRPCQuarantined = errors.New("rpc is quarantined")
+
+const (
+	maxNodeScore = 100
+	// disagreementPenalty is taken for every block hash or call result disagreeing with the majority of the pool
+	disagreementPenalty = 25
+	staleHeadPenalty    = 10
+	slowResponsePenalty = 5
+	// agreementReward is given for every round in which the RPC passed all checks
+	agreementReward = 10
+	// an RPC responding slower than slowLatencyFactor times the median latency of the pool, and slower than
+	// minSlowLatency, is penalized
+	slowLatencyFactor = 3
+	minSlowLatency    = 100 * time.Millisecond
+	// latencyWeight is the weight of the latest round in the moving average of the latency
+	latencyWeight = 0.3
+)
+
+var promEVMPoolRPCNodeConsistencyScore = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "evm_pool_rpc_node_consistency_score",
+	Help: "The consistency score of the given RPC node, from 0 to 100. The node is quarantined while the score is below the configured QuarantineScore",
+}, []string{"evmChainID", "nodeName"})
+
+// NodeScore is the outcome of the consistency probes of a primary RPC.
+type NodeScore struct {
+	Name             string
+	Score            int
+	Quarantined      bool
+	QuarantineReason string
+	// Latency is the moving average of the time taken by the RPC to report its head.
+	Latency time.Duration
+	// Disagreements counts the block hashes, call results and chain IDs which disagreed with the pool.
+	Disagreements uint64
+	// LastFailure describes the checks failed during the last round, empty if all of them passed.
+	LastFailure  string
+	LastProbedAt time.Time
+}
+
+// NodeScorer is implemented by clients which score their RPCs with consistency probes.
+type NodeScorer interface {
+	NodeScores() []NodeScore
+}
+
+// probedRPC is the subset of RPCClient used by the consistencyProber.
+type probedRPC interface {
+	Name() string
+	fetchChainID(ctx context.Context) (*big.Int, error)
+	BlockNumber(ctx context.Context) (uint64, error)
+	BlockByNumber(ctx context.Context, number *big.Int) (*evmtypes.Head, error)
+	CallContract(ctx context.Context, msg interface{}, blockNumber *big.Int) ([]byte, error)
+	Quarantine(reason string)
+	Release()
+}
+
+// observation is what an RPC answered during a probe round.
+type observation struct {
+	// err is set if the RPC did not report its chain ID and head, in which case it is not scored
+	err     error
+	chainID *big.Int
+	head    uint64
+	latency time.Duration
+	// hash and calls are empty if the RPC failed to answer them
+	hash  string
+	calls []string
+}
+
+// consistencyProber periodically compares the answers of the primary RPCs of the pool, scores the RPCs which
+// disagree with the majority, lag behind it or respond slowly, and quarantines those whose score gets too low.
+type consistencyProber struct {
+	services.Service
+	eng *services.Engine
+
+	cfg     evmconfig.ConsistencyProbe
+	chainID *big.Int
+	rpcs    []probedRPC
+	calls   []ethereum.CallMsg
+
+	scoresMu sync.RWMutex
+	scores   []NodeScore // in the same order as rpcs
+}
+
+func newConsistencyProber(lggr logger.Logger, cfg evmconfig.ConsistencyProbe, chainID *big.Int, rpcs []probedRPC) *consistencyProber {
+	p := &consistencyProber{
+		cfg:     cfg,
+		chainID: chainID,
+		rpcs:    rpcs,
+		calls:   cfg.Calls(),
+		scores:  make([]NodeScore, len(rpcs)),
+	}
+	for i, rpc := range rpcs {
+		p.scores[i] = NodeScore{Name: rpc.Name(), Score: maxNodeScore}
+	}
+	p.Service, p.eng = services.Config{
+		Name:  "ConsistencyProber",
+		Start: p.start,
+	}.NewServiceEngine(lggr)
+	return p
+}
+
+func (p *consistencyProber) start(context.Context) error {
+	p.eng.GoTick(services.NewTicker(p.cfg.Interval()), p.probe)
+	return nil
+}
+
+// NodeScores returns the scores of the primary RPCs, in the order they are configured.
+func (p *consistencyProber) NodeScores() []NodeScore {
+	p.scoresMu.RLock()
+	defer p.scoresMu.RUnlock()
+	return slices.Clone(p.scores)
+}
+
+// probe runs a single round of probes: the chain ID and head of every RPC are fetched first, then the hash of the
+// reference block, BlockDepth blocks below the median head, and the results of the calls at that block are compared.
+func (p *consistencyProber) probe(ctx context.Context) {
+	obs := make([]*observation, len(p.rpcs))
+	p.forEachRPC(func(i int, rpc probedRPC) {
+		obs[i] = p.observeHead(ctx, rpc)
+	})
+
+	var heads []uint64
+	var latencies []time.Duration
+	for _, o := range obs {
+		if o.err == nil {
+			heads = append(heads, o.head)
+			latencies = append(latencies, o.latency)
+		}
+	}
+	if len(heads) == 0 {
+		p.eng.Warn("No RPC reported its head, skipping consistency probe")
+		return
+	}
+	medianHead := median(heads)
+	medianLatency := median(latencies)
+	refBlock := medianHead - min(medianHead, uint64(p.cfg.BlockDepth()))
+
+	p.forEachRPC(func(i int, rpc probedRPC) {
+		if obs[i].err == nil && obs[i].head >= refBlock {
+			p.observeBlock(ctx, rpc, obs[i], new(big.Int).SetUint64(refBlock))
+		}
+	})
+
+	majorityHash := majority(obs, func(o *observation) string { return o.hash })
+	majorityCalls := make([]string, len(p.calls))
+	for c := range p.calls {
+		majorityCalls[c] = majority(obs, func(o *observation) string { return o.calls[c] })
+	}
+
+	p.scoresMu.Lock()
+	defer p.scoresMu.Unlock()
+	now := time.Now()
+	for i, o := range obs {
+		if o.err != nil {
+			// unreachable RPCs are already handled by the node lifecycle
+			p.eng.Debugw("RPC did not answer the consistency probe", "rpc", p.scores[i].Name, "err", o.err)
+			continue
+		}
+		var failures []string
+		var penalty int
+		var disagreements uint64
+		if o.chainID.Cmp(p.chainID) != 0 {
+			failures = append(failures, fmt.Sprintf("chain ID %s does not match %s", o.chainID, p.chainID))
+			penalty = maxNodeScore
+			disagreements++
+		}
+		if o.head+uint64(p.cfg.StaleHeadThreshold()) < medianHead {
+			failures = append(failures, fmt.Sprintf("head %d is %d blocks behind the pool", o.head, medianHead-o.head))
+			penalty += staleHeadPenalty
+		}
+		if o.latency > max(slowLatencyFactor*medianLatency, minSlowLatency) {
+			failures = append(failures, fmt.Sprintf("responded in %s while the pool responded in %s", o.latency, medianLatency))
+			penalty += slowResponsePenalty
+		}
+		if o.hash != "" && majorityHash != "" && o.hash != majorityHash {
+			failures = append(failures, fmt.Sprintf("block %d hash %s does not match %s", refBlock, o.hash, majorityHash))
+			penalty += disagreementPenalty
+			disagreements++
+		}
+		for c, result := range o.calls {
+			if result != "" && majorityCalls[c] != "" && result != majorityCalls[c] {
+				failures = append(failures, fmt.Sprintf("call %d to %s at block %d returned %s instead of %s", c, p.calls[c].To, refBlock, result, majorityCalls[c]))
+				penalty += disagreementPenalty
+				disagreements++
+			}
+		}
+
+		s := &p.scores[i]
+		if len(failures) == 0 {
+			s.Score = min(maxNodeScore, s.Score+agreementReward)
+		} else {
+			s.Score = max(0, s.Score-penalty)
+		}
+		if s.Latency == 0 {
+			s.Latency = o.latency
+		} else {
+			s.Latency = time.Duration(latencyWeight*float64(o.latency) + (1-latencyWeight)*float64(s.Latency))
+		}
+		s.Disagreements += disagreements
+		s.LastFailure = strings.Join(failures, "; ")
+		s.LastProbedAt = now
+		promEVMPoolRPCNodeConsistencyScore.WithLabelValues(p.chainID.String(), s.Name).Set(float64(s.Score))
+		if len(failures) > 0 {
+			p.eng.Warnw("RPC failed consistency probe", "rpc", s.Name, "score", s.Score, "failures", s.LastFailure)
+		}
+	}
+	p.updateQuarantine()
+}
+
+// updateQuarantine quarantines the RPCs whose score dropped below QuarantineScore, as long as a majority of the pool
+// stays available, and releases those which recovered the full score. Must be called with scoresMu held.
+func (p *consistencyProber) updateQuarantine() {
+	available := 0
+	for _, s := range p.scores {
+		if !s.Quarantined {
+			available++
+		}
+	}
+	for i := range p.scores {
+		s := &p.scores[i]
+		switch {
+		case s.Quarantined && s.Score == maxNodeScore:
+			p.rpcs[i].Release()
+			s.Quarantined, s.QuarantineReason = false, ""
+			available++
+			p.eng.Infow("Releasing RPC from quarantine", "rpc", s.Name)
+		case !s.Quarantined && s.Score < int(p.cfg.QuarantineScore()):
+			if 2*(available-1) <= len(p.scores) {
+				p.eng.Errorw("RPC should be quarantined, but it would leave less than a majority of the pool available", "rpc", s.Name, "score", s.Score, "failures", s.LastFailure)
+				continue
+			}
+			s.Quarantined, s.QuarantineReason = true, s.LastFailure
+			p.rpcs[i].Quarantine(s.QuarantineReason)
+			available--
+			p.eng.Errorw("Quarantining RPC", "rpc", s.Name, "score", s.Score, "reason", s.QuarantineReason)
+		}
+	}
+}
+
+func (p *consistencyProber) observeHead(ctx context.Context, rpc probedRPC) *observation {
+	o := &observation{calls: make([]string, len(p.calls))}
+	if o.chainID, o.err = rpc.fetchChainID(ctx); o.err != nil {
+		return o
+	}
+	start := time.Now()
+	o.head, o.err = rpc.BlockNumber(ctx)
+	o.latency = time.Since(start)
+	return o
+}
+
+func (p *consistencyProber) observeBlock(ctx context.Context, rpc probedRPC, o *observation, refBlock *big.Int) {
+	if head, err := rpc.BlockByNumber(ctx, refBlock); err != nil {
+		p.eng.Debugw("Failed to fetch reference block", "rpc", rpc.Name(), "block", refBlock, "err", err)
+	} else {
+		o.hash = head.Hash.Hex()
+	}
+	for c, call := range p.calls {
+		result, err := rpc.CallContract(ctx, call, refBlock)
+		if err != nil {
+			p.eng.Debugw("Failed to call contract", "rpc", rpc.Name(), "to", call.To, "block", refBlock, "err", err)
+			continue
+		}
+		o.calls[c] = hexutil.Encode(result)
+	}
+}
+
+func (p *consistencyProber) forEachRPC(fn func(i int, rpc probedRPC)) {
+	var wg sync.WaitGroup
+	for i, rpc := range p.rpcs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fn(i, rpc)
+		}()
+	}
+	wg.Wait()
+}
+
+// majority returns the value answered by more than half of the RPCs which answered, or an empty string if there is none.
+func majority(obs []*observation, value func(*observation) string) string {
+	counts := make(map[string]int)
+	answered := 0
+	for _, o := range obs {
+		if o.err != nil {
+			continue
+		}
+		if v := value(o); v != "" {
+			counts[v]++
+			answered++
+		}
+	}
+	for v, n := range counts {
+		if 2*n > answered {
+			return v
+		}
+	}
+	return ""
+}
+
+func median[T ~int64 | ~uint64](values []T) T {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	return sorted[len(sorted)/2]
+}
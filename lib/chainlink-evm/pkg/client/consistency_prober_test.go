@@ -0,0 +1,185 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/config"
+	"github.com/smartcontractkit/chainlink-evm/pkg/config/toml"
+	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+)
+
+type fakeProbedRPC struct {
+	name             string
+	chainID          *big.Int
+	head             uint64
+	hash             common.Hash
+	result           []byte
+	err              error
+	quarantineReason string
+}
+
+func (f *fakeProbedRPC) Name() string { return f.name }
+
+func (f *fakeProbedRPC) fetchChainID(context.Context) (*big.Int, error) { return f.chainID, f.err }
+
+func (f *fakeProbedRPC) BlockNumber(context.Context) (uint64, error) { return f.head, f.err }
+
+func (f *fakeProbedRPC) BlockByNumber(_ context.Context, n *big.Int) (*evmtypes.Head, error) {
+	return &evmtypes.Head{Number: n.Int64(), Hash: f.hash}, nil
+}
+
+func (f *fakeProbedRPC) CallContract(context.Context, interface{}, *big.Int) ([]byte, error) {
+	return f.result, nil
+}
+
+func (f *fakeProbedRPC) Quarantine(reason string) { f.quarantineReason = reason }
+
+func (f *fakeProbedRPC) Release() { f.quarantineReason = "" }
+
+func newTestConsistencyProber(t *testing.T, rpcs ...*fakeProbedRPC) *consistencyProber {
+	to := evmtypes.MustEIP55Address("0x2a3e23c6f242F5345320814aC8a1b4E58707D292")
+	data := hexutil.Bytes{0x31, 0x3c, 0xe5, 0x67}
+	cfg := &config.NodePoolConfig{C: toml.NodePool{ConsistencyProbe: toml.ConsistencyProbe{
+		Enabled:            ptr(true),
+		Interval:           commonconfig.MustNewDuration(time.Minute),
+		BlockDepth:         ptr[uint32](10),
+		StaleHeadThreshold: ptr[uint32](20),
+		QuarantineScore:    ptr[uint32](50),
+		Calls:              []toml.ConsistencyProbeCall{{To: &to, Data: &data}},
+	}}}
+	var probed []probedRPC
+	for _, rpc := range rpcs {
+		probed = append(probed, rpc)
+	}
+	return newConsistencyProber(logger.Test(t), cfg.ConsistencyProbe(), testutils.FixtureChainID, probed)
+}
+
+func newFakeProbedRPCs(n int) []*fakeProbedRPC {
+	rpcs := make([]*fakeProbedRPC, n)
+	for i := range rpcs {
+		rpcs[i] = &fakeProbedRPC{
+			name:    string(rune('a' + i)),
+			chainID: testutils.FixtureChainID,
+			head:    100,
+			hash:    common.HexToHash("0x1"),
+			result:  []byte{0x12},
+		}
+	}
+	return rpcs
+}
+
+func TestConsistencyProber_QuarantineAndRelease(t *testing.T) {
+	t.Parallel()
+	ctx := tests.Context(t)
+	rpcs := newFakeProbedRPCs(3)
+	p := newTestConsistencyProber(t, rpcs...)
+
+	rpcs[2].hash = common.HexToHash("0x2")
+	for range 2 {
+		p.probe(ctx)
+	}
+	score := p.NodeScores()[2]
+	assert.Equal(t, maxNodeScore-2*disagreementPenalty, score.Score)
+	assert.False(t, score.Quarantined)
+	assert.Equal(t, uint64(2), score.Disagreements)
+	assert.Contains(t, score.LastFailure, "block 90 hash")
+
+	p.probe(ctx)
+	score = p.NodeScores()[2]
+	assert.True(t, score.Quarantined)
+	assert.Equal(t, score.LastFailure, score.QuarantineReason)
+	assert.Equal(t, score.QuarantineReason, rpcs[2].quarantineReason)
+	for _, s := range p.NodeScores()[:2] {
+		assert.Equal(t, maxNodeScore, s.Score)
+		assert.False(t, s.Quarantined)
+	}
+
+	rpcs[2].hash = common.HexToHash("0x1")
+	for score.Score < maxNodeScore {
+		require.True(t, score.Quarantined)
+		p.probe(ctx)
+		score = p.NodeScores()[2]
+	}
+	assert.False(t, score.Quarantined)
+	assert.Empty(t, rpcs[2].quarantineReason)
+	assert.Empty(t, score.LastFailure)
+}
+
+func TestConsistencyProber_Checks(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name        string
+		breakRPC    func(*fakeProbedRPC)
+		score       int
+		quarantined bool
+		failure     string
+	}{
+		{"chain ID", func(r *fakeProbedRPC) { r.chainID = big.NewInt(1) }, 0, true, "chain ID 1 does not match"},
+		{"call result", func(r *fakeProbedRPC) { r.result = []byte{0x34} }, maxNodeScore - disagreementPenalty, false, "returned 0x34 instead of 0x12"},
+		{"stale head", func(r *fakeProbedRPC) { r.head = 50 }, maxNodeScore - staleHeadPenalty, false, "head 50 is 50 blocks behind the pool"},
+		{"unreachable", func(r *fakeProbedRPC) { r.err = errors.New("dial failed") }, maxNodeScore, false, ""},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			rpcs := newFakeProbedRPCs(3)
+			p := newTestConsistencyProber(t, rpcs...)
+			tt.breakRPC(rpcs[0])
+
+			p.probe(tests.Context(t))
+			score := p.NodeScores()[0]
+			assert.Equal(t, tt.score, score.Score)
+			assert.Equal(t, tt.quarantined, score.Quarantined)
+			if tt.failure == "" {
+				assert.Empty(t, score.LastFailure)
+			} else {
+				assert.Contains(t, score.LastFailure, tt.failure)
+			}
+		})
+	}
+}
+
+func TestConsistencyProber_KeepsMajorityAvailable(t *testing.T) {
+	t.Parallel()
+	ctx := tests.Context(t)
+	rpcs := newFakeProbedRPCs(2)
+	p := newTestConsistencyProber(t, rpcs...)
+
+	rpcs[1].chainID = big.NewInt(1)
+	p.probe(ctx)
+	score := p.NodeScores()[1]
+	assert.Equal(t, 0, score.Score)
+	assert.False(t, score.Quarantined)
+	assert.Empty(t, rpcs[1].quarantineReason)
+}
+
+func TestRPCClient_Quarantine(t *testing.T) {
+	t.Parallel()
+	ctx := tests.Context(t)
+	r := NewTestRPCClient(t, RPCClientOpts{HTTP: &url.URL{Scheme: "http", Host: "rpc.test"}})
+
+	r.Quarantine("block hash mismatch")
+	assert.Equal(t, "block hash mismatch", r.QuarantineReason())
+	_, err := r.ChainID(ctx)
+	require.ErrorIs(t, err, ErrRPCQuarantined)
+	require.ErrorContains(t, err, "block hash mismatch")
+	_, _, err = r.SubscribeToHeads(ctx)
+	require.ErrorIs(t, err, ErrRPCQuarantined)
+
+	r.Release()
+	assert.Empty(t, r.QuarantineReason())
+}
@@ -25,6 +25,7 @@ type ChainConfig interface {
 func NewEvmClient(cfg evmconfig.NodePool, chainCfg ChainConfig, clientErrors evmconfig.ClientErrors, lggr logger.Logger, chainID *big.Int, nodes []*toml.Node, chainType chaintype.ChainType) (Client, error) {
 	var primaries []multinode.Node[*big.Int, *RPCClient]
 	var sendonlys []multinode.SendOnlyNode[*big.Int, *RPCClient]
+	var probed []probedRPC
 	largePayloadRPCTimeout, defaultRPCTimeout := getRPCTimeouts(chainType)
 
 	multiNodeMetrics, err := metrics.NewGenericMultiNodeMetrics(metrics.EVM, chainID.String())
@@ -49,11 +50,16 @@ func NewEvmClient(cfg evmconfig.NodePool, chainCfg ChainConfig, clientErrors evm
 				lggr, multiNodeMetrics, node.WSURL.URL(), node.HTTPURL.URL(), *node.Name, i, chainID, *node.Order,
 				rpc, "EVM", *node.IsLoadBalancedRPC)
 			primaries = append(primaries, primaryNode)
+			probed = append(probed, rpc)
 		}
 	}
 
-	return NewChainClient(lggr, multiNodeMetrics, cfg.SelectionMode(), cfg.LeaseDuration(),
-		primaries, sendonlys, chainID, clientErrors, cfg.DeathDeclarationDelay(), chainType), nil
+	c := newChainClient(lggr, multiNodeMetrics, cfg.SelectionMode(), cfg.LeaseDuration(),
+		primaries, sendonlys, chainID, clientErrors, cfg.DeathDeclarationDelay(), chainType)
+	if probeCfg := cfg.ConsistencyProbe(); probeCfg != nil && probeCfg.Enabled() {
+		c.prober = newConsistencyProber(lggr, probeCfg, chainID, probed)
+	}
+	return c, nil
 }
 
 func getRPCTimeouts(chainType chaintype.ChainType) (largePayload, defaultTimeout time.Duration) {
@@ -100,6 +100,7 @@ type TestNodePoolConfig struct {
 	NodeDeathDeclarationDelay         time.Duration
 	NodeNewHeadsPollInterval          time.Duration
 	ExternalRequestMaxResponseSizeVal uint32
+	NodeConsistencyProbe              config.ConsistencyProbe
 }
 
 func (tc TestNodePoolConfig) PollFailureThreshold() uint32 { return tc.NodePollFailureThreshold }
@@ -142,6 +143,10 @@ func (tc TestNodePoolConfig) ExternalRequestMaxResponseSize() uint32 {
 	return tc.ExternalRequestMaxResponseSizeVal
 }
 
+func (tc TestNodePoolConfig) ConsistencyProbe() config.ConsistencyProbe {
+	return tc.NodeConsistencyProbe
+}
+
 func NewChainClientWithTestNode(
 	t *testing.T,
 	nodeCfg multinode.NodeConfig,
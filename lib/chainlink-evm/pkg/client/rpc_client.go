@@ -111,6 +111,9 @@ type RPCClient struct {
 	limitedWS atomic.Pointer[rawclient] // ws client with limited response size
 	http      atomic.Pointer[rawclient]
 
+	// quarantineReason is set while the consistency prober keeps the RPC out of the pool
+	quarantineReason atomic.Pointer[string]
+
 	*multinode.RPCClientBase[*evmtypes.Head]
 }
 
@@ -428,6 +431,9 @@ func isRequestingFinalizedBlock(el rpc.BatchElem) bool {
 // SubscribeToHeads implements custom SubscribeToheads method to override the RPCClientBase
 // with added ws support.
 func (r *RPCClient) SubscribeToHeads(ctx context.Context) (ch <-chan *evmtypes.Head, sub multinode.Subscription, err error) {
+	if err = r.checkQuarantine(); err != nil {
+		return nil, nil, err
+	}
 	ctx, cancel, chStopInFlight, ws, _ := r.acquireQueryCtx(ctx, r.rpcTimeout)
 	defer cancel()
 	args := []interface{}{rpcSubscriptionMethodNewHeads}
@@ -1250,9 +1256,17 @@ func (r *RPCClient) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err
 	return
 }
 
-// Returns the ChainID according to the geth client. This is useful for functions like verify()
-// the common node.
+// ChainID returns the chain ID reported by the RPC. It fails while the RPC is quarantined, so that the node cannot
+// be verified and stays out of the pool.
 func (r *RPCClient) ChainID(ctx context.Context) (chainID *big.Int, err error) {
+	if err = r.checkQuarantine(); err != nil {
+		return nil, err
+	}
+	return r.fetchChainID(ctx)
+}
+
+// fetchChainID returns the chain ID reported by the RPC, even if it is quarantined.
+func (r *RPCClient) fetchChainID(ctx context.Context) (chainID *big.Int, err error) {
 	ctx, cancel, client := r.makeLiveQueryCtxAndSafeGetClient(ctx, r.rpcTimeout)
 	defer cancel()
 
@@ -1261,6 +1275,33 @@ func (r *RPCClient) ChainID(ctx context.Context) (chainID *big.Int, err error) {
 	return
 }
 
+// Quarantine keeps the RPC out of the pool until Release is called. Its subscriptions are terminated, so that the node
+// is declared unreachable, and it cannot be verified again when redialed.
+func (r *RPCClient) Quarantine(reason string) {
+	r.quarantineReason.Store(&reason)
+	r.UnsubscribeAllExcept()
+}
+
+// Release lets a quarantined RPC rejoin the pool on its next redial.
+func (r *RPCClient) Release() {
+	r.quarantineReason.Store(nil)
+}
+
+// QuarantineReason returns why the RPC is quarantined, or an empty string if it is not.
+func (r *RPCClient) QuarantineReason() string {
+	if reason := r.quarantineReason.Load(); reason != nil {
+		return *reason
+	}
+	return ""
+}
+
+func (r *RPCClient) checkQuarantine() error {
+	if reason := r.quarantineReason.Load(); reason != nil {
+		return fmt.Errorf("%w: %s", ErrRPCQuarantined, *reason)
+	}
+	return nil
+}
+
 // newRqLggr generates a new logger with a unique request ID
 func (r *RPCClient) newRqLggr() logger.SugaredLogger {
 	return r.rpcLog.With("requestID", uuid.New())
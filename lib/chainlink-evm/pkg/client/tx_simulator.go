@@ -52,5 +52,8 @@ func toCallArg(msg ethereum.CallMsg) interface{} {
 	if msg.GasTipCap != nil {
 		arg["maxPriorityFeePerGas"] = (*hexutil.Big)(msg.GasTipCap)
 	}
+	if len(msg.AuthorizationList) > 0 {
+		arg["authorizationList"] = msg.AuthorizationList
+	}
 	return arg
 }
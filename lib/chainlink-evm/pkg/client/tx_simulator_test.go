@@ -1,9 +1,11 @@
 package client_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/require"
 	"github.com/tidwall/gjson"
 
@@ -106,4 +108,38 @@ func TestSimulateTx_Default(t *testing.T) {
 		sendErr := client.SimulateTransaction(ctx, ethClient, logger.TestSugared(t), "", msg)
 		require.False(t, sendErr.IsTerminallyStuckConfigError(nil))
 	})
+
+	t.Run("passes authorization list to simulation", func(t *testing.T) {
+		ctx := tests.Context(t)
+		delegate := testutils.NewAddress()
+		wsURL := testutils.NewWSServer(t, testutils.FixtureChainID, func(method string, params gjson.Result) (resp testutils.JSONRPCResponse) {
+			switch method {
+			case "eth_subscribe":
+				resp.Result = `"0x00"`
+				resp.Notify = headResult
+				return
+			case "eth_unsubscribe":
+				resp.Result = "true"
+				return
+			case "eth_estimateGas":
+				if params.Get("0.authorizationList.0.address").String() != strings.ToLower(delegate.Hex()) {
+					resp.Error.Code = -32000
+					resp.Error.Message = "missing authorization list"
+					return
+				}
+				resp.Result = `"0x100"`
+			}
+			return
+		}).WSURL().String()
+
+		ethClient := mustNewChainClient(t, wsURL)
+
+		msg := ethereum.CallMsg{
+			From:              fromAddress,
+			To:                &fromAddress,
+			AuthorizationList: []gethtypes.SetCodeAuthorization{{Address: delegate, Nonce: 1}},
+		}
+		sendErr := client.SimulateTransaction(ctx, ethClient, logger.TestSugared(t), "", msg)
+		require.Empty(t, sendErr)
+	})
 }
@@ -2,6 +2,7 @@ package config
 
 import (
 	"math/big"
+	"sync/atomic"
 	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
@@ -31,8 +32,16 @@ func (c *ChainScoped) BlockEmissionIdleWarningThreshold() time.Duration {
 	return c.EVM().NodeNoNewHeadsThreshold()
 }
 
+// SetGasEstimator replaces the GasEstimator section of the chain while it is running. Values that are read by the
+// estimators and the TXM on every use take effect immediately, the rest only after a restart.
+func (c *ChainScoped) SetGasEstimator(ge toml.GasEstimator) {
+	c.evmConfig.gasEstimator.Store(&ge)
+}
+
 type EVMConfig struct {
 	C *toml.EVMConfig
+
+	gasEstimator atomic.Pointer[toml.GasEstimator] // overrides C.GasEstimator once set
 }
 
 func (e *EVMConfig) ConfirmationTimeout() time.Duration {
@@ -48,7 +57,7 @@ func (e *EVMConfig) TOMLString() (string, error) {
 }
 
 func (e *EVMConfig) BalanceMonitor() BalanceMonitor {
-	return &balanceMonitorConfig{c: e.C.BalanceMonitor}
+	return &balanceMonitorConfig{c: e.C.BalanceMonitor, k: e.C.KeySpecific}
 }
 
 func (e *EVMConfig) Transactions() Transactions {
@@ -72,7 +81,14 @@ func (e *EVMConfig) Workflow() Workflow {
 }
 
 func (e *EVMConfig) GasEstimator() GasEstimator {
-	return &gasEstimatorConfig{c: e.C.GasEstimator, blockDelay: e.C.RPCBlockQueryDelay, transactionsMaxInFlight: e.C.Transactions.MaxInFlight, k: e.C.KeySpecific}
+	return &gasEstimatorConfig{c: e.gasEstimatorTOML, blockDelay: e.C.RPCBlockQueryDelay, transactionsMaxInFlight: e.C.Transactions.MaxInFlight, k: e.C.KeySpecific}
+}
+
+func (e *EVMConfig) gasEstimatorTOML() toml.GasEstimator {
+	if ge := e.gasEstimator.Load(); ge != nil {
+		return *ge
+	}
+	return e.C.GasEstimator
 }
 
 func (e *EVMConfig) AutoCreateKey() bool {
@@ -1,13 +1,51 @@
 package config
 
 import (
+	"net/url"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
 	"github.com/smartcontractkit/chainlink-evm/pkg/config/toml"
 )
 
 type balanceMonitorConfig struct {
 	c toml.BalanceMonitor
+	k toml.KeySpecificConfig
 }
 
 func (b *balanceMonitorConfig) Enabled() bool {
 	return *b.c.Enabled
 }
+
+func (b *balanceMonitorConfig) MinBalance(addr gethcommon.Address) *assets.Wei {
+	for i := range b.k {
+		ks := b.k[i]
+		if ks.Key.Address() == addr && ks.BalanceMonitor.MinBalance != nil {
+			return ks.BalanceMonitor.MinBalance
+		}
+	}
+	return b.c.MinBalance
+}
+
+func (b *balanceMonitorConfig) BurnRateWindow() time.Duration {
+	return b.c.BurnRateWindow.Duration()
+}
+
+func (b *balanceMonitorConfig) AlertWebhookURL() *url.URL {
+	return b.c.AlertWebhookURL.URL()
+}
+
+func (b *balanceMonitorConfig) Tokens() []BalanceMonitorToken {
+	tokens := make([]BalanceMonitorToken, 0, len(b.c.Tokens))
+	for _, t := range b.c.Tokens {
+		tokens = append(tokens, BalanceMonitorToken{
+			Symbol:     *t.Symbol,
+			Address:    t.Address.Address(),
+			Decimals:   *t.Decimals,
+			MinBalance: t.MinBalance,
+		})
+	}
+	return tokens
+}
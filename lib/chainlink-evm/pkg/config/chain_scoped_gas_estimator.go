@@ -11,7 +11,7 @@ import (
 )
 
 type gasEstimatorConfig struct {
-	c                       toml.GasEstimator
+	c                       func() toml.GasEstimator
 	k                       toml.KeySpecificConfig
 	blockDelay              *uint16
 	transactionsMaxInFlight *uint32
@@ -27,106 +27,110 @@ func (g *gasEstimatorConfig) PriceMaxKey(addr gethcommon.Address) *assets.Wei {
 		}
 	}
 
-	chainSpecific := g.c.PriceMax
+	chainSpecific := g.c().PriceMax
 	if keySpecific != nil && keySpecific.Cmp(chainSpecific) < 0 {
 		return keySpecific
 	}
 
-	return g.c.PriceMax
+	return g.c().PriceMax
 }
 
 func (g *gasEstimatorConfig) BlockHistory() BlockHistory {
-	return &blockHistoryConfig{c: g.c.BlockHistory, blockDelay: g.blockDelay, bumpThreshold: g.c.BumpThreshold}
+	return &blockHistoryConfig{c: g.c().BlockHistory, blockDelay: g.blockDelay, bumpThreshold: g.c().BumpThreshold}
 }
 
 func (g *gasEstimatorConfig) FeeHistory() FeeHistory {
-	return &feeHistoryConfig{c: g.c.FeeHistory}
+	return &feeHistoryConfig{c: g.c().FeeHistory}
+}
+
+func (g *gasEstimatorConfig) Composite() CompositeEstimator {
+	return &compositeEstimatorConfig{c: g.c().Composite}
 }
 
 func (g *gasEstimatorConfig) DAOracle() DAOracle {
-	return &daOracleConfig{c: g.c.DAOracle}
+	return &daOracleConfig{c: g.c().DAOracle}
 }
 
 func (g *gasEstimatorConfig) EIP1559DynamicFees() bool {
-	return *g.c.EIP1559DynamicFees
+	return *g.c().EIP1559DynamicFees
 }
 
 func (g *gasEstimatorConfig) BumpPercent() uint16 {
-	return *g.c.BumpPercent
+	return *g.c().BumpPercent
 }
 
 func (g *gasEstimatorConfig) BumpThreshold() uint64 {
-	return uint64(*g.c.BumpThreshold)
+	return uint64(*g.c().BumpThreshold)
 }
 
 func (g *gasEstimatorConfig) BumpTxDepth() uint32 {
-	if g.c.BumpTxDepth != nil {
-		return *g.c.BumpTxDepth
+	if g.c().BumpTxDepth != nil {
+		return *g.c().BumpTxDepth
 	}
 	return *g.transactionsMaxInFlight
 }
 
 func (g *gasEstimatorConfig) BumpMin() *assets.Wei {
-	return g.c.BumpMin
+	return g.c().BumpMin
 }
 
 func (g *gasEstimatorConfig) FeeCapDefault() *assets.Wei {
-	return g.c.FeeCapDefault
+	return g.c().FeeCapDefault
 }
 
 func (g *gasEstimatorConfig) LimitDefault() uint64 {
-	return *g.c.LimitDefault
+	return *g.c().LimitDefault
 }
 
 func (g *gasEstimatorConfig) LimitMax() uint64 {
-	return *g.c.LimitMax
+	return *g.c().LimitMax
 }
 
 func (g *gasEstimatorConfig) LimitMultiplier() float32 {
-	f, _ := g.c.LimitMultiplier.BigFloat().Float32()
+	f, _ := g.c().LimitMultiplier.BigFloat().Float32()
 	return f
 }
 
 func (g *gasEstimatorConfig) LimitTransfer() uint64 {
-	return *g.c.LimitTransfer
+	return *g.c().LimitTransfer
 }
 
 func (g *gasEstimatorConfig) PriceDefault() *assets.Wei {
-	return g.c.PriceDefault
+	return g.c().PriceDefault
 }
 
 func (g *gasEstimatorConfig) PriceMin() *assets.Wei {
-	return g.c.PriceMin
+	return g.c().PriceMin
 }
 
 func (g *gasEstimatorConfig) PriceMax() *assets.Wei {
-	return g.c.PriceMax
+	return g.c().PriceMax
 }
 
 func (g *gasEstimatorConfig) TipCapDefault() *assets.Wei {
-	return g.c.TipCapDefault
+	return g.c().TipCapDefault
 }
 
 func (g *gasEstimatorConfig) TipCapMin() *assets.Wei {
-	return g.c.TipCapMin
+	return g.c().TipCapMin
 }
 
 func (g *gasEstimatorConfig) Mode() string {
-	return *g.c.Mode
+	return *g.c().Mode
 }
 
 func (g *gasEstimatorConfig) LimitJobType() LimitJobType {
-	return &limitJobTypeConfig{c: g.c.LimitJobType}
+	return &limitJobTypeConfig{c: g.c().LimitJobType}
 }
 
 func (g *gasEstimatorConfig) EstimateLimit() bool {
-	return *g.c.EstimateLimit
+	return *g.c().EstimateLimit
 }
 
 // SenderAddress returns the estimation sender address for dynamic gas estimation and is ignored if EstimateLimit is set to false.
 func (g *gasEstimatorConfig) SenderAddress() *types.EIP55Address {
-	if g.c.EstimateLimit != nil && *g.c.EstimateLimit {
-		return g.c.SenderAddress
+	if g.c().EstimateLimit != nil && *g.c().EstimateLimit {
+		return g.c().SenderAddress
 	}
 	return nil
 }
@@ -224,3 +228,24 @@ type feeHistoryConfig struct {
 func (u *feeHistoryConfig) CacheTimeout() time.Duration {
 	return u.c.CacheTimeout.Duration()
 }
+
+type compositeEstimatorConfig struct {
+	c toml.CompositeEstimator
+}
+
+func (c *compositeEstimatorConfig) Modes() []string {
+	return c.c.Modes
+}
+
+func (c *compositeEstimatorConfig) Aggregation() string {
+	return *c.c.Aggregation
+}
+
+// PriceFloor returns nil if no floor is configured.
+func (c *compositeEstimatorConfig) PriceFloor() *assets.Wei {
+	return c.c.PriceFloor
+}
+
+func (c *compositeEstimatorConfig) MinSources() uint32 {
+	return *c.c.MinSources
+}
@@ -3,6 +3,8 @@ package config
 import (
 	"time"
 
+	"github.com/ethereum/go-ethereum"
+
 	"github.com/smartcontractkit/chainlink-evm/pkg/config/toml"
 )
 
@@ -62,3 +64,41 @@ func (n *NodePoolConfig) ExternalRequestMaxResponseSize() uint32 {
 	}
 	return *n.C.ExternalRequestMaxResponseSize
 }
+
+func (n *NodePoolConfig) ConsistencyProbe() ConsistencyProbe {
+	return &consistencyProbeConfig{c: n.C.ConsistencyProbe}
+}
+
+type consistencyProbeConfig struct {
+	c toml.ConsistencyProbe
+}
+
+func (c *consistencyProbeConfig) Enabled() bool {
+	// the probes are off for node pools which were not built from a full TOML config, see client.NewClientConfigs
+	return c.c.Enabled != nil && *c.c.Enabled
+}
+
+func (c *consistencyProbeConfig) Interval() time.Duration {
+	return c.c.Interval.Duration()
+}
+
+func (c *consistencyProbeConfig) BlockDepth() uint32 {
+	return *c.c.BlockDepth
+}
+
+func (c *consistencyProbeConfig) StaleHeadThreshold() uint32 {
+	return *c.c.StaleHeadThreshold
+}
+
+func (c *consistencyProbeConfig) QuarantineScore() uint32 {
+	return *c.c.QuarantineScore
+}
+
+func (c *consistencyProbeConfig) Calls() []ethereum.CallMsg {
+	calls := make([]ethereum.CallMsg, 0, len(c.c.Calls))
+	for _, call := range c.c.Calls {
+		to := call.To.Address()
+		calls = append(calls, ethereum.CallMsg{To: &to, Data: *call.Data})
+	}
+	return calls
+}
@@ -4,6 +4,8 @@ import (
 	"net/url"
 	"time"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
 	"github.com/smartcontractkit/chainlink-evm/pkg/config/toml"
 )
 
@@ -64,6 +66,67 @@ func (t *transactionManagerV2Config) DualBroadcast() *bool {
 	return t.c.DualBroadcast
 }
 
+func (t *transactionManagerV2Config) Persistent() bool {
+	return *t.c.Persistent
+}
+
+func (t *transactionsConfig) LifecycleEvents() LifecycleEvents {
+	return &lifecycleEventsConfig{c: t.c.LifecycleEvents}
+}
+
+type lifecycleEventsConfig struct {
+	c toml.LifecycleEventsConfig
+}
+
+func (l *lifecycleEventsConfig) Enabled() bool {
+	return *l.c.Enabled
+}
+
+func (l *lifecycleEventsConfig) WebhookURL() *url.URL {
+	return l.c.WebhookURL.URL()
+}
+
+func (l *lifecycleEventsConfig) WebhookSecret() string {
+	if l.c.WebhookSecret == nil {
+		return ""
+	}
+	return string(*l.c.WebhookSecret)
+}
+
+func (l *lifecycleEventsConfig) FilePath() string {
+	if l.c.FilePath == nil {
+		return ""
+	}
+	return *l.c.FilePath
+}
+
+func (t *transactionsConfig) Batching() Batching {
+	return &batchingConfig{c: t.c.Batching}
+}
+
+type batchingConfig struct {
+	c toml.BatchingConfig
+}
+
+func (b *batchingConfig) Enabled() bool {
+	return *b.c.Enabled
+}
+
+func (b *batchingConfig) Window() time.Duration {
+	return b.c.Window.Duration()
+}
+
+func (b *batchingConfig) MaxBatchSize() uint32 {
+	return *b.c.MaxBatchSize
+}
+
+func (b *batchingConfig) Multicall3Address() gethcommon.Address {
+	if b.c.Multicall3Address == nil {
+		return gethcommon.Address{}
+	}
+	return b.c.Multicall3Address.Address()
+}
+
 func (t *transactionsConfig) AutoPurge() AutoPurgeConfig {
 	return &autoPurgeConfig{c: t.c.AutoPurge}
 }
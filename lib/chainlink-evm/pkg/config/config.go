@@ -5,7 +5,9 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
 
 	commonassets "github.com/smartcontractkit/chainlink-common/pkg/assets"
 	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
@@ -87,6 +89,20 @@ type HeadTracker interface {
 
 type BalanceMonitor interface {
 	Enabled() bool
+	// MinBalance returns the minimum native balance of the key, which is zero if no alert is configured.
+	MinBalance(addr gethcommon.Address) *assets.Wei
+	BurnRateWindow() time.Duration
+	AlertWebhookURL() *url.URL
+	Tokens() []BalanceMonitorToken
+}
+
+// BalanceMonitorToken is an ERC-20 token tracked by the balance monitor.
+type BalanceMonitorToken struct {
+	Symbol   string
+	Address  gethcommon.Address
+	Decimals uint8
+	// MinBalance is in whole tokens, nil if no alert is configured.
+	MinBalance *decimal.Decimal
 }
 
 type ClientErrors interface {
@@ -118,6 +134,8 @@ type Transactions interface {
 	MaxQueued() uint64
 	AutoPurge() AutoPurgeConfig
 	TransactionManagerV2() TransactionManagerV2
+	LifecycleEvents() LifecycleEvents
+	Batching() Batching
 }
 
 type AutoPurgeConfig interface {
@@ -132,11 +150,27 @@ type TransactionManagerV2 interface {
 	BlockTime() *time.Duration
 	CustomURL() *url.URL
 	DualBroadcast() *bool
+	Persistent() bool
+}
+
+type LifecycleEvents interface {
+	Enabled() bool
+	WebhookURL() *url.URL
+	WebhookSecret() string
+	FilePath() string
+}
+
+type Batching interface {
+	Enabled() bool
+	Window() time.Duration
+	MaxBatchSize() uint32
+	Multicall3Address() gethcommon.Address
 }
 
 type GasEstimator interface {
 	BlockHistory() BlockHistory
 	FeeHistory() FeeHistory
+	Composite() CompositeEstimator
 	LimitJobType() LimitJobType
 
 	EIP1559DynamicFees() bool
@@ -190,6 +224,13 @@ type FeeHistory interface {
 	CacheTimeout() time.Duration
 }
 
+type CompositeEstimator interface {
+	Modes() []string
+	Aggregation() string
+	PriceFloor() *assets.Wei
+	MinSources() uint32
+}
+
 type Workflow interface {
 	AcceptanceTimeout() time.Duration
 	ForwarderAddress() *types.EIP55Address
@@ -213,6 +254,16 @@ type NodePool interface {
 	NewHeadsPollInterval() time.Duration
 	VerifyChainID() bool
 	ExternalRequestMaxResponseSize() uint32
+	ConsistencyProbe() ConsistencyProbe
+}
+
+type ConsistencyProbe interface {
+	Enabled() bool
+	Interval() time.Duration
+	BlockDepth() uint32
+	StaleHeadThreshold() uint32
+	QuarantineScore() uint32
+	Calls() []ethereum.CallMsg
 }
 
 type ChainScopedConfig interface {
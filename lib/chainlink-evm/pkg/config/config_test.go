@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink-evm/pkg/config"
 	"github.com/smartcontractkit/chainlink-evm/pkg/config/configtest"
 	"github.com/smartcontractkit/chainlink-evm/pkg/config/toml"
 	"github.com/smartcontractkit/chainlink-evm/pkg/types"
@@ -282,6 +283,16 @@ func TestChainScopedConfig_GasEstimator(t *testing.T) {
 	assert.Equal(t, assets.NewWeiI(1), ge.TipCapDefault())
 	assert.Equal(t, assets.NewWeiI(1), ge.TipCapMin())
 	assert.False(t, ge.EstimateLimit())
+
+	t.Run("SetGasEstimator", func(t *testing.T) {
+		updated := cfg.EVM().(*config.EVMConfig).C.GasEstimator
+		updated.PriceMax = assets.GWei(800)
+		cfg.SetGasEstimator(updated)
+
+		assert.Equal(t, assets.GWei(800), ge.PriceMax(), "previously returned configs must see the update")
+		assert.Equal(t, assets.GWei(800), cfg.EVM().GasEstimator().PriceMax())
+		assert.Equal(t, assets.GWei(20), ge.PriceDefault())
+	})
 }
 
 func TestChainScopedConfig_BSCDefaults(t *testing.T) {
@@ -255,6 +255,53 @@ func (_c *GasEstimator_BumpTxDepth_Call) RunAndReturn(run func() uint32) *GasEst
 	return _c
 }
 
+// Composite provides a mock function with no fields
+func (_m *GasEstimator) Composite() config.CompositeEstimator {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Composite")
+	}
+
+	var r0 config.CompositeEstimator
+	if rf, ok := ret.Get(0).(func() config.CompositeEstimator); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.CompositeEstimator)
+		}
+	}
+
+	return r0
+}
+
+// GasEstimator_Composite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Composite'
+type GasEstimator_Composite_Call struct {
+	*mock.Call
+}
+
+// Composite is a helper method to define mock.On call
+func (_e *GasEstimator_Expecter) Composite() *GasEstimator_Composite_Call {
+	return &GasEstimator_Composite_Call{Call: _e.mock.On("Composite")}
+}
+
+func (_c *GasEstimator_Composite_Call) Run(run func()) *GasEstimator_Composite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GasEstimator_Composite_Call) Return(_a0 config.CompositeEstimator) *GasEstimator_Composite_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GasEstimator_Composite_Call) RunAndReturn(run func() config.CompositeEstimator) *GasEstimator_Composite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DAOracle provides a mock function with no fields
 func (_m *GasEstimator) DAOracle() config.DAOracle {
 	ret := _m.Called()
@@ -6,8 +6,10 @@ import (
 	"net/url"
 	"slices"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
 	"github.com/pelletier/go-toml/v2"
 	"github.com/shopspring/decimal"
@@ -533,6 +535,8 @@ type Transactions struct {
 
 	AutoPurge            AutoPurgeConfig            `toml:",omitempty"`
 	TransactionManagerV2 TransactionManagerV2Config `toml:",omitempty"`
+	LifecycleEvents      LifecycleEventsConfig      `toml:",omitempty"`
+	Batching             BatchingConfig             `toml:",omitempty"`
 }
 
 func (t *Transactions) setFrom(f *Transactions) {
@@ -562,6 +566,8 @@ func (t *Transactions) setFrom(f *Transactions) {
 	}
 	t.AutoPurge.setFrom(&f.AutoPurge)
 	t.TransactionManagerV2.setFrom(&f.TransactionManagerV2)
+	t.LifecycleEvents.setFrom(&f.LifecycleEvents)
+	t.Batching.setFrom(&f.Batching)
 }
 
 type AutoPurgeConfig struct {
@@ -591,6 +597,7 @@ type TransactionManagerV2Config struct {
 	BlockTime     *commonconfig.Duration `toml:",omitempty"`
 	CustomURL     *commonconfig.URL      `toml:",omitempty"`
 	DualBroadcast *bool                  `toml:",omitempty"`
+	Persistent    *bool                  `toml:",omitempty"`
 }
 
 func (t *TransactionManagerV2Config) setFrom(f *TransactionManagerV2Config) {
@@ -606,6 +613,9 @@ func (t *TransactionManagerV2Config) setFrom(f *TransactionManagerV2Config) {
 	if v := f.DualBroadcast; v != nil {
 		t.DualBroadcast = f.DualBroadcast
 	}
+	if v := f.Persistent; v != nil {
+		t.Persistent = f.Persistent
+	}
 }
 
 func (t *TransactionManagerV2Config) ValidateConfig() (err error) {
@@ -621,6 +631,84 @@ func (t *TransactionManagerV2Config) ValidateConfig() (err error) {
 	return
 }
 
+type LifecycleEventsConfig struct {
+	Enabled       *bool                      `toml:",omitempty"`
+	WebhookURL    *commonconfig.URL          `toml:",omitempty"`
+	WebhookSecret *commonconfig.SecretString `toml:",omitempty"`
+	FilePath      *string                    `toml:",omitempty"`
+}
+
+func (l *LifecycleEventsConfig) setFrom(f *LifecycleEventsConfig) {
+	if v := f.Enabled; v != nil {
+		l.Enabled = v
+	}
+	if v := f.WebhookURL; v != nil {
+		l.WebhookURL = v
+	}
+	if v := f.WebhookSecret; v != nil {
+		l.WebhookSecret = v
+	}
+	if v := f.FilePath; v != nil {
+		l.FilePath = v
+	}
+}
+
+func (l *LifecycleEventsConfig) ValidateConfig() (err error) {
+	if l.Enabled == nil || !*l.Enabled {
+		return
+	}
+	if l.WebhookURL != nil {
+		if scheme := l.WebhookURL.URL().Scheme; scheme != "http" && scheme != "https" {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "WebhookURL", Value: l.WebhookURL.String(), Msg: "must be an http or https URL"})
+		}
+	} else if l.WebhookSecret != nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "WebhookURL", Msg: "must be set if WebhookSecret is set"})
+	}
+	return
+}
+
+type BatchingConfig struct {
+	Enabled           *bool                  `toml:",omitempty"`
+	Window            *commonconfig.Duration `toml:",omitempty"`
+	MaxBatchSize      *uint32                `toml:",omitempty"`
+	Multicall3Address *types.EIP55Address    `toml:",omitempty"`
+}
+
+func (b *BatchingConfig) setFrom(f *BatchingConfig) {
+	if v := f.Enabled; v != nil {
+		b.Enabled = v
+	}
+	if v := f.Window; v != nil {
+		b.Window = v
+	}
+	if v := f.MaxBatchSize; v != nil {
+		b.MaxBatchSize = v
+	}
+	if v := f.Multicall3Address; v != nil {
+		b.Multicall3Address = v
+	}
+}
+
+func (b *BatchingConfig) ValidateConfig() (err error) {
+	if b.Enabled == nil || !*b.Enabled {
+		return
+	}
+	if b.Window == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "Window", Msg: "must be set if batching is enabled"})
+	} else if b.Window.Duration() <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Window", Value: b.Window.Duration(), Msg: "must be greater than 0 if batching is enabled"})
+	}
+	if b.MaxBatchSize == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "MaxBatchSize", Msg: "must be set if batching is enabled"})
+	} else if *b.MaxBatchSize < 2 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "MaxBatchSize", Value: *b.MaxBatchSize, Msg: "must be at least 2 if batching is enabled"})
+	}
+	if b.Multicall3Address == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "Multicall3Address", Msg: "must be set if batching is enabled"})
+	}
+	return
+}
+
 type OCR2 struct {
 	Automation Automation `toml:",omitempty"`
 }
@@ -671,13 +759,74 @@ func (m *Workflow) setFrom(f *Workflow) {
 }
 
 type BalanceMonitor struct {
-	Enabled *bool
+	Enabled         *bool
+	MinBalance      *assets.Wei
+	BurnRateWindow  *commonconfig.Duration
+	AlertWebhookURL *commonconfig.URL     `toml:",omitempty"`
+	Tokens          []BalanceMonitorToken `toml:",omitempty"`
+}
+
+// BalanceMonitorToken is an ERC-20 token whose balance is tracked for every key.
+type BalanceMonitorToken struct {
+	Symbol     *string
+	Address    *types.EIP55Address
+	Decimals   *uint8
+	MinBalance *decimal.Decimal
 }
 
 func (m *BalanceMonitor) setFrom(f *BalanceMonitor) {
 	if v := f.Enabled; v != nil {
 		m.Enabled = v
 	}
+	if v := f.MinBalance; v != nil {
+		m.MinBalance = v
+	}
+	if v := f.BurnRateWindow; v != nil {
+		m.BurnRateWindow = v
+	}
+	if v := f.AlertWebhookURL; v != nil {
+		m.AlertWebhookURL = v
+	}
+	if v := f.Tokens; v != nil {
+		m.Tokens = v
+	}
+}
+
+func (m *BalanceMonitor) ValidateConfig() (err error) {
+	if m.MinBalance != nil && m.MinBalance.IsNegative() {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "MinBalance", Value: m.MinBalance.String(), Msg: "must not be negative"})
+	}
+	if m.BurnRateWindow != nil && m.BurnRateWindow.Duration() <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BurnRateWindow", Value: m.BurnRateWindow, Msg: "must be greater than 0"})
+	}
+	if m.AlertWebhookURL != nil {
+		if scheme := m.AlertWebhookURL.URL().Scheme; scheme != "http" && scheme != "https" {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "AlertWebhookURL", Value: m.AlertWebhookURL.String(), Msg: "must be an http or https URL"})
+		}
+	}
+	addrs := map[string]struct{}{}
+	for i, t := range m.Tokens {
+		if t.Symbol == nil || *t.Symbol == "" {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: fmt.Sprintf("Tokens[%d].Symbol", i), Msg: "required for all tokens"})
+		}
+		if t.Address == nil {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: fmt.Sprintf("Tokens[%d].Address", i), Msg: "required for all tokens"})
+		} else {
+			addr := t.Address.String()
+			if _, ok := addrs[addr]; ok {
+				err = multierr.Append(err, commonconfig.NewErrDuplicate(fmt.Sprintf("Tokens[%d].Address", i), addr))
+			} else {
+				addrs[addr] = struct{}{}
+			}
+		}
+		if t.Decimals == nil {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: fmt.Sprintf("Tokens[%d].Decimals", i), Msg: "required for all tokens"})
+		}
+		if t.MinBalance != nil && t.MinBalance.IsNegative() {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: fmt.Sprintf("Tokens[%d].MinBalance", i), Value: t.MinBalance.String(), Msg: "must not be negative"})
+		}
+	}
+	return
 }
 
 type GasEstimator struct {
@@ -708,6 +857,7 @@ type GasEstimator struct {
 
 	BlockHistory BlockHistoryEstimator `toml:",omitempty"`
 	FeeHistory   FeeHistoryEstimator   `toml:",omitempty"`
+	Composite    CompositeEstimator    `toml:",omitempty"`
 	DAOracle     DAOracle              `toml:",omitempty"`
 }
 
@@ -741,10 +891,14 @@ func (e *GasEstimator) ValidateConfig() (err error) {
 		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "PriceMax", Value: e.PriceMin,
 			Msg: "must be greater than or equal to PriceDefault"})
 	}
-	if *e.Mode == "BlockHistory" && *e.BlockHistory.BlockHistorySize <= 0 {
+	usesBlockHistory := *e.Mode == "BlockHistory" || (*e.Mode == "Composite" && slices.Contains(e.Composite.Modes, "BlockHistory"))
+	if usesBlockHistory && *e.BlockHistory.BlockHistorySize <= 0 {
 		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BlockHistory.BlockHistorySize", Value: *e.BlockHistory.BlockHistorySize,
 			Msg: "must be greater than or equal to 1 with BlockHistory Mode"})
 	}
+	if *e.Mode == "Composite" {
+		err = multierr.Append(err, e.Composite.validate())
+	}
 
 	return
 }
@@ -807,6 +961,7 @@ func (e *GasEstimator) setFrom(f *GasEstimator) {
 	e.LimitJobType.setFrom(&f.LimitJobType)
 	e.BlockHistory.setFrom(&f.BlockHistory)
 	e.FeeHistory.setFrom(&f.FeeHistory)
+	e.Composite.setFrom(&f.Composite)
 	e.DAOracle.setFrom(&f.DAOracle)
 }
 
@@ -880,6 +1035,62 @@ func (u *FeeHistoryEstimator) setFrom(f *FeeHistoryEstimator) {
 	}
 }
 
+// CompositeAggregations are the ways the Composite gas estimator can combine the prices proposed by its sources.
+var CompositeAggregations = []string{"Median", "Max", "MinWithFloor"}
+
+type CompositeEstimator struct {
+	Modes       []string `toml:",omitempty"`
+	Aggregation *string
+	PriceFloor  *assets.Wei `toml:",omitempty"`
+	MinSources  *uint32
+}
+
+func (c *CompositeEstimator) setFrom(f *CompositeEstimator) {
+	if v := f.Modes; v != nil {
+		c.Modes = v
+	}
+	if v := f.Aggregation; v != nil {
+		c.Aggregation = v
+	}
+	if v := f.PriceFloor; v != nil {
+		c.PriceFloor = v
+	}
+	if v := f.MinSources; v != nil {
+		c.MinSources = v
+	}
+}
+
+// validate is called by GasEstimator.ValidateConfig, since the Composite settings only apply in Composite Mode.
+func (c *CompositeEstimator) validate() (err error) {
+	if len(c.Modes) < 2 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Composite.Modes", Value: c.Modes,
+			Msg: "must list at least two estimators with Composite Mode"})
+	}
+	seen := make(map[string]struct{}, len(c.Modes))
+	for _, mode := range c.Modes {
+		switch mode {
+		case "Arbitrum", "BlockHistory", "FixedPrice", "L2Suggested", "SuggestedPrice", "FeeHistory":
+		default:
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Composite.Modes", Value: mode,
+				Msg: "must be one of Arbitrum, BlockHistory, FixedPrice, SuggestedPrice or FeeHistory"})
+		}
+		if _, ok := seen[mode]; ok {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Composite.Modes", Value: mode,
+				Msg: "must not be listed more than once"})
+		}
+		seen[mode] = struct{}{}
+	}
+	if c.Aggregation != nil && !slices.Contains(CompositeAggregations, *c.Aggregation) {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Composite.Aggregation", Value: *c.Aggregation,
+			Msg: "must be one of " + strings.Join(CompositeAggregations, ", ")})
+	}
+	if c.MinSources != nil && (*c.MinSources < 1 || int(*c.MinSources) > len(c.Modes)) {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Composite.MinSources", Value: *c.MinSources,
+			Msg: "must be between 1 and the number of Modes"})
+	}
+	return
+}
+
 type DAOracle struct {
 	OracleType             *DAOracleType
 	OracleAddress          *types.EIP55Address
@@ -951,8 +1162,9 @@ func (ks KeySpecificConfig) ValidateConfig() (err error) {
 }
 
 type KeySpecific struct {
-	Key          *types.EIP55Address
-	GasEstimator KeySpecificGasEstimator `toml:",omitempty"`
+	Key            *types.EIP55Address
+	GasEstimator   KeySpecificGasEstimator   `toml:",omitempty"`
+	BalanceMonitor KeySpecificBalanceMonitor `toml:",omitempty"`
 }
 
 type KeySpecificGasEstimator struct {
@@ -965,6 +1177,16 @@ func (e *KeySpecificGasEstimator) setFrom(f *KeySpecificGasEstimator) {
 	}
 }
 
+type KeySpecificBalanceMonitor struct {
+	MinBalance *assets.Wei
+}
+
+func (m *KeySpecificBalanceMonitor) setFrom(f *KeySpecificBalanceMonitor) {
+	if v := f.MinBalance; v != nil {
+		m.MinBalance = v
+	}
+}
+
 type HeadTracker struct {
 	HistoryDepth            *uint32
 	MaxBufferSize           *uint32
@@ -1093,6 +1315,7 @@ type NodePool struct {
 	NewHeadsPollInterval           *commonconfig.Duration
 	VerifyChainID                  *bool
 	ExternalRequestMaxResponseSize *uint32
+	ConsistencyProbe               ConsistencyProbe `toml:",omitempty"`
 }
 
 func (p *NodePool) setFrom(f *NodePool) {
@@ -1139,6 +1362,7 @@ func (p *NodePool) setFrom(f *NodePool) {
 	}
 
 	p.Errors.setFrom(&f.Errors)
+	p.ConsistencyProbe.setFrom(&f.ConsistencyProbe)
 }
 
 func (p *NodePool) ValidateConfig(finalityTagEnabled *bool) (err error) {
@@ -1152,6 +1376,67 @@ func (p *NodePool) ValidateConfig(finalityTagEnabled *bool) (err error) {
 				Msg: "must be greater than 0"})
 		}
 	}
+	err = multierr.Append(err, p.ConsistencyProbe.validate())
+	return
+}
+
+type ConsistencyProbe struct {
+	Enabled            *bool
+	Interval           *commonconfig.Duration
+	BlockDepth         *uint32
+	StaleHeadThreshold *uint32
+	QuarantineScore    *uint32
+	Calls              []ConsistencyProbeCall `toml:",omitempty"`
+}
+
+// ConsistencyProbeCall is a read-only contract call whose result is compared across the RPCs of the pool.
+type ConsistencyProbeCall struct {
+	To   *types.EIP55Address
+	Data *hexutil.Bytes
+}
+
+func (c *ConsistencyProbe) setFrom(f *ConsistencyProbe) {
+	if v := f.Enabled; v != nil {
+		c.Enabled = v
+	}
+	if v := f.Interval; v != nil {
+		c.Interval = v
+	}
+	if v := f.BlockDepth; v != nil {
+		c.BlockDepth = v
+	}
+	if v := f.StaleHeadThreshold; v != nil {
+		c.StaleHeadThreshold = v
+	}
+	if v := f.QuarantineScore; v != nil {
+		c.QuarantineScore = v
+	}
+	if v := f.Calls; v != nil {
+		c.Calls = v
+	}
+}
+
+// validate is called by NodePool.ValidateConfig, since the probe settings are only checked when the probe is enabled.
+func (c *ConsistencyProbe) validate() (err error) {
+	if c.Enabled == nil || !*c.Enabled {
+		return
+	}
+	if c.Interval == nil || c.Interval.Duration() <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "ConsistencyProbe.Interval", Value: c.Interval,
+			Msg: "must be greater than 0"})
+	}
+	if c.QuarantineScore != nil && *c.QuarantineScore > 100 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "ConsistencyProbe.QuarantineScore", Value: *c.QuarantineScore,
+			Msg: "must be between 0 and 100"})
+	}
+	for i, call := range c.Calls {
+		if call.To == nil {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: fmt.Sprintf("ConsistencyProbe.Calls[%d].To", i), Msg: "required for all calls"})
+		}
+		if call.Data == nil || len(*call.Data) == 0 {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: fmt.Sprintf("ConsistencyProbe.Calls[%d].Data", i), Msg: "required for all calls"})
+		}
+	}
 	return
 }
 
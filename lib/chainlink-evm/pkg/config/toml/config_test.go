@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/kylelemons/godebug/diff"
 	"github.com/pelletier/go-toml/v2"
 	"github.com/shopspring/decimal"
@@ -48,6 +49,38 @@ func TestEVMConfig_ValidateConfig(t *testing.T) {
 	}
 }
 
+func TestGasEstimator_ValidateConfig_Composite(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		composite CompositeEstimator
+		errs      []string
+	}{
+		{"valid", CompositeEstimator{Modes: []string{"BlockHistory", "SuggestedPrice"}, MinSources: ptr[uint32](2)}, nil},
+		{"single mode", CompositeEstimator{Modes: []string{"BlockHistory"}},
+			[]string{"Composite.Modes: invalid value ([BlockHistory]): must list at least two estimators with Composite Mode"}},
+		{"unknown and duplicate modes", CompositeEstimator{Modes: []string{"Composite", "FeeHistory", "FeeHistory"}},
+			[]string{"Composite.Modes: invalid value (Composite)", "Composite.Modes: invalid value (FeeHistory): must not be listed more than once"}},
+		{"unknown aggregation", CompositeEstimator{Modes: []string{"BlockHistory", "FeeHistory"}, Aggregation: ptr("Mean")},
+			[]string{"Composite.Aggregation: invalid value (Mean): must be one of Median, Max, MinWithFloor"}},
+		{"too many sources", CompositeEstimator{Modes: []string{"BlockHistory", "FeeHistory"}, MinSources: ptr[uint32](3)},
+			[]string{"Composite.MinSources: invalid value (3): must be between 1 and the number of Modes"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ge := Defaults(nil).GasEstimator
+			ge.Mode = ptr("Composite")
+			ge.Composite.setFrom(&tt.composite)
+			err := ge.ValidateConfig()
+			if len(tt.errs) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			for _, msg := range tt.errs {
+				require.ErrorContains(t, err, msg)
+			}
+		})
+	}
+}
+
 func TestDefaults_fieldsNotNil(t *testing.T) {
 	unknown := Defaults(nil)
 
@@ -64,11 +97,17 @@ func TestDefaults_fieldsNotNil(t *testing.T) {
 	unknown.Transactions.TransactionManagerV2.BlockTime = new(config.Duration)
 	unknown.Transactions.TransactionManagerV2.CustomURL = new(config.URL)
 	unknown.Transactions.TransactionManagerV2.DualBroadcast = ptr(false)
+	unknown.Transactions.LifecycleEvents.WebhookURL = new(config.URL)
+	unknown.Transactions.LifecycleEvents.WebhookSecret = new(config.SecretString)
+	unknown.Transactions.LifecycleEvents.FilePath = ptr("")
+	unknown.Transactions.Batching.Multicall3Address = new(types.EIP55Address)
 	unknown.Transactions.AutoPurge.Threshold = ptr(uint32(0))
+	unknown.BalanceMonitor.AlertWebhookURL = new(config.URL)
 	unknown.Transactions.AutoPurge.MinAttempts = ptr(uint32(0))
 	unknown.Transactions.AutoPurge.DetectionApiUrl = new(config.URL)
 	unknown.GasEstimator.BlockHistory.EIP1559FeeCapBufferBlocks = ptr[uint16](10)
 	unknown.GasEstimator.SenderAddress = asEIP55Address(t, "0xae4E781a6218A8031764928E88d457937A954fC3")
+	unknown.GasEstimator.Composite.PriceFloor = new(assets.Wei)
 	oracleType := DAOracleOPStack
 	unknown.GasEstimator.DAOracle.OracleType = &oracleType
 	unknown.GasEstimator.DAOracle.OracleAddress = new(types.EIP55Address)
@@ -104,6 +143,73 @@ func TestDefaults_fieldsNotNil(t *testing.T) {
 	configtest.AssertFieldsNotNil(t, unknown)
 }
 
+func TestNodePool_ValidateConfig_ConsistencyProbe(t *testing.T) {
+	to := types.MustEIP55Address("0x2a3e23c6f242F5345320814aC8a1b4E58707D292")
+	data := hexutil.Bytes{0x31, 0x3c, 0xe5, 0x67}
+	for _, tt := range []struct {
+		name  string
+		probe ConsistencyProbe
+		errs  []string
+	}{
+		{"disabled", ConsistencyProbe{Enabled: ptr(false), Interval: config.MustNewDuration(0)}, nil},
+		{"valid", ConsistencyProbe{Enabled: ptr(true), Calls: []ConsistencyProbeCall{{To: &to, Data: &data}}}, nil},
+		{"zero interval", ConsistencyProbe{Enabled: ptr(true), Interval: config.MustNewDuration(0)},
+			[]string{"ConsistencyProbe.Interval: invalid value (0s): must be greater than 0"}},
+		{"score out of range", ConsistencyProbe{Enabled: ptr(true), QuarantineScore: ptr[uint32](101)},
+			[]string{"ConsistencyProbe.QuarantineScore: invalid value (101): must be between 0 and 100"}},
+		{"incomplete call", ConsistencyProbe{Enabled: ptr(true), Calls: []ConsistencyProbeCall{{To: &to}, {Data: &data}}},
+			[]string{"ConsistencyProbe.Calls[0].Data: missing: required for all calls", "ConsistencyProbe.Calls[1].To: missing: required for all calls"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			np := Defaults(nil).NodePool
+			np.ConsistencyProbe.setFrom(&tt.probe)
+			err := np.ValidateConfig(ptr(false))
+			if len(tt.errs) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			for _, msg := range tt.errs {
+				require.ErrorContains(t, err, msg)
+			}
+		})
+	}
+}
+
+func TestBalanceMonitor_ValidateConfig(t *testing.T) {
+	to := types.MustEIP55Address("0x2a3e23c6f242F5345320814aC8a1b4E58707D292")
+	for _, tt := range []struct {
+		name string
+		bm   BalanceMonitor
+		errs []string
+	}{
+		{"valid", BalanceMonitor{AlertWebhookURL: config.MustParseURL("https://alerts.test"),
+			Tokens: []BalanceMonitorToken{{Symbol: ptr("LINK"), Address: &to, Decimals: ptr[uint8](18)}}}, nil},
+		{"zero window", BalanceMonitor{BurnRateWindow: config.MustNewDuration(0)},
+			[]string{"BurnRateWindow: invalid value (0s): must be greater than 0"}},
+		{"webhook scheme", BalanceMonitor{AlertWebhookURL: config.MustParseURL("ftp://alerts.test")},
+			[]string{"AlertWebhookURL: invalid value (ftp://alerts.test): must be an http or https URL"}},
+		{"incomplete token", BalanceMonitor{Tokens: []BalanceMonitorToken{{Address: &to}}},
+			[]string{"Tokens[0].Symbol: missing: required for all tokens", "Tokens[0].Decimals: missing: required for all tokens"}},
+		{"duplicate token", BalanceMonitor{Tokens: []BalanceMonitorToken{
+			{Symbol: ptr("LINK"), Address: &to, Decimals: ptr[uint8](18)},
+			{Symbol: ptr("LINK2"), Address: &to, Decimals: ptr[uint8](18)},
+		}}, []string{"Tokens[1].Address: invalid value (0x2a3e23c6f242F5345320814aC8a1b4E58707D292): duplicate - must be unique"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			bm := Defaults(nil).BalanceMonitor
+			bm.setFrom(&tt.bm)
+			err := bm.ValidateConfig()
+			if len(tt.errs) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			for _, msg := range tt.errs {
+				require.ErrorContains(t, err, msg)
+			}
+		})
+	}
+}
+
 func TestDocs(t *testing.T) {
 	t.Run("complete", func(t *testing.T) {
 		configtest.AssertDocsTOMLComplete[EVMConfig](t, docsTOML)
@@ -119,10 +225,26 @@ func TestDocs(t *testing.T) {
 		// clean up KeySpecific as a special case
 		require.Len(t, docDefaults.KeySpecific, 1)
 		ks := KeySpecific{Key: new(types.EIP55Address),
-			GasEstimator: KeySpecificGasEstimator{PriceMax: new(assets.Wei)}}
+			GasEstimator:   KeySpecificGasEstimator{PriceMax: new(assets.Wei)},
+			BalanceMonitor: KeySpecificBalanceMonitor{MinBalance: new(assets.Wei)}}
 		require.Equal(t, ks, docDefaults.KeySpecific[0])
 		docDefaults.KeySpecific = nil
 
+		// clean up ConsistencyProbe.Calls as a special case
+		require.Len(t, docDefaults.NodePool.ConsistencyProbe.Calls, 1)
+		call := ConsistencyProbeCall{To: new(types.EIP55Address), Data: new(hexutil.Bytes)}
+		require.Equal(t, call, docDefaults.NodePool.ConsistencyProbe.Calls[0])
+		docDefaults.NodePool.ConsistencyProbe.Calls = nil
+
+		// clean up BalanceMonitor.Tokens as a special case
+		require.Len(t, docDefaults.BalanceMonitor.Tokens, 1)
+		token := BalanceMonitorToken{Symbol: ptr(""), Address: new(types.EIP55Address), Decimals: ptr[uint8](0), MinBalance: new(decimal.Decimal)}
+		require.Equal(t, token, docDefaults.BalanceMonitor.Tokens[0])
+		docDefaults.BalanceMonitor.Tokens = nil
+
+		// BalanceMonitor alerts are always logged, the webhook is optional
+		docDefaults.BalanceMonitor.AlertWebhookURL = nil
+
 		// EVM.GasEstimator.BumpTxDepth doesn't have a constant default - it is derived from another field
 		require.Zero(t, *docDefaults.GasEstimator.BumpTxDepth)
 		docDefaults.GasEstimator.BumpTxDepth = nil
@@ -167,12 +289,24 @@ func TestDocs(t *testing.T) {
 		docDefaults.Transactions.TransactionManagerV2.CustomURL = nil
 		docDefaults.Transactions.TransactionManagerV2.DualBroadcast = nil
 
+		// LifecycleEvents sinks are optional
+		docDefaults.Transactions.LifecycleEvents.WebhookURL = nil
+		docDefaults.Transactions.LifecycleEvents.WebhookSecret = nil
+		docDefaults.Transactions.LifecycleEvents.FilePath = nil
+
+		// Batching Multicall3Address is only required if batching is enabled
+		docDefaults.Transactions.Batching.Multicall3Address = nil
+
 		// Fallback DA oracle is not set
 		docDefaults.GasEstimator.DAOracle = DAOracle{}
 
 		// GasEstimator SendAddress is only set if EstimateLimit is enabled
 		docDefaults.GasEstimator.SenderAddress = nil
 
+		// Composite.PriceFloor is nilable
+		require.Zero(t, *docDefaults.GasEstimator.Composite.PriceFloor)
+		docDefaults.GasEstimator.Composite.PriceFloor = nil
+
 		fallbackDefaults := Defaults(nil)
 		assertTOML(t, fallbackDefaults, docDefaults.Chain)
 	})
@@ -187,7 +321,16 @@ var fullConfig = EVMConfig{
 	Chain: Chain{
 		AutoCreateKey: ptr(false),
 		BalanceMonitor: BalanceMonitor{
-			Enabled: ptr(true),
+			Enabled:         ptr(true),
+			MinBalance:      assets.Ether(1),
+			BurnRateWindow:  config.MustNewDuration(12 * time.Hour),
+			AlertWebhookURL: config.MustParseURL("http://alerts.org"),
+			Tokens: []BalanceMonitorToken{{
+				Symbol:     ptr("LINK"),
+				Address:    ptr(types.MustEIP55Address("0x2a3e23c6f242F5345320814aC8a1b4E58707D292")),
+				Decimals:   ptr[uint8](18),
+				MinBalance: ptr(decimal.RequireFromString("10.5")),
+			}},
 		},
 		BlockBackfillDepth:   ptr[uint32](100),
 		BlockBackfillSkip:    ptr(true),
@@ -244,6 +387,12 @@ var fullConfig = EVMConfig{
 			FeeHistory: FeeHistoryEstimator{
 				CacheTimeout: config.MustNewDuration(time.Second),
 			},
+			Composite: CompositeEstimator{
+				Modes:       []string{"BlockHistory", "FeeHistory"},
+				Aggregation: ptr("MinWithFloor"),
+				PriceFloor:  assets.GWei(2),
+				MinSources:  ptr[uint32](2),
+			},
 		},
 
 		KeySpecific: []KeySpecific{
@@ -252,6 +401,9 @@ var fullConfig = EVMConfig{
 				GasEstimator: KeySpecificGasEstimator{
 					PriceMax: assets.NewWei(new(stdbig.Int).SetBytes([]byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF})),
 				},
+				BalanceMonitor: KeySpecificBalanceMonitor{
+					MinBalance: assets.UEther(500_000),
+				},
 			},
 		},
 
@@ -291,6 +443,19 @@ var fullConfig = EVMConfig{
 				DualBroadcast: ptr(true),
 				BlockTime:     config.MustNewDuration(42 * time.Second),
 				CustomURL:     config.MustParseURL("http://txs.org"),
+				Persistent:    ptr(true),
+			},
+			LifecycleEvents: LifecycleEventsConfig{
+				Enabled:       ptr(true),
+				WebhookURL:    config.MustParseURL("http://events.org"),
+				WebhookSecret: config.NewSecretString("events-secret"),
+				FilePath:      ptr("tx-events.jsonl"),
+			},
+			Batching: BatchingConfig{
+				Enabled:           ptr(true),
+				Window:            config.MustNewDuration(2 * time.Second),
+				MaxBatchSize:      ptr[uint32](10),
+				Multicall3Address: ptr(types.MustEIP55Address("0xcA11bde05977b3631167028862bE2a173976CA11")),
 			},
 		},
 
@@ -335,6 +500,17 @@ var fullConfig = EVMConfig{
 				TooManyResults:                    ptr[string]("(: |^)too many results"),
 				MissingBlocks:                     ptr[string]("(: |^)invalid block range"),
 			},
+			ConsistencyProbe: ConsistencyProbe{
+				Enabled:            ptr(true),
+				Interval:           config.MustNewDuration(30 * time.Second),
+				BlockDepth:         ptr[uint32](5),
+				StaleHeadThreshold: ptr[uint32](15),
+				QuarantineScore:    ptr[uint32](40),
+				Calls: []ConsistencyProbeCall{{
+					To:   ptr(types.MustEIP55Address("0x2a3e23c6f242F5345320814aC8a1b4E58707D292")),
+					Data: ptr(hexutil.Bytes(hexutil.MustDecode("0x313ce567"))),
+				}},
+			},
 		},
 		OCR: OCR{
 			ContractConfirmations:              ptr[uint16](11),
@@ -277,6 +277,7 @@ func (c *Chain) SetFrom(f *Chain) {
 				c.KeySpecific = append(c.KeySpecific, v)
 			} else {
 				c.KeySpecific[i].GasEstimator.setFrom(&v.GasEstimator)
+				c.KeySpecific[i].BalanceMonitor.setFrom(&v.BalanceMonitor)
 			}
 		}
 	}
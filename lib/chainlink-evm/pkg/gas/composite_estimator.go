@@ -0,0 +1,313 @@
+package gas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-framework/chains/fees"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink-evm/pkg/gas/rollups"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+)
+
+// metrics are thread safe
+var (
+	promCompositeEstimatorSourceGasPrice = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_updater_composite_source_gas_price",
+		Help: "Gas price (in Wei) last proposed by each source of the composite estimator. For EIP-1559 fees this is the fee cap",
+	},
+		[]string{"evmChainID", "source"},
+	)
+	promCompositeEstimatorSourceTipCap = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_updater_composite_source_tip_cap",
+		Help: "Tip cap (in Wei) last proposed by each source of the composite estimator",
+	},
+		[]string{"evmChainID", "source"},
+	)
+	promCompositeEstimatorSourceRejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gas_updater_composite_source_rejected",
+		Help: "Number of proposals discarded by the composite estimator, by source and reason",
+	},
+		[]string{"evmChainID", "source", "reason"},
+	)
+)
+
+const (
+	CompositeAggregationMedian       = "Median"
+	CompositeAggregationMax          = "Max"
+	CompositeAggregationMinWithFloor = "MinWithFloor"
+)
+
+var _ EvmEstimator = (*CompositeEstimator)(nil)
+
+var errOutOfBounds = errors.New("out of bounds")
+
+type CompositeEstimatorConfig struct {
+	Aggregation string
+	// PriceFloor is the lowest price MinWithFloor may return
+	PriceFloor *assets.Wei
+	MinSources uint32
+
+	// Proposals outside [PriceMin, maxGasPriceWei] are discarded. For EIP-1559 fees the bounds apply to the fee cap.
+	PriceMin  *assets.Wei
+	TipCapMin *assets.Wei
+}
+
+// CompositeSource is an estimator used by the CompositeEstimator, along with the name it is reported under.
+type CompositeSource struct {
+	Name      string
+	Estimator EvmEstimator
+}
+
+// CompositeEstimator queries several estimators and combines the prices they propose, so that a single faulty source
+// can neither stall transactions nor make the node overpay.
+type CompositeEstimator struct {
+	services.StateMachine
+
+	logger   logger.SugaredLogger
+	cfg      CompositeEstimatorConfig
+	chainID  string
+	sources  []CompositeSource
+	l1Oracle rollups.L1Oracle
+}
+
+func NewCompositeEstimator(lggr logger.Logger, cfg CompositeEstimatorConfig, chainID *big.Int, sources []CompositeSource, l1Oracle rollups.L1Oracle) *CompositeEstimator {
+	return &CompositeEstimator{
+		logger:   logger.Sugared(logger.Named(lggr, "CompositeEstimator")),
+		cfg:      cfg,
+		chainID:  chainID.String(),
+		sources:  sources,
+		l1Oracle: l1Oracle,
+	}
+}
+
+func (c *CompositeEstimator) Name() string {
+	return c.logger.Name()
+}
+
+func (c *CompositeEstimator) L1Oracle() rollups.L1Oracle {
+	return c.l1Oracle
+}
+
+func (c *CompositeEstimator) Start(ctx context.Context) error {
+	return c.StartOnce("CompositeEstimator", func() error {
+		var ms services.MultiStart
+		for _, s := range c.sources {
+			if err := ms.Start(ctx, s.Estimator); err != nil {
+				return fmt.Errorf("failed to start %s estimator: %w", s.Name, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (c *CompositeEstimator) Close() error {
+	return c.StopOnce("CompositeEstimator", func() error {
+		var errs []error
+		for _, s := range c.sources {
+			errs = append(errs, s.Estimator.Close())
+		}
+		return errors.Join(errs...)
+	})
+}
+
+func (c *CompositeEstimator) HealthReport() map[string]error {
+	report := map[string]error{c.Name(): c.Healthy()}
+	for _, s := range c.sources {
+		services.CopyHealth(report, s.Estimator.HealthReport())
+	}
+	return report
+}
+
+func (c *CompositeEstimator) OnNewLongestChain(ctx context.Context, head *evmtypes.Head) {
+	for _, s := range c.sources {
+		s.Estimator.OnNewLongestChain(ctx, head)
+	}
+}
+
+func (c *CompositeEstimator) GetLegacyGas(ctx context.Context, calldata []byte, gasLimit uint64, maxGasPriceWei *assets.Wei, opts ...fees.Opt) (*assets.Wei, uint64, error) {
+	return c.legacy("GetLegacyGas", maxGasPriceWei, func(e EvmEstimator) (*assets.Wei, uint64, error) {
+		return e.GetLegacyGas(ctx, calldata, gasLimit, maxGasPriceWei, opts...)
+	})
+}
+
+func (c *CompositeEstimator) BumpLegacyGas(ctx context.Context, originalGasPrice *assets.Wei, gasLimit uint64, maxGasPriceWei *assets.Wei, attempts []EvmPriorAttempt) (*assets.Wei, uint64, error) {
+	return c.legacy("BumpLegacyGas", maxGasPriceWei, func(e EvmEstimator) (*assets.Wei, uint64, error) {
+		return e.BumpLegacyGas(ctx, originalGasPrice, gasLimit, maxGasPriceWei, attempts)
+	})
+}
+
+func (c *CompositeEstimator) GetDynamicFee(ctx context.Context, maxGasPriceWei *assets.Wei) (DynamicFee, error) {
+	return c.dynamic("GetDynamicFee", maxGasPriceWei, func(e EvmEstimator) (DynamicFee, error) {
+		return e.GetDynamicFee(ctx, maxGasPriceWei)
+	})
+}
+
+func (c *CompositeEstimator) BumpDynamicFee(ctx context.Context, original DynamicFee, maxGasPriceWei *assets.Wei, attempts []EvmPriorAttempt) (DynamicFee, error) {
+	return c.dynamic("BumpDynamicFee", maxGasPriceWei, func(e EvmEstimator) (DynamicFee, error) {
+		return e.BumpDynamicFee(ctx, original, maxGasPriceWei, attempts)
+	})
+}
+
+type legacyProposal struct {
+	gasPrice *assets.Wei
+	gasLimit uint64
+	err      error
+}
+
+func (c *CompositeEstimator) legacy(method string, maxGasPriceWei *assets.Wei, estimate func(EvmEstimator) (*assets.Wei, uint64, error)) (gasPrice *assets.Wei, gasLimit uint64, err error) {
+	if !c.IfStarted(func() {
+		proposals := make([]legacyProposal, len(c.sources))
+		c.query(func(i int, e EvmEstimator) {
+			p := &proposals[i]
+			p.gasPrice, p.gasLimit, p.err = estimate(e)
+		})
+
+		var prices []*assets.Wei
+		for i, p := range proposals {
+			source := c.sources[i].Name
+			if p.err == nil && p.gasPrice == nil {
+				p.err = errors.New("no gas price proposed")
+			}
+			if p.err == nil {
+				promCompositeEstimatorSourceGasPrice.WithLabelValues(c.chainID, source).Set(float64(p.gasPrice.Int64()))
+				p.err = c.checkBounds(p.gasPrice, maxGasPriceWei)
+			}
+			if p.err != nil {
+				c.reject(method, source, p.err)
+				continue
+			}
+			prices = append(prices, p.gasPrice)
+			gasLimit = max(gasLimit, p.gasLimit)
+		}
+		if err = c.checkQuorum(method, len(prices)); err != nil {
+			return
+		}
+		gasPrice = c.aggregate(prices, c.priceFloor())
+		c.logger.Debugw(method, "gasPrice", gasPrice, "gasLimit", gasLimit, "sources", len(prices))
+	}) {
+		return nil, 0, errors.New("estimator is not started")
+	}
+	return
+}
+
+type dynamicProposal struct {
+	fee DynamicFee
+	err error
+}
+
+func (c *CompositeEstimator) dynamic(method string, maxGasPriceWei *assets.Wei, estimate func(EvmEstimator) (DynamicFee, error)) (fee DynamicFee, err error) {
+	if !c.IfStarted(func() {
+		proposals := make([]dynamicProposal, len(c.sources))
+		c.query(func(i int, e EvmEstimator) {
+			p := &proposals[i]
+			p.fee, p.err = estimate(e)
+		})
+
+		var feeCaps, tipCaps []*assets.Wei
+		for i, p := range proposals {
+			source := c.sources[i].Name
+			if p.err == nil && (p.fee.GasFeeCap == nil || p.fee.GasTipCap == nil) {
+				p.err = errors.New("no dynamic fee proposed")
+			}
+			if p.err == nil {
+				promCompositeEstimatorSourceGasPrice.WithLabelValues(c.chainID, source).Set(float64(p.fee.GasFeeCap.Int64()))
+				promCompositeEstimatorSourceTipCap.WithLabelValues(c.chainID, source).Set(float64(p.fee.GasTipCap.Int64()))
+				p.err = c.checkBounds(p.fee.GasFeeCap, maxGasPriceWei)
+			}
+			if p.err != nil {
+				c.reject(method, source, p.err)
+				continue
+			}
+			feeCaps = append(feeCaps, p.fee.GasFeeCap)
+			tipCaps = append(tipCaps, p.fee.GasTipCap)
+		}
+		if err = c.checkQuorum(method, len(feeCaps)); err != nil {
+			return
+		}
+		fee.GasFeeCap = c.aggregate(feeCaps, c.priceFloor())
+		fee.GasTipCap = c.aggregate(tipCaps, c.cfg.TipCapMin)
+		// the tip cap is aggregated independently, so it might now exceed the fee cap
+		if fee.GasTipCap.Cmp(fee.GasFeeCap) > 0 {
+			fee.GasTipCap = fee.GasFeeCap
+		}
+		c.logger.Debugw(method, "gasFeeCap", fee.GasFeeCap, "gasTipCap", fee.GasTipCap, "sources", len(feeCaps))
+	}) {
+		return fee, errors.New("estimator is not started")
+	}
+	return
+}
+
+// query calls fn for every source concurrently, so that a single slow source does not delay the others.
+func (c *CompositeEstimator) query(fn func(i int, e EvmEstimator)) {
+	var wg sync.WaitGroup
+	for i, s := range c.sources {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fn(i, s.Estimator)
+		}()
+	}
+	wg.Wait()
+}
+
+func (c *CompositeEstimator) checkBounds(price *assets.Wei, maxGasPriceWei *assets.Wei) error {
+	if price.Cmp(c.cfg.PriceMin) < 0 {
+		return fmt.Errorf("%w: proposed price %s is lower than the minimum gas price %s", errOutOfBounds, price, c.cfg.PriceMin)
+	}
+	if price.Cmp(maxGasPriceWei) > 0 {
+		return fmt.Errorf("%w: proposed price %s is higher than the maximum gas price %s", errOutOfBounds, price, maxGasPriceWei)
+	}
+	return nil
+}
+
+func (c *CompositeEstimator) reject(method, source string, err error) {
+	reason := "error"
+	if errors.Is(err, errOutOfBounds) {
+		reason = "out_of_bounds"
+	}
+	promCompositeEstimatorSourceRejected.WithLabelValues(c.chainID, source, reason).Inc()
+	c.logger.Warnw("Discarding gas estimate", "method", method, "source", source, "err", err)
+}
+
+func (c *CompositeEstimator) checkQuorum(method string, n int) error {
+	if n < int(c.cfg.MinSources) {
+		return fmt.Errorf("%s: only %d of %d estimators proposed a valid price, need at least %d", method, n, len(c.sources), c.cfg.MinSources)
+	}
+	return nil
+}
+
+func (c *CompositeEstimator) priceFloor() *assets.Wei {
+	if c.cfg.PriceFloor != nil {
+		return c.cfg.PriceFloor
+	}
+	return c.cfg.PriceMin
+}
+
+// aggregate combines prices according to the configured aggregation. floor only applies to MinWithFloor.
+func (c *CompositeEstimator) aggregate(prices []*assets.Wei, floor *assets.Wei) *assets.Wei {
+	slices.SortFunc(prices, func(a, b *assets.Wei) int { return a.Cmp(b) })
+	switch c.cfg.Aggregation {
+	case CompositeAggregationMax:
+		return prices[len(prices)-1]
+	case CompositeAggregationMinWithFloor:
+		return assets.WeiMax(prices[0], floor)
+	default:
+		mid := len(prices) / 2
+		if len(prices)%2 == 1 {
+			return prices[mid]
+		}
+		sum := new(big.Int).Add(prices[mid-1].ToInt(), prices[mid].ToInt())
+		return assets.NewWei(sum.Div(sum, big.NewInt(2)))
+	}
+}
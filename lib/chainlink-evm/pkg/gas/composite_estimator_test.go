@@ -0,0 +1,140 @@
+package gas_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink-evm/pkg/gas"
+	"github.com/smartcontractkit/chainlink-evm/pkg/gas/mocks"
+	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
+)
+
+func newCompositeSource(t *testing.T, name string) (gas.CompositeSource, *mocks.EvmEstimator) {
+	est := mocks.NewEvmEstimator(t)
+	est.On("Start", mock.Anything).Return(nil).Once()
+	est.On("Close").Return(nil).Once()
+	return gas.CompositeSource{Name: name, Estimator: est}, est
+}
+
+func newCompositeEstimator(t *testing.T, cfg gas.CompositeEstimatorConfig, legacyPrices ...any) *gas.CompositeEstimator {
+	var sources []gas.CompositeSource
+	for i, price := range legacyPrices {
+		source, est := newCompositeSource(t, string(rune('A'+i)))
+		if err, ok := price.(error); ok {
+			est.On("GetLegacyGas", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, uint64(0), err).Once()
+		} else {
+			est.On("GetLegacyGas", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(price.(*assets.Wei), uint64(21000), nil).Once()
+		}
+		sources = append(sources, source)
+	}
+	c := gas.NewCompositeEstimator(logger.Test(t), cfg, testutils.FixtureChainID, sources, nil)
+	servicetest.Run(t, c)
+	return c
+}
+
+func TestCompositeEstimator_GetLegacyGas(t *testing.T) {
+	t.Parallel()
+
+	maxPrice := assets.GWei(100)
+	cfg := gas.CompositeEstimatorConfig{
+		Aggregation: gas.CompositeAggregationMedian,
+		MinSources:  1,
+		PriceMin:    assets.GWei(1),
+		TipCapMin:   assets.GWei(1),
+	}
+
+	t.Run("median of an odd number of sources", func(t *testing.T) {
+		c := newCompositeEstimator(t, cfg, assets.GWei(10), assets.GWei(90), assets.GWei(20))
+		price, limit, err := c.GetLegacyGas(tests.Context(t), nil, 21000, maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(20), price)
+		assert.Equal(t, uint64(21000), limit)
+	})
+
+	t.Run("median of an even number of sources", func(t *testing.T) {
+		c := newCompositeEstimator(t, cfg, assets.GWei(10), assets.GWei(20))
+		price, _, err := c.GetLegacyGas(tests.Context(t), nil, 21000, maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(15), price)
+	})
+
+	t.Run("max", func(t *testing.T) {
+		cfg := cfg
+		cfg.Aggregation = gas.CompositeAggregationMax
+		c := newCompositeEstimator(t, cfg, assets.GWei(10), assets.GWei(30), assets.GWei(20))
+		price, _, err := c.GetLegacyGas(tests.Context(t), nil, 21000, maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(30), price)
+	})
+
+	t.Run("min with floor", func(t *testing.T) {
+		cfg := cfg
+		cfg.Aggregation = gas.CompositeAggregationMinWithFloor
+		cfg.PriceFloor = assets.GWei(15)
+		c := newCompositeEstimator(t, cfg, assets.GWei(10), assets.GWei(30))
+		price, _, err := c.GetLegacyGas(tests.Context(t), nil, 21000, maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(15), price)
+	})
+
+	t.Run("discards failing and out of bounds sources", func(t *testing.T) {
+		c := newCompositeEstimator(t, cfg, assert.AnError, assets.GWei(500), assets.NewWeiI(1), assets.GWei(40))
+		price, _, err := c.GetLegacyGas(tests.Context(t), nil, 21000, maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(40), price)
+	})
+
+	t.Run("fails without quorum", func(t *testing.T) {
+		cfg := cfg
+		cfg.MinSources = 2
+		c := newCompositeEstimator(t, cfg, assert.AnError, assets.GWei(40))
+		_, _, err := c.GetLegacyGas(tests.Context(t), nil, 21000, maxPrice)
+		require.ErrorContains(t, err, "only 1 of 2 estimators proposed a valid price, need at least 2")
+	})
+}
+
+func TestCompositeEstimator_GetDynamicFee(t *testing.T) {
+	t.Parallel()
+
+	cfg := gas.CompositeEstimatorConfig{
+		Aggregation: gas.CompositeAggregationMedian,
+		MinSources:  2,
+		PriceMin:    assets.GWei(1),
+		TipCapMin:   assets.GWei(1),
+	}
+	fees := []gas.DynamicFee{
+		{GasFeeCap: assets.GWei(10), GasTipCap: assets.GWei(2)},
+		{GasFeeCap: assets.GWei(20), GasTipCap: assets.GWei(30)},
+		{GasFeeCap: assets.GWei(30), GasTipCap: assets.GWei(25)},
+	}
+	var sources []gas.CompositeSource
+	for i, fee := range fees {
+		source, est := newCompositeSource(t, string(rune('A'+i)))
+		est.On("GetDynamicFee", mock.Anything, mock.Anything).Return(fee, nil).Once()
+		sources = append(sources, source)
+	}
+	c := gas.NewCompositeEstimator(logger.Test(t), cfg, testutils.FixtureChainID, sources, nil)
+	servicetest.Run(t, c)
+
+	fee, err := c.GetDynamicFee(tests.Context(t), assets.GWei(100))
+	require.NoError(t, err)
+	assert.Equal(t, assets.GWei(20), fee.GasFeeCap)
+	// the median tip cap is capped by the median fee cap
+	assert.Equal(t, assets.GWei(20), fee.GasTipCap)
+}
+
+func TestCompositeEstimator_NotStarted(t *testing.T) {
+	t.Parallel()
+
+	c := gas.NewCompositeEstimator(logger.Test(t), gas.CompositeEstimatorConfig{}, testutils.FixtureChainID, nil, nil)
+	_, _, err := c.GetLegacyGas(tests.Context(t), nil, 21000, assets.GWei(1))
+	require.ErrorContains(t, err, "estimator is not started")
+}
@@ -11,6 +11,8 @@ import (
 
 	context "context"
 
+	coretypes "github.com/ethereum/go-ethereum/core/types"
+
 	fees "github.com/smartcontractkit/chainlink-framework/chains/fees"
 
 	gas "github.com/smartcontractkit/chainlink-evm/pkg/gas"
@@ -309,6 +311,75 @@ func (_c *EvmFeeEstimator_GetMaxCost_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// GetSetCodeFee provides a mock function with given fields: ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, authorizationList
+func (_m *EvmFeeEstimator) GetSetCodeFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress *common.Address, toAddress *common.Address, authorizationList []coretypes.SetCodeAuthorization) (gas.EvmFee, uint64, error) {
+	ret := _m.Called(ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, authorizationList)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSetCodeFee")
+	}
+
+	var r0 gas.EvmFee
+	var r1 uint64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, uint64, *assets.Wei, *common.Address, *common.Address, []coretypes.SetCodeAuthorization) (gas.EvmFee, uint64, error)); ok {
+		return rf(ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, authorizationList)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, uint64, *assets.Wei, *common.Address, *common.Address, []coretypes.SetCodeAuthorization) gas.EvmFee); ok {
+		r0 = rf(ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, authorizationList)
+	} else {
+		r0 = ret.Get(0).(gas.EvmFee)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, uint64, *assets.Wei, *common.Address, *common.Address, []coretypes.SetCodeAuthorization) uint64); ok {
+		r1 = rf(ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, authorizationList)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []byte, uint64, *assets.Wei, *common.Address, *common.Address, []coretypes.SetCodeAuthorization) error); ok {
+		r2 = rf(ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, authorizationList)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// EvmFeeEstimator_GetSetCodeFee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSetCodeFee'
+type EvmFeeEstimator_GetSetCodeFee_Call struct {
+	*mock.Call
+}
+
+// GetSetCodeFee is a helper method to define mock.On call
+//   - ctx context.Context
+//   - calldata []byte
+//   - feeLimit uint64
+//   - maxFeePrice *assets.Wei
+//   - fromAddress *common.Address
+//   - toAddress *common.Address
+//   - authorizationList []coretypes.SetCodeAuthorization
+func (_e *EvmFeeEstimator_Expecter) GetSetCodeFee(ctx interface{}, calldata interface{}, feeLimit interface{}, maxFeePrice interface{}, fromAddress interface{}, toAddress interface{}, authorizationList interface{}) *EvmFeeEstimator_GetSetCodeFee_Call {
+	return &EvmFeeEstimator_GetSetCodeFee_Call{Call: _e.mock.On("GetSetCodeFee", ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, authorizationList)}
+}
+
+func (_c *EvmFeeEstimator_GetSetCodeFee_Call) Run(run func(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress *common.Address, toAddress *common.Address, authorizationList []coretypes.SetCodeAuthorization)) *EvmFeeEstimator_GetSetCodeFee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]byte), args[2].(uint64), args[3].(*assets.Wei), args[4].(*common.Address), args[5].(*common.Address), args[6].([]coretypes.SetCodeAuthorization))
+	})
+	return _c
+}
+
+func (_c *EvmFeeEstimator_GetSetCodeFee_Call) Return(fee gas.EvmFee, estimatedFeeLimit uint64, err error) *EvmFeeEstimator_GetSetCodeFee_Call {
+	_c.Call.Return(fee, estimatedFeeLimit, err)
+	return _c
+}
+
+func (_c *EvmFeeEstimator_GetSetCodeFee_Call) RunAndReturn(run func(context.Context, []byte, uint64, *assets.Wei, *common.Address, *common.Address, []coretypes.SetCodeAuthorization) (gas.EvmFee, uint64, error)) *EvmFeeEstimator_GetSetCodeFee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HealthReport provides a mock function with no fields
 func (_m *EvmFeeEstimator) HealthReport() map[string]error {
 	ret := _m.Called()
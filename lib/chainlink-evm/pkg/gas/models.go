@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	pkgerrors "github.com/pkg/errors"
 
@@ -35,6 +36,9 @@ type EvmFeeEstimator interface {
 	// L1Oracle returns the L1 gas price oracle only if the chain has one, e.g. OP stack L2s and Arbitrum.
 	L1Oracle() rollups.L1Oracle
 	GetFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, opts ...fees.Opt) (fee EvmFee, estimatedFeeLimit uint64, err error)
+	// GetSetCodeFee returns a dynamic fee for an EIP-7702 set-code transaction. The authorization list is included in the
+	// gas limit estimation so that delegated code and per-authorization costs are accounted for.
+	GetSetCodeFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, authorizationList []gethtypes.SetCodeAuthorization) (fee EvmFee, estimatedFeeLimit uint64, err error)
 	BumpFee(ctx context.Context, originalFee EvmFee, feeLimit uint64, maxFeePrice *assets.Wei, attempts []EvmPriorAttempt) (bumpedFee EvmFee, chainSpecificFeeLimit uint64, err error)
 
 	// GetMaxCost returns the total value = max price x fee units + transferred value
@@ -86,7 +90,40 @@ func NewEstimator(lggr logger.Logger, ethClient feeEstimatorClient, chaintype ch
 	}
 
 	var newEstimator func(logger.Logger) EvmEstimator
-	switch s {
+	if s == "Composite" {
+		cc := geCfg.Composite()
+		lggr.Infow("Combining EVM gas estimators", "modes", cc.Modes(), "aggregation", cc.Aggregation(), "priceFloor", cc.PriceFloor(), "minSources", cc.MinSources())
+		newSources := make([]func(logger.Logger) EvmEstimator, len(cc.Modes()))
+		for i, mode := range cc.Modes() {
+			if newSources[i], err = newModeEstimator(lggr, mode, ethClient, chaintype, chainID, geCfg, l1Oracle); err != nil {
+				return nil, err
+			}
+		}
+		newEstimator = func(l logger.Logger) EvmEstimator {
+			sources := make([]CompositeSource, len(newSources))
+			for i, newSource := range newSources {
+				sources[i] = CompositeSource{Name: cc.Modes()[i], Estimator: newSource(l)}
+			}
+			ccfg := CompositeEstimatorConfig{
+				Aggregation: cc.Aggregation(),
+				PriceFloor:  cc.PriceFloor(),
+				MinSources:  cc.MinSources(),
+				PriceMin:    geCfg.PriceMin(),
+				TipCapMin:   geCfg.TipCapMin(),
+			}
+			return NewCompositeEstimator(lggr, ccfg, chainID, sources, l1Oracle)
+		}
+	} else if newEstimator, err = newModeEstimator(lggr, s, ethClient, chaintype, chainID, geCfg, l1Oracle); err != nil {
+		return nil, err
+	}
+	return NewEvmFeeEstimator(lggr, newEstimator, df, geCfg, ethClient), nil
+}
+
+// newModeEstimator returns a constructor for the estimator of the given Mode.
+func newModeEstimator(lggr logger.Logger, mode string, ethClient feeEstimatorClient, chaintype chaintype.ChainType, chainID *big.Int, geCfg evmconfig.GasEstimator, l1Oracle rollups.L1Oracle) (func(logger.Logger) EvmEstimator, error) {
+	bh := geCfg.BlockHistory()
+	var newEstimator func(logger.Logger) EvmEstimator
+	switch mode {
 	case "Arbitrum":
 		arbOracle, err := rollups.NewArbitrumL1GasOracle(lggr, ethClient)
 		if err != nil {
@@ -120,12 +157,12 @@ func NewEstimator(lggr logger.Logger, ethClient feeEstimatorClient, chaintype ch
 		}
 
 	default:
-		lggr.Warnf("GasEstimator: unrecognised mode '%s', falling back to FixedPriceEstimator", s)
+		lggr.Warnf("GasEstimator: unrecognised mode '%s', falling back to FixedPriceEstimator", mode)
 		newEstimator = func(l logger.Logger) EvmEstimator {
 			return NewFixedPriceEstimator(geCfg, ethClient, bh, lggr, l1Oracle)
 		}
 	}
-	return NewEvmFeeEstimator(lggr, newEstimator, df, geCfg, ethClient), nil
+	return newEstimator, nil
 }
 
 // DynamicFee encompasses both FeeCap and TipCap for EIP1559 transactions
@@ -296,7 +333,17 @@ func (e *evmFeeEstimator) GetFee(ctx context.Context, calldata []byte, feeLimit
 		}
 	}
 
-	estimatedFeeLimit, err = e.estimateFeeLimit(ctx, chainSpecificFeeLimit, calldata, fromAddress, toAddress)
+	estimatedFeeLimit, err = e.estimateFeeLimit(ctx, chainSpecificFeeLimit, calldata, fromAddress, toAddress, nil)
+	return
+}
+
+func (e *evmFeeEstimator) GetSetCodeFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, authorizationList []gethtypes.SetCodeAuthorization) (fee EvmFee, estimatedFeeLimit uint64, err error) {
+	// Set-code transactions are always dynamic fee transactions
+	fee.DynamicFee, err = e.EvmEstimator.GetDynamicFee(ctx, maxFeePrice)
+	if err != nil {
+		return
+	}
+	estimatedFeeLimit, err = e.estimateFeeLimit(ctx, feeLimit, calldata, fromAddress, toAddress, authorizationList)
 	return
 }
 
@@ -352,7 +399,7 @@ func (e *evmFeeEstimator) BumpFee(ctx context.Context, originalFee EvmFee, feeLi
 	return
 }
 
-func (e *evmFeeEstimator) estimateFeeLimit(ctx context.Context, feeLimit uint64, calldata []byte, fromAddress, toAddress *common.Address) (estimatedFeeLimit uint64, err error) {
+func (e *evmFeeEstimator) estimateFeeLimit(ctx context.Context, feeLimit uint64, calldata []byte, fromAddress, toAddress *common.Address, authorizationList []gethtypes.SetCodeAuthorization) (estimatedFeeLimit uint64, err error) {
 	// Use the feeLimit * LimitMultiplier as the provided gas limit since this multiplier is applied on top of the caller specified gas limit
 	providedGasLimit, err := fees.ApplyMultiplier(feeLimit, e.geCfg.LimitMultiplier())
 	if err != nil {
@@ -366,8 +413,9 @@ func (e *evmFeeEstimator) estimateFeeLimit(ctx context.Context, feeLimit uint64,
 	// Create call msg for gas limit estimation
 	// Skip setting Gas to avoid capping the results of the estimation
 	callMsg := ethereum.CallMsg{
-		To:   toAddress,
-		Data: calldata,
+		To:                toAddress,
+		Data:              calldata,
+		AuthorizationList: authorizationList,
 	}
 	if e.geCfg.SenderAddress() != nil {
 		callMsg.From = e.geCfg.SenderAddress().Address()
@@ -5,6 +5,8 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	pkgerrors "github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -407,4 +409,25 @@ func TestWrappedEvmEstimator(t *testing.T) {
 		_, _, err = estimator.GetFee(ctx, []byte{}, 0, nil, &fromAddress, &toAddress)
 		require.Error(t, err)
 	})
+
+	t.Run("GetSetCodeFee returns dynamic fee and estimates with authorization list", func(t *testing.T) {
+		estimatedGasLimit := uint64(5)
+		est.On("GetDynamicFee", mock.Anything, mock.Anything).
+			Return(dynamicFee, nil).Once()
+		lggr := logger.Test(t)
+		geCfg.EstimateLimitF = true
+		authorizationList := []gethtypes.SetCodeAuthorization{{Address: testutils.NewAddress(), Nonce: 1}}
+		ethClient := clienttest.NewClientWithDefaultChainID(t)
+		ethClient.On("EstimateGas", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+			return assert.ObjectsAreEqual(authorizationList, msg.AuthorizationList)
+		})).Return(estimatedGasLimit, nil).Once()
+		// dynamic fees are returned even if EIP-1559 is disabled
+		estimator := gas.NewEvmFeeEstimator(lggr, getRootEst, false, geCfg, ethClient)
+		fee, limit, err := estimator.GetSetCodeFee(ctx, []byte{}, gasLimit, nil, &fromAddress, &toAddress, authorizationList)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(float32(estimatedGasLimit)*gas.EstimateGasBuffer), limit)
+		assert.True(t, dynamicFee.GasFeeCap.Equal(fee.GasFeeCap))
+		assert.True(t, dynamicFee.GasTipCap.Equal(fee.GasTipCap))
+		assert.Nil(t, fee.GasPrice)
+	})
 }
@@ -118,7 +118,11 @@ func (s *store) EnabledAddresses(ctx context.Context) ([]common.Address, error)
 	return addresses, nil
 }
 
+// SignMessage delegates to ks if it implements MessageSigner, e.g. when the keys are held by a remote signer.
 func (s *store) SignMessage(ctx context.Context, address common.Address, message []byte) ([]byte, error) {
+	if ms, ok := s.ks.(MessageSigner); ok {
+		return ms.SignMessage(ctx, address, message)
+	}
 	return s.ks.Sign(ctx, address.String(), accounts.TextHash(message))
 }
 
@@ -190,7 +194,12 @@ func NewChainStore(ks core.Keystore, chainID *big.Int) ChainStore {
 	}
 }
 
+// SignTx delegates to ks if it implements TxSigner, e.g. when the keys are held by a remote signer which signs whole
+// transactions rather than their hashes.
 func (s *chainStore) SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction) (*types.Transaction, error) {
+	if ts, ok := s.ks.(TxSigner); ok {
+		return ts.SignTx(ctx, fromAddress, tx)
+	}
 	signer := types.LatestSignerForChainID(s.chainID)
 	h := signer.Hash(tx)
 	sig, err := s.ks.Sign(ctx, fromAddress.String(), h[:])
@@ -0,0 +1,374 @@
+package logpoller
+
+import (
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+)
+
+// ArchiveVersion is the version of the archive format written by ExportArchive.
+const ArchiveVersion = 1
+
+// defaultArchiveBatchSize is the number of blocks read from the database, or verified against the chain,
+// at once when no batch size is given.
+const defaultArchiveBatchSize = 1000
+
+// ErrArchiveBlockMismatch is returned by ImportArchive when an archived block is not part of the canonical chain.
+var ErrArchiveBlockMismatch = errors.New("archived block does not match the chain")
+
+// ArchiveOpts selects the data written by ExportArchive.
+type ArchiveOpts struct {
+	FromBlock int64
+	ToBlock   int64    // 0 = latest finalized block
+	Filters   []string // names of the filters whose logs are exported, all filters if empty
+	BatchSize int64    // number of blocks read from the database at once
+}
+
+// ArchiveStats summarizes an exported or imported archive.
+type ArchiveStats struct {
+	FromBlock int64
+	ToBlock   int64
+	Filters   []string
+	Blocks    int
+	Logs      int
+}
+
+// archiveHeader is the first record of an archive.
+type archiveHeader struct {
+	Version   int       `json:"version"`
+	ChainID   *ubig.Big `json:"chainID"`
+	FromBlock int64     `json:"fromBlock"`
+	ToBlock   int64     `json:"toBlock"`
+	Filters   []string  `json:"filters"`
+}
+
+// archiveBlock is a block and its logs. Blocks that were pruned from evm.log_poller_blocks are still
+// archived when they have logs, but are not Stored and only their logs are imported.
+type archiveBlock struct {
+	Number               int64        `json:"number"`
+	Hash                 common.Hash  `json:"hash"`
+	Timestamp            time.Time    `json:"timestamp"`
+	FinalizedBlockNumber int64        `json:"finalizedBlockNumber,omitempty"`
+	SafeBlockNumber      int64        `json:"safeBlockNumber,omitempty"`
+	Stored               bool         `json:"stored,omitempty"`
+	Logs                 []archiveLog `json:"logs,omitempty"`
+}
+
+type archiveLog struct {
+	LogIndex int64           `json:"logIndex"`
+	TxHash   common.Hash     `json:"txHash"`
+	Address  common.Address  `json:"address"`
+	EventSig common.Hash     `json:"eventSig"`
+	Topics   []hexutil.Bytes `json:"topics"`
+	Data     hexutil.Bytes   `json:"data"`
+}
+
+// ExportArchive writes the finalized logs matching the selected filters, and the blocks they belong to, as gzip
+// compressed JSON lines to w. The first line is a header describing the chain, block range and filters,
+// each following line is a block with its logs, in ascending order.
+func ExportArchive(ctx context.Context, orm ORM, chainID *big.Int, w io.Writer, opts ArchiveOpts) (stats ArchiveStats, err error) {
+	filters, err := selectArchiveFilters(ctx, orm, opts.Filters)
+	if err != nil {
+		return stats, err
+	}
+
+	finalized, err := orm.SelectLatestFinalizedBlock(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return stats, errors.New("no finalized blocks to export")
+		}
+		return stats, fmt.Errorf("failed to load latest finalized block: %w", err)
+	}
+	toBlock := opts.ToBlock
+	if toBlock == 0 {
+		toBlock = finalized.BlockNumber
+	}
+	if toBlock > finalized.BlockNumber {
+		return stats, fmt.Errorf("cannot export unfinalized blocks: to block %d is after latest finalized block %d", toBlock, finalized.BlockNumber)
+	}
+	if opts.FromBlock < 0 || opts.FromBlock > toBlock {
+		return stats, fmt.Errorf("invalid block range [%d, %d]", opts.FromBlock, toBlock)
+	}
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultArchiveBatchSize
+	}
+
+	stats.FromBlock, stats.ToBlock = opts.FromBlock, toBlock
+	for _, f := range filters {
+		stats.Filters = append(stats.Filters, f.Name)
+	}
+
+	gz := gzip.NewWriter(w)
+	defer func() {
+		err = errors.Join(err, gz.Close())
+	}()
+	enc := json.NewEncoder(gz)
+	if err = enc.Encode(archiveHeader{
+		Version:   ArchiveVersion,
+		ChainID:   ubig.New(chainID),
+		FromBlock: stats.FromBlock,
+		ToBlock:   stats.ToBlock,
+		Filters:   stats.Filters,
+	}); err != nil {
+		return stats, err
+	}
+
+	for start := opts.FromBlock; start <= toBlock; start += batchSize {
+		end := min(start+batchSize-1, toBlock)
+		blocks, err := archiveBlocksRange(ctx, orm, filters, start, end)
+		if err != nil {
+			return stats, err
+		}
+		for _, b := range blocks {
+			if err = enc.Encode(b); err != nil {
+				return stats, err
+			}
+			stats.Blocks++
+			stats.Logs += len(b.Logs)
+		}
+	}
+	return stats, nil
+}
+
+func selectArchiveFilters(ctx context.Context, orm ORM, names []string) ([]Filter, error) {
+	all, err := orm.LoadFilters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load filters: %w", err)
+	}
+	var filters []Filter
+	if len(names) == 0 {
+		for _, f := range all {
+			filters = append(filters, f)
+		}
+	} else {
+		for _, name := range names {
+			f, ok := all[name]
+			if !ok {
+				return nil, fmt.Errorf("filter %q not found", name)
+			}
+			filters = append(filters, f)
+		}
+	}
+	if len(filters) == 0 {
+		return nil, errors.New("no filters to export")
+	}
+	sort.Slice(filters, func(i, j int) bool { return filters[i].Name < filters[j].Name })
+	return filters, nil
+}
+
+// archiveBlocksRange returns the stored blocks in [start, end] along with the blocks of any logs matching filters.
+func archiveBlocksRange(ctx context.Context, orm ORM, filters []Filter, start, end int64) ([]*archiveBlock, error) {
+	stored, err := orm.GetBlocksRange(ctx, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load blocks [%d, %d]: %w", start, end, err)
+	}
+	logs, err := orm.SelectLogsByBlockRange(ctx, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load logs [%d, %d]: %w", start, end, err)
+	}
+
+	blocks := make(map[int64]*archiveBlock, len(stored))
+	for _, b := range stored {
+		blocks[b.BlockNumber] = &archiveBlock{
+			Number:               b.BlockNumber,
+			Hash:                 b.BlockHash,
+			Timestamp:            b.BlockTimestamp,
+			FinalizedBlockNumber: b.FinalizedBlockNumber,
+			SafeBlockNumber:      b.SafeBlockNumber,
+			Stored:               true,
+		}
+	}
+	for _, l := range logs {
+		if !slices.ContainsFunc(filters, func(f Filter) bool { return f.matchesLog(&l) }) {
+			continue
+		}
+		b, ok := blocks[l.BlockNumber]
+		if !ok {
+			b = &archiveBlock{Number: l.BlockNumber, Hash: l.BlockHash, Timestamp: l.BlockTimestamp}
+			blocks[l.BlockNumber] = b
+		} else if b.Hash != l.BlockHash {
+			return nil, fmt.Errorf("log %d of tx %s references block %s at height %d, but block %s is stored", l.LogIndex, l.TxHash, l.BlockHash, l.BlockNumber, b.Hash)
+		}
+		topics := make([]hexutil.Bytes, 0, len(l.Topics))
+		for _, t := range l.Topics {
+			topics = append(topics, t)
+		}
+		b.Logs = append(b.Logs, archiveLog{
+			LogIndex: l.LogIndex,
+			TxHash:   l.TxHash,
+			Address:  l.Address,
+			EventSig: l.EventSig,
+			Topics:   topics,
+			Data:     l.Data,
+		})
+	}
+
+	sorted := make([]*archiveBlock, 0, len(blocks))
+	for _, b := range blocks {
+		sorted = append(sorted, b)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Number < sorted[j].Number })
+	return sorted, nil
+}
+
+// matchesLog returns true if log would have been captured by filter.
+func (filter *Filter) matchesLog(log *Log) bool {
+	if !slices.Contains(filter.Addresses, log.Address) || !slices.Contains(filter.EventSigs, log.EventSig) {
+		return false
+	}
+	for i, values := range []evmtypes.HashArray{filter.Topic2, filter.Topic3, filter.Topic4} {
+		if len(values) == 0 {
+			continue
+		}
+		if len(log.Topics) <= i+1 || !slices.Contains(values, common.BytesToHash(log.Topics[i+1])) {
+			return false
+		}
+	}
+	return true
+}
+
+// ImportArchive reads an archive written by ExportArchive from r and inserts its blocks and logs. Blocks are
+// verified against the canonical chain using ec in batches of batchSize before they are inserted, so an
+// archive that fails verification part way through leaves only the already verified blocks behind.
+func ImportArchive(ctx context.Context, orm ORM, ec Client, r io.Reader, batchSize int64) (stats ArchiveStats, err error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return stats, fmt.Errorf("failed to read archive: %w", err)
+	}
+	defer func() {
+		err = errors.Join(err, gz.Close())
+	}()
+	dec := json.NewDecoder(gz)
+
+	var header archiveHeader
+	if err = dec.Decode(&header); err != nil {
+		return stats, fmt.Errorf("failed to read archive header: %w", err)
+	}
+	if header.Version != ArchiveVersion {
+		return stats, fmt.Errorf("unsupported archive version %d, expected %d", header.Version, ArchiveVersion)
+	}
+	if header.ChainID == nil || header.ChainID.Cmp(ubig.New(ec.ConfiguredChainID())) != 0 {
+		return stats, fmt.Errorf("archive is for chain %s, but importing into chain %s", header.ChainID, ec.ConfiguredChainID())
+	}
+	if batchSize <= 0 {
+		batchSize = defaultArchiveBatchSize
+	}
+	stats.FromBlock, stats.ToBlock, stats.Filters = header.FromBlock, header.ToBlock, header.Filters
+
+	batch := make([]archiveBlock, 0, batchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := verifyArchiveBlocks(ctx, ec, batch); err != nil {
+			return err
+		}
+		for _, b := range batch {
+			if err := insertArchiveBlock(ctx, orm, header.ChainID, b); err != nil {
+				return fmt.Errorf("failed to insert block %d: %w", b.Number, err)
+			}
+			stats.Blocks++
+			stats.Logs += len(b.Logs)
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	last := header.FromBlock - 1
+	for {
+		var b archiveBlock
+		if err = dec.Decode(&b); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return stats, fmt.Errorf("failed to read archive: %w", err)
+		}
+		if b.Number <= last || b.Number > header.ToBlock {
+			return stats, fmt.Errorf("block %d is out of order or outside of the archived range [%d, %d]", b.Number, header.FromBlock, header.ToBlock)
+		}
+		last = b.Number
+		batch = append(batch, b)
+		if int64(len(batch)) >= batchSize {
+			if err = flush(); err != nil {
+				return stats, err
+			}
+		}
+	}
+	return stats, flush()
+}
+
+// verifyArchiveBlocks checks that each block's hash matches the block at the same height on the chain.
+func verifyArchiveBlocks(ctx context.Context, ec Client, blocks []archiveBlock) error {
+	reqs := make([]rpc.BatchElem, 0, len(blocks))
+	for _, b := range blocks {
+		reqs = append(reqs, newBlockReq(hexutil.EncodeBig(big.NewInt(b.Number))))
+	}
+	if err := ec.BatchCallContext(ctx, reqs); err != nil {
+		return fmt.Errorf("failed to fetch blocks to verify archive: %w", err)
+	}
+	for i, b := range blocks {
+		if reqs[i].Error != nil {
+			return fmt.Errorf("failed to fetch block %d to verify archive: %w", b.Number, reqs[i].Error)
+		}
+		head, err := validateBlockResponse(reqs[i])
+		if err != nil {
+			return fmt.Errorf("failed to fetch block %d to verify archive: %w", b.Number, err)
+		}
+		if head.Hash != b.Hash {
+			return fmt.Errorf("block %d has hash %s in archive, but %s on chain: %w", b.Number, b.Hash, head.Hash, ErrArchiveBlockMismatch)
+		}
+	}
+	return nil
+}
+
+func insertArchiveBlock(ctx context.Context, orm ORM, chainID *ubig.Big, b archiveBlock) error {
+	logs := make([]Log, 0, len(b.Logs))
+	for _, l := range b.Logs {
+		topics := make([][]byte, 0, len(l.Topics))
+		for _, t := range l.Topics {
+			topics = append(topics, t)
+		}
+		logs = append(logs, Log{
+			EVMChainID:     chainID,
+			LogIndex:       l.LogIndex,
+			BlockHash:      b.Hash,
+			BlockNumber:    b.Number,
+			BlockTimestamp: b.Timestamp,
+			Topics:         topics,
+			EventSig:       l.EventSig,
+			Address:        l.Address,
+			TxHash:         l.TxHash,
+			Data:           l.Data,
+		})
+	}
+	if !b.Stored {
+		if len(logs) == 0 {
+			return nil
+		}
+		return orm.InsertLogs(ctx, logs)
+	}
+	return orm.InsertLogsWithBlock(ctx, logs, Block{
+		EVMChainID:           chainID,
+		BlockHash:            b.Hash,
+		BlockNumber:          b.Number,
+		BlockTimestamp:       b.Timestamp,
+		FinalizedBlockNumber: b.FinalizedBlockNumber,
+		SafeBlockNumber:      b.SafeBlockNumber,
+	})
+}
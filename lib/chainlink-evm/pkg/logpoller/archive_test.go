@@ -0,0 +1,133 @@
+package logpoller_test
+
+import (
+	"bytes"
+	"database/sql"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/client/clienttest"
+	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
+	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+)
+
+func archiveBlockHash(n int64) common.Hash {
+	return common.BigToHash(big.NewInt(n))
+}
+
+// newArchiveClient returns a client whose chain has archiveBlockHash(n) at each height n, except for the overrides.
+func newArchiveClient(t *testing.T, chainID *big.Int, overrides map[int64]common.Hash) *clienttest.Client {
+	ec := clienttest.NewClient(t)
+	ec.On("ConfiguredChainID").Return(chainID).Maybe()
+	ec.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		for _, e := range args.Get(1).([]rpc.BatchElem) {
+			n, err := hexutil.DecodeUint64(e.Args[0].(string))
+			require.NoError(t, err)
+			hash, ok := overrides[int64(n)]
+			if !ok {
+				hash = archiveBlockHash(int64(n))
+			}
+			*e.Result.(*evmtypes.Head) = evmtypes.Head{Number: int64(n), Hash: hash}
+		}
+	}).Maybe()
+	return ec
+}
+
+func TestArchive_ExportImport(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	lggr := logger.Test(t)
+	chainID := testutils.NewRandomEVMChainID()
+	src := logpoller.NewORM(chainID, testutils.NewSqlxDB(t), lggr)
+
+	addr1, addr2 := testutils.NewAddress(), testutils.NewAddress()
+	sig1, sig2 := EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID
+	require.NoError(t, src.InsertFilter(ctx, logpoller.Filter{Name: "f1", Addresses: []common.Address{addr1}, EventSigs: []common.Hash{sig1}}))
+	require.NoError(t, src.InsertFilter(ctx, logpoller.Filter{Name: "f2", Addresses: []common.Address{addr2}, EventSigs: []common.Hash{sig2}}))
+
+	// blocks 1-10 are stored, except for 3 which was pruned but still has logs, with 8 finalized
+	for n := int64(1); n <= 10; n++ {
+		if n == 3 {
+			continue
+		}
+		require.NoError(t, src.InsertBlock(ctx, archiveBlockHash(n), n, time.Unix(n, 0).UTC(), 8, 8))
+	}
+	require.NoError(t, src.InsertLogs(ctx, []logpoller.Log{
+		GenLog(chainID, 1, 2, archiveBlockHash(2).Hex(), sig1.Bytes(), addr1),
+		GenLog(chainID, 1, 3, archiveBlockHash(3).Hex(), sig1.Bytes(), addr1),
+		GenLog(chainID, 1, 5, archiveBlockHash(5).Hex(), sig1.Bytes(), addr1),
+		GenLog(chainID, 2, 5, archiveBlockHash(5).Hex(), sig2.Bytes(), addr1), // not matched by any filter
+		GenLog(chainID, 1, 6, archiveBlockHash(6).Hex(), sig2.Bytes(), addr2), // only matched by f2
+		GenLog(chainID, 1, 9, archiveBlockHash(9).Hex(), sig1.Bytes(), addr1), // not finalized
+	}))
+
+	t.Run("rejects unknown filters", func(t *testing.T) {
+		_, err := logpoller.ExportArchive(ctx, src, chainID, &bytes.Buffer{}, logpoller.ArchiveOpts{Filters: []string{"missing"}})
+		require.ErrorContains(t, err, `filter "missing" not found`)
+	})
+
+	t.Run("rejects unfinalized blocks", func(t *testing.T) {
+		_, err := logpoller.ExportArchive(ctx, src, chainID, &bytes.Buffer{}, logpoller.ArchiveOpts{ToBlock: 9})
+		require.ErrorContains(t, err, "cannot export unfinalized blocks")
+	})
+
+	var archive bytes.Buffer
+	stats, err := logpoller.ExportArchive(ctx, src, chainID, &archive, logpoller.ArchiveOpts{FromBlock: 1, Filters: []string{"f1"}, BatchSize: 3})
+	require.NoError(t, err)
+	assert.Equal(t, logpoller.ArchiveStats{FromBlock: 1, ToBlock: 8, Filters: []string{"f1"}, Blocks: 8, Logs: 3}, stats)
+
+	t.Run("imports verified blocks and logs", func(t *testing.T) {
+		dst := logpoller.NewORM(chainID, testutils.NewSqlxDB(t), lggr)
+		stats, err := logpoller.ImportArchive(ctx, dst, newArchiveClient(t, chainID, nil), bytes.NewReader(archive.Bytes()), 3)
+		require.NoError(t, err)
+		assert.Equal(t, 8, stats.Blocks)
+		assert.Equal(t, 3, stats.Logs)
+
+		logs, err := dst.SelectLogsByBlockRange(ctx, 1, 10)
+		require.NoError(t, err)
+		require.Len(t, logs, 3)
+		for i, n := range []int64{2, 3, 5} {
+			assert.Equal(t, n, logs[i].BlockNumber)
+			assert.Equal(t, archiveBlockHash(n), logs[i].BlockHash)
+			assert.Equal(t, addr1, logs[i].Address)
+			assert.Equal(t, sig1, logs[i].EventSig)
+		}
+
+		latest, err := dst.SelectLatestBlock(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(8), latest.BlockNumber)
+		assert.Equal(t, int64(8), latest.FinalizedBlockNumber)
+
+		_, err = dst.SelectBlockByNumber(ctx, 3)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("rejects blocks not on the chain", func(t *testing.T) {
+		dst := logpoller.NewORM(chainID, testutils.NewSqlxDB(t), lggr)
+		ec := newArchiveClient(t, chainID, map[int64]common.Hash{5: common.HexToHash("0xdead")})
+		_, err := logpoller.ImportArchive(ctx, dst, ec, bytes.NewReader(archive.Bytes()), 3)
+		require.ErrorIs(t, err, logpoller.ErrArchiveBlockMismatch)
+
+		// the batch holding block 5 was not inserted
+		_, err = dst.SelectBlockByNumber(ctx, 5)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("rejects archives of another chain", func(t *testing.T) {
+		dst := logpoller.NewORM(chainID, testutils.NewSqlxDB(t), lggr)
+		ec := newArchiveClient(t, testutils.NewRandomEVMChainID(), nil)
+		_, err := logpoller.ImportArchive(ctx, dst, ec, bytes.NewReader(archive.Bytes()), 3)
+		require.ErrorContains(t, err, "archive is for chain")
+	})
+}
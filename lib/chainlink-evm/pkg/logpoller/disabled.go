@@ -38,6 +38,10 @@ func (disabled) Replay(ctx context.Context, fromBlock int64) error { return ErrD
 
 func (disabled) ReplayAsync(fromBlock int64) {}
 
+func (disabled) ReplayFilter(ctx context.Context, name string, fromBlock int64) error {
+	return ErrDisabled
+}
+
 func (disabled) RegisterFilter(ctx context.Context, filter Filter) error { return ErrDisabled }
 
 func (disabled) UnregisterFilter(ctx context.Context, name string) error { return ErrDisabled }
@@ -46,6 +50,8 @@ func (disabled) HasFilter(name string) bool { return false }
 
 func (disabled) GetFilters() map[string]Filter { return nil }
 
+func (disabled) GetFilterStats(ctx context.Context) ([]FilterStats, error) { return nil, ErrDisabled }
+
 func (disabled) LatestBlock(ctx context.Context) (Block, error) {
 	return Block{}, ErrDisabled
 }
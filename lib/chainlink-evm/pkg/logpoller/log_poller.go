@@ -32,6 +32,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-evm/pkg/client"
 	"github.com/smartcontractkit/chainlink-evm/pkg/config"
+	"github.com/smartcontractkit/chainlink-evm/pkg/reorgs"
 	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
 	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
 )
@@ -41,10 +42,12 @@ type LogPoller interface {
 	Healthy() error
 	Replay(ctx context.Context, fromBlock int64) error
 	ReplayAsync(fromBlock int64)
+	ReplayFilter(ctx context.Context, name string, fromBlock int64) error
 	RegisterFilter(ctx context.Context, filter Filter) error
 	UnregisterFilter(ctx context.Context, name string) error
 	HasFilter(name string) bool
 	GetFilters() map[string]Filter
+	GetFilterStats(ctx context.Context) ([]FilterStats, error)
 	LatestBlock(ctx context.Context) (Block, error)
 	GetBlocksRange(ctx context.Context, numbers []uint64) ([]Block, error)
 	FindLCA(ctx context.Context) (*Block, error)
@@ -104,6 +107,13 @@ var (
 	ErrLogPollerShutdown                  = pkgerrors.New("replay aborted due to log poller shutdown")
 )
 
+var (
+	// ErrFilterReplayInProgress is returned by ReplayFilter while another filter is being replayed.
+	ErrFilterReplayInProgress = pkgerrors.New("a filter replay is already in progress")
+	// ErrFilterNotRegistered is returned by ReplayFilter for a filter name which is not registered.
+	ErrFilterNotRegistered = pkgerrors.New("filter is not registered")
+)
+
 type logPoller struct {
 	services.StateMachine
 	ec                       Client
@@ -119,6 +129,7 @@ type logPoller struct {
 	rpcBatchSize             int64         // batch size to use for fallback RPC calls made in GetBlocks
 	logPrunePageSize         int64
 	clientErrors             config.ClientErrors
+	reorgJournal             reorgs.Journal
 	backupPollerNextBlock    int64 // next block to be processed by Backup LogPoller
 	backupPollerBlockDelay   int64 // how far behind regular LogPoller should BackupLogPoller run. 0 = disabled
 
@@ -130,6 +141,7 @@ type logPoller struct {
 
 	replayStart    chan int64
 	replayComplete chan error
+	filterReplayMu sync.Mutex // held while a single filter is being replayed
 	stopCh         services.StopChan
 	wg             sync.WaitGroup
 	// This flag is raised whenever the log poller detects that the chain's finality has been violated.
@@ -152,6 +164,8 @@ type Opts struct {
 	BackupPollerBlockDelay   int64
 	LogPrunePageSize         int64
 	ClientErrors             config.ClientErrors
+	// ReorgJournal records the reorgs found by the LogPoller, if set.
+	ReorgJournal reorgs.Journal
 }
 
 // NewLogPoller creates a log poller. Note there is an assumption
@@ -183,6 +197,7 @@ func NewLogPoller(orm ORM, ec Client, lggr logger.Logger, headTracker HeadTracke
 		keepFinalizedBlocksDepth: opts.KeepFinalizedBlocksDepth,
 		logPrunePageSize:         opts.LogPrunePageSize,
 		clientErrors:             opts.ClientErrors,
+		reorgJournal:             opts.ReorgJournal,
 		filters:                  make(map[string]Filter),
 		filterDirty:              true, // Always build Filter on first call to cache an empty filter if nothing registered yet.
 	}
@@ -367,6 +382,21 @@ func (lp *logPoller) GetFilters() map[string]Filter {
 	return filters
 }
 
+// GetFilterStats returns the registered filters, sorted by name, along with the stats of the logs retained for each of them.
+func (lp *logPoller) GetFilterStats(ctx context.Context) ([]FilterStats, error) {
+	logStats, err := lp.orm.SelectFilterLogStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filters := lp.GetFilters()
+	stats := make([]FilterStats, 0, len(filters))
+	for name, filter := range filters {
+		stats = append(stats, FilterStats{Filter: filter, FilterLogStats: logStats[name]})
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Name < stats[j].Name })
+	return stats, nil
+}
+
 func (lp *logPoller) Filter(from, to *big.Int, bh *common.Hash) ethereum.FilterQuery {
 	lp.filterMu.Lock()
 	defer lp.filterMu.Unlock()
@@ -479,6 +509,55 @@ func (lp *logPoller) Replay(ctx context.Context, fromBlock int64) (err error) {
 	}
 }
 
+// ReplayFilter backfills the logs of a single registered filter from fromBlock, without replaying every other filter.
+// Only the finalized blocks are queried for the filter alone, any remaining blocks are replayed by the main loop as with Replay.
+// At most one filter replay may run at a time, ErrFilterReplayInProgress is returned otherwise.
+func (lp *logPoller) ReplayFilter(ctx context.Context, name string, fromBlock int64) error {
+	if !lp.filterReplayMu.TryLock() {
+		return ErrFilterReplayInProgress
+	}
+	defer lp.filterReplayMu.Unlock()
+
+	lp.filterMu.RLock()
+	filter, ok := lp.filters[name]
+	lp.filterMu.RUnlock()
+	if !ok {
+		return pkgerrors.Wrapf(ErrFilterNotRegistered, "cannot replay filter %q", name)
+	}
+
+	latest, err := lp.latencyMonitor.HeadByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if fromBlock < 1 || fromBlock > latest.Number {
+		return pkgerrors.Errorf("Invalid replay block number %v, acceptable range [1, %v]", fromBlock, latest.Number)
+	}
+
+	savedFinalizedBlockNumber, err := lp.savedFinalizedBlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	if fromBlock <= savedFinalizedBlockNumber {
+		lp.lggr.Infow("Replaying filter", "filter", name, "fromBlock", fromBlock, "toBlock", savedFinalizedBlockNumber)
+		err = lp.backfillWithQuery(ctx, fromBlock, savedFinalizedBlockNumber, func(from, to *big.Int) ethereum.FilterQuery {
+			return ethereum.FilterQuery{FromBlock: from, ToBlock: to, Topics: [][]common.Hash{filter.EventSigs}, Addresses: filter.Addresses}
+		})
+		if errors.Is(err, commontypes.ErrFinalityViolated) {
+			lp.lggr.Criticalw("Filter replay failed due to finality violation", "filter", name, "fromBlock", fromBlock, "err", err)
+			lp.finalityViolated.Store(true)
+			lp.SvcErrBuffer.Append(err)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if fromBlock = mathutil.Max(fromBlock, savedFinalizedBlockNumber+1); fromBlock > latest.Number {
+		return nil
+	}
+	return lp.Replay(ctx, fromBlock)
+}
+
 // savedFinalizedBlockNumber returns the FinalizedBlockNumber saved with the last processed block in the db
 // (latestFinalizedBlock at the time the last processed block was saved)
 // If this is the first poll and no blocks are in the db, it returns 0
@@ -901,11 +980,18 @@ func (lp *logPoller) blocksFromFinalizedLogs(ctx context.Context, logs []types.L
 // backfill will query FilterLogs in batches for logs in the
 // block range [start, end] and save them to the db.
 func (lp *logPoller) backfill(ctx context.Context, start, end int64) error {
+	return lp.backfillWithQuery(ctx, start, end, func(from, to *big.Int) ethereum.FilterQuery {
+		return lp.Filter(from, to, nil)
+	})
+}
+
+// backfillWithQuery is like backfill, but queries the logs matching the FilterQuery returned by query for each batch.
+func (lp *logPoller) backfillWithQuery(ctx context.Context, start, end int64, query func(from, to *big.Int) ethereum.FilterQuery) error {
 	batchSize := lp.backfillBatchSize
 	for from := start; from <= end; from += batchSize {
 		to := mathutil.Min(from+batchSize-1, end)
 
-		gethLogs, err := lp.latencyMonitor.FilterLogs(ctx, lp.Filter(big.NewInt(from), big.NewInt(to), nil))
+		gethLogs, err := lp.latencyMonitor.FilterLogs(ctx, query(big.NewInt(from), big.NewInt(to)))
 		if err != nil {
 			if client.IsMissingBlocks(err, lp.clientErrors) {
 				errCount := lp.missingBlocksErrorCount.Add(1)
@@ -1006,6 +1092,7 @@ func (lp *logPoller) getCurrentBlockMaybeHandleReorg(ctx context.Context, curren
 		}
 
 		lp.lggr.Infow("Reorg detected", "blockAfterLCA", blockAfterLCA.Number, "currentBlockNumber", currentBlockNumber)
+		lp.recordReorg(ctx, currentBlock, blockAfterLCA)
 		// We truncate all the blocks and logs after the LCA.
 		// We could preserve the logs for forensics, since its possible
 		// that applications see them and take action upon it, however that
@@ -1025,6 +1112,44 @@ func (lp *logPoller) getCurrentBlockMaybeHandleReorg(ctx context.Context, curren
 	return currentBlock, nil
 }
 
+// recordReorg saves the reorg to the ReorgJournal, along with the hashes of the blocks after the LCA so that the
+// affected transactions and logs can be looked up before they are deleted. Failures are only logged, the
+// journal must never keep the LogPoller from recovering from the reorg.
+func (lp *logPoller) recordReorg(ctx context.Context, currentBlock, blockAfterLCA *evmtypes.Head) {
+	if lp.reorgJournal == nil {
+		return
+	}
+	latest, err := lp.orm.SelectLatestBlock(ctx)
+	if err != nil {
+		lp.lggr.Warnw("Unable to record reorg, failed to read latest block", "err", err)
+		return
+	}
+	blocks, err := lp.orm.GetBlocksRange(ctx, blockAfterLCA.Number, latest.BlockNumber)
+	if err != nil {
+		lp.lggr.Warnw("Unable to record reorg, failed to read orphaned blocks", "err", err)
+		return
+	}
+	orphaned := make([]common.Hash, len(blocks))
+	for i, b := range blocks {
+		orphaned[i] = b.BlockHash
+	}
+	r := reorgs.Reorg{
+		Source:           reorgs.SourceLogPoller,
+		Depth:            latest.BlockNumber - blockAfterLCA.Number + 1,
+		LCABlockNumber:   blockAfterLCA.Number - 1,
+		LCABlockHash:     blockAfterLCA.ParentHash,
+		OldHeadNumber:    latest.BlockNumber,
+		OldHeadHash:      latest.BlockHash,
+		OldHeadTimestamp: latest.BlockTimestamp,
+		NewHeadNumber:    currentBlock.Number,
+		NewHeadHash:      currentBlock.Hash,
+		NewHeadTimestamp: currentBlock.Timestamp,
+	}
+	if err = lp.reorgJournal.Record(ctx, r, orphaned); err != nil {
+		lp.lggr.Errorw("Failed to record reorg", "err", err, "blockAfterLCA", blockAfterLCA.Number)
+	}
+}
+
 // PollAndSaveLogs On startup/crash current is the first block after the last processed block.
 // currentBlockNumber is the block from where new logs are to be polled & saved. Under normal
 // conditions this would be equal to lastProcessed.BlockNumber + 1.
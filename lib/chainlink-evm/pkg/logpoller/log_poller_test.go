@@ -36,6 +36,8 @@ import (
 	"github.com/smartcontractkit/chainlink-evm/pkg/heads/headstest"
 	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
 	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller/internal/log_emitter"
+	"github.com/smartcontractkit/chainlink-evm/pkg/reorgs"
+	reorgsmocks "github.com/smartcontractkit/chainlink-evm/pkg/reorgs/mocks"
 	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
 	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
 	"github.com/smartcontractkit/chainlink-evm/pkg/utils"
@@ -1150,6 +1152,7 @@ func TestLogPoller_PollAndSaveLogsDeepReorg(t *testing.T) {
 				BackfillBatchSize:        50,
 				RPCBatchSize:             50,
 				KeepFinalizedBlocksDepth: 1000,
+				ReorgJournal:             reorgsmocks.NewJournal(t),
 			}
 			th := SetupTH(t, lpOpts)
 			// Set up a log poller listening for log emitter logs.
@@ -1195,6 +1198,13 @@ func TestLogPoller_PollAndSaveLogsDeepReorg(t *testing.T) {
 			}
 			th.finalizeThroughBlock(t, 32)
 
+			orphaned, err := th.ORM.SelectBlockByNumber(testutils.Context(t), 2)
+			require.NoError(t, err)
+			lpOpts.ReorgJournal.(*reorgsmocks.Journal).On("Record", mock.Anything, mock.MatchedBy(func(r reorgs.Reorg) bool {
+				return r.Source == reorgs.SourceLogPoller && r.Depth == 1 && r.LCABlockNumber == 1 && r.LCABlockHash == lca.Hash() &&
+					r.OldHeadHash == orphaned.BlockHash
+			}), []common.Hash{orphaned.BlockHash}).Return(nil).Once()
+
 			newStart = th.PollAndSaveLogs(testutils.Context(t), newStart)
 			assert.Equal(t, int64(36), newStart)
 			assert.NoError(t, th.LogPoller.Healthy())
@@ -1294,6 +1304,65 @@ func TestLogPoller_LoadFilters(t *testing.T) {
 	})
 }
 
+func TestLogPoller_ReplayFilter(t *testing.T) {
+	t.Parallel()
+
+	lpOpts := logpoller.Opts{
+		UseFinalityTag:           false,
+		FinalityDepth:            2,
+		BackfillBatchSize:        3,
+		RPCBatchSize:             2,
+		KeepFinalizedBlocksDepth: 1000,
+	}
+	th := SetupTH(t, lpOpts)
+	ctx := testutils.Context(t)
+	log1 := EmitterABI.Events["Log1"].ID
+
+	require.NoError(t, th.LogPoller.RegisterFilter(ctx, logpoller.Filter{Name: "emitter1", EventSigs: []common.Hash{log1}, Addresses: []common.Address{th.EmitterAddress1}}))
+
+	// Emit logs from both emitters in blocks 2->6, then finalize them.
+	for i := 0; i < 5; i++ {
+		_, err := th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(int64(i))})
+		require.NoError(t, err)
+		_, err = th.Emitter2.EmitLog1(th.Owner, []*big.Int{big.NewInt(int64(i))})
+		require.NoError(t, err)
+		th.Backend.Commit()
+	}
+	for i := 0; i < 3; i++ {
+		th.Backend.Commit()
+	}
+	th.PollAndSaveLogs(ctx, 1)
+
+	logs, err := th.LogPoller.Logs(ctx, 2, 6, log1, th.EmitterAddress2)
+	require.NoError(t, err)
+	require.Empty(t, logs)
+
+	require.NoError(t, th.LogPoller.RegisterFilter(ctx, logpoller.Filter{Name: "emitter2", EventSigs: []common.Hash{log1}, Addresses: []common.Address{th.EmitterAddress2}}))
+	require.NoError(t, th.LogPoller.Start(ctx))
+	t.Cleanup(func() { assert.NoError(t, th.LogPoller.Close()) })
+
+	require.ErrorIs(t, th.LogPoller.ReplayFilter(ctx, "missing", 2), logpoller.ErrFilterNotRegistered)
+	require.ErrorContains(t, th.LogPoller.ReplayFilter(ctx, "emitter2", 0), "Invalid replay block number")
+
+	require.NoError(t, th.LogPoller.ReplayFilter(ctx, "emitter2", 2))
+	logs, err = th.LogPoller.Logs(ctx, 2, 6, log1, th.EmitterAddress2)
+	require.NoError(t, err)
+	assert.Len(t, logs, 5)
+
+	stats, err := th.LogPoller.GetFilterStats(ctx)
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+	for i, name := range []string{"emitter1", "emitter2"} {
+		assert.Equal(t, name, stats[i].Name)
+		assert.Equal(t, int64(5), stats[i].LogCount)
+		require.NotNil(t, stats[i].OldestBlockNumber)
+		assert.Equal(t, int64(2), *stats[i].OldestBlockNumber)
+		require.NotNil(t, stats[i].NewestBlockNumber)
+		assert.Equal(t, int64(6), *stats[i].NewestBlockNumber)
+		assert.NotNil(t, stats[i].LastMatchedAt)
+	}
+}
+
 func TestLogPoller_GetBlocks_Range(t *testing.T) {
 	t.Parallel()
 
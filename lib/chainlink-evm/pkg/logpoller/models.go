@@ -38,6 +38,20 @@ type Log struct {
 	CreatedAt      time.Time
 }
 
+// FilterLogStats summarizes the logs retained for a filter.
+type FilterLogStats struct {
+	LogCount          int64
+	OldestBlockNumber *int64     // nil if no logs are retained
+	NewestBlockNumber *int64     // nil if no logs are retained
+	LastMatchedAt     *time.Time // when the newest matching log was saved, nil if no logs are retained
+}
+
+// FilterStats is a registered filter along with the stats of its retained logs.
+type FilterStats struct {
+	Filter
+	FilterLogStats
+}
+
 func (l *Log) GetTopics() []common.Hash {
 	tps := make([]common.Hash, 0, len(l.Topics))
 	for _, topic := range l.Topics {
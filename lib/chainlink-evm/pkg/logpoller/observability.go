@@ -78,6 +78,12 @@ func (o *ObservedORM) LoadFilters(ctx context.Context) (map[string]Filter, error
 	})
 }
 
+func (o *ObservedORM) SelectFilterLogStats(ctx context.Context) (map[string]FilterLogStats, error) {
+	return withObservedQuery(ctx, o, "SelectFilterLogStats", func() (map[string]FilterLogStats, error) {
+		return o.ORM.SelectFilterLogStats(ctx)
+	})
+}
+
 func (o *ObservedORM) DeleteFilter(ctx context.Context, name string) error {
 	return withObservedExec(ctx, o, "DeleteFilter", metrics.Del, func() error {
 		return o.ORM.DeleteFilter(ctx, name)
@@ -29,6 +29,7 @@ type ORM interface {
 	InsertFilter(ctx context.Context, filter Filter) error
 
 	LoadFilters(ctx context.Context) (map[string]Filter, error)
+	SelectFilterLogStats(ctx context.Context) (map[string]FilterLogStats, error)
 	DeleteFilter(ctx context.Context, name string) error
 
 	DeleteLogsByRowID(ctx context.Context, rowIDs []uint64) (int64, error)
@@ -188,6 +189,36 @@ func (o *DSORM) LoadFilters(ctx context.Context) (map[string]Filter, error) {
 	return filters, err
 }
 
+// SelectFilterLogStats returns the stats of the logs matching each filter, keyed by filter name.
+// Like log pruning, logs are matched on address and event signature only.
+func (o *DSORM) SelectFilterLogStats(ctx context.Context) (map[string]FilterLogStats, error) {
+	query := `WITH filters AS (
+			SELECT name, ARRAY_AGG(DISTINCT address) AS addresses, ARRAY_AGG(DISTINCT event) AS events
+			FROM evm.log_poller_filters WHERE evm_chain_id = $1
+			GROUP BY name
+		)
+		SELECT f.name,
+			COUNT(l.id) AS log_count,
+			MIN(l.block_number) AS oldest_block_number,
+			MAX(l.block_number) AS newest_block_number,
+			MAX(l.created_at) AS last_matched_at
+		FROM filters f LEFT JOIN evm.logs l ON
+			l.evm_chain_id = $1 AND l.address = ANY(f.addresses) AND l.event_sig = ANY(f.events)
+		GROUP BY f.name`
+	var rows []struct {
+		Name string
+		FilterLogStats
+	}
+	if err := o.ds.SelectContext(ctx, &rows, query, ubig.New(o.chainID)); err != nil {
+		return nil, err
+	}
+	stats := make(map[string]FilterLogStats, len(rows))
+	for _, row := range rows {
+		stats[row.Name] = row.FilterLogStats
+	}
+	return stats, nil
+}
+
 func blocksQuery(clause string) string {
 	return fmt.Sprintf(`SELECT %s FROM evm.log_poller_blocks %s`, strings.Join(blocksFields[:], ", "), clause)
 }
@@ -94,6 +94,41 @@ func TestLogPoller_Batching(t *testing.T) {
 	require.Equal(t, len(logs), len(lgs))
 }
 
+func TestORM_SelectFilterLogStats(t *testing.T) {
+	th := SetupTH(t, lpOpts)
+	o1 := th.ORM
+	ctx := testutils.Context(t)
+	event1 := EmitterABI.Events["Log1"].ID
+	event2 := EmitterABI.Events["Log2"].ID
+
+	require.NoError(t, o1.InsertFilter(ctx, logpoller.Filter{Name: "matched", Addresses: []common.Address{th.EmitterAddress1}, EventSigs: []common.Hash{event1, event2}}))
+	require.NoError(t, o1.InsertFilter(ctx, logpoller.Filter{Name: "unmatched", Addresses: []common.Address{th.EmitterAddress2}, EventSigs: []common.Hash{event1}}))
+	require.NoError(t, o1.InsertLogs(ctx, []logpoller.Log{
+		GenLog(th.ChainID, 1, 10, "0x10", event1.Bytes(), th.EmitterAddress1),
+		GenLog(th.ChainID, 2, 10, "0x10", event2.Bytes(), th.EmitterAddress1),
+		GenLog(th.ChainID, 1, 12, "0x12", event1.Bytes(), th.EmitterAddress1),
+		GenLog(th.ChainID, 2, 12, "0x12", event2.Bytes(), th.EmitterAddress2),
+	}))
+	// logs of other chains are not counted
+	require.NoError(t, th.ORM2.InsertLogs(ctx, []logpoller.Log{
+		GenLog(th.ChainID2, 1, 11, "0x11", event1.Bytes(), th.EmitterAddress2),
+	}))
+
+	stats, err := o1.SelectFilterLogStats(ctx)
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+
+	matched := stats["matched"]
+	assert.Equal(t, int64(3), matched.LogCount)
+	require.NotNil(t, matched.OldestBlockNumber)
+	assert.Equal(t, int64(10), *matched.OldestBlockNumber)
+	require.NotNil(t, matched.NewestBlockNumber)
+	assert.Equal(t, int64(12), *matched.NewestBlockNumber)
+	assert.NotNil(t, matched.LastMatchedAt)
+
+	assert.Equal(t, logpoller.FilterLogStats{}, stats["unmatched"])
+}
+
 func TestORM_GetBlocks_From_Range(t *testing.T) {
 	th := SetupTH(t, lpOpts)
 	o1 := th.ORM
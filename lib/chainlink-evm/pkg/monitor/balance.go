@@ -5,12 +5,15 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"net/http"
+	"net/url"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
 	pkgerrors "github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/shopspring/decimal"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
@@ -21,6 +24,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
 	evmclient "github.com/smartcontractkit/chainlink-evm/pkg/client"
+	"github.com/smartcontractkit/chainlink-evm/pkg/config"
 	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
 )
 
@@ -30,6 +34,8 @@ type (
 	BalanceMonitor interface {
 		HeadTrackable
 		GetEthBalance(common.Address) *assets.Eth
+		// GetBalanceReport returns the balances of the key along with their minimums, or nil if they were not checked yet.
+		GetBalanceReport(common.Address) *BalanceReport
 		services.Service
 	}
 
@@ -40,9 +46,18 @@ type (
 		ethClient      evmclient.Client
 		chainIDStr     string
 		ethKeyStore    keys.AddressLister
+		cfg            config.BalanceMonitor
 		ethBalances    map[common.Address]*assets.Eth
 		ethBalancesMtx sync.RWMutex
 		sleeperTask    *utils.SleeperTask
+
+		keysMtx sync.RWMutex
+		keys    map[common.Address]*keyBalances
+
+		alertsMtx     sync.Mutex
+		alerting      map[string]bool // by health condition, see alertCondition
+		alertWebhook  *url.URL
+		webhookClient *http.Client
 	}
 
 	NullBalanceMonitor struct{}
@@ -51,12 +66,17 @@ type (
 var _ BalanceMonitor = (*balanceMonitor)(nil)
 
 // NewBalanceMonitor returns a new balanceMonitor
-func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keys.AddressLister, lggr logger.Logger) *balanceMonitor {
+func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keys.AddressLister, cfg config.BalanceMonitor, lggr logger.Logger) *balanceMonitor {
 	bm := &balanceMonitor{
-		ethClient:   ethClient,
-		chainIDStr:  ethClient.ConfiguredChainID().String(),
-		ethKeyStore: ethKeyStore,
-		ethBalances: make(map[common.Address]*assets.Eth),
+		ethClient:     ethClient,
+		chainIDStr:    ethClient.ConfiguredChainID().String(),
+		ethKeyStore:   ethKeyStore,
+		cfg:           cfg,
+		ethBalances:   make(map[common.Address]*assets.Eth),
+		keys:          make(map[common.Address]*keyBalances),
+		alerting:      make(map[string]bool),
+		alertWebhook:  cfg.AlertWebhookURL(),
+		webhookClient: &http.Client{Timeout: alertWebhookTimeout},
 	}
 	bm.Service, bm.eng = services.Config{
 		Name:  "BalanceMonitor",
@@ -95,6 +115,11 @@ func (bm *balanceMonitor) updateBalance(ethBal assets.Eth, address common.Addres
 	bm.ethBalances[address] = &ethBal
 	bm.ethBalancesMtx.Unlock()
 
+	bm.sampleBalance(address, ethBal.ToInt())
+	if minBal := bm.cfg.MinBalance(address); minBal != nil && !minBal.IsZero() {
+		bm.checkMinimum(address, nativeAsset, ethBal.String(), (*assets.Eth)(minBal.ToInt()).String(), ethBal.ToInt().Cmp(minBal.ToInt()) < 0)
+	}
+
 	lgr := logger.Named(bm.eng, "BalanceLog")
 	lgr = logger.With(lgr,
 		"address", address.Hex(),
@@ -181,12 +206,29 @@ func (w *worker) checkAccountBalance(ctx context.Context, address common.Address
 		ethBal := assets.Eth(*bal)
 		w.bm.updateBalance(ethBal, address)
 	}
+
+	for _, token := range w.bm.cfg.Tokens() {
+		bal, err := w.bm.ethClient.TokenBalance(ctx, address, token.Address)
+		if err != nil {
+			w.bm.eng.Errorw("BalanceMonitor: error getting token balance for key "+address.Hex(),
+				"err", err,
+				"address", address,
+				"token", token.Symbol,
+			)
+			continue
+		}
+		w.bm.updateTokenBalance(token, decimal.NewFromBigInt(bal, -int32(token.Decimals)), address)
+	}
 }
 
 func (*NullBalanceMonitor) GetEthBalance(common.Address) *assets.Eth {
 	return nil
 }
 
+func (*NullBalanceMonitor) GetBalanceReport(common.Address) *BalanceReport {
+	return nil
+}
+
 // Start does noop for NullBalanceMonitor.
 func (*NullBalanceMonitor) Start(context.Context) error                                { return nil }
 func (*NullBalanceMonitor) Close() error                                               { return nil }
@@ -0,0 +1,266 @@
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink-evm/pkg/config"
+)
+
+const (
+	// nativeAsset is the asset name native balance alerts are reported with
+	nativeAsset         = "ETH"
+	alertWebhookTimeout = 10 * time.Second
+)
+
+// ErrBalanceBelowMinimum is reported by the BalanceMonitor health report while a balance is below its minimum.
+var ErrBalanceBelowMinimum = errors.New("balance below minimum")
+
+var (
+	promTokenBalance = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "evm_token_balance",
+		Help: "Each EVM account's balance of the ERC-20 tokens tracked by the balance monitor, in whole tokens",
+	}, []string{"account", "evmChainID", "token"})
+	promDaysUntilEmpty = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "evm_balance_days_until_empty",
+		Help: "Estimated number of days until each EVM account's native balance runs out, at the rate it was spent over the BurnRateWindow",
+	}, []string{"account", "evmChainID"})
+)
+
+// BalanceReport is the latest known balances of a key, along with their minimums.
+type BalanceReport struct {
+	Address    common.Address
+	EthBalance *assets.Eth
+	// MinBalance is zero if no alert is configured for the key.
+	MinBalance *assets.Wei
+	// DaysUntilEmpty is estimated from the native balance spent over the BurnRateWindow, nil if none was spent.
+	DaysUntilEmpty *float64
+	Tokens         []TokenBalance
+}
+
+// BelowMinimum returns true if the native balance or any of the token balances is below its minimum.
+func (r *BalanceReport) BelowMinimum() bool {
+	if r.EthBalance != nil && r.MinBalance != nil && r.EthBalance.ToInt().Cmp(r.MinBalance.ToInt()) < 0 {
+		return true
+	}
+	for _, t := range r.Tokens {
+		if t.BelowMinimum() {
+			return true
+		}
+	}
+	return false
+}
+
+// TokenBalance is the balance of an ERC-20 token held by a key.
+type TokenBalance struct {
+	Symbol  string
+	Address common.Address
+	// Balance is in whole tokens.
+	Balance decimal.Decimal
+	// MinBalance is in whole tokens, nil if no alert is configured.
+	MinBalance *decimal.Decimal
+}
+
+func (t TokenBalance) BelowMinimum() bool {
+	return t.MinBalance != nil && t.Balance.LessThan(*t.MinBalance)
+}
+
+// BalanceAlert is POSTed to the AlertWebhookURL whenever a balance falls below its minimum, or recovers from it.
+type BalanceAlert struct {
+	EVMChainID   string    `json:"evmChainID"`
+	Address      string    `json:"address"`
+	Asset        string    `json:"asset"`
+	Balance      string    `json:"balance"`
+	MinBalance   string    `json:"minBalance"`
+	BelowMinimum bool      `json:"belowMinimum"`
+	Timestamp    time.Time `json:"timestamp"`
+}
+
+type balanceSample struct {
+	at      time.Time
+	balance *big.Int
+}
+
+// keyBalances is what is tracked for a key beyond its latest native balance.
+type keyBalances struct {
+	// samples are the changes of the native balance over the BurnRateWindow, oldest first
+	samples []balanceSample
+	tokens  map[common.Address]TokenBalance
+}
+
+// daysUntilEmpty divides the latest balance by the rate it was spent since the oldest sample. Top-ups are ignored.
+func (k *keyBalances) daysUntilEmpty(now time.Time) *float64 {
+	if len(k.samples) < 2 {
+		return nil
+	}
+	spent := new(big.Int)
+	for i := 1; i < len(k.samples); i++ {
+		if d := new(big.Int).Sub(k.samples[i-1].balance, k.samples[i].balance); d.Sign() > 0 {
+			spent.Add(spent, d)
+		}
+	}
+	elapsed := now.Sub(k.samples[0].at)
+	if spent.Sign() == 0 || elapsed <= 0 {
+		return nil
+	}
+	balance := new(big.Float).SetInt(k.samples[len(k.samples)-1].balance)
+	days, _ := balance.Mul(balance, big.NewFloat(elapsed.Hours()/24)).Quo(balance, new(big.Float).SetInt(spent)).Float64()
+	return &days
+}
+
+func (bm *balanceMonitor) GetBalanceReport(address common.Address) *BalanceReport {
+	ethBal := bm.GetEthBalance(address)
+
+	bm.keysMtx.RLock()
+	defer bm.keysMtx.RUnlock()
+	kb := bm.keys[address]
+	if ethBal == nil && kb == nil {
+		return nil
+	}
+	r := &BalanceReport{Address: address, EthBalance: ethBal, MinBalance: bm.cfg.MinBalance(address)}
+	if kb != nil {
+		r.DaysUntilEmpty = kb.daysUntilEmpty(time.Now())
+		for _, token := range bm.cfg.Tokens() {
+			if tb, ok := kb.tokens[token.Address]; ok {
+				r.Tokens = append(r.Tokens, tb)
+			}
+		}
+	}
+	return r
+}
+
+// getKeyBalances must be called with keysMtx held.
+func (bm *balanceMonitor) getKeyBalances(address common.Address) *keyBalances {
+	kb, ok := bm.keys[address]
+	if !ok {
+		kb = &keyBalances{tokens: make(map[common.Address]TokenBalance)}
+		bm.keys[address] = kb
+	}
+	return kb
+}
+
+// sampleBalance records the native balance if it changed, and updates the burn rate estimate.
+func (bm *balanceMonitor) sampleBalance(address common.Address, balance *big.Int) {
+	now := time.Now()
+
+	bm.keysMtx.Lock()
+	kb := bm.getKeyBalances(address)
+	if n := len(kb.samples); n == 0 || kb.samples[n-1].balance.Cmp(balance) != 0 {
+		kb.samples = append(kb.samples, balanceSample{at: now, balance: new(big.Int).Set(balance)})
+	}
+	// drop the samples which left the window, always keeping the latest one
+	cutoff := now.Add(-bm.cfg.BurnRateWindow())
+	i := 0
+	for i < len(kb.samples)-1 && kb.samples[i].at.Before(cutoff) {
+		i++
+	}
+	kb.samples = kb.samples[i:]
+	days := kb.daysUntilEmpty(now)
+	bm.keysMtx.Unlock()
+
+	if days == nil {
+		promDaysUntilEmpty.WithLabelValues(address.Hex(), bm.chainIDStr).Set(math.Inf(1))
+	} else {
+		promDaysUntilEmpty.WithLabelValues(address.Hex(), bm.chainIDStr).Set(*days)
+	}
+}
+
+func (bm *balanceMonitor) updateTokenBalance(token config.BalanceMonitorToken, balance decimal.Decimal, address common.Address) {
+	tb := TokenBalance{Symbol: token.Symbol, Address: token.Address, Balance: balance, MinBalance: token.MinBalance}
+
+	bm.keysMtx.Lock()
+	kb := bm.getKeyBalances(address)
+	oldBal, ok := kb.tokens[token.Address]
+	kb.tokens[token.Address] = tb
+	bm.keysMtx.Unlock()
+
+	f, _ := balance.Float64()
+	promTokenBalance.WithLabelValues(address.Hex(), bm.chainIDStr, token.Symbol).Set(f)
+	if !ok || !oldBal.Balance.Equal(balance) {
+		bm.eng.Infof("%s balance for %s: %s", token.Symbol, address.Hex(), balance)
+	}
+
+	if token.MinBalance != nil {
+		bm.checkMinimum(address, token.Symbol, balance.String(), token.MinBalance.String(), tb.BelowMinimum())
+	}
+}
+
+func alertCondition(address common.Address, asset string) string {
+	return fmt.Sprintf("%s balance of %s", asset, address.Hex())
+}
+
+// checkMinimum raises an alert when a balance falls below its minimum, and another one when it recovers.
+func (bm *balanceMonitor) checkMinimum(address common.Address, asset, balance, minBalance string, below bool) {
+	cond := alertCondition(address, asset)
+	bm.alertsMtx.Lock()
+	wasBelow := bm.alerting[cond]
+	bm.alerting[cond] = below
+	bm.alertsMtx.Unlock()
+	if below == wasBelow {
+		return
+	}
+
+	if below {
+		bm.eng.SetHealthCond(cond, fmt.Errorf("%w: %s is below %s", ErrBalanceBelowMinimum, balance, minBalance))
+		bm.eng.Criticalw(fmt.Sprintf("%s balance of %s fell below its minimum", asset, address.Hex()),
+			"address", address.Hex(), "asset", asset, "balance", balance, "minBalance", minBalance)
+	} else {
+		bm.eng.ClearHealthCond(cond)
+		bm.eng.Infow(fmt.Sprintf("%s balance of %s recovered above its minimum", asset, address.Hex()),
+			"address", address.Hex(), "asset", asset, "balance", balance, "minBalance", minBalance)
+	}
+
+	if bm.alertWebhook == nil {
+		return
+	}
+	alert := BalanceAlert{
+		EVMChainID:   bm.chainIDStr,
+		Address:      address.Hex(),
+		Asset:        asset,
+		Balance:      balance,
+		MinBalance:   minBalance,
+		BelowMinimum: below,
+		Timestamp:    time.Now(),
+	}
+	bm.eng.Go(func(ctx context.Context) {
+		if err := bm.postAlert(ctx, alert); err != nil {
+			bm.eng.Errorw("BalanceMonitor: failed to deliver alert webhook", "err", err, "address", alert.Address, "asset", asset)
+		}
+	})
+}
+
+func (bm *balanceMonitor) postAlert(ctx context.Context, alert BalanceAlert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return fmt.Errorf("failed to marshal alert: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, bm.alertWebhook.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := bm.webhookClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
@@ -2,34 +2,53 @@ package monitor_test
 
 import (
 	"context"
+	"encoding/json"
 	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/onsi/gomega"
 	pkgerrors "github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
 	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
 	"github.com/smartcontractkit/chainlink-evm/pkg/client/clienttest"
+	"github.com/smartcontractkit/chainlink-evm/pkg/config"
+	"github.com/smartcontractkit/chainlink-evm/pkg/config/configtest"
+	"github.com/smartcontractkit/chainlink-evm/pkg/config/toml"
 	"github.com/smartcontractkit/chainlink-evm/pkg/keys/keystest"
 	"github.com/smartcontractkit/chainlink-evm/pkg/monitor"
 	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
+	"github.com/smartcontractkit/chainlink-evm/pkg/types"
 )
 
+func ptr[T any](t T) *T { return &t }
+
 var nilBigInt *big.Int
 
 func newEthClientMock(t *testing.T) *clienttest.Client {
 	return clienttest.NewClientWithDefaultChainID(t)
 }
 
+func newBalanceMonitorConfig(t *testing.T, overrideFn func(c *toml.BalanceMonitor)) config.BalanceMonitor {
+	return configtest.NewChainScopedConfig(t, func(c *toml.EVMConfig) {
+		if overrideFn != nil {
+			overrideFn(&c.BalanceMonitor)
+		}
+	}).EVM().BalanceMonitor()
+}
+
 func TestBalanceMonitor_Start(t *testing.T) {
 	t.Parallel()
 
@@ -39,7 +58,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 		ethKeyStore := keystest.Addresses{k0Addr, k1Addr}
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, newBalanceMonitorConfig(t, nil), logger.Test(t))
 
 		k0bal := big.NewInt(42)
 		k1bal := big.NewInt(43)
@@ -64,7 +83,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 		ethKeyStore := keystest.Addresses{k0Addr}
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, newBalanceMonitorConfig(t, nil), logger.Test(t))
 		k0bal := big.NewInt(42)
 
 		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(k0bal, nil)
@@ -81,7 +100,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 		ethKeyStore := keystest.Addresses{k0Addr}
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, newBalanceMonitorConfig(t, nil), logger.Test(t))
 		ctxCancelledAwaiter := testutils.NewAwaiter()
 
 		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Run(func(args mock.Arguments) {
@@ -108,7 +127,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 		ethKeyStore := keystest.Addresses{k0Addr}
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, newBalanceMonitorConfig(t, nil), logger.Test(t))
 
 		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).
 			Once().
@@ -131,7 +150,7 @@ func TestBalanceMonitor_OnNewLongestChain_UpdatesBalance(t *testing.T) {
 		ethKeyStore := keystest.Addresses{k0Addr, k1Addr}
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, newBalanceMonitorConfig(t, nil), logger.Test(t))
 		k0bal := big.NewInt(42)
 		// Deliberately larger than a 64 bit unsigned integer to test overflow
 		k1bal := big.NewInt(0)
@@ -177,7 +196,7 @@ func TestBalanceMonitor_FewerRPCCallsWhenBehind(t *testing.T) {
 	ethKeyStore := keystest.Addresses{testutils.NewAddress()}
 	ethClient := newEthClientMock(t)
 
-	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, newBalanceMonitorConfig(t, nil), logger.Test(t))
 	ethClient.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).
 		Once().
 		Return(big.NewInt(1), nil)
@@ -214,6 +233,106 @@ func TestBalanceMonitor_FewerRPCCallsWhenBehind(t *testing.T) {
 	assert.LessOrEqual(t, callCount.Load(), int32(1))
 }
 
+func TestBalanceMonitor_MinBalance(t *testing.T) {
+	t.Parallel()
+
+	alerts := make(chan monitor.BalanceAlert, 2)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var alert monitor.BalanceAlert
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&alert))
+		alerts <- alert
+	}))
+	t.Cleanup(srv.Close)
+
+	k0Addr := testutils.NewAddress()
+	ethKeyStore := keystest.Addresses{k0Addr}
+	ethClient := newEthClientMock(t)
+	cfg := newBalanceMonitorConfig(t, func(c *toml.BalanceMonitor) {
+		c.MinBalance = assets.Ether(1)
+		c.AlertWebhookURL = commonconfig.MustParseURL(srv.URL)
+	})
+	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, cfg, logger.Test(t))
+
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(assets.UEther(500_000).ToInt(), nil)
+	servicetest.Run(t, bm)
+
+	report := bm.GetBalanceReport(k0Addr)
+	require.NotNil(t, report)
+	assert.True(t, report.BelowMinimum())
+	assert.Equal(t, assets.Ether(1), report.MinBalance)
+	require.ErrorIs(t, bm.HealthReport()[bm.Name()], monitor.ErrBalanceBelowMinimum)
+	alert := <-alerts
+	assert.True(t, alert.BelowMinimum)
+	assert.Equal(t, k0Addr.Hex(), alert.Address)
+	assert.Equal(t, "ETH", alert.Asset)
+
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(assets.Ether(2).ToInt(), nil)
+	bm.OnNewLongestChain(tests.Context(t), testutils.Head(1))
+	<-bm.WorkDone()
+
+	assert.False(t, bm.GetBalanceReport(k0Addr).BelowMinimum())
+	require.NoError(t, bm.HealthReport()[bm.Name()])
+	alert = <-alerts
+	assert.False(t, alert.BelowMinimum)
+}
+
+func TestBalanceMonitor_TokenBalances(t *testing.T) {
+	t.Parallel()
+
+	k0Addr := testutils.NewAddress()
+	link := types.MustEIP55Address("0x2a3e23c6f242F5345320814aC8a1b4E58707D292")
+	ethKeyStore := keystest.Addresses{k0Addr}
+	ethClient := newEthClientMock(t)
+	cfg := newBalanceMonitorConfig(t, func(c *toml.BalanceMonitor) {
+		c.Tokens = []toml.BalanceMonitorToken{{
+			Symbol:     ptr("LINK"),
+			Address:    &link,
+			Decimals:   ptr[uint8](18),
+			MinBalance: ptr(decimal.RequireFromString("10")),
+		}}
+	})
+	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, cfg, logger.Test(t))
+
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(42), nil)
+	ethClient.On("TokenBalance", mock.Anything, k0Addr, link.Address()).Once().Return(assets.Ether(5).ToInt(), nil)
+	servicetest.Run(t, bm)
+
+	report := bm.GetBalanceReport(k0Addr)
+	require.NotNil(t, report)
+	require.Len(t, report.Tokens, 1)
+	assert.Equal(t, "LINK", report.Tokens[0].Symbol)
+	assert.True(t, decimal.RequireFromString("5").Equal(report.Tokens[0].Balance))
+	assert.True(t, report.Tokens[0].BelowMinimum())
+	assert.True(t, report.BelowMinimum())
+	require.ErrorIs(t, bm.HealthReport()[bm.Name()], monitor.ErrBalanceBelowMinimum)
+}
+
+func TestBalanceMonitor_DaysUntilEmpty(t *testing.T) {
+	t.Parallel()
+
+	k0Addr := testutils.NewAddress()
+	ethKeyStore := keystest.Addresses{k0Addr}
+	ethClient := newEthClientMock(t)
+	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, newBalanceMonitorConfig(t, nil), logger.Test(t))
+
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(100), nil)
+	servicetest.RunHealthy(t, bm)
+	assert.Nil(t, bm.GetBalanceReport(k0Addr).DaysUntilEmpty)
+
+	// top-ups are not spending
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(200), nil)
+	bm.OnNewLongestChain(tests.Context(t), testutils.Head(1))
+	<-bm.WorkDone()
+	assert.Nil(t, bm.GetBalanceReport(k0Addr).DaysUntilEmpty)
+
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(150), nil)
+	bm.OnNewLongestChain(tests.Context(t), testutils.Head(2))
+	<-bm.WorkDone()
+	days := bm.GetBalanceReport(k0Addr).DaysUntilEmpty
+	require.NotNil(t, days)
+	assert.Positive(t, *days)
+}
+
 func Test_ApproximateFloat64(t *testing.T) {
 	t.Parallel()
 
@@ -10,6 +10,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	monitor "github.com/smartcontractkit/chainlink-evm/pkg/monitor"
+
 	types "github.com/smartcontractkit/chainlink-evm/pkg/types"
 )
 
@@ -71,6 +73,54 @@ func (_c *BalanceMonitor_Close_Call) RunAndReturn(run func() error) *BalanceMoni
 	return _c
 }
 
+// GetBalanceReport provides a mock function with given fields: _a0
+func (_m *BalanceMonitor) GetBalanceReport(_a0 common.Address) *monitor.BalanceReport {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBalanceReport")
+	}
+
+	var r0 *monitor.BalanceReport
+	if rf, ok := ret.Get(0).(func(common.Address) *monitor.BalanceReport); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*monitor.BalanceReport)
+		}
+	}
+
+	return r0
+}
+
+// BalanceMonitor_GetBalanceReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBalanceReport'
+type BalanceMonitor_GetBalanceReport_Call struct {
+	*mock.Call
+}
+
+// GetBalanceReport is a helper method to define mock.On call
+//   - _a0 common.Address
+func (_e *BalanceMonitor_Expecter) GetBalanceReport(_a0 interface{}) *BalanceMonitor_GetBalanceReport_Call {
+	return &BalanceMonitor_GetBalanceReport_Call{Call: _e.mock.On("GetBalanceReport", _a0)}
+}
+
+func (_c *BalanceMonitor_GetBalanceReport_Call) Run(run func(_a0 common.Address)) *BalanceMonitor_GetBalanceReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(common.Address))
+	})
+	return _c
+}
+
+func (_c *BalanceMonitor_GetBalanceReport_Call) Return(_a0 *monitor.BalanceReport) *BalanceMonitor_GetBalanceReport_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BalanceMonitor_GetBalanceReport_Call) RunAndReturn(run func(common.Address) *monitor.BalanceReport) *BalanceMonitor_GetBalanceReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetEthBalance provides a mock function with given fields: _a0
 func (_m *BalanceMonitor) GetEthBalance(_a0 common.Address) *assets.Eth {
 	ret := _m.Called(_a0)
@@ -0,0 +1,80 @@
+package reorgs
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+)
+
+type ORM interface {
+	// InsertReorg saves the reorg and sets its ID.
+	InsertReorg(ctx context.Context, r *Reorg) error
+	// SelectReorgs returns the latest reorgs, most recent first.
+	SelectReorgs(ctx context.Context, limit int) ([]Reorg, error)
+	// SelectTxIDsByBlockHash returns the IDs of the transactions with a receipt in one of the given blocks.
+	SelectTxIDsByBlockHash(ctx context.Context, blockHashes []common.Hash) ([]int64, error)
+	// CountLogsByBlockHash returns the number of LogPoller logs saved from the given blocks.
+	CountLogsByBlockHash(ctx context.Context, blockHashes []common.Hash) (int64, error)
+}
+
+var _ ORM = &DbORM{}
+
+type DbORM struct {
+	chainID ubig.Big
+	ds      sqlutil.DataSource
+}
+
+// NewORM creates an ORM scoped to chainID.
+func NewORM(chainID big.Int, ds sqlutil.DataSource) *DbORM {
+	return &DbORM{chainID: ubig.Big(chainID), ds: ds}
+}
+
+func (orm *DbORM) InsertReorg(ctx context.Context, r *Reorg) error {
+	query := `INSERT INTO evm.reorgs
+				(evm_chain_id, source, depth, lca_block_number, lca_block_hash, old_head_number, old_head_hash, old_head_timestamp,
+				 new_head_number, new_head_hash, new_head_timestamp, tx_ids, log_count, detected_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+			RETURNING id`
+	err := orm.ds.GetContext(ctx, &r.ID, query, orm.chainID, r.Source, r.Depth, r.LCABlockNumber, r.LCABlockHash,
+		r.OldHeadNumber, r.OldHeadHash, r.OldHeadTimestamp, r.NewHeadNumber, r.NewHeadHash, r.NewHeadTimestamp,
+		r.TxIDs, r.LogCount, r.DetectedAt)
+	return pkgerrors.Wrap(err, "InsertReorg failed")
+}
+
+func (orm *DbORM) SelectReorgs(ctx context.Context, limit int) (reorgs []Reorg, err error) {
+	err = orm.ds.SelectContext(ctx, &reorgs, `SELECT * FROM evm.reorgs WHERE evm_chain_id = $1 ORDER BY detected_at DESC, id DESC LIMIT $2`, orm.chainID, limit)
+	err = pkgerrors.Wrap(err, "SelectReorgs failed")
+	return
+}
+
+func (orm *DbORM) SelectTxIDsByBlockHash(ctx context.Context, blockHashes []common.Hash) (ids []int64, err error) {
+	query := `SELECT DISTINCT eta.eth_tx_id FROM evm.receipts er
+				JOIN evm.tx_attempts eta ON eta.hash = er.tx_hash
+				JOIN evm.txes et ON et.id = eta.eth_tx_id
+			WHERE et.evm_chain_id = $1 AND er.block_hash = ANY($2)
+			ORDER BY eta.eth_tx_id`
+	err = orm.ds.SelectContext(ctx, &ids, query, orm.chainID, hashArray(blockHashes))
+	err = pkgerrors.Wrap(err, "SelectTxIDsByBlockHash failed")
+	return
+}
+
+func (orm *DbORM) CountLogsByBlockHash(ctx context.Context, blockHashes []common.Hash) (count int64, err error) {
+	err = orm.ds.GetContext(ctx, &count, `SELECT COUNT(*) FROM evm.logs WHERE evm_chain_id = $1 AND block_hash = ANY($2)`, orm.chainID, hashArray(blockHashes))
+	err = pkgerrors.Wrap(err, "CountLogsByBlockHash failed")
+	return
+}
+
+func hashArray(hashes []common.Hash) pq.ByteaArray {
+	arr := make(pq.ByteaArray, len(hashes))
+	for i, h := range hashes {
+		arr[i] = h.Bytes()
+	}
+	return arr
+}
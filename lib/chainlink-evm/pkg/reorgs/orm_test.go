@@ -0,0 +1,91 @@
+package reorgs_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
+	"github.com/smartcontractkit/chainlink-evm/pkg/reorgs"
+	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
+	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr/txmgrtest"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+)
+
+func TestORM_InsertAndSelectReorgs(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+	db := testutils.NewSqlxDB(t)
+	orm := reorgs.NewORM(*testutils.FixtureChainID, db)
+	otherORM := reorgs.NewORM(*big.NewInt(1337), db)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	older := reorgs.Reorg{Source: reorgs.SourceLogPoller, Depth: 1, LCABlockNumber: 9, DetectedAt: now.Add(-time.Minute),
+		OldHeadTimestamp: now, NewHeadTimestamp: now}
+	newer := reorgs.Reorg{Source: reorgs.SourceHeadTracker, Depth: 3, LCABlockNumber: 10, LCABlockHash: testutils.NewHash(),
+		OldHeadNumber: 13, OldHeadHash: testutils.NewHash(), OldHeadTimestamp: now.Add(-2 * time.Second),
+		NewHeadNumber: 14, NewHeadHash: testutils.NewHash(), NewHeadTimestamp: now,
+		TxIDs: []int64{4, 2}, LogCount: 7, DetectedAt: now}
+	require.NoError(t, orm.InsertReorg(ctx, &older))
+	require.NoError(t, orm.InsertReorg(ctx, &newer))
+	require.NoError(t, otherORM.InsertReorg(ctx, &reorgs.Reorg{Source: reorgs.SourceHeadTracker, DetectedAt: now}))
+	assert.NotZero(t, newer.ID)
+
+	found, err := orm.SelectReorgs(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, found, 2)
+	newer.EVMChainID = ubig.New(testutils.FixtureChainID)
+	found[0].DetectedAt, found[0].OldHeadTimestamp, found[0].NewHeadTimestamp = found[0].DetectedAt.UTC(), found[0].OldHeadTimestamp.UTC(), found[0].NewHeadTimestamp.UTC()
+	assert.Equal(t, newer, found[0])
+	assert.Equal(t, older.ID, found[1].ID)
+
+	found, err = orm.SelectReorgs(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, newer.ID, found[0].ID)
+}
+
+func TestORM_OrphanedTxsAndLogs(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+	db := testutils.NewSqlxDB(t)
+	orm := reorgs.NewORM(*testutils.FixtureChainID, db)
+	txStore := txmgrtest.NewTestTxStore(t, db)
+	fromAddress := testutils.NewAddress()
+
+	orphaned, canonical := testutils.NewHash(), testutils.NewHash()
+	var etxIDs []int64
+	for i, blockHash := range []common.Hash{orphaned, canonical} {
+		etx := txmgrtest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, int64(i), 1, fromAddress)
+		_, err := txStore.InsertReceipt(ctx, &evmtypes.Receipt{
+			TxHash:      etx.TxAttempts[0].Hash,
+			BlockHash:   blockHash,
+			BlockNumber: big.NewInt(10),
+			Status:      uint64(1),
+		})
+		require.NoError(t, err)
+		etxIDs = append(etxIDs, etx.ID)
+	}
+
+	lpORM := logpoller.NewORM(testutils.FixtureChainID, db, logger.Test(t))
+	require.NoError(t, lpORM.InsertLogs(ctx, []logpoller.Log{
+		{EVMChainID: ubig.New(testutils.FixtureChainID), LogIndex: 1, BlockHash: orphaned, BlockNumber: 10, EventSig: testutils.NewHash(), Address: testutils.NewAddress(), TxHash: testutils.NewHash()},
+		{EVMChainID: ubig.New(testutils.FixtureChainID), LogIndex: 2, BlockHash: orphaned, BlockNumber: 10, EventSig: testutils.NewHash(), Address: testutils.NewAddress(), TxHash: testutils.NewHash()},
+		{EVMChainID: ubig.New(testutils.FixtureChainID), LogIndex: 1, BlockHash: canonical, BlockNumber: 10, EventSig: testutils.NewHash(), Address: testutils.NewAddress(), TxHash: testutils.NewHash()},
+	}))
+
+	ids, err := orm.SelectTxIDsByBlockHash(ctx, []common.Hash{orphaned, testutils.NewHash()})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{etxIDs[0]}, ids)
+
+	count, err := orm.CountLogsByBlockHash(ctx, []common.Hash{orphaned})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
@@ -0,0 +1,161 @@
+package reorgs
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/heads"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+)
+
+// Source is the service which detected a reorg.
+type Source string
+
+const (
+	SourceHeadTracker Source = "HeadTracker"
+	SourceLogPoller   Source = "LogPoller"
+)
+
+var promReorgDepth = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "evm_reorg_depth",
+	Help:    "The number of blocks orphaned by each reorg, by the service which detected it",
+	Buckets: []float64{1, 2, 3, 5, 10, 20, 50, 100, 250, 500, 1000},
+}, []string{"evmChainID", "source"})
+
+// Reorg is a reorganization of the chain, from the old head to the new one.
+type Reorg struct {
+	ID         int64
+	EVMChainID *ubig.Big
+	Source     Source
+	// Depth is the number of blocks of the old chain which were orphaned.
+	Depth int64
+	// LCABlockNumber and LCABlockHash are the latest common ancestor of the old and new chains.
+	// The hash is zero if the ancestor is older than the chain tracked in memory, in which case the depth is a lower bound.
+	LCABlockNumber   int64       `db:"lca_block_number"`
+	LCABlockHash     common.Hash `db:"lca_block_hash"`
+	OldHeadNumber    int64
+	OldHeadHash      common.Hash
+	OldHeadTimestamp time.Time
+	NewHeadNumber    int64
+	NewHeadHash      common.Hash
+	NewHeadTimestamp time.Time
+	// TxIDs are the IDs of the transactions which had a receipt in one of the orphaned blocks.
+	TxIDs pq.Int64Array `db:"tx_ids"`
+	// LogCount is the number of logs the LogPoller saved from the orphaned blocks.
+	LogCount   int64
+	DetectedAt time.Time
+}
+
+// Journal keeps a durable record of the reorgs detected by the head tracker and the LogPoller.
+type Journal interface {
+	heads.Trackable
+	// Record looks up the transactions and logs of the orphaned blocks, and saves the reorg.
+	Record(ctx context.Context, r Reorg, orphaned []common.Hash) error
+	// Reorgs returns the latest reorgs, most recent first.
+	Reorgs(ctx context.Context, limit int) ([]Reorg, error)
+}
+
+var _ Journal = &journal{}
+
+type journal struct {
+	orm        ORM
+	lggr       logger.SugaredLogger
+	chainID    *big.Int
+	chainIDStr string
+
+	latestMu sync.Mutex
+	latest   *evmtypes.Head
+}
+
+// NewJournal creates a Journal, which must be subscribed to the head broadcaster for the reorgs of the head tracker
+// to be recorded.
+func NewJournal(orm ORM, chainID *big.Int, lggr logger.Logger) Journal {
+	return &journal{
+		orm:        orm,
+		lggr:       logger.Sugared(logger.Named(lggr, "ReorgJournal")),
+		chainID:    chainID,
+		chainIDStr: chainID.String(),
+	}
+}
+
+// OnNewLongestChain records a reorg if the previous head is not part of the new longest chain.
+func (j *journal) OnNewLongestChain(ctx context.Context, head *evmtypes.Head) {
+	j.latestMu.Lock()
+	prev := j.latest
+	j.latest = head
+	j.latestMu.Unlock()
+
+	if prev == nil || head.IsInChain(prev.Hash) {
+		return
+	}
+	if head.EarliestInChain().Number > prev.Number {
+		// the new chain does not reach back to the previous head, e.g. after the node was disconnected for a while
+		j.lggr.Debugw("Unable to tell whether the chain was reorged", "prevHead", prev.Number, "head", head.Number)
+		return
+	}
+	r, orphaned := findReorg(prev, head)
+	if err := j.Record(ctx, r, orphaned); err != nil {
+		j.lggr.Errorw("Failed to record reorg", "err", err, "oldHead", r.OldHeadHash, "newHead", r.NewHeadHash)
+	}
+}
+
+// findReorg walks the old chain back to the latest block which is also part of the new chain, and returns the reorg
+// along with the hashes of the orphaned blocks.
+func findReorg(oldHead, newHead *evmtypes.Head) (Reorg, []common.Hash) {
+	r := Reorg{
+		Source:           SourceHeadTracker,
+		OldHeadNumber:    oldHead.Number,
+		OldHeadHash:      oldHead.Hash,
+		OldHeadTimestamp: oldHead.Timestamp,
+		NewHeadNumber:    newHead.Number,
+		NewHeadHash:      newHead.Hash,
+		NewHeadTimestamp: newHead.Timestamp,
+	}
+	var orphaned []common.Hash
+	cur := oldHead
+	for ; cur != nil; cur = cur.Parent.Load() {
+		if newHead.HashAtHeight(cur.Number) == cur.Hash {
+			break
+		}
+		orphaned = append(orphaned, cur.Hash)
+	}
+	if cur != nil {
+		r.LCABlockNumber, r.LCABlockHash = cur.Number, cur.Hash
+	} else {
+		r.LCABlockNumber = oldHead.EarliestInChain().Number - 1
+	}
+	r.Depth = oldHead.Number - r.LCABlockNumber
+	return r, orphaned
+}
+
+func (j *journal) Record(ctx context.Context, r Reorg, orphaned []common.Hash) (err error) {
+	r.EVMChainID = ubig.New(j.chainID)
+	r.DetectedAt = time.Now()
+	promReorgDepth.WithLabelValues(j.chainIDStr, string(r.Source)).Observe(float64(r.Depth))
+
+	if len(orphaned) > 0 {
+		if r.TxIDs, err = j.orm.SelectTxIDsByBlockHash(ctx, orphaned); err != nil {
+			return err
+		}
+		if r.LogCount, err = j.orm.CountLogsByBlockHash(ctx, orphaned); err != nil {
+			return err
+		}
+	}
+	j.lggr.Warnw("Reorg detected", "source", r.Source, "depth", r.Depth, "lcaBlockNumber", r.LCABlockNumber,
+		"oldHead", r.OldHeadHash, "newHead", r.NewHeadHash, "txIDs", r.TxIDs, "logCount", r.LogCount)
+	return j.orm.InsertReorg(ctx, &r)
+}
+
+func (j *journal) Reorgs(ctx context.Context, limit int) ([]Reorg, error) {
+	return j.orm.SelectReorgs(ctx, limit)
+}
@@ -0,0 +1,87 @@
+package reorgs_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/reorgs"
+	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+)
+
+// extendChain appends n new blocks to parent and returns the new head.
+func extendChain(parent *evmtypes.Head, n int) *evmtypes.Head {
+	head := parent
+	for range n {
+		h := testutils.Head(head.Number + 1)
+		h.ParentHash = head.Hash
+		h.Parent.Store(head)
+		head = h
+	}
+	return head
+}
+
+func TestJournal_OnNewLongestChain(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+	db := testutils.NewSqlxDB(t)
+	orm := reorgs.NewORM(*testutils.FixtureChainID, db)
+	j := reorgs.NewJournal(orm, testutils.FixtureChainID, logger.Test(t))
+
+	lca := extendChain(testutils.Head(0), 10)
+	oldHead := extendChain(lca, 3)
+	j.OnNewLongestChain(ctx, oldHead)
+	// extending the chain is not a reorg
+	oldHead = extendChain(oldHead, 1)
+	j.OnNewLongestChain(ctx, oldHead)
+
+	found, err := j.Reorgs(ctx, 10)
+	require.NoError(t, err)
+	require.Empty(t, found)
+
+	newHead := extendChain(lca, 5)
+	j.OnNewLongestChain(ctx, newHead)
+
+	found, err = j.Reorgs(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	r := found[0]
+	assert.Equal(t, reorgs.SourceHeadTracker, r.Source)
+	assert.Equal(t, int64(4), r.Depth)
+	assert.Equal(t, lca.Number, r.LCABlockNumber)
+	assert.Equal(t, lca.Hash, r.LCABlockHash)
+	assert.Equal(t, oldHead.Number, r.OldHeadNumber)
+	assert.Equal(t, oldHead.Hash, r.OldHeadHash)
+	assert.Equal(t, newHead.Number, r.NewHeadNumber)
+	assert.Equal(t, newHead.Hash, r.NewHeadHash)
+	assert.Empty(t, r.TxIDs)
+	assert.Zero(t, r.LogCount)
+
+	// the new chain no longer reaches back to the previous head, which can't be told apart from a reorg
+	j.OnNewLongestChain(ctx, extendChain(testutils.Head(newHead.Number+100), 1))
+	found, err = j.Reorgs(ctx, 10)
+	require.NoError(t, err)
+	assert.Len(t, found, 1)
+}
+
+func TestJournal_ReorgDeeperThanChain(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+	db := testutils.NewSqlxDB(t)
+	j := reorgs.NewJournal(reorgs.NewORM(*testutils.FixtureChainID, db), testutils.FixtureChainID, logger.Test(t))
+
+	oldHead := extendChain(testutils.Head(10), 2)
+	j.OnNewLongestChain(ctx, oldHead)
+	j.OnNewLongestChain(ctx, extendChain(testutils.Head(8), 5))
+
+	found, err := j.Reorgs(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, int64(9), found[0].LCABlockNumber)
+	assert.Zero(t, found[0].LCABlockHash)
+	assert.Equal(t, int64(3), found[0].Depth)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	evmtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
@@ -30,6 +31,14 @@ func NewAttemptBuilder(priceMaxKey func(common.Address) *assets.Wei, estimator g
 }
 
 func (a *attemptBuilder) NewAttempt(ctx context.Context, lggr logger.Logger, tx *types.Transaction, dynamic bool) (*types.Attempt, error) {
+	// Set-code transactions are always dynamic fee transactions, regardless of the chain's EIP-1559 setting
+	if len(tx.AuthorizationList) > 0 && !tx.IsPurgeable {
+		fee, estimatedGasLimit, err := a.EvmFeeEstimator.GetSetCodeFee(ctx, tx.Data, tx.SpecifiedGasLimit, a.priceMaxKey(tx.FromAddress), &tx.FromAddress, &tx.ToAddress, tx.AuthorizationList)
+		if err != nil {
+			return nil, err
+		}
+		return a.newCustomAttempt(ctx, tx, fee, estimatedGasLimit, evmtypes.SetCodeTxType, lggr)
+	}
 	fee, estimatedGasLimit, err := a.EvmFeeEstimator.GetFee(ctx, tx.Data, tx.SpecifiedGasLimit, a.priceMaxKey(tx.FromAddress), &tx.FromAddress, &tx.ToAddress)
 	if err != nil {
 		return nil, err
@@ -72,6 +81,17 @@ func (a *attemptBuilder) newCustomAttempt(
 			return
 		}
 		return a.newDynamicFeeAttempt(ctx, tx, fee.DynamicFee, estimatedGasLimit)
+	case 0x4:
+		if !fee.ValidDynamic() {
+			err = fmt.Errorf("tried to create attempt of type %v for txID: %v but estimator did not return dynamic fee", txType, tx.ID)
+			logger.Sugared(lggr).AssumptionViolation(err.Error())
+			return
+		}
+		// Purge attempts only need to consume the nonce, so they don't re-apply the authorizations
+		if tx.IsPurgeable {
+			return a.newDynamicFeeAttempt(ctx, tx, fee.DynamicFee, estimatedGasLimit)
+		}
+		return a.newSetCodeAttempt(ctx, tx, fee.DynamicFee, estimatedGasLimit)
 	default:
 		return nil, fmt.Errorf("cannot build attempt, unrecognized transaction type: %v", txType)
 	}
@@ -153,3 +173,40 @@ func (a *attemptBuilder) newDynamicFeeAttempt(ctx context.Context, tx *types.Tra
 
 	return attempt, nil
 }
+
+func (a *attemptBuilder) newSetCodeAttempt(ctx context.Context, tx *types.Transaction, dynamicFee gas.DynamicFee, estimatedGasLimit uint64) (*types.Attempt, error) {
+	if tx.Nonce == nil {
+		return nil, fmt.Errorf("failed to create attempt for txID: %v: nonce empty", tx.ID)
+	}
+	value := tx.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+	setCodeTx := evmtypes.SetCodeTx{
+		ChainID:   uint256.MustFromBig(tx.ChainID),
+		Nonce:     *tx.Nonce,
+		To:        tx.ToAddress,
+		Value:     uint256.MustFromBig(value),
+		Gas:       estimatedGasLimit,
+		GasFeeCap: uint256.MustFromBig(dynamicFee.GasFeeCap.ToInt()),
+		GasTipCap: uint256.MustFromBig(dynamicFee.GasTipCap.ToInt()),
+		Data:      tx.Data,
+		AuthList:  tx.AuthorizationList,
+	}
+
+	signedTx, err := a.keystore.SignTx(ctx, tx.FromAddress, evmtypes.NewTx(&setCodeTx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign attempt for txID: %v, err: %w", tx.ID, err)
+	}
+
+	attempt := &types.Attempt{
+		TxID:              tx.ID,
+		Fee:               gas.EvmFee{DynamicFee: gas.DynamicFee{GasFeeCap: dynamicFee.GasFeeCap, GasTipCap: dynamicFee.GasTipCap}},
+		Hash:              signedTx.Hash(),
+		GasLimit:          estimatedGasLimit,
+		Type:              evmtypes.SetCodeTxType,
+		SignedTransaction: signedTx,
+	}
+
+	return attempt, nil
+}
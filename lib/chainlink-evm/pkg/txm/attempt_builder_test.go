@@ -85,3 +85,44 @@ func TestAttemptBuilder_newDynamicFeeAttempt(t *testing.T) {
 		assert.Equal(t, gasLimit, a.GasLimit)
 	})
 }
+
+func TestAttemptBuilder_newSetCodeAttempt(t *testing.T) {
+	ab := NewAttemptBuilder(nil, nil, keystest.TxSigner(nil))
+	address := testutils.NewAddress()
+	authorizationList := []evmtypes.SetCodeAuthorization{{Address: testutils.NewAddress(), Nonce: 1}}
+	fee := gas.EvmFee{DynamicFee: gas.DynamicFee{GasTipCap: assets.NewWeiI(1), GasFeeCap: assets.NewWeiI(2)}}
+
+	lggr := logger.Test(t)
+	var gasLimit uint64 = 100
+
+	t.Run("fails if DynamicFee is invalid", func(t *testing.T) {
+		tx := &types.Transaction{ID: 10, FromAddress: address, AuthorizationList: authorizationList}
+		_, err := ab.newCustomAttempt(t.Context(), tx, gas.EvmFee{GasPrice: assets.NewWeiI(1)}, gasLimit, evmtypes.SetCodeTxType, lggr)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "estimator did not return dynamic fee")
+	})
+
+	t.Run("creates attempt with authorization list", func(t *testing.T) {
+		var nonce uint64 = 77
+		tx := &types.Transaction{ID: 10, ChainID: testutils.FixtureChainID, FromAddress: address, ToAddress: address, Nonce: &nonce, AuthorizationList: authorizationList}
+
+		a, err := ab.newCustomAttempt(t.Context(), tx, fee, gasLimit, evmtypes.SetCodeTxType, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, tx.ID, a.TxID)
+		assert.Equal(t, evmtypes.SetCodeTxType, int(a.Type))
+		assert.Equal(t, "1 wei", a.Fee.DynamicFee.GasTipCap.String())
+		assert.Equal(t, "2 wei", a.Fee.DynamicFee.GasFeeCap.String())
+		assert.Equal(t, gasLimit, a.GasLimit)
+		assert.Equal(t, authorizationList, a.SignedTransaction.SetCodeAuthorizations())
+	})
+
+	t.Run("creates dynamic fee attempt for purgeable transactions", func(t *testing.T) {
+		var nonce uint64 = 77
+		tx := &types.Transaction{ID: 10, ChainID: testutils.FixtureChainID, FromAddress: address, ToAddress: address, Nonce: &nonce, AuthorizationList: authorizationList, IsPurgeable: true}
+
+		a, err := ab.newCustomAttempt(t.Context(), tx, fee, gasLimit, evmtypes.SetCodeTxType, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, evmtypes.DynamicFeeTxType, int(a.Type))
+		assert.Empty(t, a.SignedTransaction.SetCodeAuthorizations())
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/google/uuid"
 	nullv4 "gopkg.in/guregu/null.v4"
 
@@ -220,6 +221,13 @@ func (o *Orchestrator[BLOCK_HASH, HEAD]) CreateTransaction(ctx context.Context,
 			meta = &m
 		}
 
+		var authorizationList []gethtypes.SetCodeAuthorization
+		if request.AuthorizationList != nil {
+			if aErr := json.Unmarshal(*request.AuthorizationList, &authorizationList); aErr != nil {
+				return tx, fmt.Errorf("failed to unmarshal authorization list: %w", aErr)
+			}
+		}
+
 		wrappedTxRequest := &txmtypes.TxRequest{
 			IdempotencyKey:    request.IdempotencyKey,
 			ChainID:           o.chainID,
@@ -228,6 +236,7 @@ func (o *Orchestrator[BLOCK_HASH, HEAD]) CreateTransaction(ctx context.Context,
 			Value:             &request.Value,
 			Data:              request.EncodedPayload,
 			SpecifiedGasLimit: request.FeeLimit,
+			AuthorizationList: authorizationList,
 			Meta:              meta,
 			ForwarderAddress:  request.ForwarderAddress,
 
@@ -265,6 +274,14 @@ func (o *Orchestrator[BLOCK_HASH, HEAD]) CreateTransaction(ctx context.Context,
 		SignalCallback:    wrappedTx.SignalCallback,
 		CallbackCompleted: wrappedTx.CallbackCompleted,
 	}
+	if len(wrappedTx.AuthorizationList) > 0 {
+		raw, aErr := json.Marshal(wrappedTx.AuthorizationList)
+		if aErr != nil {
+			return tx, aErr
+		}
+		authorizationList := sqlutil.JSON(raw)
+		tx.AuthorizationList = &authorizationList
+	}
 	return
 }
 
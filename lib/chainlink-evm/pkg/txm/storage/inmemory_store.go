@@ -143,6 +143,7 @@ func (m *InMemoryStore) CreateTransaction(txRequest *types.TxRequest) *types.Tra
 		Value:             txRequest.Value,
 		Data:              txRequest.Data,
 		SpecifiedGasLimit: txRequest.SpecifiedGasLimit,
+		AuthorizationList: txRequest.AuthorizationList,
 		CreatedAt:         time.Now(),
 		State:             txmgr.TxUnstarted,
 		Meta:              txRequest.Meta,
@@ -0,0 +1,853 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	clnull "github.com/smartcontractkit/chainlink-common/pkg/utils/null"
+	"github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink-framework/chains/txmgr/types"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink-evm/pkg/gas"
+	"github.com/smartcontractkit/chainlink-evm/pkg/txm/types"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+)
+
+// legacyImportTimeout bounds the one-off import of pending transactions from evm.txes when an address is added.
+const legacyImportTimeout = 30 * time.Second
+
+const txmTransactionColumns = `id, evm_chain_id, from_address, to_address, idempotency_key, nonce, value, data, specified_gas_limit, authorization_list,
+state, is_purgeable, meta, subject, pipeline_task_run_id, min_confirmations, signal_callback, callback_completed, created_at, initial_broadcast_at, last_broadcast_at`
+
+const txmAttemptColumns = `id, tx_id, hash, gas_price, gas_tip_cap, gas_fee_cap, gas_limit, tx_type, signed_raw_tx, created_at, broadcast_at`
+
+// PostgresStoreManager persists TXMv2 transactions in evm.txm_transactions and evm.txm_attempts. It has the same
+// semantics as the InMemoryStoreManager, so transactions and idempotency keys survive a restart of the node.
+// Like the InMemoryStore, attempt counts are strictly kept in memory.
+type PostgresStoreManager struct {
+	lggr    logger.Logger
+	ds      sqlutil.DataSource
+	chainID *big.Int
+
+	mu            sync.RWMutex
+	addressLocks  map[common.Address]*sync.Mutex
+	attemptCounts map[uint64]uint16
+}
+
+func NewPostgresStoreManager(lggr logger.Logger, ds sqlutil.DataSource, chainID *big.Int) *PostgresStoreManager {
+	return &PostgresStoreManager{
+		lggr:          logger.Named(lggr, "PostgresStore"),
+		ds:            ds,
+		chainID:       chainID,
+		addressLocks:  make(map[common.Address]*sync.Mutex),
+		attemptCounts: make(map[uint64]uint16),
+	}
+}
+
+type dbTransaction struct {
+	ID                 uint64
+	EVMChainID         ubig.Big
+	FromAddress        common.Address
+	ToAddress          common.Address
+	IdempotencyKey     *string
+	Nonce              *int64
+	Value              ubig.Big
+	Data               []byte
+	SpecifiedGasLimit  uint64
+	AuthorizationList  dbAuthorizationList
+	State              txmgrtypes.TxState
+	IsPurgeable        bool
+	Meta               *sqlutil.JSON
+	Subject            uuid.NullUUID
+	PipelineTaskRunID  uuid.NullUUID
+	MinConfirmations   clnull.Uint32
+	SignalCallback     bool
+	CallbackCompleted  bool
+	CreatedAt          time.Time
+	InitialBroadcastAt *time.Time
+	LastBroadcastAt    *time.Time
+}
+
+func (db *dbTransaction) toTransaction() *types.Transaction {
+	tx := &types.Transaction{
+		ID:                 db.ID,
+		IdempotencyKey:     db.IdempotencyKey,
+		ChainID:            db.EVMChainID.ToInt(),
+		FromAddress:        db.FromAddress,
+		ToAddress:          db.ToAddress,
+		Value:              db.Value.ToInt(),
+		Data:               db.Data,
+		SpecifiedGasLimit:  db.SpecifiedGasLimit,
+		AuthorizationList:  db.AuthorizationList,
+		CreatedAt:          db.CreatedAt,
+		InitialBroadcastAt: db.InitialBroadcastAt,
+		LastBroadcastAt:    db.LastBroadcastAt,
+		State:              db.State,
+		IsPurgeable:        db.IsPurgeable,
+		Meta:               db.Meta,
+		Subject:            db.Subject,
+		PipelineTaskRunID:  db.PipelineTaskRunID,
+		MinConfirmations:   db.MinConfirmations,
+		SignalCallback:     db.SignalCallback,
+		CallbackCompleted:  db.CallbackCompleted,
+	}
+	if db.Nonce != nil {
+		//nolint:gosec // nonces are stored from uint64 values
+		nonce := uint64(*db.Nonce)
+		tx.Nonce = &nonce
+	}
+	return tx
+}
+
+// dbAuthorizationList stores EIP-7702 authorizations as jsonb, empty lists are stored as NULL.
+type dbAuthorizationList []gethtypes.SetCodeAuthorization
+
+func (l *dbAuthorizationList) Scan(value interface{}) error {
+	if value == nil {
+		*l = nil
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+	return json.Unmarshal(b, (*[]gethtypes.SetCodeAuthorization)(l))
+}
+
+func (l dbAuthorizationList) Value() (driver.Value, error) {
+	if len(l) == 0 {
+		return nil, nil
+	}
+	return json.Marshal([]gethtypes.SetCodeAuthorization(l))
+}
+
+type dbAttempt struct {
+	ID          uint64
+	TxID        uint64
+	Hash        common.Hash
+	GasPrice    *assets.Wei
+	GasTipCap   *assets.Wei
+	GasFeeCap   *assets.Wei
+	GasLimit    uint64
+	TxType      int
+	SignedRawTx []byte
+	CreatedAt   time.Time
+	BroadcastAt *time.Time
+}
+
+func (db *dbAttempt) toAttempt() (*types.Attempt, error) {
+	attempt := &types.Attempt{
+		ID:   db.ID,
+		TxID: db.TxID,
+		Hash: db.Hash,
+		Fee: gas.EvmFee{
+			GasPrice:   db.GasPrice,
+			DynamicFee: gas.DynamicFee{GasTipCap: db.GasTipCap, GasFeeCap: db.GasFeeCap},
+		},
+		GasLimit:    db.GasLimit,
+		Type:        byte(db.TxType), //nolint:gosec // tx types fit in a byte
+		CreatedAt:   db.CreatedAt,
+		BroadcastAt: db.BroadcastAt,
+	}
+	if len(db.SignedRawTx) > 0 {
+		signedTx := new(gethtypes.Transaction)
+		if err := signedTx.UnmarshalBinary(db.SignedRawTx); err != nil {
+			return nil, fmt.Errorf("failed to decode signed transaction of attempt: %v: %w", db.ID, err)
+		}
+		attempt.SignedTransaction = signedTx
+	}
+	return attempt, nil
+}
+
+// lockAddress serializes the operations of a single address, the same way each InMemoryStore is locked.
+func (m *PostgresStoreManager) lockAddress(fromAddress common.Address) (func(), error) {
+	m.mu.RLock()
+	lock, exists := m.addressLocks[fromAddress]
+	m.mu.RUnlock()
+	if !exists {
+		return nil, fmt.Errorf(StoreNotFoundForAddress, fromAddress)
+	}
+	lock.Lock()
+	return lock.Unlock, nil
+}
+
+func (m *PostgresStoreManager) Add(addresses ...common.Address) (err error) {
+	for _, address := range addresses {
+		m.mu.Lock()
+		_, exists := m.addressLocks[address]
+		if !exists {
+			m.addressLocks[address] = &sync.Mutex{}
+		}
+		m.mu.Unlock()
+
+		if exists {
+			err = errors.Join(err, fmt.Errorf("address %v already exists in store manager", address))
+			continue
+		}
+		if ierr := m.importLegacyTransactions(address); ierr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to import legacy transactions for address %v: %w", address, ierr))
+		}
+	}
+	return
+}
+
+func (m *PostgresStoreManager) AbandonPendingTransactions(ctx context.Context, fromAddress common.Address) error {
+	unlock, err := m.lockAddress(fromAddress)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	var abandonedTxIDs []uint64
+	err = sqlutil.TransactDataSource(ctx, m.ds, nil, func(ds sqlutil.DataSource) error {
+		if _, err := ds.ExecContext(ctx, `DELETE FROM evm.txm_transactions WHERE evm_chain_id = $1 AND from_address = $2 AND state = $3`,
+			ubig.New(m.chainID), fromAddress, txmgr.TxFatalError); err != nil {
+			return fmt.Errorf("failed to delete fatal transactions: %w", err)
+		}
+		return ds.SelectContext(ctx, &abandonedTxIDs, `UPDATE evm.txm_transactions SET state = $3
+WHERE evm_chain_id = $1 AND from_address = $2 AND state IN ($4, $5) RETURNING id`,
+			ubig.New(m.chainID), fromAddress, txmgr.TxFatalError, txmgr.TxUnstarted, txmgr.TxUnconfirmed)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to abandon pending transactions: %w", err)
+	}
+	m.forgetAttemptCounts(abandonedTxIDs...)
+	return nil
+}
+
+func (m *PostgresStoreManager) AppendAttemptToTransaction(ctx context.Context, txNonce uint64, fromAddress common.Address, attempt *types.Attempt) error {
+	unlock, err := m.lockAddress(fromAddress)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	tx, err := m.unconfirmedTransactionAtNonce(ctx, m.ds, txNonce, fromAddress)
+	if err != nil {
+		return err
+	}
+	if tx == nil {
+		return fmt.Errorf("unconfirmed tx was not found for nonce: %d - txID: %v", txNonce, attempt.TxID)
+	}
+	if tx.ID != attempt.TxID {
+		return fmt.Errorf("unconfirmed tx with nonce exists but attempt points to a different txID. Found Tx: %v - txID: %v", tx, attempt.TxID)
+	}
+
+	var signedRawTx []byte
+	if attempt.SignedTransaction != nil {
+		if signedRawTx, err = attempt.SignedTransaction.MarshalBinary(); err != nil {
+			return fmt.Errorf("failed to encode signed transaction of attempt: %w", err)
+		}
+	}
+	attempt.CreatedAt = time.Now()
+	err = m.ds.GetContext(ctx, &attempt.ID, `INSERT INTO evm.txm_attempts (tx_id, hash, gas_price, gas_tip_cap, gas_fee_cap, gas_limit, tx_type, signed_raw_tx, created_at, broadcast_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING id`,
+		tx.ID, attempt.Hash, attempt.Fee.GasPrice, attempt.Fee.GasTipCap, attempt.Fee.GasFeeCap, attempt.GasLimit, int(attempt.Type), signedRawTx,
+		attempt.CreatedAt, attempt.BroadcastAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert attempt for txID: %v: %w", tx.ID, err)
+	}
+
+	m.mu.Lock()
+	m.attemptCounts[tx.ID]++
+	m.mu.Unlock()
+	return nil
+}
+
+func (m *PostgresStoreManager) CountUnstartedTransactions(ctx context.Context, fromAddress common.Address) (count int, err error) {
+	unlock, err := m.lockAddress(fromAddress)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	err = m.ds.GetContext(ctx, &count, `SELECT count(*) FROM evm.txm_transactions WHERE evm_chain_id = $1 AND from_address = $2 AND state = $3`,
+		ubig.New(m.chainID), fromAddress, txmgr.TxUnstarted)
+	return
+}
+
+func (m *PostgresStoreManager) CreateEmptyUnconfirmedTransaction(ctx context.Context, fromAddress common.Address, nonce uint64, gasLimit uint64) (*types.Transaction, error) {
+	unlock, err := m.lockAddress(fromAddress)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	var emptyTx *types.Transaction
+	err = sqlutil.TransactDataSource(ctx, m.ds, nil, func(ds sqlutil.DataSource) error {
+		existing, err := m.transactionsAtNonce(ctx, ds, nonce, fromAddress, txmgr.TxUnconfirmed, txmgr.TxConfirmed)
+		if err != nil {
+			return err
+		}
+		for _, tx := range existing {
+			if tx.State == txmgr.TxUnconfirmed {
+				return fmt.Errorf("an unconfirmed tx with the same nonce already exists: %v", tx)
+			}
+		}
+		if len(existing) > 0 {
+			return fmt.Errorf("a confirmed tx with the same nonce already exists: %v", existing[0])
+		}
+
+		emptyTx = &types.Transaction{
+			ChainID:           m.chainID,
+			Nonce:             &nonce,
+			FromAddress:       fromAddress,
+			ToAddress:         common.Address{},
+			Value:             big.NewInt(0),
+			SpecifiedGasLimit: gasLimit,
+			CreatedAt:         time.Now(),
+			State:             txmgr.TxUnconfirmed,
+		}
+		return m.insertTransaction(ctx, ds, emptyTx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return emptyTx, nil
+}
+
+func (m *PostgresStoreManager) CreateTransaction(ctx context.Context, txRequest *types.TxRequest) (*types.Transaction, error) {
+	unlock, err := m.lockAddress(txRequest.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	tx := &types.Transaction{
+		IdempotencyKey:    txRequest.IdempotencyKey,
+		ChainID:           m.chainID,
+		FromAddress:       txRequest.FromAddress,
+		ToAddress:         txRequest.ToAddress,
+		Value:             txRequest.Value,
+		Data:              txRequest.Data,
+		SpecifiedGasLimit: txRequest.SpecifiedGasLimit,
+		AuthorizationList: txRequest.AuthorizationList,
+		CreatedAt:         time.Now(),
+		State:             txmgr.TxUnstarted,
+		Meta:              txRequest.Meta,
+		MinConfirmations:  txRequest.MinConfirmations,
+		PipelineTaskRunID: txRequest.PipelineTaskRunID,
+		SignalCallback:    txRequest.SignalCallback,
+	}
+	var droppedTxIDs []uint64
+	err = sqlutil.TransactDataSource(ctx, m.ds, nil, func(ds sqlutil.DataSource) error {
+		var uLen int
+		if err := ds.GetContext(ctx, &uLen, `SELECT count(*) FROM evm.txm_transactions WHERE evm_chain_id = $1 AND from_address = $2 AND state = $3`,
+			ubig.New(m.chainID), tx.FromAddress, txmgr.TxUnstarted); err != nil {
+			return fmt.Errorf("failed to count unstarted transactions: %w", err)
+		}
+		if uLen >= maxQueuedTransactions {
+			// need to make room for the new tx
+			if err := ds.SelectContext(ctx, &droppedTxIDs, `DELETE FROM evm.txm_transactions WHERE id IN (
+	SELECT id FROM evm.txm_transactions WHERE evm_chain_id = $1 AND from_address = $2 AND state = $3 ORDER BY id ASC LIMIT $4
+) RETURNING id`, ubig.New(m.chainID), tx.FromAddress, txmgr.TxUnstarted, uLen-maxQueuedTransactions+1); err != nil {
+				return fmt.Errorf("failed to drop oldest unstarted transactions: %w", err)
+			}
+		}
+		return m.insertTransaction(ctx, ds, tx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(droppedTxIDs) > 0 {
+		sort.Slice(droppedTxIDs, func(i, j int) bool { return droppedTxIDs[i] < droppedTxIDs[j] })
+		m.lggr.Warnw(fmt.Sprintf("Unstarted transactions queue for address: %v reached max limit of: %d. Dropping oldest transactions", tx.FromAddress, maxQueuedTransactions),
+			"txIDs", droppedTxIDs)
+	}
+	return tx, nil
+}
+
+func (m *PostgresStoreManager) FetchUnconfirmedTransactionAtNonceWithCount(ctx context.Context, latestNonce uint64, fromAddress common.Address) (tx *types.Transaction, unconfirmedCount int, err error) {
+	unlock, err := m.lockAddress(fromAddress)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer unlock()
+
+	err = sqlutil.TransactDataSource(ctx, m.ds, &sqlutil.TxOptions{TxOptions: sql.TxOptions{ReadOnly: true}}, func(ds sqlutil.DataSource) error {
+		if tx, err = m.unconfirmedTransactionAtNonce(ctx, ds, latestNonce, fromAddress); err != nil {
+			return err
+		}
+		return ds.GetContext(ctx, &unconfirmedCount, `SELECT count(*) FROM evm.txm_transactions WHERE evm_chain_id = $1 AND from_address = $2 AND state = $3`,
+			ubig.New(m.chainID), fromAddress, txmgr.TxUnconfirmed)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return
+}
+
+func (m *PostgresStoreManager) MarkConfirmedAndReorgedTransactions(ctx context.Context, latestNonce uint64, fromAddress common.Address) (confirmedTransactions []*types.Transaction, unconfirmedTransactionIDs []uint64, err error) {
+	unlock, err := m.lockAddress(fromAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer unlock()
+
+	var prunedTxIDs []uint64
+	err = sqlutil.TransactDataSource(ctx, m.ds, nil, func(ds sqlutil.DataSource) error {
+		confirmedTransactions, unconfirmedTransactionIDs, prunedTxIDs = nil, nil, nil
+		unconfirmed, err := m.selectTransactions(ctx, ds, `WHERE evm_chain_id = $1 AND from_address = $2 AND state = $3`, fromAddress, txmgr.TxUnconfirmed)
+		if err != nil {
+			return err
+		}
+		confirmed, err := m.selectTransactions(ctx, ds, `WHERE evm_chain_id = $1 AND from_address = $2 AND state = $3`, fromAddress, txmgr.TxConfirmed)
+		if err != nil {
+			return err
+		}
+		unconfirmedByNonce := make(map[uint64]*types.Transaction, len(unconfirmed))
+		for _, tx := range unconfirmed {
+			if tx.Nonce == nil {
+				return fmt.Errorf("nonce for txID: %v is empty", tx.ID)
+			}
+			unconfirmedByNonce[*tx.Nonce] = tx
+		}
+		confirmedByNonce := make(map[uint64]*types.Transaction, len(confirmed))
+		for _, tx := range confirmed {
+			if tx.Nonce == nil {
+				return fmt.Errorf("nonce for txID: %v is empty", tx.ID)
+			}
+			confirmedByNonce[*tx.Nonce] = tx
+		}
+
+		// Transactions that get overwritten at their nonce are no longer tracked, so they are marked as fatal
+		// and dropped on the next abandon.
+		var overwrittenTxIDs, toConfirm, toUnconfirm []uint64
+		for _, tx := range unconfirmed {
+			existingTx, exists := confirmedByNonce[*tx.Nonce]
+			if exists {
+				m.lggr.Errorw("Another confirmed transaction with the same nonce exists. Transaction will be overwritten.",
+					"existingTx", existingTx, "newTx", tx)
+			}
+			if *tx.Nonce < latestNonce {
+				if exists {
+					overwrittenTxIDs = append(overwrittenTxIDs, existingTx.ID)
+				}
+				tx.State = txmgr.TxConfirmed
+				toConfirm = append(toConfirm, tx.ID)
+				confirmedTransactions = append(confirmedTransactions, tx)
+				confirmedByNonce[*tx.Nonce] = tx
+				delete(unconfirmedByNonce, *tx.Nonce)
+			}
+		}
+
+		for nonce, tx := range confirmedByNonce {
+			existingTx, exists := unconfirmedByNonce[nonce]
+			if exists {
+				m.lggr.Errorw("Another unconfirmed transaction with the same nonce exists. Transaction will overwritten.",
+					"existingTx", existingTx, "newTx", tx)
+			}
+			if nonce >= latestNonce {
+				if exists {
+					overwrittenTxIDs = append(overwrittenTxIDs, existingTx.ID)
+				}
+				unconfirmedTransactionIDs = append(unconfirmedTransactionIDs, tx.ID)
+				toUnconfirm = append(toUnconfirm, tx.ID)
+				unconfirmedByNonce[nonce] = tx
+				delete(confirmedByNonce, nonce)
+			}
+		}
+
+		if err := m.updateState(ctx, ds, overwrittenTxIDs, txmgr.TxFatalError, false); err != nil {
+			return err
+		}
+		if err := m.updateState(ctx, ds, toConfirm, txmgr.TxConfirmed, false); err != nil {
+			return err
+		}
+		// Mark reorged transaction as if it wasn't broadcasted before
+		if err := m.updateState(ctx, ds, toUnconfirm, txmgr.TxUnconfirmed, true); err != nil {
+			return err
+		}
+
+		if err := m.loadAttempts(ctx, ds, confirmedTransactions...); err != nil {
+			return err
+		}
+		if len(confirmedByNonce) > maxQueuedTransactions {
+			prunedTxIDs, err = m.pruneConfirmedTransactions(ctx, ds, confirmedByNonce)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(prunedTxIDs) > 0 {
+		m.forgetAttemptCounts(prunedTxIDs...)
+		m.lggr.Debugf("Confirmed transactions for address: %v reached max limit of: %d. Pruned 1/%d of the oldest confirmed transactions. TxIDs: %v",
+			fromAddress, maxQueuedTransactions, pruneSubset, prunedTxIDs)
+	}
+	m.setAttemptCounts(confirmedTransactions...)
+	sort.Slice(confirmedTransactions, func(i, j int) bool { return confirmedTransactions[i].ID < confirmedTransactions[j].ID })
+	sort.Slice(unconfirmedTransactionIDs, func(i, j int) bool { return unconfirmedTransactionIDs[i] < unconfirmedTransactionIDs[j] })
+	return confirmedTransactions, unconfirmedTransactionIDs, nil
+}
+
+func (m *PostgresStoreManager) MarkUnconfirmedTransactionPurgeable(ctx context.Context, nonce uint64, fromAddress common.Address) error {
+	unlock, err := m.lockAddress(fromAddress)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	res, err := m.ds.ExecContext(ctx, `UPDATE evm.txm_transactions SET is_purgeable = true
+WHERE evm_chain_id = $1 AND from_address = $2 AND state = $3 AND nonce = $4`, ubig.New(m.chainID), fromAddress, txmgr.TxUnconfirmed, nonce)
+	if err != nil {
+		return fmt.Errorf("failed to mark unconfirmed tx with nonce: %d purgeable: %w", nonce, err)
+	}
+	if rows, err := res.RowsAffected(); err != nil {
+		return err
+	} else if rows == 0 {
+		return fmt.Errorf("unconfirmed tx with nonce: %d was not found", nonce)
+	}
+	return nil
+}
+
+func (m *PostgresStoreManager) UpdateTransactionBroadcast(ctx context.Context, txID uint64, txNonce uint64, attemptHash common.Hash, fromAddress common.Address) error {
+	unlock, err := m.lockAddress(fromAddress)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return sqlutil.TransactDataSource(ctx, m.ds, nil, func(ds sqlutil.DataSource) error {
+		var unconfirmedTxID uint64
+		err := ds.GetContext(ctx, &unconfirmedTxID, `SELECT id FROM evm.txm_transactions WHERE evm_chain_id = $1 AND from_address = $2 AND state = $3 AND nonce = $4`,
+			ubig.New(m.chainID), fromAddress, txmgr.TxUnconfirmed, txNonce)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("unconfirmed tx was not found for nonce: %d - txID: %v", txNonce, txID)
+		} else if err != nil {
+			return fmt.Errorf("failed to fetch unconfirmed tx for nonce: %d: %w", txNonce, err)
+		}
+
+		// Set the same time for both the tx and its attempt
+		now := time.Now()
+		res, err := ds.ExecContext(ctx, `UPDATE evm.txm_attempts SET broadcast_at = $3 WHERE tx_id = $1 AND hash = $2`, unconfirmedTxID, attemptHash, now)
+		if err != nil {
+			return fmt.Errorf("UpdateTransactionBroadcast failed to update attempt. %w", err)
+		}
+		if rows, err := res.RowsAffected(); err != nil {
+			return err
+		} else if rows == 0 {
+			return fmt.Errorf("UpdateTransactionBroadcast failed to find attempt. attempt with hash: %v was not found", attemptHash)
+		}
+		if _, err := ds.ExecContext(ctx, `UPDATE evm.txm_transactions SET last_broadcast_at = $2, initial_broadcast_at = COALESCE(initial_broadcast_at, $2) WHERE id = $1`,
+			unconfirmedTxID, now); err != nil {
+			return fmt.Errorf("UpdateTransactionBroadcast failed to update tx. %w", err)
+		}
+		return nil
+	})
+}
+
+func (m *PostgresStoreManager) UpdateUnstartedTransactionWithNonce(ctx context.Context, fromAddress common.Address, nonce uint64) (*types.Transaction, error) {
+	unlock, err := m.lockAddress(fromAddress)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	var tx *types.Transaction
+	err = sqlutil.TransactDataSource(ctx, m.ds, nil, func(ds sqlutil.DataSource) error {
+		unstarted, err := m.selectTransactions(ctx, ds, `WHERE evm_chain_id = $1 AND from_address = $2 AND state = $3 ORDER BY id ASC LIMIT 1`, fromAddress, txmgr.TxUnstarted)
+		if err != nil {
+			return err
+		}
+		if len(unstarted) == 0 {
+			m.lggr.Debugf("Unstarted transactions queue is empty for address: %v", fromAddress)
+			return nil
+		}
+
+		existing, err := m.unconfirmedTransactionAtNonce(ctx, ds, nonce, fromAddress)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return fmt.Errorf("an unconfirmed tx with the same nonce already exists: %v", existing)
+		}
+
+		tx = unstarted[0]
+		tx.Nonce = &nonce
+		tx.State = txmgr.TxUnconfirmed
+		if _, err := ds.ExecContext(ctx, `UPDATE evm.txm_transactions SET state = $2, nonce = $3 WHERE id = $1`, tx.ID, tx.State, nonce); err != nil {
+			return fmt.Errorf("failed to update unstarted tx: %v: %w", tx.ID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// Error Handler
+func (m *PostgresStoreManager) DeleteAttemptForUnconfirmedTx(ctx context.Context, transactionNonce uint64, attempt *types.Attempt, fromAddress common.Address) error {
+	unlock, err := m.lockAddress(fromAddress)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return sqlutil.TransactDataSource(ctx, m.ds, nil, func(ds sqlutil.DataSource) error {
+		var unconfirmedTxID uint64
+		err := ds.GetContext(ctx, &unconfirmedTxID, `SELECT id FROM evm.txm_transactions WHERE evm_chain_id = $1 AND from_address = $2 AND state = $3 AND nonce = $4`,
+			ubig.New(m.chainID), fromAddress, txmgr.TxUnconfirmed, transactionNonce)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("unconfirmed tx was not found for nonce: %d - txID: %v", transactionNonce, attempt.TxID)
+		} else if err != nil {
+			return fmt.Errorf("failed to fetch unconfirmed tx for nonce: %d: %w", transactionNonce, err)
+		}
+
+		res, err := ds.ExecContext(ctx, `DELETE FROM evm.txm_attempts WHERE id = (
+	SELECT id FROM evm.txm_attempts WHERE tx_id = $1 AND hash = $2 ORDER BY id ASC LIMIT 1
+)`, unconfirmedTxID, attempt.Hash)
+		if err != nil {
+			return fmt.Errorf("failed to delete attempt with hash: %v for txID: %v: %w", attempt.Hash, attempt.TxID, err)
+		}
+		if rows, err := res.RowsAffected(); err != nil {
+			return err
+		} else if rows == 0 {
+			return fmt.Errorf("attempt with hash: %v for txID: %v was not found", attempt.Hash, attempt.TxID)
+		}
+		return nil
+	})
+}
+
+func (m *PostgresStoreManager) MarkTxFatal(context.Context, *types.Transaction, common.Address) error {
+	return errors.New("not implemented")
+}
+
+// Orchestrator
+func (m *PostgresStoreManager) FindTxWithIdempotencyKey(ctx context.Context, idempotencyKey string) (*types.Transaction, error) {
+	m.mu.RLock()
+	addresses := make([][]byte, 0, len(m.addressLocks))
+	for address := range m.addressLocks {
+		addresses = append(addresses, address.Bytes())
+	}
+	m.mu.RUnlock()
+
+	var tx *types.Transaction
+	err := sqlutil.TransactDataSource(ctx, m.ds, &sqlutil.TxOptions{TxOptions: sql.TxOptions{ReadOnly: true}}, func(ds sqlutil.DataSource) error {
+		txs, err := m.selectTransactions(ctx, ds, `WHERE evm_chain_id = $1 AND from_address = ANY($2) AND idempotency_key = $3`, pq.ByteaArray(addresses), idempotencyKey)
+		if err != nil || len(txs) == 0 {
+			return err
+		}
+		tx = txs[0]
+		return m.loadAttempts(ctx, ds, tx)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tx with idempotency key: %s: %w", idempotencyKey, err)
+	}
+	m.setAttemptCounts(tx)
+	return tx, nil
+}
+
+// importLegacyTransactions copies the pending transactions of an address, and the confirmed ones that have an idempotency
+// key, from the legacy evm.txes tables so they aren't lost when switching to TXMv2. It only runs if the store doesn't
+// hold any transactions for the address yet. Legacy rows are left untouched.
+func (m *PostgresStoreManager) importLegacyTransactions(fromAddress common.Address) error {
+	ctx, cancel := context.WithTimeout(context.Background(), legacyImportTimeout)
+	defer cancel()
+
+	var imported int
+	err := sqlutil.TransactDataSource(ctx, m.ds, nil, func(ds sqlutil.DataSource) error {
+		var exists bool
+		if err := ds.GetContext(ctx, &exists, `SELECT EXISTS (SELECT 1 FROM evm.txm_transactions WHERE evm_chain_id = $1 AND from_address = $2)`,
+			ubig.New(m.chainID), fromAddress); err != nil {
+			return err
+		}
+		if exists {
+			return nil
+		}
+
+		var legacyTxIDs []int64
+		if err := ds.SelectContext(ctx, &legacyTxIDs, `SELECT id FROM (
+	SELECT id FROM evm.txes WHERE evm_chain_id = $1 AND from_address = $2 AND state IN ('unstarted', 'in_progress', 'unconfirmed', 'confirmed_missing_receipt')
+	UNION ALL
+	(SELECT id FROM evm.txes WHERE evm_chain_id = $1 AND from_address = $2 AND state IN ('confirmed', 'finalized') AND idempotency_key IS NOT NULL
+	ORDER BY nonce DESC LIMIT $3)
+) ids ORDER BY id ASC`, ubig.New(m.chainID), fromAddress, maxQueuedTransactions); err != nil {
+			return err
+		}
+
+		for _, legacyTxID := range legacyTxIDs {
+			var txID uint64
+			if err := ds.GetContext(ctx, &txID, `INSERT INTO evm.txm_transactions (evm_chain_id, from_address, to_address, idempotency_key, nonce, value, data,
+	specified_gas_limit, state, meta, subject, pipeline_task_run_id, min_confirmations, signal_callback, callback_completed, created_at,
+	initial_broadcast_at, last_broadcast_at)
+SELECT evm_chain_id, from_address, to_address, idempotency_key, nonce, value, encoded_payload, gas_limit,
+	CASE WHEN state = 'unstarted' THEN 'unstarted' WHEN state IN ('confirmed', 'finalized') THEN 'confirmed' ELSE 'unconfirmed' END,
+	meta, subject, pipeline_task_run_id, min_confirmations, signal_callback, callback_completed, created_at, initial_broadcast_at, broadcast_at
+FROM evm.txes WHERE id = $1 RETURNING id`, legacyTxID); err != nil {
+				return fmt.Errorf("failed to import legacy tx: %v: %w", legacyTxID, err)
+			}
+			if _, err := ds.ExecContext(ctx, `INSERT INTO evm.txm_attempts (tx_id, hash, gas_price, gas_tip_cap, gas_fee_cap, gas_limit, tx_type, signed_raw_tx, created_at, broadcast_at)
+SELECT $1, a.hash, a.gas_price, a.gas_tip_cap, a.gas_fee_cap, a.chain_specific_gas_limit, a.tx_type, a.signed_raw_tx, a.created_at,
+	CASE WHEN a.state = 'broadcast' THEN t.broadcast_at END
+FROM evm.tx_attempts a JOIN evm.txes t ON t.id = a.eth_tx_id WHERE a.eth_tx_id = $2 ORDER BY a.id ASC`, txID, legacyTxID); err != nil {
+				return fmt.Errorf("failed to import attempts of legacy tx: %v: %w", legacyTxID, err)
+			}
+		}
+		imported = len(legacyTxIDs)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if imported > 0 {
+		m.lggr.Infow("Imported transactions from legacy evm.txes table", "address", fromAddress, "count", imported)
+	}
+	return nil
+}
+
+// pruneConfirmedTransactions deletes the oldest confirmed transactions. Should be called within the transaction of the caller.
+func (m *PostgresStoreManager) pruneConfirmedTransactions(ctx context.Context, ds sqlutil.DataSource, confirmedByNonce map[uint64]*types.Transaction) ([]uint64, error) {
+	noncesToPrune := make([]uint64, 0, len(confirmedByNonce))
+	for nonce := range confirmedByNonce {
+		noncesToPrune = append(noncesToPrune, nonce)
+	}
+	sort.Slice(noncesToPrune, func(i, j int) bool { return noncesToPrune[i] < noncesToPrune[j] })
+	minNonce := noncesToPrune[len(noncesToPrune)/pruneSubset]
+
+	var txIDsToPrune []uint64
+	for nonce, tx := range confirmedByNonce {
+		if nonce < minNonce {
+			txIDsToPrune = append(txIDsToPrune, tx.ID)
+		}
+	}
+	if _, err := ds.ExecContext(ctx, `DELETE FROM evm.txm_transactions WHERE id = ANY($1)`, pq.Array(txIDsToPrune)); err != nil {
+		return nil, fmt.Errorf("failed to prune confirmed transactions: %w", err)
+	}
+	sort.Slice(txIDsToPrune, func(i, j int) bool { return txIDsToPrune[i] < txIDsToPrune[j] })
+	return txIDsToPrune, nil
+}
+
+func (m *PostgresStoreManager) insertTransaction(ctx context.Context, ds sqlutil.DataSource, tx *types.Transaction) error {
+	value := tx.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+	data := tx.Data
+	if data == nil {
+		data = []byte{}
+	}
+	var nonce *int64
+	if tx.Nonce != nil {
+		//nolint:gosec // nonces don't overflow int64
+		n := int64(*tx.Nonce)
+		nonce = &n
+	}
+	err := ds.GetContext(ctx, &tx.ID, `INSERT INTO evm.txm_transactions (evm_chain_id, from_address, to_address, idempotency_key, nonce, value, data,
+	specified_gas_limit, authorization_list, state, is_purgeable, meta, subject, pipeline_task_run_id, min_confirmations, signal_callback, callback_completed, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18) RETURNING id`,
+		ubig.New(m.chainID), tx.FromAddress, tx.ToAddress, tx.IdempotencyKey, nonce, ubig.New(value), data, tx.SpecifiedGasLimit,
+		dbAuthorizationList(tx.AuthorizationList), tx.State,
+		tx.IsPurgeable, tx.Meta, tx.Subject, tx.PipelineTaskRunID, tx.MinConfirmations, tx.SignalCallback, tx.CallbackCompleted, tx.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert transaction: %w", err)
+	}
+	return nil
+}
+
+func (m *PostgresStoreManager) updateState(ctx context.Context, ds sqlutil.DataSource, txIDs []uint64, state txmgrtypes.TxState, resetBroadcast bool) error {
+	if len(txIDs) == 0 {
+		return nil
+	}
+	if _, err := ds.ExecContext(ctx, `UPDATE evm.txm_transactions SET state = $2,
+	last_broadcast_at = CASE WHEN $3 THEN NULL ELSE last_broadcast_at END
+WHERE id = ANY($1)`, pq.Array(txIDs), state, resetBroadcast); err != nil {
+		return fmt.Errorf("failed to mark transactions as %s: %w", state, err)
+	}
+	return nil
+}
+
+func (m *PostgresStoreManager) transactionsAtNonce(ctx context.Context, ds sqlutil.DataSource, nonce uint64, fromAddress common.Address, states ...txmgrtypes.TxState) ([]*types.Transaction, error) {
+	stateNames := make([]string, 0, len(states))
+	for _, state := range states {
+		stateNames = append(stateNames, string(state))
+	}
+	return m.selectTransactions(ctx, ds, `WHERE evm_chain_id = $1 AND from_address = $2 AND nonce = $3 AND state = ANY($4) ORDER BY id ASC`,
+		fromAddress, nonce, pq.Array(stateNames))
+}
+
+func (m *PostgresStoreManager) unconfirmedTransactionAtNonce(ctx context.Context, ds sqlutil.DataSource, nonce uint64, fromAddress common.Address) (*types.Transaction, error) {
+	txs, err := m.transactionsAtNonce(ctx, ds, nonce, fromAddress, txmgr.TxUnconfirmed)
+	if err != nil || len(txs) == 0 {
+		return nil, err
+	}
+	if err = m.loadAttempts(ctx, ds, txs[0]); err != nil {
+		return nil, err
+	}
+	m.setAttemptCounts(txs[0])
+	return txs[0], nil
+}
+
+// selectTransactions fetches transactions of the chain that match the given clause. The chain ID is always passed as $1.
+func (m *PostgresStoreManager) selectTransactions(ctx context.Context, ds sqlutil.DataSource, clause string, args ...any) ([]*types.Transaction, error) {
+	var dbTxs []dbTransaction
+	if err := ds.SelectContext(ctx, &dbTxs, `SELECT `+txmTransactionColumns+` FROM evm.txm_transactions `+clause, append([]any{ubig.New(m.chainID)}, args...)...); err != nil {
+		return nil, fmt.Errorf("failed to fetch transactions: %w", err)
+	}
+	txs := make([]*types.Transaction, 0, len(dbTxs))
+	for i := range dbTxs {
+		txs = append(txs, dbTxs[i].toTransaction())
+	}
+	return txs, nil
+}
+
+func (m *PostgresStoreManager) loadAttempts(ctx context.Context, ds sqlutil.DataSource, txs ...*types.Transaction) error {
+	if len(txs) == 0 {
+		return nil
+	}
+	txsByID := make(map[uint64]*types.Transaction, len(txs))
+	txIDs := make([]uint64, 0, len(txs))
+	for _, tx := range txs {
+		txsByID[tx.ID] = tx
+		txIDs = append(txIDs, tx.ID)
+	}
+	var dbAttempts []dbAttempt
+	if err := ds.SelectContext(ctx, &dbAttempts, `SELECT `+txmAttemptColumns+` FROM evm.txm_attempts WHERE tx_id = ANY($1) ORDER BY id ASC`, pq.Array(txIDs)); err != nil {
+		return fmt.Errorf("failed to fetch attempts: %w", err)
+	}
+	for i := range dbAttempts {
+		attempt, err := dbAttempts[i].toAttempt()
+		if err != nil {
+			return err
+		}
+		tx := txsByID[attempt.TxID]
+		tx.Attempts = append(tx.Attempts, attempt)
+	}
+	return nil
+}
+
+func (m *PostgresStoreManager) setAttemptCounts(txs ...*types.Transaction) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, tx := range txs {
+		if tx != nil {
+			tx.AttemptCount = m.attemptCounts[tx.ID]
+		}
+	}
+}
+
+func (m *PostgresStoreManager) forgetAttemptCounts(txIDs ...uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, txID := range txIDs {
+		delete(m.attemptCounts, txID)
+	}
+}
@@ -0,0 +1,157 @@
+package storage
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
+	"github.com/smartcontractkit/chainlink-evm/pkg/txm/types"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink-framework/chains/txmgr/types"
+)
+
+func TestPostgresStoreConformance(t *testing.T) {
+	t.Parallel()
+
+	runStoreConformanceTests(t, func(t *testing.T, lggr logger.Logger) storeFixture {
+		db := testutils.NewSqlxDB(t)
+		return &postgresStoreFixture{ds: db, m: NewPostgresStoreManager(lggr, db, testutils.FixtureChainID)}
+	})
+}
+
+func TestPostgresStoreManager_ImportLegacyTransactions(t *testing.T) {
+	t.Parallel()
+
+	db := testutils.NewSqlxDB(t)
+	ctx := tests.Context(t)
+	fromAddress := testutils.NewAddress()
+	chainID := ubig.New(testutils.FixtureChainID)
+	now := time.Now()
+
+	var unstartedID, unconfirmedID int64
+	require.NoError(t, db.GetContext(ctx, &unstartedID, `INSERT INTO evm.txes (evm_chain_id, from_address, to_address, encoded_payload, value, gas_limit, state, created_at)
+VALUES ($1, $2, $3, '\x00', 0, 21000, 'unstarted', $4) RETURNING id`, chainID, fromAddress, testutils.NewAddress(), now))
+	require.NoError(t, db.GetContext(ctx, &unconfirmedID, `INSERT INTO evm.txes (evm_chain_id, from_address, to_address, encoded_payload, value, gas_limit, state, nonce,
+	broadcast_at, initial_broadcast_at, created_at, idempotency_key)
+VALUES ($1, $2, $3, '\x00', 0, 21000, 'unconfirmed', 7, $4, $4, $4, 'legacy-ik') RETURNING id`, chainID, fromAddress, testutils.NewAddress(), now))
+
+	signedTx, err := gethtypes.NewTx(&gethtypes.LegacyTx{Nonce: 7, Gas: 21000, GasPrice: big.NewInt(1)}).MarshalBinary()
+	require.NoError(t, err)
+	hash := testutils.NewHash()
+	testutils.MustExec(t, db, `INSERT INTO evm.tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, state, created_at, chain_specific_gas_limit, tx_type)
+VALUES ($1, 1, $2, $3, 'broadcast', $4, 21000, 0)`, unconfirmedID, signedTx, hash, now)
+
+	m := NewPostgresStoreManager(logger.Test(t), db, testutils.FixtureChainID)
+	require.NoError(t, m.Add(fromAddress))
+
+	count, err := m.CountUnstartedTransactions(ctx, fromAddress)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	tx, count, err := m.FetchUnconfirmedTransactionAtNonceWithCount(ctx, 7, fromAddress)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.NotNil(t, tx)
+	require.Len(t, tx.Attempts, 1)
+	assert.Equal(t, hash, tx.Attempts[0].Hash)
+	assert.NotNil(t, tx.Attempts[0].BroadcastAt)
+	require.NotNil(t, tx.Attempts[0].SignedTransaction)
+	assert.Equal(t, uint64(7), tx.Attempts[0].SignedTransaction.Nonce())
+
+	itx, err := m.FindTxWithIdempotencyKey(ctx, "legacy-ik")
+	require.NoError(t, err)
+	require.NotNil(t, itx)
+	assert.Equal(t, tx.ID, itx.ID)
+
+	// Legacy transactions are left untouched and imported only once
+	var legacyCount int
+	require.NoError(t, db.GetContext(ctx, &legacyCount, `SELECT count(*) FROM evm.txes WHERE from_address = $1`, fromAddress))
+	assert.Equal(t, 2, legacyCount)
+	m = NewPostgresStoreManager(logger.Test(t), db, testutils.FixtureChainID)
+	require.NoError(t, m.Add(fromAddress))
+	count, err = m.CountUnstartedTransactions(ctx, fromAddress)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+type postgresStoreFixture struct {
+	ds sqlutil.DataSource
+	m  *PostgresStoreManager
+}
+
+func (f *postgresStoreFixture) store() conformanceStore { return f.m }
+
+func (f *postgresStoreFixture) insert(t *testing.T, fromAddress common.Address, state txmgrtypes.TxState, nonce *uint64) *types.Transaction {
+	tx := &types.Transaction{
+		ChainID:     testutils.FixtureChainID,
+		Nonce:       nonce,
+		FromAddress: fromAddress,
+		ToAddress:   testutils.NewAddress(),
+		Value:       big.NewInt(0),
+		CreatedAt:   time.Now(),
+		State:       state,
+	}
+	require.NoError(t, f.m.insertTransaction(tests.Context(t), f.ds, tx))
+	return tx
+}
+
+func (f *postgresStoreFixture) insertUnstartedTransaction(t *testing.T, fromAddress common.Address) *types.Transaction {
+	return f.insert(t, fromAddress, txmgr.TxUnstarted, nil)
+}
+
+func (f *postgresStoreFixture) insertUnconfirmedTransaction(t *testing.T, fromAddress common.Address, nonce uint64) *types.Transaction {
+	return f.insert(t, fromAddress, txmgr.TxUnconfirmed, &nonce)
+}
+
+func (f *postgresStoreFixture) insertConfirmedTransaction(t *testing.T, fromAddress common.Address, nonce uint64) *types.Transaction {
+	return f.insert(t, fromAddress, txmgr.TxConfirmed, &nonce)
+}
+
+func (f *postgresStoreFixture) insertFatalTransaction(t *testing.T, fromAddress common.Address) *types.Transaction {
+	return f.insert(t, fromAddress, txmgr.TxFatalError, nil)
+}
+
+func (f *postgresStoreFixture) insertAttempt(t *testing.T, _ common.Address, attempt *types.Attempt) {
+	testutils.MustExec(t, f.ds, `INSERT INTO evm.txm_attempts (tx_id, hash, gas_limit, tx_type, created_at) VALUES ($1, $2, $3, $4, $5)`,
+		attempt.TxID, attempt.Hash, attempt.GasLimit, int(attempt.Type), time.Now())
+}
+
+func (f *postgresStoreFixture) setIdempotencyKey(t *testing.T, _ common.Address, txID uint64, idempotencyKey string) {
+	testutils.MustExec(t, f.ds, `UPDATE evm.txm_transactions SET idempotency_key = $2 WHERE id = $1`, txID, idempotencyKey)
+}
+
+func (f *postgresStoreFixture) fetchTransaction(t *testing.T, fromAddress common.Address, txID uint64) *types.Transaction {
+	ctx := tests.Context(t)
+	txs, err := f.m.selectTransactions(ctx, f.ds, `WHERE evm_chain_id = $1 AND from_address = $2 AND id = $3`, fromAddress, txID)
+	require.NoError(t, err)
+	if len(txs) == 0 {
+		return nil
+	}
+	require.NoError(t, f.m.loadAttempts(ctx, f.ds, txs[0]))
+	return txs[0]
+}
+
+func (f *postgresStoreFixture) countUnstartedTransactions(t *testing.T, fromAddress common.Address) int {
+	count, err := f.m.CountUnstartedTransactions(tests.Context(t), fromAddress)
+	require.NoError(t, err)
+	return count
+}
+
+func (f *postgresStoreFixture) countConfirmedTransactions(t *testing.T, fromAddress common.Address) int {
+	var count int
+	err := f.ds.GetContext(tests.Context(t), &count, `SELECT count(*) FROM evm.txm_transactions WHERE evm_chain_id = $1 AND from_address = $2 AND state = $3`,
+		ubig.New(testutils.FixtureChainID), fromAddress, txmgr.TxConfirmed)
+	require.NoError(t, err)
+	return count
+}
@@ -0,0 +1,422 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
+	"github.com/smartcontractkit/chainlink-evm/pkg/txm"
+	"github.com/smartcontractkit/chainlink-evm/pkg/txm/types"
+	"github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+)
+
+type conformanceStore interface {
+	txm.TxStore
+	txm.OrchestratorTxStore
+}
+
+// storeFixture gives the conformance suite access to a store implementation. Transactions are seeded directly,
+// bypassing the store's own methods, so each behaviour can be tested in isolation.
+type storeFixture interface {
+	store() conformanceStore
+	insertUnstartedTransaction(t *testing.T, fromAddress common.Address) *types.Transaction
+	insertUnconfirmedTransaction(t *testing.T, fromAddress common.Address, nonce uint64) *types.Transaction
+	insertConfirmedTransaction(t *testing.T, fromAddress common.Address, nonce uint64) *types.Transaction
+	insertFatalTransaction(t *testing.T, fromAddress common.Address) *types.Transaction
+	insertAttempt(t *testing.T, fromAddress common.Address, attempt *types.Attempt)
+	setIdempotencyKey(t *testing.T, fromAddress common.Address, txID uint64, idempotencyKey string)
+	// fetchTransaction returns nil if the transaction was dropped by the store.
+	fetchTransaction(t *testing.T, fromAddress common.Address, txID uint64) *types.Transaction
+	countUnstartedTransactions(t *testing.T, fromAddress common.Address) int
+	countConfirmedTransactions(t *testing.T, fromAddress common.Address) int
+}
+
+// runStoreConformanceTests checks that a store implementation behaves like the InMemoryStore.
+func runStoreConformanceTests(t *testing.T, newFixture func(t *testing.T, lggr logger.Logger) storeFixture) {
+	newStore := func(t *testing.T, lggr logger.Logger) (storeFixture, common.Address) {
+		f := newFixture(t, lggr)
+		fromAddress := testutils.NewAddress()
+		require.NoError(t, f.store().Add(fromAddress))
+		return f, fromAddress
+	}
+
+	t.Run("Add", func(t *testing.T) {
+		f := newFixture(t, logger.Test(t))
+		fromAddress := testutils.NewAddress()
+		// Adds a new address
+		require.NoError(t, f.store().Add(fromAddress))
+
+		// Fails if address exists
+		require.Error(t, f.store().Add(fromAddress))
+
+		// Adds multiple addresses
+		require.NoError(t, f.store().Add(testutils.NewAddress(), testutils.NewAddress()))
+
+		// Fails for unknown addresses
+		_, err := f.store().CreateTransaction(tests.Context(t), &types.TxRequest{FromAddress: testutils.NewAddress()})
+		require.Error(t, err)
+	})
+
+	t.Run("AbandonPendingTransactions", func(t *testing.T) {
+		t.Run("abandons unstarted and unconfirmed transactions", func(t *testing.T) {
+			f, fromAddress := newStore(t, logger.Test(t))
+			// Unstarted
+			tx1 := f.insertUnstartedTransaction(t, fromAddress)
+			tx2 := f.insertUnstartedTransaction(t, fromAddress)
+
+			// Unconfirmed
+			tx3 := f.insertUnconfirmedTransaction(t, fromAddress, 3)
+			tx4 := f.insertUnconfirmedTransaction(t, fromAddress, 4)
+
+			require.NoError(t, f.store().AbandonPendingTransactions(tests.Context(t), fromAddress))
+
+			for _, tx := range []*types.Transaction{tx1, tx2, tx3, tx4} {
+				assert.Equal(t, txmgr.TxFatalError, f.fetchTransaction(t, fromAddress, tx.ID).State)
+			}
+		})
+
+		t.Run("skips all types apart from unstarted and unconfirmed transactions", func(t *testing.T) {
+			f, fromAddress := newStore(t, logger.Test(t))
+			// Fatal
+			tx1 := f.insertFatalTransaction(t, fromAddress)
+			tx2 := f.insertFatalTransaction(t, fromAddress)
+
+			// Confirmed
+			tx3 := f.insertConfirmedTransaction(t, fromAddress, 3)
+			tx4 := f.insertConfirmedTransaction(t, fromAddress, 4)
+
+			require.NoError(t, f.store().AbandonPendingTransactions(tests.Context(t), fromAddress))
+
+			// tx1, tx2 were dropped
+			assert.Nil(t, f.fetchTransaction(t, fromAddress, tx1.ID))
+			assert.Nil(t, f.fetchTransaction(t, fromAddress, tx2.ID))
+			assert.Equal(t, txmgr.TxConfirmed, f.fetchTransaction(t, fromAddress, tx3.ID).State)
+			assert.Equal(t, txmgr.TxConfirmed, f.fetchTransaction(t, fromAddress, tx4.ID).State)
+		})
+	})
+
+	t.Run("AppendAttemptToTransaction", func(t *testing.T) {
+		f, fromAddress := newStore(t, logger.Test(t))
+		ctx := tests.Context(t)
+		tx := f.insertUnconfirmedTransaction(t, fromAddress, 10)
+		otherTx := f.insertConfirmedTransaction(t, fromAddress, 2)
+
+		t.Run("fails if corresponding unconfirmed transaction for attempt was not found", func(t *testing.T) {
+			err := f.store().AppendAttemptToTransaction(ctx, 1, fromAddress, &types.Attempt{})
+			require.Error(t, err)
+			require.ErrorContains(t, err, "unconfirmed tx was not found")
+		})
+
+		t.Run("fails if unconfirmed transaction was found but doesn't match the txID", func(t *testing.T) {
+			err := f.store().AppendAttemptToTransaction(ctx, 10, fromAddress, &types.Attempt{TxID: otherTx.ID})
+			require.Error(t, err)
+			require.ErrorContains(t, err, "attempt points to a different txID")
+		})
+
+		t.Run("appends attempt to transaction", func(t *testing.T) {
+			require.NoError(t, f.store().AppendAttemptToTransaction(ctx, 10, fromAddress, &types.Attempt{TxID: tx.ID, Hash: testutils.NewHash()}))
+			utx, _, err := f.store().FetchUnconfirmedTransactionAtNonceWithCount(ctx, 10, fromAddress)
+			require.NoError(t, err)
+			assert.Len(t, utx.Attempts, 1)
+			assert.Equal(t, uint16(1), utx.AttemptCount)
+			assert.False(t, utx.Attempts[0].CreatedAt.IsZero())
+		})
+	})
+
+	t.Run("CreateEmptyUnconfirmedTransaction", func(t *testing.T) {
+		f, fromAddress := newStore(t, logger.Test(t))
+		ctx := tests.Context(t)
+		f.insertUnconfirmedTransaction(t, fromAddress, 1)
+		f.insertConfirmedTransaction(t, fromAddress, 0)
+
+		t.Run("fails if unconfirmed transaction with the same nonce exists", func(t *testing.T) {
+			_, err := f.store().CreateEmptyUnconfirmedTransaction(ctx, fromAddress, 1, 0)
+			require.Error(t, err)
+		})
+
+		t.Run("fails if confirmed transaction with the same nonce exists", func(t *testing.T) {
+			_, err := f.store().CreateEmptyUnconfirmedTransaction(ctx, fromAddress, 0, 0)
+			require.Error(t, err)
+		})
+
+		t.Run("creates a new empty unconfirmed transaction", func(t *testing.T) {
+			tx, err := f.store().CreateEmptyUnconfirmedTransaction(ctx, fromAddress, 2, 0)
+			require.NoError(t, err)
+			assert.Equal(t, txmgr.TxUnconfirmed, tx.State)
+		})
+	})
+
+	t.Run("CreateTransaction", func(t *testing.T) {
+		t.Run("creates new transactions", func(t *testing.T) {
+			f, fromAddress := newStore(t, logger.Test(t))
+			ctx := tests.Context(t)
+			now := time.Now()
+			tx1, err := f.store().CreateTransaction(ctx, &types.TxRequest{FromAddress: fromAddress})
+			require.NoError(t, err)
+			assert.LessOrEqual(t, now, tx1.CreatedAt)
+
+			tx2, err := f.store().CreateTransaction(ctx, &types.TxRequest{FromAddress: fromAddress})
+			require.NoError(t, err)
+			assert.Greater(t, tx2.ID, tx1.ID)
+			assert.LessOrEqual(t, now, tx2.CreatedAt)
+
+			assert.Equal(t, 2, f.countUnstartedTransactions(t, fromAddress))
+		})
+
+		t.Run("keeps the authorization list of set-code transactions", func(t *testing.T) {
+			f, fromAddress := newStore(t, logger.Test(t))
+			ctx := tests.Context(t)
+			authorizationList := []gethtypes.SetCodeAuthorization{{Address: testutils.NewAddress(), Nonce: 1}}
+			_, err := f.store().CreateTransaction(ctx, &types.TxRequest{FromAddress: fromAddress, AuthorizationList: authorizationList})
+			require.NoError(t, err)
+
+			tx, err := f.store().UpdateUnstartedTransactionWithNonce(ctx, fromAddress, 0)
+			require.NoError(t, err)
+			assert.Equal(t, authorizationList, tx.AuthorizationList)
+		})
+
+		t.Run("prunes oldest unstarted transactions if limit is reached", func(t *testing.T) {
+			f, fromAddress := newStore(t, logger.Test(t))
+			ctx := tests.Context(t)
+			overshot := 5
+			var txIDs []uint64
+			for i := 0; i < maxQueuedTransactions+overshot; i++ {
+				tx, err := f.store().CreateTransaction(ctx, &types.TxRequest{FromAddress: fromAddress})
+				require.NoError(t, err)
+				txIDs = append(txIDs, tx.ID)
+			}
+			// total shouldn't exceed maxQueuedTransactions
+			assert.Equal(t, maxQueuedTransactions, f.countUnstartedTransactions(t, fromAddress))
+			// earliest tx should be the first one that wasn't dropped
+			tx, err := f.store().UpdateUnstartedTransactionWithNonce(ctx, fromAddress, 0)
+			require.NoError(t, err)
+			assert.Equal(t, txIDs[overshot], tx.ID)
+		})
+	})
+
+	t.Run("FetchUnconfirmedTransactionAtNonceWithCount", func(t *testing.T) {
+		f, fromAddress := newStore(t, logger.Test(t))
+		ctx := tests.Context(t)
+
+		tx, count, err := f.store().FetchUnconfirmedTransactionAtNonceWithCount(ctx, 0, fromAddress)
+		require.NoError(t, err)
+		assert.Nil(t, tx)
+		assert.Equal(t, 0, count)
+
+		var nonce uint64
+		f.insertUnconfirmedTransaction(t, fromAddress, nonce)
+		tx, count, err = f.store().FetchUnconfirmedTransactionAtNonceWithCount(ctx, 0, fromAddress)
+		require.NoError(t, err)
+		assert.Equal(t, nonce, *tx.Nonce)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("MarkConfirmedAndReorgedTransactions", func(t *testing.T) {
+		t.Run("returns 0 if there are no transactions", func(t *testing.T) {
+			f, fromAddress := newStore(t, logger.Test(t))
+			un, cn, err := f.store().MarkConfirmedAndReorgedTransactions(tests.Context(t), 100, fromAddress)
+			require.NoError(t, err)
+			assert.Empty(t, un)
+			assert.Empty(t, cn)
+		})
+
+		t.Run("confirms transaction with nonce lower than the latest", func(t *testing.T) {
+			f, fromAddress := newStore(t, logger.Test(t))
+			ctx1 := f.insertUnconfirmedTransaction(t, fromAddress, 0)
+			ctx2 := f.insertUnconfirmedTransaction(t, fromAddress, 1)
+
+			ctxs, utxs, err := f.store().MarkConfirmedAndReorgedTransactions(tests.Context(t), 1, fromAddress)
+			require.NoError(t, err)
+			assert.Equal(t, txmgr.TxConfirmed, f.fetchTransaction(t, fromAddress, ctx1.ID).State)
+			assert.Equal(t, txmgr.TxUnconfirmed, f.fetchTransaction(t, fromAddress, ctx2.ID).State)
+			require.Len(t, ctxs, 1)
+			assert.Equal(t, ctx1.ID, ctxs[0].ID) // Ensure order
+			assert.Empty(t, utxs)
+		})
+
+		t.Run("state remains the same if nonce didn't change", func(t *testing.T) {
+			f, fromAddress := newStore(t, logger.Test(t))
+			ctx1 := f.insertConfirmedTransaction(t, fromAddress, 0)
+			ctx2 := f.insertUnconfirmedTransaction(t, fromAddress, 1)
+
+			ctxs, utxs, err := f.store().MarkConfirmedAndReorgedTransactions(tests.Context(t), 1, fromAddress)
+			require.NoError(t, err)
+			assert.Equal(t, txmgr.TxConfirmed, f.fetchTransaction(t, fromAddress, ctx1.ID).State)
+			assert.Equal(t, txmgr.TxUnconfirmed, f.fetchTransaction(t, fromAddress, ctx2.ID).State)
+			assert.Empty(t, ctxs)
+			assert.Empty(t, utxs)
+		})
+
+		t.Run("unconfirms transaction with nonce equal to or higher than the latest", func(t *testing.T) {
+			f, fromAddress := newStore(t, logger.Test(t))
+			ctx1 := f.insertConfirmedTransaction(t, fromAddress, 0)
+			ctx2 := f.insertConfirmedTransaction(t, fromAddress, 1)
+
+			ctxs, utxs, err := f.store().MarkConfirmedAndReorgedTransactions(tests.Context(t), 1, fromAddress)
+			require.NoError(t, err)
+			assert.Equal(t, txmgr.TxConfirmed, f.fetchTransaction(t, fromAddress, ctx1.ID).State)
+			reorged := f.fetchTransaction(t, fromAddress, ctx2.ID)
+			assert.Equal(t, txmgr.TxUnconfirmed, reorged.State)
+			assert.Nil(t, reorged.LastBroadcastAt)
+			assert.Equal(t, []uint64{ctx2.ID}, utxs)
+			assert.Empty(t, ctxs)
+		})
+
+		t.Run("logs an error during confirmation if a transaction with the same nonce already exists", func(t *testing.T) {
+			lggr, observedLogs := logger.TestObserved(t, zap.DebugLevel)
+			f, fromAddress := newStore(t, lggr)
+			f.insertConfirmedTransaction(t, fromAddress, 0)
+			f.insertUnconfirmedTransaction(t, fromAddress, 0)
+
+			_, _, err := f.store().MarkConfirmedAndReorgedTransactions(tests.Context(t), 1, fromAddress)
+			require.NoError(t, err)
+			tests.AssertLogEventually(t, observedLogs, "Another confirmed transaction with the same nonce exists")
+		})
+
+		t.Run("prunes confirmed transactions if they reach the limit", func(t *testing.T) {
+			f, fromAddress := newStore(t, logger.Test(t))
+			overshot := 5
+			for i := 0; i < maxQueuedTransactions+overshot; i++ {
+				//nolint:gosec // this won't overflow
+				f.insertConfirmedTransaction(t, fromAddress, uint64(i))
+			}
+			assert.Equal(t, maxQueuedTransactions+overshot, f.countConfirmedTransactions(t, fromAddress))
+			//nolint:gosec // this won't overflow
+			_, _, err := f.store().MarkConfirmedAndReorgedTransactions(tests.Context(t), uint64(maxQueuedTransactions+overshot), fromAddress)
+			require.NoError(t, err)
+			assert.Equal(t, 170, f.countConfirmedTransactions(t, fromAddress))
+		})
+	})
+
+	t.Run("MarkUnconfirmedTransactionPurgeable", func(t *testing.T) {
+		f, fromAddress := newStore(t, logger.Test(t))
+		ctx := tests.Context(t)
+
+		// fails if tx was not found
+		require.Error(t, f.store().MarkUnconfirmedTransactionPurgeable(ctx, 0, fromAddress))
+
+		tx := f.insertUnconfirmedTransaction(t, fromAddress, 0)
+		require.NoError(t, f.store().MarkUnconfirmedTransactionPurgeable(ctx, 0, fromAddress))
+		assert.True(t, f.fetchTransaction(t, fromAddress, tx.ID).IsPurgeable)
+	})
+
+	t.Run("UpdateTransactionBroadcast", func(t *testing.T) {
+		hash := testutils.NewHash()
+		t.Run("fails if unconfirmed transaction was not found", func(t *testing.T) {
+			f, fromAddress := newStore(t, logger.Test(t))
+			var nonce uint64
+			require.Error(t, f.store().UpdateTransactionBroadcast(tests.Context(t), 0, nonce, hash, fromAddress))
+		})
+
+		t.Run("fails if attempt was not found for a given transaction", func(t *testing.T) {
+			f, fromAddress := newStore(t, logger.Test(t))
+			ctx := tests.Context(t)
+			var nonce uint64
+			tx := f.insertUnconfirmedTransaction(t, fromAddress, nonce)
+			require.Error(t, f.store().UpdateTransactionBroadcast(ctx, tx.ID, nonce, hash, fromAddress))
+
+			// Attempt with different hash
+			f.insertAttempt(t, fromAddress, &types.Attempt{TxID: tx.ID, Hash: testutils.NewHash()})
+			require.Error(t, f.store().UpdateTransactionBroadcast(ctx, tx.ID, nonce, hash, fromAddress))
+		})
+
+		t.Run("updates transaction's and attempt's broadcast times", func(t *testing.T) {
+			f, fromAddress := newStore(t, logger.Test(t))
+			var nonce uint64
+			tx := f.insertUnconfirmedTransaction(t, fromAddress, nonce)
+			f.insertAttempt(t, fromAddress, &types.Attempt{TxID: tx.ID, Hash: hash})
+			require.NoError(t, f.store().UpdateTransactionBroadcast(tests.Context(t), tx.ID, nonce, hash, fromAddress))
+
+			tx = f.fetchTransaction(t, fromAddress, tx.ID)
+			require.Len(t, tx.Attempts, 1)
+			assert.False(t, tx.LastBroadcastAt.IsZero())
+			assert.False(t, tx.Attempts[0].BroadcastAt.IsZero())
+			assert.False(t, tx.InitialBroadcastAt.IsZero())
+		})
+	})
+
+	t.Run("UpdateUnstartedTransactionWithNonce", func(t *testing.T) {
+		t.Run("returns nil if there are no unstarted transactions", func(t *testing.T) {
+			f, fromAddress := newStore(t, logger.Test(t))
+			tx, err := f.store().UpdateUnstartedTransactionWithNonce(tests.Context(t), fromAddress, 0)
+			require.NoError(t, err)
+			assert.Nil(t, tx)
+		})
+
+		t.Run("fails if there is already another unconfirmed transaction with the same nonce", func(t *testing.T) {
+			var nonce uint64
+			f, fromAddress := newStore(t, logger.Test(t))
+			f.insertUnstartedTransaction(t, fromAddress)
+			f.insertUnconfirmedTransaction(t, fromAddress, nonce)
+
+			_, err := f.store().UpdateUnstartedTransactionWithNonce(tests.Context(t), fromAddress, nonce)
+			require.Error(t, err)
+		})
+
+		t.Run("updates unstarted transaction to unconfirmed and assigns a nonce", func(t *testing.T) {
+			var nonce uint64
+			f, fromAddress := newStore(t, logger.Test(t))
+			f.insertUnstartedTransaction(t, fromAddress)
+
+			tx, err := f.store().UpdateUnstartedTransactionWithNonce(tests.Context(t), fromAddress, nonce)
+			require.NoError(t, err)
+			assert.Equal(t, nonce, *tx.Nonce)
+			assert.Equal(t, txmgr.TxUnconfirmed, tx.State)
+			assert.Equal(t, 0, f.countUnstartedTransactions(t, fromAddress))
+		})
+	})
+
+	t.Run("DeleteAttemptForUnconfirmedTx", func(t *testing.T) {
+		t.Run("fails if corresponding unconfirmed transaction for attempt was not found", func(t *testing.T) {
+			f, fromAddress := newStore(t, logger.Test(t))
+			var nonce uint64
+			attempt := &types.Attempt{TxID: 0}
+			require.Error(t, f.store().DeleteAttemptForUnconfirmedTx(tests.Context(t), nonce, attempt, fromAddress))
+		})
+
+		t.Run("fails if corresponding unconfirmed attempt for txID was not found", func(t *testing.T) {
+			f, fromAddress := newStore(t, logger.Test(t))
+			f.insertUnconfirmedTransaction(t, fromAddress, 0)
+
+			attempt := &types.Attempt{TxID: 2, Hash: testutils.NewHash()}
+			require.Error(t, f.store().DeleteAttemptForUnconfirmedTx(tests.Context(t), 0, attempt, fromAddress))
+		})
+
+		t.Run("deletes attempt of unconfirmed transaction", func(t *testing.T) {
+			var nonce uint64
+			f, fromAddress := newStore(t, logger.Test(t))
+			tx := f.insertUnconfirmedTransaction(t, fromAddress, nonce)
+
+			attempt := &types.Attempt{TxID: tx.ID, Hash: testutils.NewHash()}
+			f.insertAttempt(t, fromAddress, attempt)
+			require.NoError(t, f.store().DeleteAttemptForUnconfirmedTx(tests.Context(t), nonce, attempt, fromAddress))
+
+			assert.Empty(t, f.fetchTransaction(t, fromAddress, tx.ID).Attempts)
+		})
+	})
+
+	t.Run("FindTxWithIdempotencyKey", func(t *testing.T) {
+		f, fromAddress := newStore(t, logger.Test(t))
+		ctx := tests.Context(t)
+		tx := f.insertConfirmedTransaction(t, fromAddress, 0)
+
+		ik := "IK"
+		f.setIdempotencyKey(t, fromAddress, tx.ID, ik)
+		itx, err := f.store().FindTxWithIdempotencyKey(ctx, ik)
+		require.NoError(t, err)
+		assert.Equal(t, ik, *itx.IdempotencyKey)
+
+		itx, err = f.store().FindTxWithIdempotencyKey(ctx, "Unknown")
+		require.NoError(t, err)
+		assert.Nil(t, itx)
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils"
 	"github.com/smartcontractkit/chainlink-evm/pkg/keys"
 	"github.com/smartcontractkit/chainlink-evm/pkg/txm/types"
+	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr/lifecycle"
 )
 
 const (
@@ -84,6 +85,8 @@ type Txm struct {
 	keystore        keys.AddressLister
 	config          Config
 	metrics         *txmMetrics
+	// lifecycleEmitter is optional and receives broadcast, bump and confirmation events.
+	lifecycleEmitter *lifecycle.Emitter
 
 	nonceMapMu sync.RWMutex
 	nonceMap   map[common.Address]uint64
@@ -93,18 +96,19 @@ type Txm struct {
 	wg        sync.WaitGroup
 }
 
-func NewTxm(lggr logger.Logger, chainID *big.Int, client Client, attemptBuilder AttemptBuilder, txStore TxStore, stuckTxDetector StuckTxDetector, config Config, keystore keys.AddressLister) *Txm {
+func NewTxm(lggr logger.Logger, chainID *big.Int, client Client, attemptBuilder AttemptBuilder, txStore TxStore, stuckTxDetector StuckTxDetector, config Config, keystore keys.AddressLister, lifecycleEmitter *lifecycle.Emitter) *Txm {
 	return &Txm{
-		lggr:            logger.Sugared(logger.Named(lggr, "Txm")),
-		keystore:        keystore,
-		chainID:         chainID,
-		client:          client,
-		attemptBuilder:  attemptBuilder,
-		txStore:         txStore,
-		stuckTxDetector: stuckTxDetector,
-		config:          config,
-		nonceMap:        make(map[common.Address]uint64),
-		triggerCh:       make(map[common.Address]chan struct{}),
+		lggr:             logger.Sugared(logger.Named(lggr, "Txm")),
+		keystore:         keystore,
+		chainID:          chainID,
+		client:           client,
+		attemptBuilder:   attemptBuilder,
+		txStore:          txStore,
+		stuckTxDetector:  stuckTxDetector,
+		config:           config,
+		lifecycleEmitter: lifecycleEmitter,
+		nonceMap:         make(map[common.Address]uint64),
+		triggerCh:        make(map[common.Address]chan struct{}),
 	}
 }
 
@@ -366,7 +370,15 @@ func (t *Txm) sendTransactionWithError(ctx context.Context, tx *types.Transactio
 		t.lggr.Errorw("Beholder error emitting tx message", "err", err)
 	}
 
-	return t.txStore.UpdateTransactionBroadcast(ctx, attempt.TxID, *tx.Nonce, attempt.Hash, fromAddress)
+	if err = t.txStore.UpdateTransactionBroadcast(ctx, attempt.TxID, *tx.Nonce, attempt.Hash, fromAddress); err != nil {
+		return err
+	}
+	eventType := lifecycle.EventBumped
+	if tx.AttemptCount == 1 {
+		eventType = lifecycle.EventBroadcast
+	}
+	t.lifecycleEmitter.Emit(newLifecycleEvent(eventType, tx, attempt.Hash))
+	return nil
 }
 
 func (t *Txm) backfillTransactions(ctx context.Context, address common.Address) (bool, error) {
@@ -382,6 +394,9 @@ func (t *Txm) backfillTransactions(ctx context.Context, address common.Address)
 	if len(confirmedTransactions) > 0 || len(unconfirmedTransactionIDs) > 0 {
 		t.metrics.IncrementNumConfirmedTxs(ctx, len(confirmedTransactions))
 		confirmedTransactionIDs := t.extractMetrics(ctx, confirmedTransactions)
+		for _, tx := range confirmedTransactions {
+			t.lifecycleEmitter.Emit(newLifecycleEvent(lifecycle.EventConfirmed, tx))
+		}
 		t.lggr.Infof("Confirmed transaction IDs: %v . Re-orged transaction IDs: %v", confirmedTransactionIDs, unconfirmedTransactionIDs)
 	}
 
@@ -449,3 +464,24 @@ func (t *Txm) extractMetrics(ctx context.Context, txs []*types.Transaction) []ui
 	}
 	return confirmedTxIDs
 }
+
+func newLifecycleEvent(eventType lifecycle.EventType, tx *types.Transaction, hashes ...common.Hash) lifecycle.Event {
+	event := lifecycle.Event{
+		Type:           eventType,
+		TxID:           int64(tx.ID), //nolint:gosec // IDs fit in an int64
+		IdempotencyKey: tx.IdempotencyKey,
+		FromAddress:    tx.FromAddress,
+		ToAddress:      tx.ToAddress,
+		Nonce:          tx.Nonce,
+		TxHashes:       hashes,
+	}
+	if len(hashes) == 0 {
+		for _, attempt := range tx.Attempts {
+			event.TxHashes = append(event.TxHashes, attempt.Hash)
+		}
+	}
+	if meta, err := tx.GetMeta(); err == nil && meta != nil {
+		event.JobID = meta.JobID
+	}
+	return event
+}
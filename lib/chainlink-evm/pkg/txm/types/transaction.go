@@ -30,6 +30,8 @@ type Transaction struct {
 	Value             *big.Int
 	Data              []byte
 	SpecifiedGasLimit uint64
+	// AuthorizationList holds signed EIP-7702 authorizations. Transactions with a non-empty list are sent as set-code transactions.
+	AuthorizationList []types.SetCodeAuthorization
 
 	CreatedAt          time.Time
 	InitialBroadcastAt *time.Time
@@ -52,10 +54,10 @@ type Transaction struct {
 
 func (t *Transaction) String() string {
 	return fmt.Sprintf(`{txID:%d, IdempotencyKey:%v, ChainID:%v, Nonce:%s, FromAddress:%v, ToAddress:%v, Value:%v, `+
-		`Data:%s, SpecifiedGasLimit:%d, CreatedAt:%v, InitialBroadcastAt:%v, LastBroadcastAt:%v, State:%v, IsPurgeable:%v, AttemptCount:%d, `+
+		`Data:%s, SpecifiedGasLimit:%d, AuthorizationListLength:%d, CreatedAt:%v, InitialBroadcastAt:%v, LastBroadcastAt:%v, State:%v, IsPurgeable:%v, AttemptCount:%d, `+
 		`Meta:%v, Subject:%v}`,
 		t.ID, stringOrNull(t.IdempotencyKey), t.ChainID, stringOrNull(t.Nonce), t.FromAddress, t.ToAddress, t.Value,
-		base64.StdEncoding.EncodeToString(t.Data), t.SpecifiedGasLimit, t.CreatedAt, stringOrNull(t.InitialBroadcastAt), stringOrNull(t.LastBroadcastAt),
+		base64.StdEncoding.EncodeToString(t.Data), t.SpecifiedGasLimit, len(t.AuthorizationList), t.CreatedAt, stringOrNull(t.InitialBroadcastAt), stringOrNull(t.LastBroadcastAt),
 		t.State, t.IsPurgeable, t.AttemptCount, t.Meta, t.Subject)
 }
 
@@ -92,6 +94,9 @@ func (t *Transaction) DeepCopy() *Transaction {
 		attemptsCopy = append(attemptsCopy, attempt.DeepCopy())
 	}
 	txCopy.Attempts = attemptsCopy
+	if t.AuthorizationList != nil {
+		txCopy.AuthorizationList = append([]types.SetCodeAuthorization(nil), t.AuthorizationList...)
+	}
 	return &txCopy
 }
 
@@ -140,6 +145,7 @@ type TxRequest struct {
 	Value             *big.Int
 	Data              []byte
 	SpecifiedGasLimit uint64
+	AuthorizationList []types.SetCodeAuthorization
 
 	Meta             *sqlutil.JSON // TODO: *TxMeta after migration
 	ForwarderAddress common.Address
@@ -3,14 +3,17 @@ package txmgr
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
 	pkgerrors "github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
 	"github.com/smartcontractkit/chainlink-evm/pkg/gas"
 	"github.com/smartcontractkit/chainlink-evm/pkg/keys"
@@ -38,11 +41,44 @@ func NewEvmTxAttemptBuilder(chainID big.Int, feeConfig evmTxAttemptBuilderFeeCon
 	return &evmTxAttemptBuilder{chainID, feeConfig, keystore, estimator}
 }
 
+// NewAuthorizationList marshals signed EIP-7702 authorizations for TxRequest.AuthorizationList.
+// Transactions with a non-empty authorization list are sent as set-code (type 4) transactions.
+func NewAuthorizationList(authorizationList []types.SetCodeAuthorization) (*sqlutil.JSON, error) {
+	if len(authorizationList) == 0 {
+		return nil, nil
+	}
+	b, err := json.Marshal(authorizationList)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to marshal authorization list")
+	}
+	l := sqlutil.JSON(b)
+	return &l, nil
+}
+
+// GetAuthorizationList returns the signed EIP-7702 authorizations of the tx.
+func GetAuthorizationList(etx Tx) ([]types.SetCodeAuthorization, error) {
+	if etx.AuthorizationList == nil {
+		return nil, nil
+	}
+	var authorizationList []types.SetCodeAuthorization
+	if err := json.Unmarshal(*etx.AuthorizationList, &authorizationList); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to unmarshal authorization list")
+	}
+	return authorizationList, nil
+}
+
 // NewTxAttempt builds an new attempt using the configured fee estimator + using the EIP1559 config to determine tx type
 // used for when a brand new transaction is being created in the txm
 func (c *evmTxAttemptBuilder) NewTxAttempt(ctx context.Context, etx Tx, lggr logger.Logger, opts ...fees.Opt) (attempt TxAttempt, fee gas.EvmFee, feeLimit uint64, retryable bool, err error) {
+	authorizationList, err := GetAuthorizationList(etx)
+	if err != nil {
+		return attempt, fee, feeLimit, false, err
+	}
 	txType := 0x0
-	if c.feeConfig.EIP1559DynamicFees() {
+	if len(authorizationList) > 0 {
+		// set-code transactions are always dynamic fee transactions, regardless of the EIP1559 config
+		txType = 0x4
+	} else if c.feeConfig.EIP1559DynamicFees() {
 		txType = 0x2
 	}
 	return c.NewTxAttemptWithType(ctx, etx, lggr, txType, opts...)
@@ -52,7 +88,16 @@ func (c *evmTxAttemptBuilder) NewTxAttempt(ctx context.Context, etx Tx, lggr log
 // used for L2 re-estimation on broadcasting (note EIP1559 must be disabled otherwise this will fail with mismatched fees + tx type)
 func (c *evmTxAttemptBuilder) NewTxAttemptWithType(ctx context.Context, etx Tx, lggr logger.Logger, txType int, opts ...fees.Opt) (attempt TxAttempt, fee gas.EvmFee, feeLimit uint64, retryable bool, err error) {
 	keySpecificMaxGasPriceWei := c.feeConfig.PriceMaxKey(etx.FromAddress)
-	fee, feeLimit, err = c.EvmFeeEstimator.GetFee(ctx, etx.EncodedPayload, etx.FeeLimit, keySpecificMaxGasPriceWei, &etx.FromAddress, &etx.ToAddress, opts...)
+	if txType == 0x4 {
+		var authorizationList []types.SetCodeAuthorization
+		authorizationList, err = GetAuthorizationList(etx)
+		if err != nil {
+			return attempt, fee, feeLimit, false, err
+		}
+		fee, feeLimit, err = c.EvmFeeEstimator.GetSetCodeFee(ctx, etx.EncodedPayload, etx.FeeLimit, keySpecificMaxGasPriceWei, &etx.FromAddress, &etx.ToAddress, authorizationList)
+	} else {
+		fee, feeLimit, err = c.EvmFeeEstimator.GetFee(ctx, etx.EncodedPayload, etx.FeeLimit, keySpecificMaxGasPriceWei, &etx.FromAddress, &etx.ToAddress, opts...)
+	}
 	if err != nil {
 		return attempt, fee, feeLimit, true, pkgerrors.Wrap(err, "failed to get fee") // estimator errors are retryable
 	}
@@ -70,13 +115,15 @@ func (c *evmTxAttemptBuilder) NewBumpTxAttempt(ctx context.Context, etx Tx, prev
 	if err != nil {
 		return attempt, bumpedFee, bumpedFeeLimit, true, pkgerrors.Wrap(err, "failed to bump fee") // estimator errors are retryable
 	}
+	txType := previousAttempt.TxType
 	// If transaction's previous attempt is marked for purge, ensure the new bumped attempt also sends empty payload, 0 value, and LimitDefault as fee limit
 	if previousAttempt.IsPurgeAttempt {
 		etx.EncodedPayload = []byte{}
 		etx.Value = *big.NewInt(0)
 		bumpedFeeLimit = c.feeConfig.LimitDefault()
+		txType = purgeTxType(txType)
 	}
-	attempt, retryable, err = c.NewCustomTxAttempt(ctx, etx, bumpedFee, bumpedFeeLimit, previousAttempt.TxType, lggr)
+	attempt, retryable, err = c.NewCustomTxAttempt(ctx, etx, bumpedFee, bumpedFeeLimit, txType, lggr)
 	// If transaction's previous attempt is marked for purge, ensure the new bumped attempt is also marked for purge
 	if previousAttempt.IsPurgeAttempt {
 		attempt.IsPurgeAttempt = true
@@ -97,7 +144,7 @@ func (c *evmTxAttemptBuilder) NewPurgeTxAttempt(ctx context.Context, etx Tx, lgg
 	// Set empty payload and 0 value for purge attempts
 	etx.EncodedPayload = []byte{}
 	etx.Value = *big.NewInt(0)
-	attempt, _, err = c.NewCustomTxAttempt(ctx, etx, bumpedFee, gasLimit, previousAttempt.TxType, lggr)
+	attempt, _, err = c.NewCustomTxAttempt(ctx, etx, bumpedFee, gasLimit, purgeTxType(previousAttempt.TxType), lggr)
 	if err != nil {
 		return attempt, fmt.Errorf("failed to create purge attempt: %w", err)
 	}
@@ -105,6 +152,15 @@ func (c *evmTxAttemptBuilder) NewPurgeTxAttempt(ctx context.Context, etx Tx, lgg
 	return attempt, nil
 }
 
+// purgeTxType returns the tx type used to purge a transaction. Purge attempts only need to consume the nonce,
+// so set-code transactions are purged with a dynamic fee transaction that does not re-apply the authorizations.
+func purgeTxType(txType int) int {
+	if txType == 0x4 {
+		return 0x2
+	}
+	return txType
+}
+
 // NewCustomTxAttempt is the lowest level func where the fee parameters + tx type must be passed in
 // used in the txm for force rebroadcast where fees and tx type are pre-determined without an estimator
 func (c *evmTxAttemptBuilder) NewCustomTxAttempt(ctx context.Context, etx Tx, fee gas.EvmFee, gasLimit uint64, txType int, lggr logger.Logger) (attempt TxAttempt, retryable bool, err error) {
@@ -128,6 +184,27 @@ func (c *evmTxAttemptBuilder) NewCustomTxAttempt(ctx context.Context, etx Tx, fe
 			GasTipCap: fee.GasTipCap,
 		}, gasLimit)
 		return attempt, true, err
+	case 0x4: // set-code, EIP7702
+		if !fee.ValidDynamic() {
+			err = pkgerrors.Errorf("Attempt %v is a type 4 transaction but estimator did not return dynamic fee bump", attempt.ID)
+			logger.Sugared(lggr).AssumptionViolation(err.Error())
+			return attempt, false, err // not retryable
+		}
+		var authorizationList []types.SetCodeAuthorization
+		authorizationList, err = GetAuthorizationList(etx)
+		if err != nil {
+			return attempt, false, err // not retryable
+		}
+		if len(authorizationList) == 0 {
+			err = pkgerrors.Errorf("Attempt %v is a type 4 transaction but tx %v has no authorization list", attempt.ID, etx.ID)
+			logger.Sugared(lggr).AssumptionViolation(err.Error())
+			return attempt, false, err // not retryable
+		}
+		attempt, err = c.newSetCodeAttempt(ctx, etx, gas.DynamicFee{
+			GasFeeCap: fee.GasFeeCap,
+			GasTipCap: fee.GasTipCap,
+		}, gasLimit, authorizationList)
+		return attempt, true, err
 	default:
 		err = pkgerrors.Errorf("invariant violation: Attempt %v had unrecognised transaction type %v"+
 			"This is a bug! Please report to https://github.com/smartcontractkit/chainlink/issues", attempt.ID, attempt.TxType)
@@ -193,6 +270,35 @@ func (c *evmTxAttemptBuilder) newDynamicFeeAttempt(ctx context.Context, etx Tx,
 	return attempt, nil
 }
 
+func (c *evmTxAttemptBuilder) newSetCodeAttempt(ctx context.Context, etx Tx, fee gas.DynamicFee, gasLimit uint64, authorizationList []types.SetCodeAuthorization) (attempt TxAttempt, err error) {
+	if err = validateDynamicFeeGas(c.feeConfig, fee, etx); err != nil {
+		return attempt, pkgerrors.Wrap(err, "error validating gas")
+	}
+
+	d := newSetCodeTransaction(
+		uint64(*etx.Sequence),
+		etx.ToAddress,
+		&etx.Value,
+		gasLimit,
+		&c.chainID,
+		fee.GasTipCap,
+		fee.GasFeeCap,
+		etx.EncodedPayload,
+		authorizationList,
+	)
+	tx := types.NewTx(&d)
+	attempt, err = c.newSignedAttempt(ctx, etx, tx)
+	if err != nil {
+		return attempt, err
+	}
+	attempt.TxFee = gas.EvmFee{
+		DynamicFee: gas.DynamicFee{GasFeeCap: fee.GasFeeCap, GasTipCap: fee.GasTipCap},
+	}
+	attempt.ChainSpecificFeeLimit = gasLimit
+	attempt.TxType = 4
+	return attempt, nil
+}
+
 var Max256BitUInt = big.NewInt(0).Exp(big.NewInt(2), big.NewInt(256), nil)
 
 type keySpecificEstimator interface {
@@ -244,6 +350,20 @@ func newDynamicFeeTransaction(nonce uint64, to common.Address, value *big.Int, g
 	}
 }
 
+func newSetCodeTransaction(nonce uint64, to common.Address, value *big.Int, gasLimit uint64, chainID *big.Int, gasTipCap, gasFeeCap *assets.Wei, data []byte, authorizationList []types.SetCodeAuthorization) types.SetCodeTx {
+	return types.SetCodeTx{
+		ChainID:   uint256.MustFromBig(chainID),
+		Nonce:     nonce,
+		GasTipCap: uint256.MustFromBig(gasTipCap.ToInt()),
+		GasFeeCap: uint256.MustFromBig(gasFeeCap.ToInt()),
+		Gas:       gasLimit,
+		To:        to,
+		Value:     uint256.MustFromBig(value),
+		Data:      data,
+		AuthList:  authorizationList,
+	}
+}
+
 func (c *evmTxAttemptBuilder) newLegacyAttempt(ctx context.Context, etx Tx, gasPrice *assets.Wei, gasLimit uint64) (attempt TxAttempt, err error) {
 	if err = validateLegacyGas(c.feeConfig, gasPrice, etx); err != nil {
 		return attempt, pkgerrors.Wrap(err, "error validating gas")
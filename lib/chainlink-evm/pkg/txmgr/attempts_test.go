@@ -182,6 +182,63 @@ func TestTxm_NewDynamicFeeTx(t *testing.T) {
 	})
 }
 
+func TestTxm_NewSetCodeAttempt(t *testing.T) {
+	addr := NewEvmAddress()
+	kst := keystest.TxSigner(nil)
+	lggr := logger.Test(t)
+	feeCfg := newFeeConfig()
+	feeCfg.priceMax = assets.GWei(200)
+	feeCfg.limitDefault = uint64(10)
+	dynamicFee := gas.DynamicFee{GasTipCap: assets.GWei(100), GasFeeCap: assets.GWei(200)}
+	authorizationList := []gethtypes.SetCodeAuthorization{{Address: NewEvmAddress(), Nonce: 1}}
+	authorizationListJSON, err := txmgr.NewAuthorizationList(authorizationList)
+	require.NoError(t, err)
+
+	t.Run("creates set-code attempt if tx has an authorization list", func(t *testing.T) {
+		est := gasmocks.NewEvmFeeEstimator(t)
+		est.On("GetSetCodeFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, authorizationList).
+			Return(gas.EvmFee{DynamicFee: dynamicFee}, uint64(100), nil).Once()
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), feeCfg, kst, est)
+		var n evmtypes.Nonce
+		a, _, _, _, err := cks.NewTxAttempt(t.Context(), txmgr.Tx{Sequence: &n, FromAddress: addr, AuthorizationList: authorizationListJSON}, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, 4, a.TxType)
+		assert.Equal(t, 100, int(a.ChainSpecificFeeLimit))
+		assert.Nil(t, a.TxFee.GasPrice)
+		assert.Equal(t, dynamicFee.GasTipCap.String(), a.TxFee.GasTipCap.String())
+		assert.Equal(t, dynamicFee.GasFeeCap.String(), a.TxFee.GasFeeCap.String())
+
+		signedTx, err := txmgr.GetGethSignedTx(a.SignedRawTx)
+		require.NoError(t, err)
+		assert.Equal(t, uint8(gethtypes.SetCodeTxType), signedTx.Type())
+		assert.Equal(t, authorizationList, signedTx.SetCodeAuthorizations())
+	})
+
+	t.Run("fails if tx has no authorization list", func(t *testing.T) {
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), feeCfg, kst, nil)
+		var n evmtypes.Nonce
+		_, retryable, err := cks.NewCustomTxAttempt(t.Context(), txmgr.Tx{Sequence: &n, FromAddress: addr}, gas.EvmFee{DynamicFee: dynamicFee}, 100, 0x4, lggr)
+		require.ErrorContains(t, err, "has no authorization list")
+		assert.False(t, retryable)
+	})
+
+	t.Run("creates dynamic purge attempt if previous attempt is set-code", func(t *testing.T) {
+		est := gasmocks.NewEvmFeeEstimator(t)
+		est.On("BumpFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(gas.EvmFee{DynamicFee: dynamicFee}, uint64(100), nil).Once()
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), feeCfg, kst, est)
+		var n evmtypes.Nonce
+		etx := txmgr.Tx{Sequence: &n, FromAddress: addr, AuthorizationList: authorizationListJSON}
+		prevAttempt, _, err := cks.NewCustomTxAttempt(t.Context(), etx, gas.EvmFee{DynamicFee: dynamicFee}, 100, 0x4, lggr)
+		require.NoError(t, err)
+		etx.TxAttempts = append(etx.TxAttempts, prevAttempt)
+		a, err := cks.NewPurgeTxAttempt(t.Context(), etx, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, 2, a.TxType)
+		assert.True(t, a.IsPurgeAttempt)
+		assert.Equal(t, feeCfg.limitDefault, a.ChainSpecificFeeLimit)
+	})
+}
+
 func TestTxm_NewLegacyAttempt(t *testing.T) {
 	addr := NewEvmAddress()
 	kst := keystest.TxSigner(nil)
@@ -0,0 +1,442 @@
+package txmgr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+
+	"github.com/smartcontractkit/chainlink-evm/gethwrappers/operatorforwarder/generated/authorized_forwarder"
+	"github.com/smartcontractkit/chainlink-evm/gethwrappers/shared/generated/latest/multicall3"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+)
+
+// batchCallGasOverhead is added to the fee limit of a batch for every call it contains, to cover the cost of
+// decoding and dispatching the call in Multicall3 or the forwarder.
+const batchCallGasOverhead = 5_000
+
+var (
+	multicall3ABI      = evmtypes.MustGetABI(multicall3.Multicall3ABI)
+	multiForwardMethod = evmtypes.MustGetABI(authorized_forwarder.AuthorizedForwarderABI).Methods["multiForward"]
+
+	// ErrBatcherStopped is passed to the callbacks of requests that were still queued when the Batcher was closed.
+	ErrBatcherStopped = errors.New("batcher stopped before the request was sent")
+)
+
+// BatcherConfig configures a Batcher.
+type BatcherConfig struct {
+	// Window is how long the first request of a batch waits for compatible requests before the batch is sent.
+	Window time.Duration
+	// MaxBatchSize sends a batch as soon as it holds this many requests.
+	MaxBatchSize int
+	// Multicall3Address is the Multicall3 deployment used to batch requests that are not forwarded.
+	Multicall3Address common.Address
+	// PollInterval is how often the status of sent transactions is checked to notify callbacks.
+	PollInterval time.Duration
+}
+
+// BatchResult is passed to the callback of every request once the transaction carrying it is mined or has failed.
+type BatchResult struct {
+	// IdempotencyKey of the transaction that carried the request.
+	IdempotencyKey string
+	// BatchSize is the number of requests carried by the transaction, 1 if the request was sent on its own.
+	BatchSize int
+	// Meta is the meta of the original request.
+	Meta *TxMeta
+	// Reverted is set if the transaction was mined but reverted. Batches are all-or-nothing, so every request
+	// of a reverted batch is reverted.
+	Reverted bool
+	// GasUsed is the share of the transaction's gas used attributed to the request, in proportion to its fee limit.
+	GasUsed uint64
+	// Err is set if the transaction could not be sent or failed before being mined.
+	Err error
+}
+
+// BatchCallback is called once with the result of a request. Callbacks are best-effort: they are only held in memory,
+// so they are not called for requests whose transaction was still pending when the node stopped.
+type BatchCallback func(ctx context.Context, result BatchResult)
+
+// BatchOptions are set by the caller of each request.
+type BatchOptions struct {
+	// AnySender must only be set if the target accepts calls from any msg.sender, like the blockhash store's store.
+	// Requests that are not forwarded are batched through Multicall3, which becomes their msg.sender, so they are
+	// sent on their own unless AnySender is set.
+	AnySender bool
+	// Callback, if set, is called once the transaction carrying the request is mined or failed.
+	Callback BatchCallback
+}
+
+type batchTxManager interface {
+	CreateTransaction(ctx context.Context, txRequest TxRequest) (Tx, error)
+	GetTransactionStatus(ctx context.Context, transactionID string) (commontypes.TransactionStatus, error)
+	GetTransactionReceipt(ctx context.Context, transactionID string) (*ChainReceipt, error)
+}
+
+type batchCaller interface {
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+// batchKey groups requests that can share a transaction. Requests of different jobs are never batched together,
+// so that the transaction keeps the job ID of its requests.
+type batchKey struct {
+	fromAddress      common.Address
+	forwarderAddress common.Address
+	jobID            int32
+	hasJobID         bool
+}
+
+type batchItem struct {
+	request  TxRequest
+	callback BatchCallback
+}
+
+type pendingBatch struct {
+	createdAt time.Time
+	items     []batchItem
+}
+
+type sentBatch struct {
+	items []batchItem
+}
+
+// Batcher is an opt-in layer in front of the TxManager which collects compatible TxRequests of the same job sent from
+// the same key within a window and sends them as a single transaction, to reduce nonce pressure and fees.
+// Forwarded requests are batched with the forwarder's multiForward, all others with Multicall3's aggregate3. Calls
+// made through Multicall3 have the Multicall3 contract as msg.sender, so requests which are not forwarded are only
+// batched if their caller sets BatchOptions.AnySender.
+// Batches are all-or-nothing: a batch is simulated before it is sent, and if the simulation fails its requests are
+// sent one by one instead.
+// Queued requests and callbacks are only held in memory. Callers must not depend on callbacks for correctness, see
+// BatchCallback.
+type Batcher struct {
+	services.Service
+	eng *services.Engine
+
+	txm    batchTxManager
+	client batchCaller
+	cfg    BatcherConfig
+
+	mu      sync.Mutex
+	pending map[batchKey]*pendingBatch
+	sent    map[string]*sentBatch
+}
+
+// NewBatcher returns a new Batcher sending transactions through txm.
+func NewBatcher(lggr logger.Logger, txm batchTxManager, client batchCaller, cfg BatcherConfig) *Batcher {
+	b := &Batcher{
+		txm:     txm,
+		client:  client,
+		cfg:     cfg,
+		pending: make(map[batchKey]*pendingBatch),
+		sent:    make(map[string]*sentBatch),
+	}
+	b.Service, b.eng = services.Config{
+		Name:  "TxmBatcher",
+		Start: b.start,
+		Close: b.close,
+	}.NewServiceEngine(lggr)
+	return b
+}
+
+func (b *Batcher) start(_ context.Context) error {
+	b.eng.GoTick(services.NewTicker(b.cfg.Window), b.flushExpired)
+	b.eng.GoTick(services.NewTicker(b.cfg.PollInterval), b.checkSent)
+	return nil
+}
+
+func (b *Batcher) close() error {
+	b.mu.Lock()
+	var items []batchItem
+	for key, batch := range b.pending {
+		items = append(items, batch.items...)
+		delete(b.pending, key)
+	}
+	b.mu.Unlock()
+
+	// The engine's stop channel is already closed here, so callbacks get a short-lived context of their own.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	for _, item := range items {
+		item.callback(ctx, BatchResult{Meta: item.request.Meta, Err: ErrBatcherStopped})
+	}
+	return nil
+}
+
+// CreateTransaction queues the request to be sent in the next batch of compatible requests. Requests which can't be
+// batched are sent straight away.
+func (b *Batcher) CreateTransaction(ctx context.Context, txRequest TxRequest, opts BatchOptions) error {
+	callback := opts.Callback
+	if callback == nil {
+		callback = func(context.Context, BatchResult) {}
+	}
+	return b.eng.IfStarted(func() error {
+		item := batchItem{request: txRequest, callback: callback}
+		if !isBatchable(txRequest, opts.AnySender) {
+			return b.send(ctx, []batchItem{item})
+		}
+
+		key := batchKey{fromAddress: txRequest.FromAddress, forwarderAddress: txRequest.ForwarderAddress}
+		if txRequest.Meta != nil && txRequest.Meta.JobID != nil {
+			key.jobID, key.hasJobID = *txRequest.Meta.JobID, true
+		}
+		b.mu.Lock()
+		batch, ok := b.pending[key]
+		if !ok {
+			batch = &pendingBatch{createdAt: time.Now()}
+			b.pending[key] = batch
+		}
+		batch.items = append(batch.items, item)
+		var full []batchItem
+		if b.cfg.MaxBatchSize > 0 && len(batch.items) >= b.cfg.MaxBatchSize {
+			full = batch.items
+			delete(b.pending, key)
+		}
+		b.mu.Unlock()
+
+		if full != nil {
+			return b.send(ctx, full)
+		}
+		return nil
+	})
+}
+
+// isBatchable returns true if the request can share a transaction with other requests. Requests that transfer
+// value, resume pipeline runs, carry their own idempotency key or need a transmit check are sent on their own, as are
+// requests that are not forwarded unless their target accepts any sender.
+func isBatchable(txRequest TxRequest, anySender bool) bool {
+	return (anySender || txRequest.ForwarderAddress != (common.Address{})) &&
+		txRequest.Value.Sign() == 0 &&
+		txRequest.PipelineTaskRunID == nil &&
+		!txRequest.SignalCallback &&
+		txRequest.IdempotencyKey == nil &&
+		txRequest.Checker.CheckerType == ""
+}
+
+func (b *Batcher) flushExpired(ctx context.Context) {
+	b.mu.Lock()
+	var expired [][]batchItem
+	for key, batch := range b.pending {
+		if time.Since(batch.createdAt) >= b.cfg.Window {
+			expired = append(expired, batch.items)
+			delete(b.pending, key)
+		}
+	}
+	b.mu.Unlock()
+
+	for _, items := range expired {
+		if err := b.send(ctx, items); err != nil {
+			b.eng.Errorw("Failed to send batch", "size", len(items), "err", err)
+		}
+	}
+}
+
+// send sends the items as a single transaction. Callbacks of items that could not be sent are notified with the error.
+func (b *Batcher) send(ctx context.Context, items []batchItem) error {
+	txRequest := items[0].request
+	if len(items) > 1 {
+		var err error
+		txRequest, err = b.newBatchRequest(items)
+		if err == nil {
+			err = b.simulate(ctx, txRequest)
+		}
+		if err != nil {
+			b.eng.Warnw("Batch cannot be sent, sending requests one by one", "size", len(items), "err", err)
+			var errs error
+			for _, item := range items {
+				errs = errors.Join(errs, b.send(ctx, []batchItem{item}))
+			}
+			return errs
+		}
+	}
+
+	if txRequest.IdempotencyKey == nil {
+		key := "txm-batch-" + uuid.NewString()
+		txRequest.IdempotencyKey = &key
+	}
+	if _, err := b.txm.CreateTransaction(ctx, txRequest); err != nil {
+		for _, item := range items {
+			item.callback(ctx, BatchResult{BatchSize: len(items), Meta: item.request.Meta, Err: err})
+		}
+		return fmt.Errorf("failed to create transaction for batch of %d requests: %w", len(items), err)
+	}
+
+	b.mu.Lock()
+	b.sent[*txRequest.IdempotencyKey] = &sentBatch{items: items}
+	b.mu.Unlock()
+	return nil
+}
+
+// newBatchRequest combines the items into a single request to the forwarder or to Multicall3.
+func (b *Batcher) newBatchRequest(items []batchItem) (TxRequest, error) {
+	first := items[0].request
+	txRequest := TxRequest{
+		FromAddress: first.FromAddress,
+		Strategy:    txmgr.NewSendEveryStrategy(),
+	}
+	metas := make([]*TxMeta, 0, len(items))
+	for _, item := range items {
+		txRequest.FeeLimit += item.request.FeeLimit + batchCallGasOverhead
+		metas = append(metas, item.request.Meta)
+	}
+	txRequest.Meta = mergeBatchMeta(metas)
+
+	var err error
+	if first.ForwarderAddress != (common.Address{}) {
+		txRequest.ToAddress = first.ForwarderAddress
+		tos := make([]common.Address, 0, len(items))
+		datas := make([][]byte, 0, len(items))
+		for _, item := range items {
+			tos = append(tos, item.request.ToAddress)
+			datas = append(datas, item.request.EncodedPayload)
+		}
+		var args []byte
+		args, err = multiForwardMethod.Inputs.Pack(tos, datas)
+		txRequest.EncodedPayload = append(append([]byte{}, multiForwardMethod.ID...), args...)
+	} else {
+		if b.cfg.Multicall3Address == (common.Address{}) {
+			return txRequest, errors.New("no Multicall3 address configured")
+		}
+		txRequest.ToAddress = b.cfg.Multicall3Address
+		calls := make([]multicall3.Multicall3Call3, 0, len(items))
+		for _, item := range items {
+			calls = append(calls, multicall3.Multicall3Call3{Target: item.request.ToAddress, CallData: item.request.EncodedPayload})
+		}
+		txRequest.EncodedPayload, err = multicall3ABI.Pack("aggregate3", calls)
+	}
+	if err != nil {
+		return txRequest, fmt.Errorf("failed to encode batch: %w", err)
+	}
+	return txRequest, nil
+}
+
+func (b *Batcher) simulate(ctx context.Context, txRequest TxRequest) error {
+	_, err := b.client.CallContract(ctx, ethereum.CallMsg{
+		From: txRequest.FromAddress,
+		To:   &txRequest.ToAddress,
+		Gas:  txRequest.FeeLimit,
+		Data: txRequest.EncodedPayload,
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("batch simulation failed: %w", err)
+	}
+	return nil
+}
+
+// mergeBatchMeta combines the metas of batched requests, so that lookups by meta fields still find the batch. The
+// requests of a batch belong to the same job, see batchKey.
+func mergeBatchMeta(metas []*TxMeta) *TxMeta {
+	var merged *TxMeta
+	for _, meta := range metas {
+		if meta == nil {
+			continue
+		}
+		if merged == nil {
+			merged = &TxMeta{JobID: meta.JobID}
+		}
+		if meta.FailOnRevert.Valid && meta.FailOnRevert.Bool {
+			merged.FailOnRevert = meta.FailOnRevert
+		}
+		if meta.RequestID != nil {
+			merged.RequestIDs = append(merged.RequestIDs, *meta.RequestID)
+		}
+		merged.RequestIDs = append(merged.RequestIDs, meta.RequestIDs...)
+		if meta.RequestTxHash != nil {
+			merged.RequestTxHashes = append(merged.RequestTxHashes, *meta.RequestTxHash)
+		}
+		merged.RequestTxHashes = append(merged.RequestTxHashes, meta.RequestTxHashes...)
+		merged.MessageIDs = append(merged.MessageIDs, meta.MessageIDs...)
+		merged.SeqNumbers = append(merged.SeqNumbers, meta.SeqNumbers...)
+	}
+	return merged
+}
+
+// checkSent notifies the callbacks of sent transactions which were mined or have failed.
+func (b *Batcher) checkSent(ctx context.Context) {
+	b.mu.Lock()
+	keys := make([]string, 0, len(b.sent))
+	for key := range b.sent {
+		keys = append(keys, key)
+	}
+	b.mu.Unlock()
+
+	for _, key := range keys {
+		result, done := b.checkStatus(ctx, key)
+		if !done {
+			continue
+		}
+		b.mu.Lock()
+		batch, ok := b.sent[key]
+		delete(b.sent, key)
+		b.mu.Unlock()
+		if !ok {
+			continue
+		}
+		b.notify(ctx, batch.items, result)
+	}
+}
+
+func (b *Batcher) checkStatus(ctx context.Context, key string) (result BatchResult, done bool) {
+	result.IdempotencyKey = key
+	status, err := b.txm.GetTransactionStatus(ctx, key)
+	switch status {
+	case commontypes.Unconfirmed, commontypes.Finalized:
+		if err != nil {
+			b.eng.Warnw("Transaction status returned an error", "idempotencyKey", key, "status", status, "err", err)
+		}
+		receipt, rerr := b.txm.GetTransactionReceipt(ctx, key)
+		if rerr != nil || receipt == nil {
+			b.eng.Debugw("Receipt not available yet", "idempotencyKey", key, "err", rerr)
+			return result, false
+		}
+		result.Reverted = (*receipt).GetStatus() == 0
+		result.GasUsed = (*receipt).GetFeeUsed()
+		return result, true
+	case commontypes.Failed, commontypes.Fatal:
+		result.Err = err
+		if result.Err == nil {
+			result.Err = fmt.Errorf("transaction %s failed", key)
+		}
+		return result, true
+	default:
+		if err != nil {
+			b.eng.Debugw("Failed to get transaction status", "idempotencyKey", key, "err", err)
+		}
+		return result, false
+	}
+}
+
+// notify fans the result of a transaction out to the callbacks of the requests it carried, splitting the gas used
+// in proportion to the fee limit of each request.
+func (b *Batcher) notify(ctx context.Context, items []batchItem, result BatchResult) {
+	result.BatchSize = len(items)
+	gasUsed := result.GasUsed
+	var totalFeeLimit uint64
+	for _, item := range items {
+		totalFeeLimit += item.request.FeeLimit
+	}
+	for _, item := range items {
+		itemResult := result
+		itemResult.Meta = item.request.Meta
+		itemResult.GasUsed = splitGasUsed(gasUsed, item.request.FeeLimit, totalFeeLimit, len(items))
+		item.callback(ctx, itemResult)
+	}
+}
+
+func splitGasUsed(gasUsed, feeLimit, totalFeeLimit uint64, n int) uint64 {
+	if totalFeeLimit == 0 {
+		return gasUsed / uint64(n) //nolint:gosec // n is the non-zero size of a batch
+	}
+	share := new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), new(big.Int).SetUint64(feeLimit))
+	return share.Div(share, new(big.Int).SetUint64(totalFeeLimit)).Uint64()
+}
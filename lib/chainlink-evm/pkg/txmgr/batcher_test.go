@@ -0,0 +1,293 @@
+package txmgr_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-evm/gethwrappers/operatorforwarder/generated/authorized_forwarder"
+	"github.com/smartcontractkit/chainlink-evm/gethwrappers/shared/generated/latest/multicall3"
+	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
+	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+)
+
+type fakeBatchTxManager struct {
+	mu       sync.Mutex
+	requests []txmgr.TxRequest
+	receipts map[string]*evmtypes.Receipt
+}
+
+func (f *fakeBatchTxManager) CreateTransaction(_ context.Context, txRequest txmgr.TxRequest) (txmgr.Tx, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = append(f.requests, txRequest)
+	return txmgr.Tx{IdempotencyKey: txRequest.IdempotencyKey}, nil
+}
+
+func (f *fakeBatchTxManager) GetTransactionStatus(_ context.Context, transactionID string) (commontypes.TransactionStatus, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.receipts[transactionID]; ok {
+		return commontypes.Unconfirmed, nil
+	}
+	return commontypes.Pending, nil
+}
+
+func (f *fakeBatchTxManager) GetTransactionReceipt(_ context.Context, transactionID string) (*txmgr.ChainReceipt, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var receipt txmgr.ChainReceipt = f.receipts[transactionID]
+	return &receipt, nil
+}
+
+func (f *fakeBatchTxManager) sentRequests() []txmgr.TxRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]txmgr.TxRequest{}, f.requests...)
+}
+
+func (f *fakeBatchTxManager) mine(txRequest txmgr.TxRequest, status, gasUsed uint64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.receipts[*txRequest.IdempotencyKey] = &evmtypes.Receipt{Status: status, GasUsed: gasUsed}
+}
+
+type fakeBatchCaller func(msg ethereum.CallMsg) error
+
+func (f fakeBatchCaller) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	return nil, f(msg)
+}
+
+type batchResults struct {
+	mu      sync.Mutex
+	results []txmgr.BatchResult
+}
+
+func (r *batchResults) callback(_ context.Context, result txmgr.BatchResult) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.results = append(r.results, result)
+}
+
+func (r *batchResults) get() []txmgr.BatchResult {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]txmgr.BatchResult{}, r.results...)
+}
+
+func newTestBatcher(t *testing.T, cfg txmgr.BatcherConfig, caller fakeBatchCaller) (*txmgr.Batcher, *fakeBatchTxManager) {
+	txm := &fakeBatchTxManager{receipts: make(map[string]*evmtypes.Receipt)}
+	if cfg.Window == 0 {
+		cfg.Window = time.Hour
+	}
+	cfg.PollInterval = 10 * time.Millisecond
+	b := txmgr.NewBatcher(logger.Test(t), txm, caller, cfg)
+	servicetest.Run(t, b)
+	return b, txm
+}
+
+func TestBatcher(t *testing.T) {
+	t.Parallel()
+
+	fromAddress := testutils.NewAddress()
+	multicall3Address := testutils.NewAddress()
+	succeed := fakeBatchCaller(func(ethereum.CallMsg) error { return nil })
+	jobID := int32(1)
+
+	t.Run("batches compatible requests into a Multicall3 call and fans out the result", func(t *testing.T) {
+		ctx := tests.Context(t)
+		b, txm := newTestBatcher(t, txmgr.BatcherConfig{MaxBatchSize: 2, Multicall3Address: multicall3Address}, succeed)
+		var results batchResults
+		anySender := txmgr.BatchOptions{AnySender: true, Callback: results.callback}
+		requestIDs := []common.Hash{testutils.NewHash(), testutils.NewHash()}
+		targets := []common.Address{testutils.NewAddress(), testutils.NewAddress()}
+		feeLimits := []uint64{100_000, 300_000}
+		for i := range targets {
+			require.NoError(t, b.CreateTransaction(ctx, txmgr.TxRequest{
+				FromAddress:    fromAddress,
+				ToAddress:      targets[i],
+				EncodedPayload: []byte{byte(i)},
+				FeeLimit:       feeLimits[i],
+				Meta:           &txmgr.TxMeta{JobID: &jobID, RequestID: &requestIDs[i]},
+			}, anySender))
+		}
+
+		sent := txm.sentRequests()
+		require.Len(t, sent, 1)
+		batch := sent[0]
+		assert.Equal(t, multicall3Address, batch.ToAddress)
+		assert.Equal(t, fromAddress, batch.FromAddress)
+		assert.Equal(t, uint64(410_000), batch.FeeLimit)
+		require.NotNil(t, batch.Meta)
+		assert.Equal(t, &jobID, batch.Meta.JobID)
+		assert.Equal(t, requestIDs, batch.Meta.RequestIDs)
+
+		multicall3ABI := evmtypes.MustGetABI(multicall3.Multicall3ABI)
+		args, err := multicall3ABI.Methods["aggregate3"].Inputs.Unpack(batch.EncodedPayload[4:])
+		require.NoError(t, err)
+		require.Len(t, args, 1)
+		calls := args[0].([]struct {
+			Target       common.Address `json:"target"`
+			AllowFailure bool           `json:"allowFailure"`
+			CallData     []byte         `json:"callData"`
+		})
+		require.Len(t, calls, 2)
+		for i, call := range calls {
+			assert.Equal(t, targets[i], call.Target)
+			assert.False(t, call.AllowFailure)
+			assert.Equal(t, []byte{byte(i)}, call.CallData)
+		}
+
+		txm.mine(batch, 1, 200_000)
+		require.Eventually(t, func() bool { return len(results.get()) == 2 }, tests.WaitTimeout(t), 10*time.Millisecond)
+		for _, result := range results.get() {
+			require.NoError(t, result.Err)
+			assert.False(t, result.Reverted)
+			assert.Equal(t, 2, result.BatchSize)
+			assert.Equal(t, *batch.IdempotencyKey, result.IdempotencyKey)
+			switch *result.Meta.RequestID {
+			case requestIDs[0]:
+				assert.Equal(t, uint64(50_000), result.GasUsed)
+			case requestIDs[1]:
+				assert.Equal(t, uint64(150_000), result.GasUsed)
+			default:
+				t.Fatalf("unexpected result %v", result)
+			}
+		}
+	})
+
+	t.Run("batches forwarded requests with multiForward", func(t *testing.T) {
+		ctx := tests.Context(t)
+		b, txm := newTestBatcher(t, txmgr.BatcherConfig{MaxBatchSize: 2}, succeed)
+		var results batchResults
+		forwarderAddress := testutils.NewAddress()
+		targets := []common.Address{testutils.NewAddress(), testutils.NewAddress()}
+		for i := range targets {
+			require.NoError(t, b.CreateTransaction(ctx, txmgr.TxRequest{
+				FromAddress:      fromAddress,
+				ToAddress:        targets[i],
+				EncodedPayload:   []byte{byte(i)},
+				ForwarderAddress: forwarderAddress,
+			}, txmgr.BatchOptions{Callback: results.callback}))
+		}
+
+		sent := txm.sentRequests()
+		require.Len(t, sent, 1)
+		assert.Equal(t, forwarderAddress, sent[0].ToAddress)
+		assert.Equal(t, common.Address{}, sent[0].ForwarderAddress)
+		forwarderABI := evmtypes.MustGetABI(authorized_forwarder.AuthorizedForwarderABI)
+		args, err := forwarderABI.Methods["multiForward"].Inputs.Unpack(sent[0].EncodedPayload[4:])
+		require.NoError(t, err)
+		assert.Equal(t, targets, args[0])
+		assert.Equal(t, [][]byte{{0}, {1}}, args[1])
+
+		txm.mine(sent[0], 0, 100)
+		require.Eventually(t, func() bool { return len(results.get()) == 2 }, tests.WaitTimeout(t), 10*time.Millisecond)
+		for _, result := range results.get() {
+			assert.True(t, result.Reverted)
+			assert.Equal(t, uint64(50), result.GasUsed)
+		}
+	})
+
+	t.Run("sends requests one by one if the batch simulation fails", func(t *testing.T) {
+		ctx := tests.Context(t)
+		b, txm := newTestBatcher(t, txmgr.BatcherConfig{MaxBatchSize: 2, Multicall3Address: multicall3Address}, func(msg ethereum.CallMsg) error {
+			return errors.New("execution reverted")
+		})
+		var results batchResults
+		anySender := txmgr.BatchOptions{AnySender: true, Callback: results.callback}
+		targets := []common.Address{testutils.NewAddress(), testutils.NewAddress()}
+		for i := range targets {
+			require.NoError(t, b.CreateTransaction(ctx, txmgr.TxRequest{FromAddress: fromAddress, ToAddress: targets[i]}, anySender))
+		}
+
+		sent := txm.sentRequests()
+		require.Len(t, sent, 2)
+		assert.Equal(t, targets[0], sent[0].ToAddress)
+		assert.Equal(t, targets[1], sent[1].ToAddress)
+		assert.NotEqual(t, *sent[0].IdempotencyKey, *sent[1].IdempotencyKey)
+
+		txm.mine(sent[0], 1, 21_000)
+		require.Eventually(t, func() bool { return len(results.get()) == 1 }, tests.WaitTimeout(t), 10*time.Millisecond)
+		assert.Equal(t, 1, results.get()[0].BatchSize)
+		assert.Equal(t, uint64(21_000), results.get()[0].GasUsed)
+	})
+
+	t.Run("sends requests that cannot be batched straight away", func(t *testing.T) {
+		ctx := tests.Context(t)
+		b, txm := newTestBatcher(t, txmgr.BatcherConfig{MaxBatchSize: 2, Multicall3Address: multicall3Address}, succeed)
+		var results batchResults
+		anySender := txmgr.BatchOptions{AnySender: true, Callback: results.callback}
+		idempotencyKey := "test-key"
+		require.NoError(t, b.CreateTransaction(ctx, txmgr.TxRequest{FromAddress: fromAddress, IdempotencyKey: &idempotencyKey}, anySender))
+		require.NoError(t, b.CreateTransaction(ctx, txmgr.TxRequest{FromAddress: fromAddress, Value: *big.NewInt(1)}, anySender))
+
+		sent := txm.sentRequests()
+		require.Len(t, sent, 2)
+		assert.Equal(t, idempotencyKey, *sent[0].IdempotencyKey)
+	})
+
+	t.Run("sends requests that are not forwarded on their own unless the target accepts any sender", func(t *testing.T) {
+		ctx := tests.Context(t)
+		b, txm := newTestBatcher(t, txmgr.BatcherConfig{MaxBatchSize: 2, Multicall3Address: multicall3Address}, succeed)
+		request := txmgr.TxRequest{FromAddress: fromAddress, ToAddress: testutils.NewAddress()}
+		require.NoError(t, b.CreateTransaction(ctx, request, txmgr.BatchOptions{}))
+		require.NoError(t, b.CreateTransaction(ctx, request, txmgr.BatchOptions{}))
+		sent := txm.sentRequests()
+		require.Len(t, sent, 2)
+		assert.Equal(t, request.ToAddress, sent[0].ToAddress)
+		assert.Equal(t, request.ToAddress, sent[1].ToAddress)
+
+		forwarded := txmgr.TxRequest{FromAddress: fromAddress, ToAddress: testutils.NewAddress(), ForwarderAddress: testutils.NewAddress()}
+		require.NoError(t, b.CreateTransaction(ctx, forwarded, txmgr.BatchOptions{}))
+		require.NoError(t, b.CreateTransaction(ctx, forwarded, txmgr.BatchOptions{}))
+		sent = txm.sentRequests()
+		require.Len(t, sent, 3)
+		assert.Equal(t, forwarded.ForwarderAddress, sent[2].ToAddress)
+	})
+
+	t.Run("does not batch requests of different jobs", func(t *testing.T) {
+		ctx := tests.Context(t)
+		b, txm := newTestBatcher(t, txmgr.BatcherConfig{MaxBatchSize: 2, Multicall3Address: multicall3Address}, succeed)
+		anySender := txmgr.BatchOptions{AnySender: true}
+		otherJobID := int32(2)
+		for _, id := range []*int32{&jobID, &otherJobID, nil} {
+			require.NoError(t, b.CreateTransaction(ctx, txmgr.TxRequest{FromAddress: fromAddress, ToAddress: testutils.NewAddress(), Meta: &txmgr.TxMeta{JobID: id}}, anySender))
+		}
+		assert.Empty(t, txm.sentRequests())
+
+		require.NoError(t, b.CreateTransaction(ctx, txmgr.TxRequest{FromAddress: fromAddress, ToAddress: testutils.NewAddress(), Meta: &txmgr.TxMeta{JobID: &otherJobID}}, anySender))
+		sent := txm.sentRequests()
+		require.Len(t, sent, 1)
+		require.NotNil(t, sent[0].Meta)
+		assert.Equal(t, &otherJobID, sent[0].Meta.JobID)
+	})
+
+	t.Run("sends a batch once the window has passed", func(t *testing.T) {
+		ctx := tests.Context(t)
+		b, txm := newTestBatcher(t, txmgr.BatcherConfig{Window: 50 * time.Millisecond, MaxBatchSize: 10, Multicall3Address: multicall3Address}, succeed)
+		var results batchResults
+		anySender := txmgr.BatchOptions{AnySender: true, Callback: results.callback}
+		for range 3 {
+			require.NoError(t, b.CreateTransaction(ctx, txmgr.TxRequest{FromAddress: fromAddress, ToAddress: testutils.NewAddress()}, anySender))
+		}
+		assert.Empty(t, txm.sentRequests())
+
+		require.Eventually(t, func() bool { return len(txm.sentRequests()) == 1 }, tests.WaitTimeout(t), 10*time.Millisecond)
+		assert.Equal(t, multicall3Address, txm.sentRequests()[0].ToAddress)
+	})
+}
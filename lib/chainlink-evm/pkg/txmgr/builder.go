@@ -24,6 +24,7 @@ import (
 	"github.com/smartcontractkit/chainlink-evm/pkg/txm"
 	"github.com/smartcontractkit/chainlink-evm/pkg/txm/clientwrappers"
 	"github.com/smartcontractkit/chainlink-evm/pkg/txm/storage"
+	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr/lifecycle"
 	"github.com/smartcontractkit/chainlink-evm/pkg/types"
 )
 
@@ -47,6 +48,7 @@ func NewTxm(
 	estimator gas.EvmFeeEstimator,
 	headTracker latestAndFinalizedBlockHeadTracker,
 	txmv2wrapper TxManager,
+	lifecycleEmitter *lifecycle.Emitter,
 ) (txm TxManager,
 	err error,
 ) {
@@ -60,11 +62,14 @@ func NewTxm(
 	checker := &CheckerFactory{Client: client}
 	// create tx attempt builder
 	txAttemptBuilder := NewEvmTxAttemptBuilder(*client.ConfiguredChainID(), fCfg, keyStore, estimator)
-	txStore := NewTxStore(ds, lggr)
 	txmCfg := NewEvmTxmConfig(chainConfig)             // wrap Evm specific config
 	feeCfg := NewEvmTxmFeeConfig(fCfg)                 // wrap Evm specific config
 	txmClient := NewEvmTxmClient(client, clientErrors) // wrap Evm specific client
 	chainID := txmClient.ConfiguredChainID()
+	var txStore EvmTxStore = NewTxStore(ds, lggr)
+	if lifecycleEmitter != nil {
+		txStore = NewLifecycleTxStore(txStore, lggr, lifecycleEmitter, chainID)
+	}
 	metrics, err := NewEVMTxmMetrics(chainID.String())
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize EVM TXM metrics: %w", err)
@@ -114,6 +119,7 @@ func NewTxmV2(
 	logPoller logpoller.LogPoller,
 	keyStore keys.ChainStore,
 	estimator gas.EvmFeeEstimator,
+	lifecycleEmitter *lifecycle.Emitter,
 ) (TxManager, error) {
 	var fwdMgr *forwarders.FwdMgr
 	if txConfig.ForwardersEnabled() {
@@ -135,7 +141,15 @@ func NewTxmV2(
 	}
 
 	attemptBuilder := txm.NewAttemptBuilder(fCfg.PriceMaxKey, estimator, keyStore)
-	inMemoryStoreManager := storage.NewInMemoryStoreManager(lggr, chainID)
+	var txStore interface {
+		txm.TxStore
+		txm.OrchestratorTxStore
+	}
+	if txmV2Config.Persistent() {
+		txStore = storage.NewPostgresStoreManager(lggr, ds, chainID)
+	} else {
+		txStore = storage.NewInMemoryStoreManager(lggr, chainID)
+	}
 	config := txm.Config{
 		EIP1559:   fCfg.EIP1559DynamicFees(),
 		BlockTime: *txmV2Config.BlockTime(),
@@ -149,8 +163,8 @@ func NewTxmV2(
 	} else {
 		c = clientwrappers.NewChainClient(client)
 	}
-	t := txm.NewTxm(lggr, chainID, c, attemptBuilder, inMemoryStoreManager, stuckTxDetector, config, keyStore)
-	return txm.NewTxmOrchestrator(lggr, chainID, t, inMemoryStoreManager, fwdMgr, keyStore, attemptBuilder), nil
+	t := txm.NewTxm(lggr, chainID, c, attemptBuilder, txStore, stuckTxDetector, config, keyStore, lifecycleEmitter)
+	return txm.NewTxmOrchestrator(lggr, chainID, t, txStore, fwdMgr, keyStore, attemptBuilder), nil
 }
 
 // NewEvmResender creates a new concrete EvmResender
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -1722,6 +1723,28 @@ func TestORM_CreateTransaction(t *testing.T) {
 		assert.Equal(t, fromAddress, dbEthTx.FromAddress)
 		assert.True(t, dbEthTx.SignalCallback)
 	})
+
+	t.Run("stores the authorization list", func(t *testing.T) {
+		authorizationList := []gethtypes.SetCodeAuthorization{{Address: testutils.NewAddress(), Nonce: 1}}
+		authorizationListJSON, err := txmgr.NewAuthorizationList(authorizationList)
+		require.NoError(t, err)
+		etx, err := txStore.CreateTransaction(tests.Context(t), txmgr.TxRequest{
+			FromAddress:       fromAddress,
+			ToAddress:         toAddress,
+			EncodedPayload:    payload,
+			FeeLimit:          gasLimit,
+			Strategy:          txmgrcommon.NewSendEveryStrategy(),
+			AuthorizationList: authorizationListJSON,
+		}, ethClient.ConfiguredChainID())
+		require.NoError(t, err)
+
+		etx, err = txStore.FindTxWithAttempts(tests.Context(t), etx.ID)
+		require.NoError(t, err)
+		stored, err := txmgr.GetAuthorizationList(etx)
+		require.NoError(t, err)
+		assert.Equal(t, authorizationList, stored)
+		assert.Nil(t, etx.Meta)
+	})
 }
 
 func TestORM_PruneUnstartedTxQueue(t *testing.T) {
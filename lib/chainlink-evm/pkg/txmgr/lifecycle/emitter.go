@@ -0,0 +1,141 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+)
+
+const (
+	// sinkQueueSize bounds the number of events waiting to be delivered to each sink. Events are dropped once a sink
+	// falls this far behind, so a slow webhook can never block the transaction managers.
+	sinkQueueSize = 1000
+	// subscriptionBufferSize bounds the number of events waiting to be read by each subscriber.
+	subscriptionBufferSize = 100
+)
+
+// Emitter fans transaction lifecycle events out to the configured sinks and to the subscribers of the node's web API.
+// A nil *Emitter is valid and drops every event.
+type Emitter struct {
+	services.Service
+	eng *services.Engine
+
+	chainID string
+	sinks   []Sink
+	queues  []chan Event
+
+	subsMu sync.RWMutex
+	subs   map[chan Event]struct{}
+}
+
+func NewEmitter(lggr logger.Logger, chainID *big.Int, sinks ...Sink) *Emitter {
+	e := &Emitter{
+		chainID: chainID.String(),
+		sinks:   sinks,
+		queues:  make([]chan Event, len(sinks)),
+		subs:    make(map[chan Event]struct{}),
+	}
+	for i := range sinks {
+		e.queues[i] = make(chan Event, sinkQueueSize)
+	}
+	e.Service, e.eng = services.Config{
+		Name:  "TxLifecycleEmitter",
+		Start: e.start,
+		Close: e.close,
+	}.NewServiceEngine(lggr)
+	return e
+}
+
+func (e *Emitter) start(context.Context) error {
+	for i := range e.sinks {
+		sink, queue := e.sinks[i], e.queues[i]
+		e.eng.Go(func(ctx context.Context) {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case event := <-queue:
+					if err := sink.Send(ctx, event); err != nil && ctx.Err() == nil {
+						e.eng.Errorw("Failed to deliver transaction lifecycle event", "sink", sink.Name(), "txID", event.TxID, "type", event.Type, "err", err)
+					}
+				}
+			}
+		})
+	}
+	return nil
+}
+
+func (e *Emitter) close() error {
+	e.subsMu.Lock()
+	for sub := range e.subs {
+		delete(e.subs, sub)
+		close(sub)
+	}
+	e.subsMu.Unlock()
+
+	var errs []error
+	for _, sink := range e.sinks {
+		errs = append(errs, sink.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// Emit queues the event for every sink and subscriber without blocking. Events are dropped for any sink or
+// subscriber which is too far behind.
+func (e *Emitter) Emit(event Event) {
+	if e == nil {
+		return
+	}
+	event.ChainID = e.chainID
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now().UTC()
+	}
+	for i, queue := range e.queues {
+		select {
+		case queue <- event:
+		default:
+			e.eng.Warnw("Dropping transaction lifecycle event, sink is too slow", "sink", e.sinks[i].Name(), "txID", event.TxID, "type", event.Type)
+		}
+	}
+
+	e.subsMu.RLock()
+	defer e.subsMu.RUnlock()
+	for sub := range e.subs {
+		select {
+		case sub <- event:
+		default:
+			e.eng.Warnw("Dropping transaction lifecycle event, subscriber is too slow", "txID", event.TxID, "type", event.Type)
+		}
+	}
+}
+
+// Subscribe returns a channel receiving every event emitted from now on, and a func to unsubscribe. The channel is
+// closed when unsubscribing or when the emitter is closed.
+func (e *Emitter) Subscribe() (<-chan Event, func()) {
+	sub := make(chan Event, subscriptionBufferSize)
+	if err := e.eng.IfNotStopped(func() error {
+		e.subsMu.Lock()
+		defer e.subsMu.Unlock()
+		e.subs[sub] = struct{}{}
+		return nil
+	}); err != nil {
+		close(sub)
+		return sub, func() {}
+	}
+	var once sync.Once
+	return sub, func() {
+		once.Do(func() {
+			e.subsMu.Lock()
+			defer e.subsMu.Unlock()
+			if _, ok := e.subs[sub]; ok {
+				delete(e.subs, sub)
+				close(sub)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package lifecycle
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
+)
+
+type recordingSink struct {
+	mu     sync.Mutex
+	events []Event
+	closed bool
+}
+
+func (r *recordingSink) Name() string { return "recording" }
+
+func (r *recordingSink) Send(_ context.Context, event Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+	return nil
+}
+
+func (r *recordingSink) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.closed = true
+	return nil
+}
+
+func (r *recordingSink) get() []Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Event{}, r.events...)
+}
+
+func TestEmitter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("delivers events to sinks and subscribers", func(t *testing.T) {
+		sink := &recordingSink{}
+		e := NewEmitter(logger.Test(t), testutils.FixtureChainID, sink)
+		servicetest.Run(t, e)
+		sub, unsubscribe := e.Subscribe()
+		defer unsubscribe()
+
+		e.Emit(Event{Type: EventBroadcast, TxID: 1})
+		e.Emit(Event{Type: EventConfirmed, TxID: 1})
+
+		require.Eventually(t, func() bool { return len(sink.get()) == 2 }, tests.WaitTimeout(t), 10*time.Millisecond)
+		events := sink.get()
+		assert.Equal(t, EventBroadcast, events[0].Type)
+		assert.Equal(t, EventConfirmed, events[1].Type)
+		assert.Equal(t, testutils.FixtureChainID.String(), events[0].ChainID)
+		assert.False(t, events[0].Timestamp.IsZero())
+
+		for _, expected := range []EventType{EventBroadcast, EventConfirmed} {
+			select {
+			case event := <-sub:
+				assert.Equal(t, expected, event.Type)
+			case <-time.After(tests.WaitTimeout(t)):
+				t.Fatal("timed out waiting for event")
+			}
+		}
+	})
+
+	t.Run("closes subscriptions and sinks on close", func(t *testing.T) {
+		sink := &recordingSink{}
+		e := NewEmitter(logger.Test(t), testutils.FixtureChainID, sink)
+		require.NoError(t, e.Start(tests.Context(t)))
+		sub, unsubscribe := e.Subscribe()
+		require.NoError(t, e.Close())
+
+		_, ok := <-sub
+		assert.False(t, ok)
+		assert.True(t, sink.closed)
+		unsubscribe()
+
+		sub, _ = e.Subscribe()
+		_, ok = <-sub
+		assert.False(t, ok)
+	})
+
+	t.Run("nil emitter drops events", func(t *testing.T) {
+		var e *Emitter
+		assert.NotPanics(t, func() { e.Emit(Event{Type: EventFatal}) })
+	})
+}
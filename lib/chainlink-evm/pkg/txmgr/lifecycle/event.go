@@ -0,0 +1,53 @@
+// Package lifecycle emits events as transactions move through the transaction managers, and delivers them to sinks
+// and subscribers outside the node.
+package lifecycle
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type EventType string
+
+const (
+	// EventBroadcast is emitted when the first attempt of a transaction is sent to the chain.
+	EventBroadcast EventType = "broadcast"
+	// EventBumped is emitted when a replacement attempt is sent for a transaction which was already broadcast.
+	EventBumped EventType = "bumped"
+	// EventConfirmed is emitted when a transaction is included on chain. If a receipt is available it was successful.
+	EventConfirmed EventType = "confirmed"
+	// EventReverted is emitted when a transaction is included on chain with a failed receipt.
+	EventReverted EventType = "reverted"
+	// EventFinalized is emitted when the block including a transaction is finalized.
+	EventFinalized EventType = "finalized"
+	// EventFatal is emitted when a transaction is marked fatal and won't be sent again.
+	EventFatal EventType = "fatal"
+)
+
+// Event describes a transaction state change. Events are delivered at least once, so consumers should be idempotent
+// on (TxID, Type).
+type Event struct {
+	Type           EventType      `json:"type"`
+	ChainID        string         `json:"chainID"`
+	TxID           int64          `json:"txID"`
+	IdempotencyKey *string        `json:"idempotencyKey,omitempty"`
+	JobID          *int32         `json:"jobID,omitempty"`
+	FromAddress    common.Address `json:"fromAddress"`
+	ToAddress      common.Address `json:"toAddress"`
+	Nonce          *uint64        `json:"nonce,omitempty"`
+	TxHashes       []common.Hash  `json:"txHashes,omitempty"`
+	ReceiptStatus  *uint64        `json:"receiptStatus,omitempty"`
+	BlockNumber    *int64         `json:"blockNumber,omitempty"`
+	Error          string         `json:"error,omitempty"`
+	Timestamp      time.Time      `json:"timestamp"`
+}
+
+// Sink delivers events to a destination outside the node. Send is called from a single goroutine per sink, in the
+// order the events were emitted.
+type Sink interface {
+	Name() string
+	Send(ctx context.Context, event Event) error
+	Close() error
+}
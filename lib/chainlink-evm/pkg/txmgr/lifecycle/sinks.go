@@ -0,0 +1,144 @@
+package lifecycle
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/jpillora/backoff"
+)
+
+const (
+	// SignatureHeader carries the hex encoded HMAC-SHA256 of the request body, keyed with the webhook secret.
+	SignatureHeader = "X-Chainlink-Signature"
+
+	webhookTimeout     = 10 * time.Second
+	webhookMaxAttempts = 5
+)
+
+var _ Sink = (*WebhookSink)(nil)
+
+// WebhookSink POSTs every event as JSON to a URL, retrying with backoff on network errors, 429s and 5xx responses.
+type WebhookSink struct {
+	url    string
+	secret []byte
+	client *http.Client
+	// minBackoff is the delay before the first retry, doubling up to maxBackoff.
+	minBackoff, maxBackoff time.Duration
+}
+
+func NewWebhookSink(u *url.URL, secret string) *WebhookSink {
+	return &WebhookSink{
+		url:        u.String(),
+		secret:     []byte(secret),
+		client:     &http.Client{Timeout: webhookTimeout},
+		minBackoff: time.Second,
+		maxBackoff: 30 * time.Second,
+	}
+}
+
+func (w *WebhookSink) Name() string { return "webhook" }
+
+func (w *WebhookSink) Send(ctx context.Context, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+	b := backoff.Backoff{Min: w.minBackoff, Max: w.maxBackoff, Factor: 2}
+	for attempt := 1; ; attempt++ {
+		retry, err := w.post(ctx, body)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt == webhookMaxAttempts {
+			return fmt.Errorf("webhook delivery failed after %d attempt(s): %w", attempt, err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(b.Duration()):
+		}
+	}
+}
+
+func (w *WebhookSink) post(ctx context.Context, body []byte) (retry bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if len(w.secret) > 0 {
+		req.Header.Set(SignatureHeader, Sign(w.secret, body))
+	}
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return true, fmt.Errorf("unexpected status %s", resp.Status)
+	default:
+		return false, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+}
+
+func (w *WebhookSink) Close() error {
+	w.client.CloseIdleConnections()
+	return nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of body keyed with secret, as sent in the SignatureHeader.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+var _ Sink = (*FileSink)(nil)
+
+// FileSink appends every event to a file as a line of JSON.
+type FileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func NewFileSink(path string) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open transaction lifecycle event file: %w", err)
+	}
+	return &FileSink{file: f}, nil
+}
+
+func (f *FileSink) Name() string { return "file" }
+
+func (f *FileSink) Send(_ context.Context, event Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	_, err = f.file.Write(append(line, '\n'))
+	return err
+}
+
+func (f *FileSink) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.file.Close()
+}
@@ -0,0 +1,112 @@
+package lifecycle
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+)
+
+func newTestWebhookSink(t *testing.T, handler http.HandlerFunc, secret string) *WebhookSink {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	w := NewWebhookSink(u, secret)
+	w.minBackoff, w.maxBackoff = time.Millisecond, time.Millisecond
+	return w
+}
+
+func TestWebhookSink(t *testing.T) {
+	t.Parallel()
+
+	event := Event{Type: EventReverted, ChainID: "1", TxID: 42}
+
+	t.Run("signs the body", func(t *testing.T) {
+		var received atomic.Pointer[Event]
+		w := newTestWebhookSink(t, func(rw http.ResponseWriter, req *http.Request) {
+			body, err := io.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+			assert.Equal(t, Sign([]byte("secret"), body), req.Header.Get(SignatureHeader))
+			var e Event
+			assert.NoError(t, json.Unmarshal(body, &e))
+			received.Store(&e)
+		}, "secret")
+
+		require.NoError(t, w.Send(tests.Context(t), event))
+		require.NotNil(t, received.Load())
+		assert.Equal(t, event.TxID, received.Load().TxID)
+		assert.Equal(t, event.Type, received.Load().Type)
+	})
+
+	t.Run("retries server errors", func(t *testing.T) {
+		var calls atomic.Int32
+		w := newTestWebhookSink(t, func(rw http.ResponseWriter, req *http.Request) {
+			if calls.Add(1) < 3 {
+				rw.WriteHeader(http.StatusServiceUnavailable)
+			}
+		}, "")
+
+		require.NoError(t, w.Send(tests.Context(t), event))
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		var calls atomic.Int32
+		w := newTestWebhookSink(t, func(rw http.ResponseWriter, req *http.Request) {
+			calls.Add(1)
+			rw.WriteHeader(http.StatusInternalServerError)
+		}, "")
+
+		require.ErrorContains(t, w.Send(tests.Context(t), event), "after 5 attempt(s)")
+		assert.Equal(t, int32(webhookMaxAttempts), calls.Load())
+	})
+
+	t.Run("does not retry client errors", func(t *testing.T) {
+		var calls atomic.Int32
+		w := newTestWebhookSink(t, func(rw http.ResponseWriter, req *http.Request) {
+			calls.Add(1)
+			rw.WriteHeader(http.StatusUnauthorized)
+		}, "")
+
+		require.ErrorContains(t, w.Send(tests.Context(t), event), "401")
+		assert.Equal(t, int32(1), calls.Load())
+	})
+}
+
+func TestFileSink(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	f, err := NewFileSink(path)
+	require.NoError(t, err)
+	require.NoError(t, f.Send(tests.Context(t), Event{Type: EventBroadcast, TxID: 1}))
+	require.NoError(t, f.Send(tests.Context(t), Event{Type: EventFinalized, TxID: 1}))
+	require.NoError(t, f.Close())
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	var types []EventType
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var e Event
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &e))
+		types = append(types, e.Type)
+	}
+	require.NoError(t, scanner.Err())
+	assert.Equal(t, []EventType{EventBroadcast, EventFinalized}, types)
+}
@@ -0,0 +1,144 @@
+package txmgr
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	txmgrtypes "github.com/smartcontractkit/chainlink-framework/chains/txmgr/types"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr/lifecycle"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+)
+
+var _ EvmTxStore = (*lifecycleTxStore)(nil)
+
+// lifecycleTxStore emits a lifecycle event after every successful state change of a transaction. The broadcaster,
+// confirmer and finalizer all persist their progress through the TxStore, which makes it the one place to observe it.
+type lifecycleTxStore struct {
+	EvmTxStore
+	lggr    logger.SugaredLogger
+	emitter *lifecycle.Emitter
+	chainID *big.Int
+}
+
+// NewLifecycleTxStore wraps txStore to emit lifecycle events to emitter.
+func NewLifecycleTxStore(txStore EvmTxStore, lggr logger.Logger, emitter *lifecycle.Emitter, chainID *big.Int) EvmTxStore {
+	return &lifecycleTxStore{
+		EvmTxStore: txStore,
+		lggr:       logger.Sugared(logger.Named(lggr, "LifecycleTxStore")),
+		emitter:    emitter,
+		chainID:    chainID,
+	}
+}
+
+func (s *lifecycleTxStore) UpdateTxAttemptInProgressToBroadcast(ctx context.Context, etx *Tx, attempt TxAttempt, newAttemptState txmgrtypes.TxAttemptState) error {
+	if err := s.EvmTxStore.UpdateTxAttemptInProgressToBroadcast(ctx, etx, attempt, newAttemptState); err != nil {
+		return err
+	}
+	if newAttemptState == txmgrtypes.TxAttemptBroadcast {
+		s.emit(lifecycle.EventBroadcast, etx, attempt.Hash)
+	}
+	return nil
+}
+
+func (s *lifecycleTxStore) SaveSentAttempt(ctx context.Context, timeout time.Duration, attempt *TxAttempt, broadcastAt time.Time) error {
+	if err := s.EvmTxStore.SaveSentAttempt(ctx, timeout, attempt, broadcastAt); err != nil {
+		return err
+	}
+	s.emit(lifecycle.EventBumped, &attempt.Tx, attempt.Hash)
+	return nil
+}
+
+func (s *lifecycleTxStore) SaveFetchedReceipts(ctx context.Context, r []*evmtypes.Receipt) error {
+	if err := s.EvmTxStore.SaveFetchedReceipts(ctx, r); err != nil {
+		return err
+	}
+	for _, receipt := range r {
+		attempt, err := s.FindTxAttempt(ctx, receipt.TxHash)
+		if err != nil {
+			s.lggr.Errorw("Failed to find transaction for lifecycle event", "txHash", receipt.TxHash, "err", err)
+			continue
+		}
+		event := s.newEvent(lifecycle.EventConfirmed, &attempt.Tx, receipt.TxHash)
+		if receipt.Status == 0 {
+			event.Type = lifecycle.EventReverted
+		}
+		status := receipt.Status
+		event.ReceiptStatus = &status
+		if receipt.BlockNumber != nil {
+			blockNumber := receipt.BlockNumber.Int64()
+			event.BlockNumber = &blockNumber
+		}
+		s.emitter.Emit(event)
+	}
+	return nil
+}
+
+func (s *lifecycleTxStore) UpdateTxStatesToFinalizedUsingTxHashes(ctx context.Context, txHashes []common.Hash, chainID *big.Int) error {
+	if err := s.EvmTxStore.UpdateTxStatesToFinalizedUsingTxHashes(ctx, txHashes, chainID); err != nil {
+		return err
+	}
+	for _, hash := range txHashes {
+		etx, err := s.FindTxByHash(ctx, hash)
+		if err != nil {
+			s.lggr.Errorw("Failed to find transaction for lifecycle event", "txHash", hash, "err", err)
+			continue
+		}
+		s.emit(lifecycle.EventFinalized, etx, hash)
+	}
+	return nil
+}
+
+func (s *lifecycleTxStore) UpdateTxFatalError(ctx context.Context, etxIDs []int64, errMsg string) error {
+	if err := s.EvmTxStore.UpdateTxFatalError(ctx, etxIDs, errMsg); err != nil {
+		return err
+	}
+	if len(etxIDs) == 0 {
+		return nil
+	}
+	etxs, err := s.FindTxesByIDs(ctx, etxIDs, s.chainID)
+	if err != nil {
+		s.lggr.Errorw("Failed to find transactions for lifecycle events", "txIDs", etxIDs, "err", err)
+		return nil
+	}
+	for _, etx := range etxs {
+		s.emit(lifecycle.EventFatal, etx)
+	}
+	return nil
+}
+
+func (s *lifecycleTxStore) UpdateTxFatalErrorAndDeleteAttempts(ctx context.Context, etx *Tx) error {
+	if err := s.EvmTxStore.UpdateTxFatalErrorAndDeleteAttempts(ctx, etx); err != nil {
+		return err
+	}
+	s.emit(lifecycle.EventFatal, etx)
+	return nil
+}
+
+func (s *lifecycleTxStore) emit(eventType lifecycle.EventType, etx *Tx, hashes ...common.Hash) {
+	s.emitter.Emit(s.newEvent(eventType, etx, hashes...))
+}
+
+func (s *lifecycleTxStore) newEvent(eventType lifecycle.EventType, etx *Tx, hashes ...common.Hash) lifecycle.Event {
+	event := lifecycle.Event{
+		Type:           eventType,
+		TxID:           etx.ID,
+		IdempotencyKey: etx.IdempotencyKey,
+		FromAddress:    etx.FromAddress,
+		ToAddress:      etx.ToAddress,
+		TxHashes:       hashes,
+		Error:          etx.Error.String,
+	}
+	if etx.Sequence != nil {
+		nonce := uint64(*etx.Sequence) //nolint:gosec // nonces are never negative
+		event.Nonce = &nonce
+	}
+	if meta, err := etx.GetMeta(); err == nil && meta != nil {
+		event.JobID = meta.JobID
+	}
+	return event
+}
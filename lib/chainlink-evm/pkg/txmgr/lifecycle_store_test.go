@@ -0,0 +1,127 @@
+package txmgr_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+	txmgrtypes "github.com/smartcontractkit/chainlink-framework/chains/txmgr/types"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/testutils"
+	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr"
+	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr/lifecycle"
+	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+)
+
+func newLifecycleTestTx(t *testing.T) txmgr.Tx {
+	jobID := int32(7)
+	idempotencyKey := "key"
+	nonce := evmtypes.Nonce(3)
+	meta, err := json.Marshal(txmgr.TxMeta{JobID: &jobID})
+	require.NoError(t, err)
+	return txmgr.Tx{
+		ID:             11,
+		IdempotencyKey: &idempotencyKey,
+		Sequence:       &nonce,
+		FromAddress:    testutils.NewAddress(),
+		ToAddress:      testutils.NewAddress(),
+		Meta:           (*sqlutil.JSON)(&meta),
+	}
+}
+
+func receiveLifecycleEvent(t *testing.T, sub <-chan lifecycle.Event) lifecycle.Event {
+	select {
+	case event := <-sub:
+		return event
+	case <-time.After(tests.WaitTimeout(t)):
+		t.Fatal("timed out waiting for lifecycle event")
+	}
+	return lifecycle.Event{}
+}
+
+func TestLifecycleTxStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	emitter := lifecycle.NewEmitter(logger.Test(t), testutils.FixtureChainID)
+	servicetest.Run(t, emitter)
+	sub, unsubscribe := emitter.Subscribe()
+	t.Cleanup(unsubscribe)
+
+	inner := mocks.NewEvmTxStore(t)
+	txStore := txmgr.NewLifecycleTxStore(inner, logger.Test(t), emitter, testutils.FixtureChainID)
+	etx := newLifecycleTestTx(t)
+	hash := testutils.NewHash()
+
+	t.Run("emits broadcast", func(t *testing.T) {
+		attempt := txmgr.TxAttempt{Hash: hash}
+		inner.On("UpdateTxAttemptInProgressToBroadcast", mock.Anything, &etx, attempt, txmgrtypes.TxAttemptBroadcast).Return(nil).Once()
+		require.NoError(t, txStore.UpdateTxAttemptInProgressToBroadcast(ctx, &etx, attempt, txmgrtypes.TxAttemptBroadcast))
+
+		event := receiveLifecycleEvent(t, sub)
+		assert.Equal(t, lifecycle.EventBroadcast, event.Type)
+		assert.Equal(t, etx.ID, event.TxID)
+		assert.Equal(t, etx.IdempotencyKey, event.IdempotencyKey)
+		assert.Equal(t, int32(7), *event.JobID)
+		assert.Equal(t, uint64(3), *event.Nonce)
+		assert.Equal(t, []common.Hash{hash}, event.TxHashes)
+		assert.Equal(t, testutils.FixtureChainID.String(), event.ChainID)
+	})
+
+	t.Run("emits reverted with receipt status", func(t *testing.T) {
+		receipt := &evmtypes.Receipt{TxHash: hash, Status: 0, BlockNumber: big.NewInt(42)}
+		inner.On("SaveFetchedReceipts", mock.Anything, []*evmtypes.Receipt{receipt}).Return(nil).Once()
+		inner.On("FindTxAttempt", mock.Anything, hash).Return(&txmgr.TxAttempt{Hash: hash, Tx: etx}, nil).Once()
+		require.NoError(t, txStore.SaveFetchedReceipts(ctx, []*evmtypes.Receipt{receipt}))
+
+		event := receiveLifecycleEvent(t, sub)
+		assert.Equal(t, lifecycle.EventReverted, event.Type)
+		assert.Equal(t, uint64(0), *event.ReceiptStatus)
+		assert.Equal(t, int64(42), *event.BlockNumber)
+	})
+
+	t.Run("emits finalized", func(t *testing.T) {
+		inner.On("UpdateTxStatesToFinalizedUsingTxHashes", mock.Anything, []common.Hash{hash}, testutils.FixtureChainID).Return(nil).Once()
+		inner.On("FindTxByHash", mock.Anything, hash).Return(&etx, nil).Once()
+		require.NoError(t, txStore.UpdateTxStatesToFinalizedUsingTxHashes(ctx, []common.Hash{hash}, testutils.FixtureChainID))
+
+		event := receiveLifecycleEvent(t, sub)
+		assert.Equal(t, lifecycle.EventFinalized, event.Type)
+		assert.Equal(t, etx.ID, event.TxID)
+	})
+
+	t.Run("emits fatal", func(t *testing.T) {
+		fatalTx := etx
+		fatalTx.Error = null.StringFrom("terminally stuck")
+		inner.On("UpdateTxFatalError", mock.Anything, []int64{etx.ID}, "terminally stuck").Return(nil).Once()
+		inner.On("FindTxesByIDs", mock.Anything, []int64{etx.ID}, testutils.FixtureChainID).Return([]*txmgr.Tx{&fatalTx}, nil).Once()
+		require.NoError(t, txStore.UpdateTxFatalError(ctx, []int64{etx.ID}, "terminally stuck"))
+
+		event := receiveLifecycleEvent(t, sub)
+		assert.Equal(t, lifecycle.EventFatal, event.Type)
+		assert.Equal(t, "terminally stuck", event.Error)
+	})
+
+	t.Run("does not emit if the update fails", func(t *testing.T) {
+		inner.On("UpdateTxFatalErrorAndDeleteAttempts", mock.Anything, &etx).Return(assert.AnError).Once()
+		require.ErrorIs(t, txStore.UpdateTxFatalErrorAndDeleteAttempts(ctx, &etx), assert.AnError)
+
+		select {
+		case event := <-sub:
+			t.Fatalf("unexpected event %v", event)
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+}
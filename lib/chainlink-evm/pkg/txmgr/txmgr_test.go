@@ -88,6 +88,7 @@ func makeTestEvmTxm(
 		keyStore,
 		estimator,
 		ht,
+		nil,
 		nil)
 }
 
@@ -16,6 +16,10 @@ import (
 
 	heads "github.com/smartcontractkit/chainlink-framework/chains/heads"
 
+	lifecycle "github.com/smartcontractkit/chainlink-evm/pkg/txmgr/lifecycle"
+
+	pkgtxmgr "github.com/smartcontractkit/chainlink-evm/pkg/txmgr"
+
 	log "github.com/smartcontractkit/chainlink-evm/pkg/log"
 
 	logger "github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -28,6 +32,8 @@ import (
 
 	pkgtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
 
+	reorgs "github.com/smartcontractkit/chainlink-evm/pkg/reorgs"
+
 	txmgr "github.com/smartcontractkit/chainlink-framework/chains/txmgr"
 
 	types "github.com/smartcontractkit/chainlink-common/pkg/types"
@@ -940,6 +946,53 @@ func (_c *Chain_Ready_Call) RunAndReturn(run func() error) *Chain_Ready_Call {
 	return _c
 }
 
+// ReorgJournal provides a mock function with no fields
+func (_m *Chain) ReorgJournal() reorgs.Journal {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReorgJournal")
+	}
+
+	var r0 reorgs.Journal
+	if rf, ok := ret.Get(0).(func() reorgs.Journal); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(reorgs.Journal)
+		}
+	}
+
+	return r0
+}
+
+// Chain_ReorgJournal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReorgJournal'
+type Chain_ReorgJournal_Call struct {
+	*mock.Call
+}
+
+// ReorgJournal is a helper method to define mock.On call
+func (_e *Chain_Expecter) ReorgJournal() *Chain_ReorgJournal_Call {
+	return &Chain_ReorgJournal_Call{Call: _e.mock.On("ReorgJournal")}
+}
+
+func (_c *Chain_ReorgJournal_Call) Run(run func()) *Chain_ReorgJournal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Chain_ReorgJournal_Call) Return(_a0 reorgs.Journal) *Chain_ReorgJournal_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Chain_ReorgJournal_Call) RunAndReturn(run func() reorgs.Journal) *Chain_ReorgJournal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Replay provides a mock function with given fields: ctx, fromBlock, args
 func (_m *Chain) Replay(ctx context.Context, fromBlock string, args map[string]interface{}) error {
 	ret := _m.Called(ctx, fromBlock, args)
@@ -1084,6 +1137,100 @@ func (_c *Chain_Transact_Call) RunAndReturn(run func(context.Context, string, st
 	return _c
 }
 
+// TxBatcher provides a mock function with no fields
+func (_m *Chain) TxBatcher() *pkgtxmgr.Batcher {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TxBatcher")
+	}
+
+	var r0 *pkgtxmgr.Batcher
+	if rf, ok := ret.Get(0).(func() *pkgtxmgr.Batcher); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pkgtxmgr.Batcher)
+		}
+	}
+
+	return r0
+}
+
+// Chain_TxBatcher_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TxBatcher'
+type Chain_TxBatcher_Call struct {
+	*mock.Call
+}
+
+// TxBatcher is a helper method to define mock.On call
+func (_e *Chain_Expecter) TxBatcher() *Chain_TxBatcher_Call {
+	return &Chain_TxBatcher_Call{Call: _e.mock.On("TxBatcher")}
+}
+
+func (_c *Chain_TxBatcher_Call) Run(run func()) *Chain_TxBatcher_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Chain_TxBatcher_Call) Return(_a0 *pkgtxmgr.Batcher) *Chain_TxBatcher_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Chain_TxBatcher_Call) RunAndReturn(run func() *pkgtxmgr.Batcher) *Chain_TxBatcher_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TxLifecycleEmitter provides a mock function with no fields
+func (_m *Chain) TxLifecycleEmitter() *lifecycle.Emitter {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TxLifecycleEmitter")
+	}
+
+	var r0 *lifecycle.Emitter
+	if rf, ok := ret.Get(0).(func() *lifecycle.Emitter); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lifecycle.Emitter)
+		}
+	}
+
+	return r0
+}
+
+// Chain_TxLifecycleEmitter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TxLifecycleEmitter'
+type Chain_TxLifecycleEmitter_Call struct {
+	*mock.Call
+}
+
+// TxLifecycleEmitter is a helper method to define mock.On call
+func (_e *Chain_Expecter) TxLifecycleEmitter() *Chain_TxLifecycleEmitter_Call {
+	return &Chain_TxLifecycleEmitter_Call{Call: _e.mock.On("TxLifecycleEmitter")}
+}
+
+func (_c *Chain_TxLifecycleEmitter_Call) Run(run func()) *Chain_TxLifecycleEmitter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Chain_TxLifecycleEmitter_Call) Return(_a0 *lifecycle.Emitter) *Chain_TxLifecycleEmitter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Chain_TxLifecycleEmitter_Call) RunAndReturn(run func() *lifecycle.Emitter) *Chain_TxLifecycleEmitter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TxManager provides a mock function with no fields
 func (_m *Chain) TxManager() txmgr.TxManager[*big.Int, *pkgtypes.Head, common.Address, common.Hash, common.Hash, pkgtypes.Nonce, gas.EvmFee] {
 	ret := _m.Called()
@@ -301,6 +301,64 @@ func (_c *LogPoller_GetBlocksRange_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetFilterStats provides a mock function with given fields: ctx
+func (_m *LogPoller) GetFilterStats(ctx context.Context) ([]logpoller.FilterStats, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFilterStats")
+	}
+
+	var r0 []logpoller.FilterStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]logpoller.FilterStats, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []logpoller.FilterStats); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]logpoller.FilterStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogPoller_GetFilterStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFilterStats'
+type LogPoller_GetFilterStats_Call struct {
+	*mock.Call
+}
+
+// GetFilterStats is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *LogPoller_Expecter) GetFilterStats(ctx interface{}) *LogPoller_GetFilterStats_Call {
+	return &LogPoller_GetFilterStats_Call{Call: _e.mock.On("GetFilterStats", ctx)}
+}
+
+func (_c *LogPoller_GetFilterStats_Call) Run(run func(ctx context.Context)) *LogPoller_GetFilterStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *LogPoller_GetFilterStats_Call) Return(_a0 []logpoller.FilterStats, _a1 error) *LogPoller_GetFilterStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogPoller_GetFilterStats_Call) RunAndReturn(run func(context.Context) ([]logpoller.FilterStats, error)) *LogPoller_GetFilterStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetFilters provides a mock function with no fields
 func (_m *LogPoller) GetFilters() map[string]logpoller.Filter {
 	ret := _m.Called()
@@ -1763,6 +1821,54 @@ func (_c *LogPoller_ReplayAsync_Call) RunAndReturn(run func(int64)) *LogPoller_R
 	return _c
 }
 
+// ReplayFilter provides a mock function with given fields: ctx, name, fromBlock
+func (_m *LogPoller) ReplayFilter(ctx context.Context, name string, fromBlock int64) error {
+	ret := _m.Called(ctx, name, fromBlock)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayFilter")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) error); ok {
+		r0 = rf(ctx, name, fromBlock)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LogPoller_ReplayFilter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayFilter'
+type LogPoller_ReplayFilter_Call struct {
+	*mock.Call
+}
+
+// ReplayFilter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - fromBlock int64
+func (_e *LogPoller_Expecter) ReplayFilter(ctx interface{}, name interface{}, fromBlock interface{}) *LogPoller_ReplayFilter_Call {
+	return &LogPoller_ReplayFilter_Call{Call: _e.mock.On("ReplayFilter", ctx, name, fromBlock)}
+}
+
+func (_c *LogPoller_ReplayFilter_Call) Run(run func(ctx context.Context, name string, fromBlock int64)) *LogPoller_ReplayFilter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *LogPoller_ReplayFilter_Call) Return(_a0 error) *LogPoller_ReplayFilter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LogPoller_ReplayFilter_Call) RunAndReturn(run func(context.Context, string, int64) error) *LogPoller_ReplayFilter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *LogPoller) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
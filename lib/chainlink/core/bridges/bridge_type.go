@@ -4,6 +4,7 @@ import (
 	"crypto/subtle"
 	"database/sql/driver"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"regexp"
@@ -11,6 +12,7 @@ import (
 	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
@@ -21,6 +23,10 @@ type BridgeTypeRequest struct {
 	URL                    models.WebURL `json:"url"`
 	Confirmations          uint32        `json:"confirmations"`
 	MinimumContractPayment *assets.Link  `json:"minimumContractPayment"`
+	// HealthCheckURL is optional. If set, it is polled every HealthCheckInterval
+	// and the bridge is reported as down while it does not answer with 2xx.
+	HealthCheckURL      models.WebURL    `json:"healthCheckURL"`
+	HealthCheckInterval sqlutil.Interval `json:"healthCheckInterval"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -40,6 +46,35 @@ func (bt *BridgeTypeRequest) SetID(value string) error {
 	return err
 }
 
+// ValidateHealthCheck returns an error if the health check settings are invalid.
+func (bt *BridgeTypeRequest) ValidateHealthCheck() error {
+	if bt.HealthCheckURL.String() == "" {
+		if bt.HealthCheckInterval != 0 {
+			return errors.New("healthCheckInterval requires a healthCheckURL")
+		}
+		return nil
+	}
+	if scheme := bt.HealthCheckURL.Scheme; scheme != "http" && scheme != "https" {
+		return fmt.Errorf("healthCheckURL must be http or https, got %q", scheme)
+	}
+	if bt.HealthCheckInterval != 0 && bt.HealthCheckInterval.Duration() < MinHealthCheckInterval {
+		return fmt.Errorf("healthCheckInterval must be at least %s", MinHealthCheckInterval)
+	}
+	return nil
+}
+
+// healthCheck returns the health check settings to persist, applying the default interval.
+func (bt *BridgeTypeRequest) healthCheck() (*models.WebURL, sqlutil.Interval) {
+	if bt.HealthCheckURL.String() == "" {
+		return nil, 0
+	}
+	u := bt.HealthCheckURL
+	if bt.HealthCheckInterval == 0 {
+		return &u, sqlutil.Interval(DefaultHealthCheckInterval)
+	}
+	return &u, bt.HealthCheckInterval
+}
+
 // BridgeTypeAuthentication is the record returned in response to a request to create a BridgeType
 type BridgeTypeAuthentication struct {
 	Name                   BridgeName
@@ -60,6 +95,8 @@ type BridgeType struct {
 	Salt                   string
 	OutgoingToken          string
 	MinimumContractPayment *assets.Link
+	HealthCheckURL         *models.WebURL
+	HealthCheckInterval    sqlutil.Interval
 	CreatedAt              time.Time
 	UpdatedAt              time.Time
 }
@@ -76,6 +113,7 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 	if err != nil {
 		return nil, nil, err
 	}
+	healthCheckURL, healthCheckInterval := btr.healthCheck()
 
 	return &BridgeTypeAuthentication{
 			Name:                   btr.Name,
@@ -92,6 +130,8 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 			Salt:                   salt,
 			OutgoingToken:          outgoingToken,
 			MinimumContractPayment: btr.MinimumContractPayment,
+			HealthCheckURL:         healthCheckURL,
+			HealthCheckInterval:    healthCheckInterval,
 		}, nil
 }
 
@@ -9,8 +9,10 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
@@ -36,6 +38,53 @@ func TestBridgeTypeRequest(t *testing.T) {
 	assert.Error(t, r.SetID("abc123.,<>/.foobar"))
 }
 
+func TestBridgeTypeRequest_ValidateHealthCheck(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		url      string
+		interval time.Duration
+		err      string
+	}{
+		{"no health check", "", 0, ""},
+		{"default interval", "http://example.com/health", 0, ""},
+		{"interval", "https://example.com/health", 30 * time.Second, ""},
+		{"interval without url", "", 30 * time.Second, "healthCheckInterval requires a healthCheckURL"},
+		{"interval too short", "http://example.com/health", time.Second, "healthCheckInterval must be at least 5s"},
+		{"bad scheme", "ftp://example.com/health", 0, `healthCheckURL must be http or https, got "ftp"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := bridges.BridgeTypeRequest{HealthCheckInterval: sqlutil.Interval(tt.interval)}
+			if tt.url != "" {
+				r.HealthCheckURL = cltest.WebURL(t, tt.url)
+			}
+			err := r.ValidateHealthCheck()
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestNewBridgeType_HealthCheck(t *testing.T) {
+	t.Parallel()
+
+	_, bt, err := bridges.NewBridgeType(&bridges.BridgeTypeRequest{Name: "test"})
+	require.NoError(t, err)
+	assert.Nil(t, bt.HealthCheckURL)
+	assert.Zero(t, bt.HealthCheckInterval)
+
+	_, bt, err = bridges.NewBridgeType(&bridges.BridgeTypeRequest{Name: "test", HealthCheckURL: cltest.WebURL(t, "http://example.com/health")})
+	require.NoError(t, err)
+	require.NotNil(t, bt.HealthCheckURL)
+	assert.Equal(t, "http://example.com/health", bt.HealthCheckURL.String())
+	assert.Equal(t, bridges.DefaultHealthCheckInterval, bt.HealthCheckInterval.Duration())
+}
+
 func TestBridgeType_Authenticate(t *testing.T) {
 	t.Parallel()
 
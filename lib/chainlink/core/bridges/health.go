@@ -0,0 +1,247 @@
+package bridges
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+)
+
+const (
+	HealthProberServiceName    = "BridgeHealthProber"
+	DefaultHealthCheckInterval = time.Minute
+	MinHealthCheckInterval     = 5 * time.Second
+
+	// maxBridgeHealthChecks is the number of health checks kept per bridge.
+	maxBridgeHealthChecks = 100
+	// healthCheckTimeout caps the time a health check may take, it is never longer than the interval.
+	healthCheckTimeout = 10 * time.Second
+	// healthProbeResolution is how often the prober looks for bridges due for a check.
+	healthProbeResolution = time.Second
+	// healthRefreshInterval is how often the prober reloads the bridges to check,
+	// so that created, updated and deleted bridges are picked up.
+	healthRefreshInterval = 30 * time.Second
+)
+
+// BridgeHealthCheck is the result of a single request to the health check URL of a bridge.
+type BridgeHealthCheck struct {
+	ID         int64
+	BridgeName BridgeName
+	Healthy    bool
+	// StatusCode is 0 if no response was received.
+	StatusCode int
+	Latency    time.Duration
+	Error      string
+	CheckedAt  time.Time
+}
+
+type HealthStatus string
+
+const (
+	HealthStatusUnknown HealthStatus = "unknown"
+	HealthStatusUp      HealthStatus = "up"
+	HealthStatusDown    HealthStatus = "down"
+)
+
+// BridgeHealth summarizes the latest health checks of a bridge.
+type BridgeHealth struct {
+	Status HealthStatus
+	// DownSince is the time of the first failed check of the current outage, nil unless Status is down.
+	DownSince *time.Time
+}
+
+// NewBridgeHealth returns the health of a bridge given its latest health checks, newest first.
+func NewBridgeHealth(checks []BridgeHealthCheck) BridgeHealth {
+	if len(checks) == 0 {
+		return BridgeHealth{Status: HealthStatusUnknown}
+	}
+	if checks[0].Healthy {
+		return BridgeHealth{Status: HealthStatusUp}
+	}
+	downSince := checks[0].CheckedAt
+	for _, check := range checks[1:] {
+		if check.Healthy {
+			break
+		}
+		downSince = check.CheckedAt
+	}
+	return BridgeHealth{Status: HealthStatusDown, DownSince: &downSince}
+}
+
+// HealthProber periodically requests the health check URL of every bridge
+// that has one, saves the results and reports bridges that are down in its
+// HealthReport. A bridge is healthy while its health check URL answers GET
+// requests with a 2xx status.
+type HealthProber struct {
+	services.Service
+	eng *services.Engine
+
+	orm    ORM
+	client *http.Client
+
+	mu          sync.RWMutex
+	probes      map[BridgeName]*bridgeProbe
+	refreshedAt time.Time
+}
+
+type bridgeProbe struct {
+	url      string
+	interval time.Duration
+	nextAt   time.Time
+	running  bool
+
+	// downSince is zero unless the last health check failed.
+	downSince time.Time
+	lastError string
+}
+
+var _ services.Service = (*HealthProber)(nil)
+
+func NewHealthProber(orm ORM, client *http.Client, lggr logger.Logger) *HealthProber {
+	p := &HealthProber{
+		orm:    orm,
+		client: client,
+		probes: make(map[BridgeName]*bridgeProbe),
+	}
+	p.Service, p.eng = services.Config{
+		Name:  HealthProberServiceName,
+		Start: p.start,
+	}.NewServiceEngine(lggr)
+	return p
+}
+
+func (p *HealthProber) start(_ context.Context) error {
+	p.eng.GoTick(services.NewTicker(healthProbeResolution), p.probeDue)
+	return nil
+}
+
+// HealthReport includes an error for every bridge that is down.
+func (p *HealthProber) HealthReport() map[string]error {
+	report := p.Service.HealthReport()
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for name, probe := range p.probes {
+		if !probe.downSince.IsZero() {
+			report[fmt.Sprintf("%s.%s", p.Name(), name)] = fmt.Errorf("bridge %s is down since %s: %s", name, probe.downSince.Format(time.RFC3339), probe.lastError)
+		}
+	}
+	return report
+}
+
+func (p *HealthProber) probeDue(ctx context.Context) {
+	now := time.Now()
+	if now.Sub(p.refreshedAt) >= healthRefreshInterval {
+		p.refresh(ctx, now)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for name, probe := range p.probes {
+		if probe.running || now.Before(probe.nextAt) {
+			continue
+		}
+		probe.running = true
+		probe.nextAt = now.Add(probe.interval)
+		url, timeout := probe.url, min(probe.interval, healthCheckTimeout)
+		p.eng.Go(func(ctx context.Context) {
+			p.probe(ctx, name, url, timeout)
+		})
+	}
+}
+
+// refresh reloads the bridges to check, keeping the state of the ones which did not change.
+func (p *HealthProber) refresh(ctx context.Context, now time.Time) {
+	// failures are retried on the next refresh, not on every tick
+	p.refreshedAt = now
+	bts, err := p.orm.HealthCheckedBridges(ctx)
+	if err != nil {
+		p.eng.Warnw("Failed to load bridges to health check", "err", err)
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	probes := make(map[BridgeName]*bridgeProbe, len(bts))
+	for _, bt := range bts {
+		url, interval := bt.HealthCheckURL.String(), bt.HealthCheckInterval.Duration()
+		if interval <= 0 {
+			interval = DefaultHealthCheckInterval
+		}
+		probe, ok := p.probes[bt.Name]
+		if !ok || probe.url != url {
+			probe = &bridgeProbe{url: url, running: ok && probe.running}
+		}
+		probe.interval = interval
+		probes[bt.Name] = probe
+	}
+	p.probes = probes
+}
+
+func (p *HealthProber) probe(ctx context.Context, name BridgeName, url string, timeout time.Duration) {
+	check := checkBridgeHealth(ctx, p.client, name, url, timeout)
+	if ctx.Err() != nil {
+		// shutting down, the check failed because of us
+		return
+	}
+	if err := p.orm.InsertBridgeHealthCheck(ctx, &check); err != nil {
+		p.eng.Warnw("Failed to save bridge health check", "bridge", name, "err", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	probe, ok := p.probes[name]
+	if !ok {
+		return
+	}
+	probe.running = false
+	if probe.url != url {
+		// changed while the check was running
+		return
+	}
+	probe.lastError = check.Error
+	if check.Healthy {
+		if !probe.downSince.IsZero() {
+			p.eng.Infow("Bridge is up again", "bridge", name, "downSince", probe.downSince)
+		}
+		probe.downSince = time.Time{}
+	} else if probe.downSince.IsZero() {
+		p.eng.Warnw("Bridge is down", "bridge", name, "url", url, "err", check.Error)
+		probe.downSince = check.CheckedAt
+	}
+}
+
+func checkBridgeHealth(ctx context.Context, client *http.Client, name BridgeName, url string, timeout time.Duration) BridgeHealthCheck {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	check := BridgeHealthCheck{BridgeName: name, CheckedAt: time.Now()}
+	err := func() error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+		check.StatusCode = resp.StatusCode
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return fmt.Errorf("unexpected status %s", resp.Status)
+		}
+		return nil
+	}()
+	check.Latency = time.Since(check.CheckedAt)
+	check.Healthy = err == nil
+	if err != nil {
+		check.Error = err.Error()
+	}
+	return check
+}
@@ -0,0 +1,104 @@
+package bridges_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func TestNewBridgeHealth(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	check := func(healthy bool, ago time.Duration) bridges.BridgeHealthCheck {
+		return bridges.BridgeHealthCheck{Healthy: healthy, CheckedAt: now.Add(-ago)}
+	}
+
+	assert.Equal(t, bridges.BridgeHealth{Status: bridges.HealthStatusUnknown}, bridges.NewBridgeHealth(nil))
+	assert.Equal(t, bridges.BridgeHealth{Status: bridges.HealthStatusUp}, bridges.NewBridgeHealth([]bridges.BridgeHealthCheck{
+		check(true, 0), check(false, time.Minute),
+	}))
+
+	health := bridges.NewBridgeHealth([]bridges.BridgeHealthCheck{
+		check(false, 0), check(false, time.Minute), check(true, 2*time.Minute), check(false, 3*time.Minute),
+	})
+	assert.Equal(t, bridges.HealthStatusDown, health.Status)
+	require.NotNil(t, health.DownSince)
+	assert.Equal(t, now.Add(-time.Minute), *health.DownSince)
+}
+
+func TestHealthProber(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	_, orm := setupORM(t)
+
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(up.Close)
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(down.Close)
+
+	for name, u := range map[bridges.BridgeName]string{"up": up.URL, "down": down.URL} {
+		healthCheckURL := cltest.WebURL(t, u)
+		require.NoError(t, orm.CreateBridgeType(ctx, &bridges.BridgeType{
+			Name:                name,
+			URL:                 cltest.WebURL(t, "http://example.com"),
+			HealthCheckURL:      &healthCheckURL,
+			HealthCheckInterval: sqlutil.Interval(bridges.MinHealthCheckInterval),
+		}))
+	}
+	require.NoError(t, orm.CreateBridgeType(ctx, &bridges.BridgeType{
+		Name: "unchecked",
+		URL:  cltest.WebURL(t, "http://example.com"),
+	}))
+
+	prober := bridges.NewHealthProber(orm, up.Client(), logger.TestLogger(t))
+	servicetest.Run(t, prober)
+
+	require.Eventually(t, func() bool {
+		upChecks, err := orm.BridgeHealthChecks(ctx, "up", 10)
+		require.NoError(t, err)
+		downChecks, err := orm.BridgeHealthChecks(ctx, "down", 10)
+		require.NoError(t, err)
+		return len(upChecks) > 0 && len(downChecks) > 0
+	}, testutils.WaitTimeout(t), 100*time.Millisecond)
+
+	checks, err := orm.BridgeHealthChecks(ctx, "up", 10)
+	require.NoError(t, err)
+	assert.True(t, checks[0].Healthy)
+	assert.Equal(t, http.StatusOK, checks[0].StatusCode)
+
+	checks, err = orm.BridgeHealthChecks(ctx, "down", 10)
+	require.NoError(t, err)
+	assert.False(t, checks[0].Healthy)
+	assert.Equal(t, http.StatusServiceUnavailable, checks[0].StatusCode)
+	assert.Contains(t, checks[0].Error, "503")
+
+	checks, err = orm.BridgeHealthChecks(ctx, "unchecked", 10)
+	require.NoError(t, err)
+	assert.Empty(t, checks)
+
+	require.Eventually(t, func() bool {
+		_, ok := prober.HealthReport()[prober.Name()+".down"]
+		return ok
+	}, testutils.WaitTimeout(t), 100*time.Millisecond)
+	report := prober.HealthReport()
+	assert.NoError(t, report[prober.Name()])
+	assert.ErrorContains(t, report[prober.Name()+".down"], "bridge down is down since")
+	assert.NotContains(t, report, prober.Name()+".up")
+}
@@ -28,6 +28,66 @@ func (_m *ORM) EXPECT() *ORM_Expecter {
 	return &ORM_Expecter{mock: &_m.Mock}
 }
 
+// BridgeHealthChecks provides a mock function with given fields: ctx, name, limit
+func (_m *ORM) BridgeHealthChecks(ctx context.Context, name bridges.BridgeName, limit int) ([]bridges.BridgeHealthCheck, error) {
+	ret := _m.Called(ctx, name, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BridgeHealthChecks")
+	}
+
+	var r0 []bridges.BridgeHealthCheck
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, bridges.BridgeName, int) ([]bridges.BridgeHealthCheck, error)); ok {
+		return rf(ctx, name, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, bridges.BridgeName, int) []bridges.BridgeHealthCheck); ok {
+		r0 = rf(ctx, name, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]bridges.BridgeHealthCheck)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, bridges.BridgeName, int) error); ok {
+		r1 = rf(ctx, name, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_BridgeHealthChecks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BridgeHealthChecks'
+type ORM_BridgeHealthChecks_Call struct {
+	*mock.Call
+}
+
+// BridgeHealthChecks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name bridges.BridgeName
+//   - limit int
+func (_e *ORM_Expecter) BridgeHealthChecks(ctx interface{}, name interface{}, limit interface{}) *ORM_BridgeHealthChecks_Call {
+	return &ORM_BridgeHealthChecks_Call{Call: _e.mock.On("BridgeHealthChecks", ctx, name, limit)}
+}
+
+func (_c *ORM_BridgeHealthChecks_Call) Run(run func(ctx context.Context, name bridges.BridgeName, limit int)) *ORM_BridgeHealthChecks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bridges.BridgeName), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ORM_BridgeHealthChecks_Call) Return(_a0 []bridges.BridgeHealthCheck, _a1 error) *ORM_BridgeHealthChecks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_BridgeHealthChecks_Call) RunAndReturn(run func(context.Context, bridges.BridgeName, int) ([]bridges.BridgeHealthCheck, error)) *ORM_BridgeHealthChecks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BridgeTypes provides a mock function with given fields: ctx, offset, limit
 func (_m *ORM) BridgeTypes(ctx context.Context, offset int, limit int) ([]bridges.BridgeType, int, error) {
 	ret := _m.Called(ctx, offset, limit)
@@ -758,6 +818,111 @@ func (_c *ORM_GetCachedResponseWithFinished_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// HealthCheckedBridges provides a mock function with given fields: ctx
+func (_m *ORM) HealthCheckedBridges(ctx context.Context) ([]bridges.BridgeType, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HealthCheckedBridges")
+	}
+
+	var r0 []bridges.BridgeType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]bridges.BridgeType, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []bridges.BridgeType); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]bridges.BridgeType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_HealthCheckedBridges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HealthCheckedBridges'
+type ORM_HealthCheckedBridges_Call struct {
+	*mock.Call
+}
+
+// HealthCheckedBridges is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ORM_Expecter) HealthCheckedBridges(ctx interface{}) *ORM_HealthCheckedBridges_Call {
+	return &ORM_HealthCheckedBridges_Call{Call: _e.mock.On("HealthCheckedBridges", ctx)}
+}
+
+func (_c *ORM_HealthCheckedBridges_Call) Run(run func(ctx context.Context)) *ORM_HealthCheckedBridges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ORM_HealthCheckedBridges_Call) Return(_a0 []bridges.BridgeType, _a1 error) *ORM_HealthCheckedBridges_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_HealthCheckedBridges_Call) RunAndReturn(run func(context.Context) ([]bridges.BridgeType, error)) *ORM_HealthCheckedBridges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertBridgeHealthCheck provides a mock function with given fields: ctx, check
+func (_m *ORM) InsertBridgeHealthCheck(ctx context.Context, check *bridges.BridgeHealthCheck) error {
+	ret := _m.Called(ctx, check)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertBridgeHealthCheck")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *bridges.BridgeHealthCheck) error); ok {
+		r0 = rf(ctx, check)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_InsertBridgeHealthCheck_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertBridgeHealthCheck'
+type ORM_InsertBridgeHealthCheck_Call struct {
+	*mock.Call
+}
+
+// InsertBridgeHealthCheck is a helper method to define mock.On call
+//   - ctx context.Context
+//   - check *bridges.BridgeHealthCheck
+func (_e *ORM_Expecter) InsertBridgeHealthCheck(ctx interface{}, check interface{}) *ORM_InsertBridgeHealthCheck_Call {
+	return &ORM_InsertBridgeHealthCheck_Call{Call: _e.mock.On("InsertBridgeHealthCheck", ctx, check)}
+}
+
+func (_c *ORM_InsertBridgeHealthCheck_Call) Run(run func(ctx context.Context, check *bridges.BridgeHealthCheck)) *ORM_InsertBridgeHealthCheck_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*bridges.BridgeHealthCheck))
+	})
+	return _c
+}
+
+func (_c *ORM_InsertBridgeHealthCheck_Call) Return(_a0 error) *ORM_InsertBridgeHealthCheck_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_InsertBridgeHealthCheck_Call) RunAndReturn(run func(context.Context, *bridges.BridgeHealthCheck) error) *ORM_InsertBridgeHealthCheck_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateBridgeType provides a mock function with given fields: ctx, bt, btr
 func (_m *ORM) UpdateBridgeType(ctx context.Context, bt *bridges.BridgeType, btr *bridges.BridgeTypeRequest) error {
 	ret := _m.Called(ctx, bt, btr)
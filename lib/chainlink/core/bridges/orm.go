@@ -21,6 +21,10 @@ type ORM interface {
 	CreateBridgeType(ctx context.Context, bt *BridgeType) error
 	UpdateBridgeType(ctx context.Context, bt *BridgeType, btr *BridgeTypeRequest) error
 
+	HealthCheckedBridges(ctx context.Context) ([]BridgeType, error)
+	InsertBridgeHealthCheck(ctx context.Context, check *BridgeHealthCheck) error
+	BridgeHealthChecks(ctx context.Context, name BridgeName, limit int) ([]BridgeHealthCheck, error)
+
 	GetCachedResponse(ctx context.Context, dotId string, specId int32, maxElapsed time.Duration) ([]byte, error)
 	UpsertBridgeResponse(ctx context.Context, dotId string, specId int32, response []byte) error
 
@@ -124,8 +128,8 @@ func (o *orm) BridgeTypes(ctx context.Context, offset int, limit int) (bridges [
 
 // CreateBridgeType saves the bridge type.
 func (o *orm) CreateBridgeType(ctx context.Context, bt *BridgeType) error {
-	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, created_at, updated_at)
-	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, now(), now())
+	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, health_check_url, health_check_interval, created_at, updated_at)
+	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, :health_check_url, :health_check_interval, now(), now())
 	RETURNING *;`
 	err := o.transact(ctx, false, func(tx *orm) error {
 		stmt, err := tx.ds.PrepareNamedContext(ctx, stmt)
@@ -141,12 +145,43 @@ func (o *orm) CreateBridgeType(ctx context.Context, bt *BridgeType) error {
 
 // UpdateBridgeType updates the bridge type.
 func (o *orm) UpdateBridgeType(ctx context.Context, bt *BridgeType, btr *BridgeTypeRequest) error {
-	stmt := "UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3 WHERE name = $4 RETURNING *"
-	err := o.ds.GetContext(ctx, bt, stmt, btr.URL, btr.Confirmations, btr.MinimumContractPayment, bt.Name)
+	stmt := "UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3, health_check_url = $4, health_check_interval = $5 WHERE name = $6 RETURNING *"
+	healthCheckURL, healthCheckInterval := btr.healthCheck()
+	err := o.ds.GetContext(ctx, bt, stmt, btr.URL, btr.Confirmations, btr.MinimumContractPayment, healthCheckURL, healthCheckInterval, bt.Name)
 
 	return err
 }
 
+// HealthCheckedBridges returns all bridges with a health check URL.
+func (o *orm) HealthCheckedBridges(ctx context.Context) (bts []BridgeType, err error) {
+	err = o.ds.SelectContext(ctx, &bts, `SELECT * FROM bridge_types WHERE health_check_url IS NOT NULL ORDER BY name asc`)
+	return bts, pkgerrors.Wrap(err, "HealthCheckedBridges failed")
+}
+
+// InsertBridgeHealthCheck saves the result of a health check, keeping only the
+// latest maxBridgeHealthChecks results of each bridge.
+func (o *orm) InsertBridgeHealthCheck(ctx context.Context, check *BridgeHealthCheck) error {
+	err := o.transact(ctx, false, func(tx *orm) error {
+		stmt := `INSERT INTO bridge_health_checks (bridge_name, healthy, status_code, latency, error, checked_at)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`
+		if err := tx.ds.GetContext(ctx, &check.ID, stmt, check.BridgeName, check.Healthy, check.StatusCode, check.Latency, check.Error, check.CheckedAt); err != nil {
+			return err
+		}
+		_, err := tx.ds.ExecContext(ctx, `DELETE FROM bridge_health_checks WHERE bridge_name = $1 AND id NOT IN (
+			SELECT id FROM bridge_health_checks WHERE bridge_name = $1 ORDER BY checked_at DESC, id DESC LIMIT $2
+		)`, check.BridgeName, maxBridgeHealthChecks)
+		return err
+	})
+	return pkgerrors.Wrap(err, "InsertBridgeHealthCheck failed")
+}
+
+// BridgeHealthChecks returns the latest health checks of a bridge, newest first.
+func (o *orm) BridgeHealthChecks(ctx context.Context, name BridgeName, limit int) (checks []BridgeHealthCheck, err error) {
+	stmt := `SELECT * FROM bridge_health_checks WHERE bridge_name = $1 ORDER BY checked_at DESC, id DESC LIMIT $2`
+	err = o.ds.SelectContext(ctx, &checks, stmt, name, limit)
+	return checks, pkgerrors.Wrap(err, "BridgeHealthChecks failed")
+}
+
 func (o *orm) GetCachedResponse(ctx context.Context, dotId string, specId int32, maxElapsed time.Duration) ([]byte, error) {
 	response, _, err := o.GetCachedResponseWithFinished(ctx, dotId, specId, maxElapsed)
 	if err != nil {
@@ -137,6 +137,70 @@ func TestORM_UpdateBridgeType(t *testing.T) {
 	require.Empty(t, bs)
 }
 
+func TestORM_BridgeHealthChecks(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	_, orm := setupORM(t)
+
+	healthCheckURL := cltest.WebURL(t, "http://bridge1.com/health")
+	checked := &bridges.BridgeType{
+		Name:                "checked",
+		URL:                 cltest.WebURL(t, "http://bridge1.com"),
+		HealthCheckURL:      &healthCheckURL,
+		HealthCheckInterval: sqlutil.Interval(30 * time.Second),
+	}
+	require.NoError(t, orm.CreateBridgeType(ctx, checked))
+	unchecked := &bridges.BridgeType{
+		Name: "unchecked",
+		URL:  cltest.WebURL(t, "http://bridge2.com"),
+	}
+	require.NoError(t, orm.CreateBridgeType(ctx, unchecked))
+
+	bts, err := orm.HealthCheckedBridges(ctx)
+	require.NoError(t, err)
+	require.Len(t, bts, 1)
+	assert.Equal(t, checked.Name, bts[0].Name)
+	assert.Equal(t, healthCheckURL, *bts[0].HealthCheckURL)
+	assert.Equal(t, 30*time.Second, bts[0].HealthCheckInterval.Duration())
+
+	start := time.Now().Add(-time.Hour)
+	for i := range 105 {
+		check := bridges.BridgeHealthCheck{
+			BridgeName: checked.Name,
+			Healthy:    i%2 == 0,
+			StatusCode: 200,
+			Latency:    time.Duration(i) * time.Millisecond,
+			CheckedAt:  start.Add(time.Duration(i) * time.Second),
+		}
+		require.NoError(t, orm.InsertBridgeHealthCheck(ctx, &check))
+		assert.NotZero(t, check.ID)
+	}
+
+	checks, err := orm.BridgeHealthChecks(ctx, checked.Name, 1000)
+	require.NoError(t, err)
+	require.Len(t, checks, 100, "older checks are pruned")
+	assert.Equal(t, 104*time.Millisecond, checks[0].Latency)
+	assert.True(t, checks[0].Healthy)
+	assert.Equal(t, 5*time.Millisecond, checks[99].Latency)
+
+	checks, err = orm.BridgeHealthChecks(ctx, checked.Name, 2)
+	require.NoError(t, err)
+	require.Len(t, checks, 2)
+
+	// removing the health check URL stops the checks, the history is kept
+	require.NoError(t, orm.UpdateBridgeType(ctx, checked, &bridges.BridgeTypeRequest{URL: checked.URL}))
+	assert.Nil(t, checked.HealthCheckURL)
+	bts, err = orm.HealthCheckedBridges(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, bts)
+
+	// and deleting the bridge deletes the history
+	require.NoError(t, orm.DeleteBridgeType(ctx, checked))
+	checks, err = orm.BridgeHealthChecks(ctx, checked.Name, 1000)
+	require.NoError(t, err)
+	assert.Empty(t, checks)
+}
+
 func TestORM_TestCachedResponse(t *testing.T) {
 	ctx := testutils.Context(t)
 	cfg := configtest.NewGeneralConfig(t, nil)
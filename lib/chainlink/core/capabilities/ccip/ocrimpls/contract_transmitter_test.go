@@ -646,6 +646,7 @@ func makeTestEvmTxm(t *testing.T, db *sqlx.DB, ethClient client.Client, keyStore
 		keyStore,
 		estimator,
 		ht,
+		nil,
 		nil)
 	require.NoError(t, err, "can't create tx manager")
 
@@ -801,6 +802,10 @@ func (g *TestGasEstimatorConfig) FeeHistory() evmconfig.FeeHistory {
 	return &TestFeeHistoryConfig{}
 }
 
+func (g *TestGasEstimatorConfig) Composite() evmconfig.CompositeEstimator {
+	return &TestCompositeConfig{}
+}
+
 func (g *TestGasEstimatorConfig) EIP1559DynamicFees() bool   { return false }
 func (g *TestGasEstimatorConfig) LimitDefault() uint64       { return 1e6 }
 func (g *TestGasEstimatorConfig) BumpPercent() uint16        { return 2 }
@@ -854,6 +859,10 @@ type TestFeeHistoryConfig struct {
 	evmconfig.FeeHistory
 }
 
+type TestCompositeConfig struct {
+	evmconfig.CompositeEstimator
+}
+
 type transactionsConfig struct {
 	evmconfig.Transactions
 	e         *TestEvmConfig
@@ -137,6 +137,70 @@ func NewApp(s *Shell) *cli.App {
 		}
 		return nil
 	}
+	// beforeLocal loads the configuration and secrets of the local commands, and swaps the logger for the configured one.
+	beforeLocal := func(c *cli.Context) error {
+		errNoDuplicateFlags := errors.New("multiple commands with --config or --secrets flags. only one command may specify these flags. when secrets are used, they must be specific together in the same command")
+		if c.IsSet("config") {
+			if s.configFilesIsSet || s.secretsFileIsSet {
+				return errNoDuplicateFlags
+			}
+			s.configFiles = c.StringSlice("config")
+		}
+
+		if c.IsSet("secrets") {
+			if s.configFilesIsSet || s.secretsFileIsSet {
+				return errNoDuplicateFlags
+			}
+			s.secretsFiles = c.StringSlice("secrets")
+		}
+
+		// flags here, or ENV VAR only
+		cfg, err := initServerConfig(&opts, s.configFiles, s.secretsFiles)
+		if err != nil {
+			return err
+		}
+		s.Config = cfg
+
+		logFileMaxSizeMB := s.Config.Log().File().MaxSize() / utils.MB
+		if logFileMaxSizeMB > 0 {
+			err = utils.EnsureDirAndMaxPerms(s.Config.Log().File().Dir(), os.FileMode(0700))
+			if err != nil {
+				return err
+			}
+		}
+
+		// Swap out the logger, replacing the old one.
+		err = s.CloseLogger()
+		if err != nil {
+			return err
+		}
+
+		// Configure a new logger with OTel atomic core support
+		lggrCfg := logger.Config{
+			LogLevel:    s.Config.Log().Level(),
+			Dir:         s.Config.Log().File().Dir(),
+			JsonConsole: s.Config.Log().JSONConsole(),
+			UnixTS:      s.Config.Log().UnixTimestamps(),
+			//nolint:gosec // filemaxsizesmb won't exceed max int
+			FileMaxSizeMB:  int(logFileMaxSizeMB),
+			FileMaxAgeDays: int(s.Config.Log().File().MaxAgeDays()),
+			FileMaxBackups: int(s.Config.Log().File().MaxBackups()),
+			SentryEnabled:  s.Config.Sentry().DSN() != "",
+		}
+
+		// Noop atomic core that can be swapped out later for OTel support
+		atomicCore := logger.NewAtomicCore()
+
+		l, closeFn := lggrCfg.NewWithCores(atomicCore)
+
+		s.Logger = l
+		s.CloseLogger = closeFn
+		// s.SetOtelCore is a hook that can be used to set the OTel core
+		s.SetOtelCore = atomicCore.Store
+
+		return nil
+	}
+
 	app.Commands = removeHidden([]cli.Command{
 		{
 			Name:        "admin",
@@ -185,6 +249,11 @@ func NewApp(s *Shell) *cli.App {
 			Usage:       "Commands for managing Jobs",
 			Subcommands: initJobsSubCmds(s),
 		},
+		{
+			Name:        "fragments",
+			Usage:       "Commands for managing reusable pipeline fragments",
+			Subcommands: initPipelineFragmentsSubCmds(s),
+		},
 		{
 			Name:  "keys",
 			Usage: "Commands for managing various types of keys used by the Chainlink node",
@@ -206,6 +275,11 @@ func NewApp(s *Shell) *cli.App {
 				keysCommand("Sui", NewSuiKeysClient(s)),
 
 				initVRFKeysSubCmd(s),
+
+				initKeysRotatePasswordSubCmd(s, beforeLocal),
+				initKeysPrunePasswordBackupsSubCmd(s, beforeLocal),
+				initKeysExportBundleSubCmd(s, beforeLocal),
+				initKeysImportBundleSubCmd(s, beforeLocal),
 			},
 		},
 		{
@@ -224,68 +298,7 @@ func NewApp(s *Shell) *cli.App {
 					Usage: "TOML configuration file for secrets. Must be set if and only if config is set. Multiple files can be used (-s secretsA.toml -s secretsB.toml), and fields from the files will be merged. No overrides are allowed.",
 				},
 			},
-			Before: func(c *cli.Context) error {
-				errNoDuplicateFlags := errors.New("multiple commands with --config or --secrets flags. only one command may specify these flags. when secrets are used, they must be specific together in the same command")
-				if c.IsSet("config") {
-					if s.configFilesIsSet || s.secretsFileIsSet {
-						return errNoDuplicateFlags
-					}
-					s.configFiles = c.StringSlice("config")
-				}
-
-				if c.IsSet("secrets") {
-					if s.configFilesIsSet || s.secretsFileIsSet {
-						return errNoDuplicateFlags
-					}
-					s.secretsFiles = c.StringSlice("secrets")
-				}
-
-				// flags here, or ENV VAR only
-				cfg, err := initServerConfig(&opts, s.configFiles, s.secretsFiles)
-				if err != nil {
-					return err
-				}
-				s.Config = cfg
-
-				logFileMaxSizeMB := s.Config.Log().File().MaxSize() / utils.MB
-				if logFileMaxSizeMB > 0 {
-					err = utils.EnsureDirAndMaxPerms(s.Config.Log().File().Dir(), os.FileMode(0700))
-					if err != nil {
-						return err
-					}
-				}
-
-				// Swap out the logger, replacing the old one.
-				err = s.CloseLogger()
-				if err != nil {
-					return err
-				}
-
-				// Configure a new logger with OTel atomic core support
-				lggrCfg := logger.Config{
-					LogLevel:    s.Config.Log().Level(),
-					Dir:         s.Config.Log().File().Dir(),
-					JsonConsole: s.Config.Log().JSONConsole(),
-					UnixTS:      s.Config.Log().UnixTimestamps(),
-					//nolint:gosec // filemaxsizesmb won't exceed max int
-					FileMaxSizeMB:  int(logFileMaxSizeMB),
-					FileMaxAgeDays: int(s.Config.Log().File().MaxAgeDays()),
-					FileMaxBackups: int(s.Config.Log().File().MaxBackups()),
-					SentryEnabled:  s.Config.Sentry().DSN() != "",
-				}
-
-				// Noop atomic core that can be swapped out later for OTel support
-				atomicCore := logger.NewAtomicCore()
-
-				l, closeFn := lggrCfg.NewWithCores(atomicCore)
-
-				s.Logger = l
-				s.CloseLogger = closeFn
-				// s.SetOtelCore is a hook that can be used to set the OTel core
-				s.SetOtelCore = atomicCore.Store
-
-				return nil
-			},
+			Before: beforeLocal,
 		},
 		{
 			Name:        "initiators",
@@ -316,6 +329,16 @@ func NewApp(s *Shell) *cli.App {
 			Usage:       "Commands for managing forwarder addresses.",
 			Subcommands: initFowardersSubCmds(s),
 		},
+		{
+			Name:        "vrf",
+			Usage:       "Commands for VRF jobs",
+			Subcommands: initVRFSubCmds(s),
+		},
+		{
+			Name:        "keepers",
+			Usage:       "Commands for keeper and automation upkeeps",
+			Subcommands: initKeepersSubCmds(s),
+		},
 		{
 			Name:  "help-all",
 			Usage: "Shows a list of all commands and sub-commands",
@@ -2,15 +2,21 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/json"
 	stderrors "errors"
 	"fmt"
+	"math/big"
 	"net/url"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
 
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
 func initBlocksSubCmds(s *Shell) []cli.Command {
@@ -53,6 +59,40 @@ func initBlocksSubCmds(s *Shell) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:   "list-filters",
+			Usage:  "List the LogPoller filters along with the stats of their retained logs",
+			Action: s.ListLogPollerFilters,
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:     "evm-chain-id",
+					Usage:    "Chain ID of the EVM-based blockchain",
+					Required: true,
+				},
+			},
+		},
+		{
+			Name:   "replay-filter",
+			Usage:  "Replays the logs of a single LogPoller filter from the given block number",
+			Action: s.ReplayLogPollerFilter,
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:     "evm-chain-id",
+					Usage:    "Chain ID of the EVM-based blockchain",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:     "name",
+					Usage:    "Name of the filter to replay",
+					Required: true,
+				},
+				cli.Int64Flag{
+					Name:     "block-number",
+					Usage:    "Block number to replay from",
+					Required: true,
+				},
+			},
+		},
 	}
 }
 
@@ -140,3 +180,177 @@ func (s *Shell) FindLCA(c *cli.Context) (err error) {
 
 	return s.renderAPIResponse(resp, &LCAPresenter{}, "Last Common Ancestor")
 }
+
+// LogPollerFilterPresenter implements TableRenderer for a LogPollerFilterResource.
+type LogPollerFilterPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.LogPollerFilterResource
+}
+
+var logPollerFilterHeaders = []string{"Name", "Chain ID", "Addresses", "Event Sigs", "Retention", "Max Logs Kept", "Log Count", "Oldest Block", "Newest Block", "Last Matched At"}
+
+// ToRow presents the LogPollerFilterResource as a slice of strings.
+func (p *LogPollerFilterPresenter) ToRow() []string {
+	var addresses, eventSigs []string
+	for _, a := range p.Addresses {
+		addresses = append(addresses, a.Hex())
+	}
+	for _, s := range p.EventSigs {
+		eventSigs = append(eventSigs, s.Hex())
+	}
+	blockNumber := func(n *int64) string {
+		if n == nil {
+			return ""
+		}
+		return strconv.FormatInt(*n, 10)
+	}
+	var lastMatchedAt string
+	if p.LastMatchedAt != nil {
+		lastMatchedAt = p.LastMatchedAt.Format(time.RFC3339)
+	}
+	return []string{
+		p.GetID(),
+		p.EVMChainID.String(),
+		strings.Join(addresses, "\n"),
+		strings.Join(eventSigs, "\n"),
+		p.Retention,
+		strconv.FormatUint(p.MaxLogsKept, 10),
+		strconv.FormatInt(p.LogCount, 10),
+		blockNumber(p.OldestBlockNumber),
+		blockNumber(p.NewestBlockNumber),
+		lastMatchedAt,
+	}
+}
+
+// LogPollerFilterPresenters implements TableRenderer for a slice of LogPollerFilterPresenter.
+type LogPollerFilterPresenters []LogPollerFilterPresenter
+
+// RenderTable implements TableRenderer
+func (ps LogPollerFilterPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(logPollerFilterHeaders, rows, rt.Writer)
+
+	return nil
+}
+
+// ListLogPollerFilters lists the LogPoller filters of a chain along with the stats of their retained logs.
+func (s *Shell) ListLogPollerFilters(c *cli.Context) (err error) {
+	v := url.Values{}
+	v.Add("evmChainID", c.String("evm-chain-id"))
+
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/logpoller/filters?"+v.Encode())
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &LogPollerFilterPresenters{}, "LogPoller Filters")
+}
+
+// ReplayLogPollerFilter replays the logs of a single LogPoller filter from the given block number
+func (s *Shell) ReplayLogPollerFilter(c *cli.Context) (err error) {
+	blockNumber := c.Int64("block-number")
+	if blockNumber <= 0 {
+		return s.errorOut(errors.New("Must pass a positive value in '--block-number' parameter"))
+	}
+	if c.String("name") == "" {
+		return s.errorOut(errors.New("Must set '--name' parameter to specify the filter"))
+	}
+
+	request, err := json.Marshal(web.ReplayLogPollerFilterRequest{
+		EVMChainID: ubig.New(big.NewInt(c.Int64("evm-chain-id"))),
+		Name:       c.String("name"),
+		FromBlock:  blockNumber,
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/logpoller/filters/replay", bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	_, err = s.parseResponse(resp)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	fmt.Printf("Filter %s replayed from block %d\n", c.String("name"), blockNumber)
+	return nil
+}
+
+// ReorgPresenter implements TableRenderer for a ReorgResource.
+type ReorgPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.ReorgResource
+}
+
+var reorgHeaders = []string{"ID", "Chain ID", "Source", "Depth", "LCA Block", "Old Head", "New Head", "Tx IDs", "Log Count", "Detected At"}
+
+// ToRow presents the ReorgResource as a slice of strings.
+func (p *ReorgPresenter) ToRow() []string {
+	txIDs := make([]string, len(p.TxIDs))
+	for i, id := range p.TxIDs {
+		txIDs[i] = strconv.FormatInt(id, 10)
+	}
+	return []string{
+		p.GetID(),
+		p.EVMChainID.String(),
+		p.Source,
+		strconv.FormatInt(p.Depth, 10),
+		fmt.Sprintf("%d %s", p.LCABlockNumber, p.LCABlockHash.Hex()),
+		fmt.Sprintf("%d %s", p.OldHeadNumber, p.OldHeadHash.Hex()),
+		fmt.Sprintf("%d %s", p.NewHeadNumber, p.NewHeadHash.Hex()),
+		strings.Join(txIDs, ", "),
+		strconv.FormatInt(p.LogCount, 10),
+		p.DetectedAt.Format(time.RFC3339),
+	}
+}
+
+// ReorgPresenters implements TableRenderer for a slice of ReorgPresenter.
+type ReorgPresenters []ReorgPresenter
+
+// RenderTable implements TableRenderer
+func (ps ReorgPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(reorgHeaders, rows, rt.Writer)
+
+	return nil
+}
+
+// ListReorgs lists the latest reorgs of a chain, most recent first.
+func (s *Shell) ListReorgs(c *cli.Context) (err error) {
+	v := url.Values{}
+	v.Add("evmChainID", c.String("evm-chain-id"))
+	v.Add("limit", c.String("limit"))
+
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/reorgs?"+v.Encode())
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &ReorgPresenters{}, "Reorgs")
+}
@@ -10,6 +10,7 @@ import (
 
 	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
 	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 )
 
@@ -93,3 +94,71 @@ func Test_FindLCA(t *testing.T) {
 	c = cli.NewContext(nil, set, nil)
 	require.ErrorContains(t, client.FindLCA(c), "FindLCA is only available if LogPoller is enabled")
 }
+
+func Test_LogPollerFilters(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].ChainID = (*ubig.Big)(big.NewInt(5))
+		c.EVM[0].Enabled = ptr(true)
+	})
+
+	client, _ := app.NewShellAndRenderer()
+
+	t.Run("list", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(client.ListLogPollerFilters, set, "")
+
+		require.NoError(t, set.Set("evm-chain-id", "1"))
+		c := cli.NewContext(nil, set, nil)
+		require.ErrorContains(t, client.ListLogPollerFilters(c), "does not match any local chains")
+
+		require.NoError(t, set.Set("evm-chain-id", "5"))
+		c = cli.NewContext(nil, set, nil)
+		require.ErrorContains(t, client.ListLogPollerFilters(c), "log poller disabled")
+	})
+
+	t.Run("replay", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(client.ReplayLogPollerFilter, set, "")
+
+		require.NoError(t, set.Set("evm-chain-id", "5"))
+		require.NoError(t, set.Set("name", "filter"))
+		require.NoError(t, set.Set("block-number", "0"))
+		c := cli.NewContext(nil, set, nil)
+		require.ErrorContains(t, client.ReplayLogPollerFilter(c), "Must pass a positive value in")
+
+		require.NoError(t, set.Set("block-number", "1"))
+		c = cli.NewContext(nil, set, nil)
+		require.ErrorContains(t, client.ReplayLogPollerFilter(c), "log poller disabled")
+	})
+}
+
+func Test_ListReorgs(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].ChainID = (*ubig.Big)(big.NewInt(5))
+		c.EVM[0].Enabled = ptr(true)
+	})
+
+	client, r := app.NewShellAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ListReorgs, set, "")
+
+	require.NoError(t, set.Set("evm-chain-id", "1"))
+	c := cli.NewContext(nil, set, nil)
+	require.ErrorContains(t, client.ListReorgs(c), "does not match any local chains")
+
+	require.NoError(t, set.Set("evm-chain-id", "5"))
+	require.NoError(t, set.Set("limit", "0"))
+	c = cli.NewContext(nil, set, nil)
+	require.ErrorContains(t, client.ListReorgs(c), "limit must be between 1 and 1000")
+
+	require.NoError(t, set.Set("limit", "10"))
+	c = cli.NewContext(nil, set, nil)
+	require.NoError(t, client.ListReorgs(c))
+	reorgs := *r.Renders[0].(*cmd.ReorgPresenters)
+	require.Empty(t, reorgs)
+}
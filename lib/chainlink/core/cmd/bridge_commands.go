@@ -59,9 +59,41 @@ func (p *BridgePresenter) RenderTable(rt RendererTable) error {
 		p.OutgoingToken,
 	})
 	render("Bridge", table)
+
+	if p.HealthCheckURL == "" {
+		return nil
+	}
+	table = rt.newTable([]string{"Health Check URL", "Interval", "Status", "Down Since"})
+	table.Append([]string{
+		p.HealthCheckURL,
+		p.HealthCheckInterval,
+		string(p.HealthStatus),
+		p.FriendlyHealthDownSince(),
+	})
+	render("Health", table)
+
+	table = rt.newTable([]string{"Checked At", "Healthy", "Status Code", "Latency", "Error"})
+	for _, check := range p.HealthChecks {
+		table.Append([]string{
+			check.CheckedAt.String(),
+			strconv.FormatBool(check.Healthy),
+			strconv.Itoa(check.StatusCode),
+			check.Latency,
+			check.Error,
+		})
+	}
+	render("Health Checks", table)
 	return nil
 }
 
+// FriendlyHealthDownSince converts the time the bridge went down to a string
+func (p *BridgePresenter) FriendlyHealthDownSince() string {
+	if p.HealthDownSince == nil {
+		return ""
+	}
+	return p.HealthDownSince.String()
+}
+
 type BridgePresenters []BridgePresenter
 
 // RenderTable implements TableRenderer
@@ -11,6 +11,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/cmd"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
@@ -127,6 +129,43 @@ func TestShell_ShowBridge(t *testing.T) {
 	assert.Equal(t, bt.Confirmations, p.Confirmations)
 }
 
+func TestShell_ShowBridge_HealthCheck(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	healthCheckURL := cltest.WebURL(t, "http://localhost:0/health")
+	bt := &bridges.BridgeType{
+		Name:                bridges.MustParseBridgeName(testutils.RandomizeName("showbridge")),
+		URL:                 cltest.WebURL(t, "https://testing.com/bridges"),
+		HealthCheckURL:      &healthCheckURL,
+		HealthCheckInterval: sqlutil.Interval(time.Minute),
+	}
+	ctx := testutils.Context(t)
+	require.NoError(t, app.BridgeORM().CreateBridgeType(ctx, bt))
+	require.NoError(t, app.BridgeORM().InsertBridgeHealthCheck(ctx, &bridges.BridgeHealthCheck{
+		BridgeName: bt.Name,
+		Error:      "connection refused",
+		CheckedAt:  time.Now(),
+	}))
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ShowBridge, set, "")
+	require.NoError(t, set.Parse([]string{bt.Name.String()}))
+	c := cli.NewContext(nil, set, nil)
+
+	require.NoError(t, client.ShowBridge(c))
+	require.Len(t, r.Renders, 1)
+	p := r.Renders[0].(*cmd.BridgePresenter)
+	assert.Equal(t, healthCheckURL.String(), p.HealthCheckURL)
+	assert.Equal(t, "1m0s", p.HealthCheckInterval)
+	assert.Equal(t, bridges.HealthStatusDown, p.HealthStatus)
+	assert.NotEmpty(t, p.FriendlyHealthDownSince())
+	require.NotEmpty(t, p.HealthChecks)
+	assert.Equal(t, "connection refused", p.HealthChecks[0].Error)
+}
+
 func TestShell_CreateBridge(t *testing.T) {
 	t.Parallel()
 
@@ -137,6 +137,22 @@ func (p *EthKeyPresenter) ToRow() []string {
 	if p.MaxGasPriceWei != nil {
 		gas = p.MaxGasPriceWei.String()
 	}
+	minEth := "None"
+	if p.MinBalance != nil {
+		minEth = p.MinBalance.String()
+	}
+	daysUntilEmpty := "Unknown"
+	if p.DaysUntilEmpty != nil {
+		daysUntilEmpty = strconv.FormatFloat(*p.DaysUntilEmpty, 'f', 1, 64)
+	}
+	var tokens []string
+	for _, t := range p.TokenBalances {
+		token := t.Balance + " " + t.Symbol
+		if t.MinBalance != nil {
+			token += " (min " + *t.MinBalance + ")"
+		}
+		tokens = append(tokens, token)
+	}
 	return []string{
 		p.Address,
 		p.EVMChainID.String(),
@@ -146,10 +162,14 @@ func (p *EthKeyPresenter) ToRow() []string {
 		p.CreatedAt.String(),
 		p.UpdatedAt.String(),
 		gas,
+		minEth,
+		daysUntilEmpty,
+		strings.Join(tokens, "\n"),
+		strconv.FormatBool(p.BelowMinimum),
 	}
 }
 
-var ethKeysTableHeaders = []string{"Address", "EVM Chain ID", "ETH", "LINK", "Disabled", "Created", "Updated", "Max Gas Price Wei"}
+var ethKeysTableHeaders = []string{"Address", "EVM Chain ID", "ETH", "LINK", "Disabled", "Created", "Updated", "Max Gas Price Wei", "Min ETH", "Days Until Empty", "Tokens", "Below Minimum"}
 
 // RenderTable implements TableRenderer
 func (p *EthKeyPresenter) RenderTable(rt RendererTable) error {
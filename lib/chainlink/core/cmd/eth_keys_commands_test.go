@@ -41,6 +41,9 @@ func TestEthKeysPresenter_RenderTable(t *testing.T) {
 		createdAt      = time.Now()
 		updatedAt      = time.Now().Add(time.Second)
 		maxGasPriceWei = ubig.NewI(12345)
+		minBalance     = assets.NewEth(3)
+		daysUntilEmpty = 4.25
+		minLink        = "10"
 		bundleID       = cltest.DefaultOCRKeyBundleID
 		buffer         = bytes.NewBufferString("")
 		r              = cmd.RendererTable{Writer: buffer}
@@ -56,6 +59,12 @@ func TestEthKeysPresenter_RenderTable(t *testing.T) {
 			CreatedAt:      createdAt,
 			UpdatedAt:      updatedAt,
 			MaxGasPriceWei: maxGasPriceWei,
+			MinBalance:     minBalance,
+			DaysUntilEmpty: &daysUntilEmpty,
+			TokenBalances: []presenters.ETHKeyTokenBalance{
+				{Symbol: "LINK", Balance: "1.5", MinBalance: &minLink, BelowMinimum: true},
+			},
+			BelowMinimum: true,
 		},
 	}
 
@@ -70,6 +79,9 @@ func TestEthKeysPresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, createdAt.String())
 	assert.Contains(t, output, updatedAt.String())
 	assert.Contains(t, output, maxGasPriceWei.String())
+	assert.Contains(t, output, minBalance.String())
+	assert.Contains(t, output, "4.2")
+	assert.Contains(t, output, "1.5 LINK (min 10)")
 
 	// Render many resources
 	buffer.Reset()
@@ -84,6 +96,9 @@ func TestEthKeysPresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, createdAt.String())
 	assert.Contains(t, output, updatedAt.String())
 	assert.Contains(t, output, maxGasPriceWei.String())
+	assert.Contains(t, output, minBalance.String())
+	assert.Contains(t, output, "4.2")
+	assert.Contains(t, output, "1.5 LINK (min 10)")
 }
 
 func TestShell_ListETHKeys(t *testing.T) {
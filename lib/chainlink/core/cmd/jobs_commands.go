@@ -6,6 +6,8 @@ import (
 	stderrors "errors"
 	"fmt"
 	"io"
+	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -50,6 +52,21 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Trigger a job run",
 			Action: s.TriggerPipelineRun,
 		},
+		{
+			Name:   "simulate",
+			Usage:  "Run the pipeline of a job spec against canned task responses, without creating the job",
+			Action: s.SimulateJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "fixtures, f",
+					Usage: "`FILE` containing the JSON fixtures for tasks that perform network or chain I/O",
+				},
+				cli.StringFlag{
+					Name:  "vars",
+					Usage: "JSON object of pipeline variables, e.g. '{\"jobRun\":{\"meta\":{}}}'",
+				},
+			},
+		},
 	}
 }
 
@@ -317,3 +334,101 @@ func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	err = s.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// JobSimulationPresenter wraps the JSONAPI job simulation resource and adds rendering functionality
+type JobSimulationPresenter struct {
+	JAID
+	presenters.JobSimulationResource
+}
+
+// RenderTable implements TableRenderer
+func (p *JobSimulationPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Task", "Type", "Mocked", "Output", "Error", "Duration"})
+	for _, t := range p.Tasks {
+		output, err := json.Marshal(t.Output)
+		if err != nil {
+			return err
+		}
+		var taskErr string
+		if t.Error != nil {
+			taskErr = *t.Error
+		}
+		table.Append([]string{
+			t.DotID,
+			t.Type.String(),
+			strconv.FormatBool(t.Mocked),
+			string(output),
+			taskErr,
+			t.Duration.String(),
+		})
+	}
+	render("Simulated Tasks", table)
+
+	table = rt.newTable([]string{"Output", "Fatal Error"})
+	for i, o := range p.Outputs {
+		output, err := json.Marshal(o)
+		if err != nil {
+			return err
+		}
+		var fatalErr string
+		if i < len(p.FatalErrors) && p.FatalErrors[i] != nil {
+			fatalErr = *p.FatalErrors[i]
+		}
+		table.Append([]string{string(output), fatalErr})
+	}
+	render("Simulated Run", table)
+	return nil
+}
+
+// SimulateJob runs the pipeline of a job spec in simulation mode and prints a per-task trace.
+// Valid input is a TOML string or a path to TOML file. It fails if the run had fatal errors,
+// so it can be used to check job specs in CI.
+func (s *Shell) SimulateJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	request := web.SimulateJobRequest{TOML: tomlString}
+	if fixturesFile := c.String("fixtures"); fixturesFile != "" {
+		request.Fixtures, err = os.ReadFile(fixturesFile)
+		if err != nil {
+			return s.errorOut(errors.Wrapf(err, "failed to read fixtures file %s", fixturesFile))
+		}
+	}
+	if vars := c.String("vars"); vars != "" {
+		if err = json.Unmarshal([]byte(vars), &request.Vars); err != nil {
+			return s.errorOut(errors.Wrap(err, "failed to parse vars"))
+		}
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/simulate", bytes.NewReader(body))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	var p JobSimulationPresenter
+	if err = s.renderAPIResponse(resp, &p, "Job simulation"); err != nil {
+		return err
+	}
+	for _, fatalErr := range p.FatalErrors {
+		if fatalErr != nil {
+			return s.errorOut(errors.New("simulated run finished with fatal errors"))
+		}
+	}
+	return nil
+}
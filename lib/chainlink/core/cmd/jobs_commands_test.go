@@ -5,6 +5,8 @@ import (
 	_ "embed"
 	"flag"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -453,6 +455,59 @@ func TestShell_DeleteJob(t *testing.T) {
 	requireJobsCount(t, app.JobORM(), 0)
 }
 
+const simulateWebhookSpecTemplate = `
+type          = "webhook"
+schemaVersion = 1
+externalJobID = "%s"
+observationSource = """
+    ds          [type=http method=GET url="https://example.invalid/price"];
+    ds_parse    [type=jsonparse path="data,result"];
+    ds_multiply [type=multiply times=100];
+    ds -> ds_parse -> ds_multiply;
+"""
+`
+
+func TestShell_SimulateJob(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	spec := fmt.Sprintf(simulateWebhookSpecTemplate, uuid.New())
+	fixtures := filepath.Join(t.TempDir(), "fixtures.json")
+	require.NoError(t, os.WriteFile(fixtures, []byte(`{"tasks": {"ds": {"value": "{\"data\":{\"result\":3}}"}}}`), 0600))
+
+	// Must supply a spec
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.SimulateJob, set, "")
+	require.EqualError(t, client.SimulateJob(cli.NewContext(nil, set, nil)), "must pass in TOML or filepath")
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.SimulateJob, set, "")
+	require.NoError(t, set.Parse([]string{"--fixtures", fixtures, spec}))
+	require.NoError(t, client.SimulateJob(cli.NewContext(nil, set, nil)))
+
+	require.Len(t, r.Renders, 1)
+	output, ok := r.Renders[0].(*cmd.JobSimulationPresenter)
+	require.True(t, ok, "Expected Renders[0] to be *cmd.JobSimulationPresenter, got %T", r.Renders[0])
+	assert.Equal(t, []any{"300"}, output.Outputs)
+	require.Len(t, output.Tasks, 3)
+	assert.True(t, output.Tasks[0].Mocked)
+
+	// Without fixtures the http task fails, and so does the command
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.SimulateJob, set, "")
+	require.NoError(t, set.Parse([]string{spec}))
+	require.EqualError(t, client.SimulateJob(cli.NewContext(nil, set, nil)), "simulated run finished with fatal errors")
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.SimulateJob, set, "")
+	require.NoError(t, set.Parse([]string{"--vars", "not json", spec}))
+	require.ErrorContains(t, client.SimulateJob(cli.NewContext(nil, set, nil)), "failed to parse vars")
+
+	requireJobsCount(t, app.JobORM(), 0)
+}
+
 func requireJobsCount(t *testing.T, orm job.ORM, expected int) {
 	ctx := testutils.Context(t)
 	jobs, _, err := orm.FindJobs(ctx, 0, 1000)
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initKeepersSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:   "simulate",
+			Usage:  "Run checkUpkeep and performUpkeep of an upkeep against its registry at a block, without sending a transaction",
+			Action: s.SimulateUpkeep,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:     "registry",
+					Usage:    "Address of the keeper or automation registry",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:     "upkeep",
+					Usage:    "ID of the upkeep, in decimal or 0x prefixed hex",
+					Required: true,
+				},
+				cli.Int64Flag{
+					Name:  "block",
+					Usage: "Block number to simulate at, defaults to the latest block",
+				},
+				cli.StringFlag{
+					Name:  "from",
+					Usage: "Keeper address to check and perform as, v1 registries only. Defaults to the first keeper of the registry",
+				},
+				cli.Int64Flag{
+					Name:     "evm-chain-id",
+					Usage:    "Chain ID of the EVM-based blockchain",
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+// UpkeepSimulationPresenter implements TableRenderer for an UpkeepSimulationResource.
+type UpkeepSimulationPresenter struct {
+	JAID
+	presenters.UpkeepSimulationResource
+}
+
+// RenderTable implements TableRenderer
+func (p *UpkeepSimulationPresenter) RenderTable(rt RendererTable) error {
+	var performSuccess, gasEstimate string
+	if p.PerformSuccess != nil {
+		performSuccess = strconv.FormatBool(*p.PerformSuccess)
+	}
+	if p.GasEstimate != nil {
+		gasEstimate = strconv.FormatUint(*p.GasEstimate, 10)
+	}
+	table := rt.newTable([]string{"Registry", "Version", "Upkeep ID", "Block", "From"})
+	table.Append([]string{
+		p.Registry,
+		p.TypeAndVersion,
+		p.UpkeepID,
+		strconv.FormatInt(p.BlockNumber, 10),
+		stringOrEmpty(p.From),
+	})
+	render("Upkeep", table)
+
+	table = rt.newTable([]string{"Eligible", "Failure Reason", "Perform Data", "Perform Success", "Gas Estimate", "Revert Reason"})
+	table.Append([]string{
+		strconv.FormatBool(p.Eligible),
+		p.FailureReason,
+		p.PerformData,
+		performSuccess,
+		gasEstimate,
+		p.RevertReason,
+	})
+	render("Simulation", table)
+	return nil
+}
+
+// SimulateUpkeep runs the check and perform path of an upkeep against its registry and
+// prints the check result, perform data, gas estimate and revert reason.
+func (s *Shell) SimulateUpkeep(c *cli.Context) (err error) {
+	request := web.SimulateUpkeepRequest{
+		EVMChainID: c.String("evm-chain-id"),
+		Registry:   c.String("registry"),
+		UpkeepID:   c.String("upkeep"),
+		From:       c.String("from"),
+	}
+	if c.IsSet("block") {
+		block := c.Int64("block")
+		request.BlockNumber = &block
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/keepers/simulate", bytes.NewReader(body))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &UpkeepSimulationPresenter{}, "Upkeep simulation")
+}
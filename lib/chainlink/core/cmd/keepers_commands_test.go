@@ -0,0 +1,46 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestUpkeepSimulationPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer      = bytes.NewBufferString("")
+		r           = cmd.RendererTable{Writer: buffer}
+		success     = true
+		gasEstimate = uint64(80000)
+	)
+
+	p := cmd.UpkeepSimulationPresenter{
+		UpkeepSimulationResource: presenters.UpkeepSimulationResource{
+			JAID:           presenters.NewJAID("7"),
+			Registry:       "0x0000000000000000000000000000000000000001",
+			TypeAndVersion: "AutomationRegistry 2.2.0",
+			UpkeepID:       "7",
+			BlockNumber:    100,
+			Eligible:       true,
+			FailureReason:  "NONE",
+			PerformData:    "0x0102",
+			PerformSuccess: &success,
+			GasEstimate:    &gasEstimate,
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+	output := buffer.String()
+	assert.Contains(t, output, "AutomationRegistry 2.2.0")
+	assert.Contains(t, output, "100")
+	assert.Contains(t, output, "NONE")
+	assert.Contains(t, output, "0x0102")
+	assert.Contains(t, output, "80000")
+}
@@ -0,0 +1,218 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func initKeysExportBundleSubCmd(s *Shell, beforeLocal cli.BeforeFunc) cli.Command {
+	return cli.Command{
+		Name:   "export-bundle",
+		Usage:  "Local command for exporting all of the node's keys, and the chains the EVM keys are enabled for, to a single encrypted bundle. The bundle password can be split into Shamir shares with --shares and --threshold",
+		Action: s.ExportKeystoreBundle,
+		Before: func(c *cli.Context) error {
+			if err := beforeLocal(c); err != nil {
+				return err
+			}
+			return s.BeforeNode(c)
+		},
+		After: s.AfterNode,
+		Flags: []cli.Flag{
+			cli.StringSliceFlag{
+				Name:  "config, c",
+				Usage: "TOML configuration file(s) via flag, or raw TOML via env var. If used, legacy env vars must not be set. Multiple files can be used (-c configA.toml -c configB.toml), and they are applied in order with duplicated fields overriding any earlier values. If the 'CL_CONFIG' env var is specified, it is always processed last with the effect of being the final override. [$CL_CONFIG]",
+			},
+			cli.StringSliceFlag{
+				Name:  "secrets, s",
+				Usage: "TOML configuration file for secrets. Must be set if and only if config is set. Multiple files can be used (-s secretsA.toml -s secretsB.toml), and fields from the files will be merged. No overrides are allowed.",
+			},
+			cli.StringFlag{
+				Name:  "password, p",
+				Usage: "`FILE` containing the keystore password, instead of the one in the secrets",
+			},
+			cli.StringFlag{
+				Name:     "output, o",
+				Usage:    "`FILE` to write the bundle to. Shares are written next to it, to FILE.share-1 and so on",
+				Required: true,
+			},
+			cli.StringFlag{
+				Name:  "bundle-password",
+				Usage: "`FILE` containing the password to encrypt the bundle with, unless --shares is set",
+			},
+			cli.IntFlag{
+				Name:  "shares",
+				Usage: "number of shares to split the bundle password into, instead of using --bundle-password",
+			},
+			cli.IntFlag{
+				Name:  "threshold",
+				Usage: "number of shares needed to decrypt the bundle",
+			},
+		},
+	}
+}
+
+func initKeysImportBundleSubCmd(s *Shell, beforeLocal cli.BeforeFunc) cli.Command {
+	return cli.Command{
+		Name:   "import-bundle",
+		Usage:  "Local command for restoring a bundle created by export-bundle into the keystore of a new node. Must be run before the node is started for the first time",
+		Action: s.ImportKeystoreBundle,
+		Before: func(c *cli.Context) error {
+			if err := beforeLocal(c); err != nil {
+				return err
+			}
+			return s.BeforeNode(c)
+		},
+		After: s.AfterNode,
+		Flags: []cli.Flag{
+			cli.StringSliceFlag{
+				Name:  "config, c",
+				Usage: "TOML configuration file(s) via flag, or raw TOML via env var. If used, legacy env vars must not be set. Multiple files can be used (-c configA.toml -c configB.toml), and they are applied in order with duplicated fields overriding any earlier values. If the 'CL_CONFIG' env var is specified, it is always processed last with the effect of being the final override. [$CL_CONFIG]",
+			},
+			cli.StringSliceFlag{
+				Name:  "secrets, s",
+				Usage: "TOML configuration file for secrets. Must be set if and only if config is set. Multiple files can be used (-s secretsA.toml -s secretsB.toml), and fields from the files will be merged. No overrides are allowed.",
+			},
+			cli.StringFlag{
+				Name:  "password, p",
+				Usage: "`FILE` containing the keystore password to encrypt the keys with, instead of the one in the secrets",
+			},
+			cli.StringFlag{
+				Name:     "bundle",
+				Usage:    "`FILE` containing the bundle",
+				Required: true,
+			},
+			cli.StringFlag{
+				Name:  "bundle-password",
+				Usage: "`FILE` containing the password of the bundle",
+			},
+			cli.StringSliceFlag{
+				Name:  "share",
+				Usage: "`FILE` containing a share of the bundle password, can be repeated",
+			},
+		},
+	}
+}
+
+// ExportKeystoreBundle writes all the keys of the node to an encrypted bundle, and its shares if requested.
+func (s *Shell) ExportKeystoreBundle(c *cli.Context) error {
+	password := s.Config.Password().Keystore()
+	if password == "" {
+		return s.errorOut(errors.New("the keystore password must be given in the secrets, or with --password"))
+	}
+	output := c.String("output")
+	scryptParams := utils.GetScryptParams(s.Config)
+
+	var bundle *keystore.Bundle
+	var shares []keystore.BundleShare
+	switch {
+	case c.IsSet("shares") && c.IsSet("bundle-password"):
+		return s.errorOut(errors.New("--bundle-password and --shares cannot be used together"))
+	case c.IsSet("shares"):
+		threshold := c.Int("threshold")
+		if !c.IsSet("threshold") {
+			return s.errorOut(errors.New("--threshold must be set with --shares"))
+		}
+		var err error
+		bundle, shares, err = keystore.ExportBundleShares(s.ctx(), s.DS, password, threshold, c.Int("shares"), scryptParams)
+		if err != nil {
+			return s.errorOut(errors.Wrap(err, "failed to export keystore bundle"))
+		}
+	case c.IsSet("bundle-password"):
+		bundlePassword, err := utils.PasswordFromFile(c.String("bundle-password"))
+		if err != nil {
+			return s.errorOut(errors.Wrap(err, "error reading bundle password from file"))
+		}
+		if err = utils.VerifyPasswordComplexity(bundlePassword); err != nil {
+			return s.errorOut(errors.Wrap(err, "bundle password is too weak"))
+		}
+		bundle, err = keystore.ExportBundle(s.ctx(), s.DS, password, bundlePassword, scryptParams)
+		if err != nil {
+			return s.errorOut(errors.Wrap(err, "failed to export keystore bundle"))
+		}
+	default:
+		return s.errorOut(errors.New("either --bundle-password or --shares must be set"))
+	}
+
+	if err := writeJSONFile(output, bundle); err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to write bundle"))
+	}
+	fmt.Printf("Exported keystore bundle %s to %s\n", bundle.ID, output)
+	for _, share := range shares {
+		path := fmt.Sprintf("%s.share-%d", output, share.Index+1)
+		if err := writeJSONFile(path, share); err != nil {
+			return s.errorOut(errors.Wrap(err, "failed to write share"))
+		}
+		fmt.Printf("Exported share %d of %d (threshold %d) to %s\n", share.Index+1, len(shares), share.Threshold, path)
+	}
+	return nil
+}
+
+// ImportKeystoreBundle restores an encrypted bundle into the empty keystore of the node.
+func (s *Shell) ImportKeystoreBundle(c *cli.Context) error {
+	password := s.Config.Password().Keystore()
+	if password == "" {
+		return s.errorOut(errors.New("the keystore password must be given in the secrets, or with --password"))
+	}
+	var bundle keystore.Bundle
+	if err := readJSONFile(c.String("bundle"), &bundle); err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to read bundle"))
+	}
+
+	var bundlePassword string
+	switch {
+	case c.IsSet("share") && c.IsSet("bundle-password"):
+		return s.errorOut(errors.New("--bundle-password and --share cannot be used together"))
+	case c.IsSet("share"):
+		var shares []keystore.BundleShare
+		for _, path := range c.StringSlice("share") {
+			var share keystore.BundleShare
+			if err := readJSONFile(path, &share); err != nil {
+				return s.errorOut(errors.Wrap(err, "failed to read share"))
+			}
+			shares = append(shares, share)
+		}
+		var err error
+		bundlePassword, err = keystore.CombineBundleShares(&bundle, shares)
+		if err != nil {
+			return s.errorOut(errors.Wrap(err, "failed to combine shares"))
+		}
+	case c.IsSet("bundle-password"):
+		var err error
+		bundlePassword, err = utils.PasswordFromFile(c.String("bundle-password"))
+		if err != nil {
+			return s.errorOut(errors.Wrap(err, "error reading bundle password from file"))
+		}
+	default:
+		return s.errorOut(errors.New("either --bundle-password or --share must be set"))
+	}
+
+	err := keystore.ImportBundle(s.ctx(), s.DS, &bundle, bundlePassword, password, utils.GetScryptParams(s.Config))
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to import keystore bundle"))
+	}
+	fmt.Printf("Imported keystore bundle %s created at %s\n", bundle.ID, bundle.CreatedAt)
+	return nil
+}
+
+func writeJSONFile(path string, v any) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return utils.WriteFileWithMaxPerms(path, b, 0o600)
+}
+
+func readJSONFile(path string, v any) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
@@ -0,0 +1,97 @@
+package cmd_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestShell_ExportImportKeystoreBundle(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		s.Password.Keystore = models.NewSecret(cltest.Password)
+	})
+	lggr := logger.TestLogger(t)
+
+	db := pgtest.NewSqlxDB(t)
+	ks := keystore.New(db, utils.FastScryptParams, lggr.Infof)
+	require.NoError(t, ks.Unlock(ctx, cltest.Password))
+	key, _ := cltest.MustInsertRandomKey(t, ks.Eth())
+
+	dir := t.TempDir()
+	bundleFile := filepath.Join(dir, "bundle.json")
+
+	t.Run("with a bundle password", func(t *testing.T) {
+		bundlePasswordFile := filepath.Join(dir, "bundle-password.txt")
+		require.NoError(t, os.WriteFile(bundlePasswordFile, []byte("b4ndl3-p4ssw0rd-for-the-keystore"), 0600))
+
+		shell := cmd.Shell{Config: cfg, Logger: lggr, DS: db}
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(shell.ExportKeystoreBundle, set, "")
+		require.NoError(t, set.Set("output", bundleFile))
+		require.NoError(t, set.Set("bundle-password", bundlePasswordFile))
+		require.NoError(t, shell.ExportKeystoreBundle(cli.NewContext(nil, set, nil)))
+
+		targetDB := pgtest.NewSqlxDB(t)
+		shell = cmd.Shell{Config: cfg, Logger: lggr, DS: targetDB}
+		set = flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(shell.ImportKeystoreBundle, set, "")
+		require.NoError(t, set.Set("bundle", bundleFile))
+		require.NoError(t, set.Set("bundle-password", bundlePasswordFile))
+		require.NoError(t, shell.ImportKeystoreBundle(cli.NewContext(nil, set, nil)))
+
+		restored := keystore.New(targetDB, utils.FastScryptParams, lggr.Infof)
+		require.NoError(t, restored.Unlock(ctx, cltest.Password))
+		got, err := restored.Eth().Get(ctx, key.ID())
+		require.NoError(t, err)
+		require.Equal(t, key.Address, got.Address)
+	})
+
+	t.Run("with shares", func(t *testing.T) {
+		shell := cmd.Shell{Config: cfg, Logger: lggr, DS: db}
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(shell.ExportKeystoreBundle, set, "")
+		require.NoError(t, set.Set("output", bundleFile))
+		require.NoError(t, set.Set("shares", "3"))
+		require.ErrorContains(t, shell.ExportKeystoreBundle(cli.NewContext(nil, set, nil)), "--threshold must be set with --shares")
+		require.NoError(t, set.Set("threshold", "2"))
+		require.NoError(t, shell.ExportKeystoreBundle(cli.NewContext(nil, set, nil)))
+		for i := 1; i <= 3; i++ {
+			require.FileExists(t, bundleFile+".share-"+strconv.Itoa(i))
+		}
+
+		targetDB := pgtest.NewSqlxDB(t)
+		shell = cmd.Shell{Config: cfg, Logger: lggr, DS: targetDB}
+		set = flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(shell.ImportKeystoreBundle, set, "")
+		require.NoError(t, set.Set("bundle", bundleFile))
+		require.NoError(t, set.Set("share", bundleFile+".share-3"))
+		require.ErrorContains(t, shell.ImportKeystoreBundle(cli.NewContext(nil, set, nil)), "2 shares are needed, got 1")
+		require.NoError(t, set.Set("share", bundleFile+".share-1"))
+		require.NoError(t, shell.ImportKeystoreBundle(cli.NewContext(nil, set, nil)))
+
+		restored := keystore.New(targetDB, utils.FastScryptParams, lggr.Infof)
+		require.NoError(t, restored.Unlock(ctx, cltest.Password))
+		got, err := restored.Eth().Get(ctx, key.ID())
+		require.NoError(t, err)
+		require.Equal(t, key.Address, got.Address)
+	})
+}
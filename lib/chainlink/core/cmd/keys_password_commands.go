@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func initKeysRotatePasswordSubCmd(s *Shell, beforeLocal cli.BeforeFunc) cli.Command {
+	return cli.Command{
+		Name:   "rotate-password",
+		Usage:  "Local command for re-encrypting all of the node's keys with a new keystore password. Must be run while the node is stopped. The previous key ring is backed up to the encrypted_key_ring_backups table, where it stays decryptable with the old password until it is deleted with prune-password-backups",
+		Action: s.RotateKeystorePassword,
+		Before: func(c *cli.Context) error {
+			if err := beforeLocal(c); err != nil {
+				return err
+			}
+			return s.BeforeNode(c)
+		},
+		After: s.AfterNode,
+		Flags: []cli.Flag{
+			cli.StringSliceFlag{
+				Name:  "config, c",
+				Usage: "TOML configuration file(s) via flag, or raw TOML via env var. If used, legacy env vars must not be set. Multiple files can be used (-c configA.toml -c configB.toml), and they are applied in order with duplicated fields overriding any earlier values. If the 'CL_CONFIG' env var is specified, it is always processed last with the effect of being the final override. [$CL_CONFIG]",
+			},
+			cli.StringSliceFlag{
+				Name:  "secrets, s",
+				Usage: "TOML configuration file for secrets. Must be set if and only if config is set. Multiple files can be used (-s secretsA.toml -s secretsB.toml), and fields from the files will be merged. No overrides are allowed.",
+			},
+			cli.StringFlag{
+				Name:  "password, p",
+				Usage: "`FILE` containing the current keystore password, instead of the one in the secrets",
+			},
+			cli.StringFlag{
+				Name:     "new-password",
+				Usage:    "`FILE` containing the new keystore password",
+				Required: true,
+			},
+			cli.IntFlag{
+				Name:  "scrypt-n",
+				Usage: "scrypt N parameter to encrypt the key ring with, defaults to the one used by the node",
+			},
+			cli.IntFlag{
+				Name:  "scrypt-p",
+				Usage: "scrypt P parameter to encrypt the key ring with, defaults to the one used by the node",
+			},
+		},
+	}
+}
+
+// RotateKeystorePassword re-encrypts the key ring with a new password, after backing up the previous one.
+func (s *Shell) RotateKeystorePassword(c *cli.Context) error {
+	oldPassword := s.Config.Password().Keystore()
+	if oldPassword == "" {
+		return s.errorOut(errors.New("the current keystore password must be given in the secrets, or with --password"))
+	}
+	newPassword, err := utils.PasswordFromFile(c.String("new-password"))
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "error reading new password from file"))
+	}
+	if err = utils.VerifyPasswordComplexity(newPassword); err != nil {
+		return s.errorOut(errors.Wrap(err, "new password is too weak"))
+	}
+
+	scryptParams := utils.GetScryptParams(s.Config)
+	if c.IsSet("scrypt-n") {
+		scryptParams.N = c.Int("scrypt-n")
+	}
+	if c.IsSet("scrypt-p") {
+		scryptParams.P = c.Int("scrypt-p")
+	}
+
+	backupID, err := keystore.RotatePassword(s.ctx(), s.DS, oldPassword, newPassword, scryptParams)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to rotate keystore password"))
+	}
+	fmt.Printf("Rotated keystore password. The previous key ring was backed up with ID %d.\n", backupID)
+	fmt.Println("Update the keystore password in the secrets before restarting the node.")
+	fmt.Println("The backup can still be decrypted with the old password. Delete it with prune-password-backups once the node runs with the new one.")
+	return nil
+}
+
+func initKeysPrunePasswordBackupsSubCmd(s *Shell, beforeLocal cli.BeforeFunc) cli.Command {
+	return cli.Command{
+		Name:   "prune-password-backups",
+		Usage:  "Local command for deleting the key rings backed up by rotate-password, which can still be decrypted with the previous keystore passwords",
+		Action: s.PruneKeystorePasswordBackups,
+		Before: func(c *cli.Context) error {
+			if err := beforeLocal(c); err != nil {
+				return err
+			}
+			return s.BeforeNode(c)
+		},
+		After: s.AfterNode,
+		Flags: []cli.Flag{
+			cli.StringSliceFlag{
+				Name:  "config, c",
+				Usage: "TOML configuration file(s) via flag, or raw TOML via env var. If used, legacy env vars must not be set. Multiple files can be used (-c configA.toml -c configB.toml), and they are applied in order with duplicated fields overriding any earlier values. If the 'CL_CONFIG' env var is specified, it is always processed last with the effect of being the final override. [$CL_CONFIG]",
+			},
+			cli.StringSliceFlag{
+				Name:  "secrets, s",
+				Usage: "TOML configuration file for secrets. Must be set if and only if config is set. Multiple files can be used (-s secretsA.toml -s secretsB.toml), and fields from the files will be merged. No overrides are allowed.",
+			},
+			cli.IntFlag{
+				Name:  "keep",
+				Usage: "number of the most recent backups to keep",
+			},
+		},
+	}
+}
+
+// PruneKeystorePasswordBackups deletes the key rings backed up by RotateKeystorePassword, except the most recent ones.
+func (s *Shell) PruneKeystorePasswordBackups(c *cli.Context) error {
+	keep := c.Int("keep")
+	if keep < 0 {
+		return s.errorOut(errors.New("--keep must not be negative"))
+	}
+	deleted, err := keystore.PrunePasswordBackups(s.ctx(), s.DS, keep)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to prune keystore password backups"))
+	}
+	fmt.Printf("Deleted %d key ring backup(s).\n", deleted)
+	return nil
+}
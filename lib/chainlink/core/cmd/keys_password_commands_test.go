@@ -0,0 +1,77 @@
+package cmd_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestShell_RotateKeystorePassword(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		s.Password.Keystore = models.NewSecret(cltest.Password)
+	})
+	lggr := logger.TestLogger(t)
+
+	ks := keystore.New(db, utils.FastScryptParams, lggr.Infof)
+	require.NoError(t, ks.Unlock(ctx, cltest.Password))
+	key, _ := cltest.MustInsertRandomKey(t, ks.Eth())
+
+	shell := cmd.Shell{
+		Config: cfg,
+		Logger: lggr,
+		DS:     db,
+	}
+	dir := t.TempDir()
+	rotate := func(newPassword string) error {
+		newPasswordFile := filepath.Join(dir, "new-password.txt")
+		require.NoError(t, os.WriteFile(newPasswordFile, []byte(newPassword), 0600))
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(shell.RotateKeystorePassword, set, "")
+		require.NoError(t, set.Set("new-password", newPasswordFile))
+		return shell.RotateKeystorePassword(cli.NewContext(nil, set, nil))
+	}
+
+	t.Run("rejects a weak new password", func(t *testing.T) {
+		require.ErrorContains(t, rotate("short"), "new password is too weak")
+	})
+
+	t.Run("re-encrypts the key ring", func(t *testing.T) {
+		const newPassword = "n3w-p4ssw0rd-for-the-keystore"
+		require.NoError(t, rotate(newPassword))
+		cltest.AssertCount(t, db, "encrypted_key_ring_backups", 1)
+
+		rotated := keystore.New(db, utils.FastScryptParams, lggr.Infof)
+		require.Error(t, rotated.Unlock(ctx, cltest.Password))
+		require.NoError(t, rotated.Unlock(ctx, newPassword))
+		got, err := rotated.Eth().Get(ctx, key.ID())
+		require.NoError(t, err)
+		require.Equal(t, key.Address, got.Address)
+	})
+
+	t.Run("prunes the backups", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(shell.PruneKeystorePasswordBackups, set, "")
+		require.NoError(t, set.Set("keep", "0"))
+		require.NoError(t, shell.PruneKeystorePasswordBackups(cli.NewContext(nil, set, nil)))
+		cltest.AssertCount(t, db, "encrypted_key_ring_backups", 0)
+	})
+}
@@ -2,12 +2,14 @@ package cmd_test
 
 import (
 	"bytes"
+	"flag"
 	"strings"
 	"testing"
 
 	"github.com/pelletier/go-toml/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/config"
 	solcfg "github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
@@ -164,3 +166,25 @@ func TestShell_IndexSolanaNodes(t *testing.T) {
 	assert.Contains(t, renderLines[12], "State")
 	assert.Contains(t, renderLines[12], n2.State)
 }
+
+func TestShell_ShowEVMNodeScores(t *testing.T) {
+	t.Parallel()
+
+	chainID := newRandChainID()
+	app := startNewApplicationV2(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].ChainID = chainID
+		c.EVM[0].Enabled = ptr(true)
+	})
+	client, _ := app.NewShellAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ShowEVMNodeScores, set, "")
+
+	require.NoError(t, set.Set("evm-chain-id", "1"))
+	c := cli.NewContext(nil, set, nil)
+	require.ErrorContains(t, client.ShowEVMNodeScores(c), "does not match any local chains")
+
+	require.NoError(t, set.Set("evm-chain-id", chainID.String()))
+	c = cli.NewContext(nil, set, nil)
+	require.ErrorContains(t, client.ShowEVMNodeScores(c), "consistency probes are not enabled")
+}
@@ -1,10 +1,14 @@
 package cmd
 
 import (
+	stderrors "errors"
 	"fmt"
 	"maps"
+	"net/url"
 	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/urfave/cli"
 
@@ -18,7 +22,22 @@ func initNodeSubCmds(s *Shell) []cli.Command {
 		if network == relay.NetworkDummy {
 			continue
 		}
-		cmds = append(cmds, nodeCommand(network, NewNodeClient(s, network)))
+		cmd := nodeCommand(network, NewNodeClient(s, network))
+		if network == relay.NetworkEVM {
+			cmd.Subcommands = append(cmd.Subcommands, cli.Command{
+				Name:   "scores",
+				Usage:  "Show the consistency scores of the EVM nodes of a chain, along with their quarantine reasons",
+				Action: s.ShowEVMNodeScores,
+				Flags: []cli.Flag{
+					cli.Int64Flag{
+						Name:     "evm-chain-id",
+						Usage:    "Chain ID of the EVM-based blockchain",
+						Required: true,
+					},
+				},
+			})
+		}
+		cmds = append(cmds, cmd)
 	}
 	return cmds
 }
@@ -101,3 +120,63 @@ func (cli *nodeClient[P2]) IndexNodes(c *cli.Context) (err error) {
 }
 
 var nodeHeaders = []string{"Name", "Chain ID", "State", "Config"}
+
+// EVMNodeScorePresenter implements TableRenderer for an EVMNodeScoreResource.
+type EVMNodeScorePresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.EVMNodeScoreResource
+}
+
+var evmNodeScoreHeaders = []string{"Name", "Chain ID", "Score", "Quarantined", "Quarantine Reason", "Latency", "Disagreements", "Last Failure", "Last Probed At"}
+
+// ToRow presents the EVMNodeScoreResource as a slice of strings.
+func (p *EVMNodeScorePresenter) ToRow() []string {
+	var lastProbedAt string
+	if p.LastProbedAt != nil {
+		lastProbedAt = p.LastProbedAt.Format(time.RFC3339)
+	}
+	return []string{
+		p.GetID(),
+		p.EVMChainID.String(),
+		strconv.Itoa(p.Score),
+		strconv.FormatBool(p.Quarantined),
+		p.QuarantineReason,
+		p.Latency,
+		strconv.FormatUint(p.Disagreements, 10),
+		p.LastFailure,
+		lastProbedAt,
+	}
+}
+
+// EVMNodeScorePresenters implements TableRenderer for a slice of EVMNodeScorePresenter.
+type EVMNodeScorePresenters []EVMNodeScorePresenter
+
+// RenderTable implements TableRenderer
+func (ps EVMNodeScorePresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(evmNodeScoreHeaders, rows, rt.Writer)
+
+	return nil
+}
+
+// ShowEVMNodeScores shows the consistency scores of the EVM nodes of a chain, along with their quarantine reasons.
+func (s *Shell) ShowEVMNodeScores(c *cli.Context) (err error) {
+	v := url.Values{}
+	v.Add("evmChainID", c.String("evm-chain-id"))
+
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/nodes/evm/scores?"+v.Encode())
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &EVMNodeScorePresenters{}, "EVM Node Scores")
+}
@@ -0,0 +1,207 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initPipelineFragmentsSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:   "list",
+			Usage:  "List all pipeline fragments",
+			Action: s.ListPipelineFragments,
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  "page",
+					Usage: "page of results to display",
+				},
+			},
+		},
+		{
+			Name:   "show",
+			Usage:  "Show a pipeline fragment, including its source",
+			Action: s.ShowPipelineFragment,
+		},
+		{
+			Name:      "create",
+			Usage:     "Create a pipeline fragment from a file containing DOT",
+			ArgsUsage: "NAME FILE",
+			Action:    s.CreatePipelineFragment,
+		},
+		{
+			Name:      "update",
+			Usage:     "Replace the source of a pipeline fragment and list the jobs created from it",
+			ArgsUsage: "NAME FILE",
+			Action:    s.UpdatePipelineFragment,
+		},
+		{
+			Name:   "delete",
+			Usage:  "Delete a pipeline fragment which is not used by any job or other fragment",
+			Action: s.DeletePipelineFragment,
+		},
+	}
+}
+
+type PipelineFragmentPresenter struct {
+	presenters.PipelineFragmentResource
+}
+
+// RenderTable implements TableRenderer
+func (p *PipelineFragmentPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Name", "Created", "Updated"})
+	table.Append([]string{
+		p.Name,
+		p.CreatedAt.String(),
+		p.UpdatedAt.String(),
+	})
+	render("Pipeline Fragment", table)
+
+	if len(p.AffectedJobIDs) > 0 || len(p.AffectedFragments) > 0 {
+		var jobIDs []string
+		for _, id := range p.AffectedJobIDs {
+			jobIDs = append(jobIDs, strconv.Itoa(int(id)))
+		}
+		table = rt.newTable([]string{"Affected Jobs", "Affected Fragments"})
+		table.Append([]string{
+			strings.Join(jobIDs, ", "),
+			strings.Join(p.AffectedFragments, ", "),
+		})
+		render("Affected", table)
+	}
+
+	_, err := fmt.Fprintln(rt, p.Source)
+	return err
+}
+
+type PipelineFragmentPresenters []PipelineFragmentPresenter
+
+// RenderTable implements TableRenderer
+func (ps PipelineFragmentPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Name", "Created", "Updated"})
+	for _, p := range ps {
+		table.Append([]string{
+			p.Name,
+			p.CreatedAt.String(),
+			p.UpdatedAt.String(),
+		})
+	}
+
+	render("Pipeline Fragments", table)
+	return nil
+}
+
+// ListPipelineFragments lists all pipeline fragments.
+func (s *Shell) ListPipelineFragments(c *cli.Context) (err error) {
+	return s.getPage("/v2/pipeline/fragments", c.Int("page"), &PipelineFragmentPresenters{})
+}
+
+// ShowPipelineFragment shows a pipeline fragment by name.
+func (s *Shell) ShowPipelineFragment(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the name of the fragment to be shown"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/pipeline/fragments/"+c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &PipelineFragmentPresenter{})
+}
+
+// CreatePipelineFragment creates a pipeline fragment from a DOT file.
+func (s *Shell) CreatePipelineFragment(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return s.errorOut(errors.New("must pass the name of the fragment and the path to its source"))
+	}
+	request, err := pipelineFragmentRequest(c.Args().Get(0), c.Args().Get(1))
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/pipeline/fragments", request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &PipelineFragmentPresenter{}, "Pipeline fragment created")
+}
+
+// UpdatePipelineFragment replaces the source of a pipeline fragment with the
+// contents of a DOT file, and shows the jobs and fragments which use it.
+func (s *Shell) UpdatePipelineFragment(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return s.errorOut(errors.New("must pass the name of the fragment and the path to its new source"))
+	}
+	name := c.Args().Get(0)
+	request, err := pipelineFragmentRequest(name, c.Args().Get(1))
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Patch(s.ctx(), "/v2/pipeline/fragments/"+name, request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &PipelineFragmentPresenter{}, "Pipeline fragment updated")
+}
+
+// DeletePipelineFragment deletes a pipeline fragment by name.
+func (s *Shell) DeletePipelineFragment(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the name of the fragment to be deleted"))
+	}
+	resp, err := s.HTTP.Delete(s.ctx(), "/v2/pipeline/fragments/"+c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+	if _, err = s.parseResponse(resp); err != nil {
+		return s.errorOut(err)
+	}
+
+	fmt.Printf("Pipeline fragment %v deleted\n", c.Args().First())
+	return nil
+}
+
+func pipelineFragmentRequest(name, path string) (*bytes.Reader, error) {
+	source, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	request, err := json.Marshal(web.PipelineFragmentRequest{Name: name, Source: string(source)})
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(request), nil
+}
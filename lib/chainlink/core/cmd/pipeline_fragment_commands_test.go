@@ -0,0 +1,91 @@
+package cmd_test
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestPipelineFragmentPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.PipelineFragmentPresenter{
+		PipelineFragmentResource: presenters.PipelineFragmentResource{
+			JAID:              presenters.NewJAID("median3"),
+			Name:              "median3",
+			Source:            "answer [type=median]",
+			CreatedAt:         time.Now(),
+			UpdatedAt:         time.Now(),
+			AffectedJobIDs:    []int32{7, 9},
+			AffectedFragments: []string{"prices"},
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+	output := buffer.String()
+	assert.Contains(t, output, "median3")
+	assert.Contains(t, output, "answer [type=median]")
+	assert.Contains(t, output, "7, 9")
+	assert.Contains(t, output, "prices")
+
+	buffer.Reset()
+	require.NoError(t, cmd.PipelineFragmentPresenters{p}.RenderTable(r))
+	output = buffer.String()
+	assert.Contains(t, output, "median3")
+	assert.NotContains(t, output, "answer [type=median]")
+}
+
+func TestShell_PipelineFragments(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	name := testutils.RandomizeName("scale")
+	dir := t.TempDir()
+	v1 := filepath.Join(dir, "v1.dot")
+	require.NoError(t, os.WriteFile(v1, []byte(`mul [type=multiply input="$(fragment.input)" times=2]`), 0600))
+	v2 := filepath.Join(dir, "v2.dot")
+	require.NoError(t, os.WriteFile(v2, []byte(`mul [type=multiply input="$(fragment.input)" times=3]`), 0600))
+
+	run := func(action func(*cli.Context) error, args ...string) error {
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(action, set, "")
+		require.NoError(t, set.Parse(args))
+		return action(cli.NewContext(nil, set, nil))
+	}
+
+	require.NoError(t, run(client.CreatePipelineFragment, name, v1))
+	created := *r.Renders[0].(*cmd.PipelineFragmentPresenter)
+	assert.Equal(t, name, created.Name)
+
+	require.NoError(t, run(client.ListPipelineFragments))
+	require.Len(t, *r.Renders[1].(*cmd.PipelineFragmentPresenters), 1)
+
+	require.NoError(t, run(client.UpdatePipelineFragment, name, v2))
+	updated := *r.Renders[2].(*cmd.PipelineFragmentPresenter)
+	assert.Contains(t, updated.Source, "times=3")
+
+	require.NoError(t, run(client.ShowPipelineFragment, name))
+	assert.Contains(t, r.Renders[3].(*cmd.PipelineFragmentPresenter).Source, "times=3")
+
+	require.Error(t, run(client.CreatePipelineFragment, name))
+	require.NoError(t, run(client.DeletePipelineFragment, name))
+	require.Error(t, run(client.ShowPipelineFragment, name))
+}
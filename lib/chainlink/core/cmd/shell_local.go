@@ -9,9 +9,11 @@ import (
 	"math/big"
 	"net/http"
 	"os"
+	ossignal "os/signal"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	gethCommon "github.com/ethereum/go-ethereum/common"
@@ -33,13 +35,16 @@ import (
 	"github.com/smartcontractkit/chainlink-evm/pkg/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink-evm/pkg/gas"
 	"github.com/smartcontractkit/chainlink-evm/pkg/keys"
+	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
 	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
 
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/periodicbackup"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/shutdown"
@@ -260,6 +265,35 @@ func initLocalSubCmds(s *Shell, safe bool) []cli.Command {
 						},
 					},
 				},
+				{
+					Name:   "backup",
+					Usage:  "Take a backup of the database now, as configured in Database.Backup. Backups are stored in Database.Backup.Target, and encrypted if Database.Backup.Encrypt is set.",
+					Action: s.BackupDatabase,
+					Before: s.validateDB,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "password, p",
+							Usage: "text file holding the keystore password, used to encrypt the backup",
+						},
+					},
+				},
+				{
+					Name:      "restore",
+					Usage:     "Restore the database from a backup in Database.Backup.Target. WARNING: This will REPLACE ALL data in the database, and must not be run while the node is running.",
+					ArgsUsage: "[NAME]",
+					Action:    s.RestoreDatabase,
+					Before:    s.validateDB,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "password, p",
+							Usage: "text file holding the keystore password, used to decrypt the backup",
+						},
+						cli.BoolFlag{
+							Name:  "danger",
+							Usage: "set to true to enable restoring into non-test databases",
+						},
+					},
+				},
 			},
 		},
 		{
@@ -281,6 +315,63 @@ func initLocalSubCmds(s *Shell, safe bool) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:        "logpoller",
+			Usage:       "Commands for exporting and importing LogPoller data.",
+			Description: "Archives of LogPoller logs and blocks can be used to seed the database of a new node, instead of replaying them from the RPC.",
+			Subcommands: []cli.Command{
+				{
+					Name:   "export",
+					Usage:  "Export finalized LogPoller logs matching the given filters, along with their blocks, to a compressed archive file",
+					Action: s.ExportLogPollerData,
+					Before: s.BeforeNode,
+					After:  s.AfterNode,
+					Flags: []cli.Flag{
+						cli.Int64Flag{
+							Name:     "evm-chain-id",
+							Usage:    "Chain ID of the EVM-based blockchain",
+							Required: true,
+						},
+						cli.StringFlag{
+							Name:     "file, f",
+							Usage:    "path of the archive file to create",
+							Required: true,
+						},
+						cli.StringSliceFlag{
+							Name:  "filter",
+							Usage: "name of a LogPoller filter whose logs are exported. Can be repeated. If left blank, all filters are exported",
+						},
+						cli.Int64Flag{
+							Name:  "from",
+							Usage: "beginning of the block range to export",
+						},
+						cli.Int64Flag{
+							Name:  "to",
+							Usage: "end of the block range to export (inclusive). If left blank, the latest finalized block is used",
+						},
+					},
+				},
+				{
+					Name:   "import",
+					Usage:  "Import LogPoller logs and blocks from an archive file, after verifying the block hashes against the chain",
+					Action: s.ImportLogPollerData,
+					Before: s.BeforeNode,
+					After:  s.AfterNode,
+					Flags: []cli.Flag{
+						cli.Int64Flag{
+							Name:     "evm-chain-id",
+							Usage:    "Chain ID of the EVM-based blockchain",
+							Required: true,
+						},
+						cli.StringFlag{
+							Name:     "file, f",
+							Usage:    "path of the archive file to import",
+							Required: true,
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -586,6 +677,24 @@ func (s *Shell) runNode(c *cli.Context) error {
 		return nil
 	})
 
+	grp.Go(func() error {
+		// SIGHUP reloads the config, see ReloadConfig
+		hup := make(chan os.Signal, 1)
+		ossignal.Notify(hup, syscall.SIGHUP)
+		defer ossignal.Stop(hup)
+		for {
+			select {
+			case <-grpCtx.Done():
+				return nil
+			case <-hup:
+				lggr.Info("Reloading config due to SIGHUP signal received...")
+				if _, errInternal := app.ReloadConfig(); errInternal != nil {
+					lggr.Errorw("Failed to reload config", "err", errInternal)
+				}
+			}
+		}
+	})
+
 	lggr.Infow(fmt.Sprintf("Chainlink booted in %.2fs", time.Since(static.InitTime).Seconds()), "appID", app.ID())
 
 	grp.Go(func() error {
@@ -1018,6 +1127,59 @@ func (s *Shell) CleanupChainTables(c *cli.Context) error {
 	return nil
 }
 
+// BackupDatabase takes a backup of the database, like the periodic backups.
+func (s *Shell) BackupDatabase(c *cli.Context) error {
+	backup, err := s.newDatabaseBackup(c)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	if err = backup.RunBackup(static.Version); err != nil {
+		return s.errorOut(err)
+	}
+	return nil
+}
+
+// RestoreDatabase replaces the contents of the database with the backup given
+// as argument, or the latest backup if none is given.
+func (s *Shell) RestoreDatabase(c *cli.Context) error {
+	parsed := s.Config.Database().URL()
+	if parsed.String() == "" {
+		return s.errorOut(errDBURLMissing)
+	}
+	dbname := parsed.Path[1:]
+	if !c.Bool("danger") && !strings.HasSuffix(dbname, "_test") {
+		return s.errorOut(fmt.Errorf("cannot restore into database named `%s`. This command can only be run against databases with a name that ends in `_test`, to prevent accidental data loss. If you really want to replace all data in this database, pass in the --danger option", dbname))
+	}
+
+	backup, err := s.newDatabaseBackup(c)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	name, err := backup.Restore(s.ctx(), c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	fmt.Printf("Restored backup %s.\n", name)
+	return nil
+}
+
+func (s *Shell) newDatabaseBackup(c *cli.Context) (periodicbackup.DatabaseBackup, error) {
+	cfg := s.Config.Database()
+	parsed := cfg.URL()
+	if parsed.String() == "" {
+		return nil, errDBURLMissing
+	}
+	password := s.Config.Password().Keystore()
+	if passwordFile := c.String("password"); passwordFile != "" {
+		p, err := utils.PasswordFromFile(passwordFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "error reading password from file")
+		}
+		password = p
+	}
+	return periodicbackup.NewDatabaseBackup(parsed, s.Config.RootDir(), cfg.Backup(), password, s.Logger)
+}
+
 func migrateDB(ctx context.Context, config store.Config) error {
 	db, err := store.NewConnection(ctx, config)
 	if err != nil {
@@ -1075,6 +1237,101 @@ func (s *Shell) RemoveBlocks(c *cli.Context) error {
 	return nil
 }
 
+// ExportLogPollerData writes an archive of LogPoller logs and blocks to a file.
+func (s *Shell) ExportLogPollerData(c *cli.Context) error {
+	path := c.String("file")
+	if path == "" {
+		return s.errorOut(errors.New("Must pass the archive path in '--file' parameter"))
+	}
+	opts := logpoller.ArchiveOpts{
+		FromBlock: c.Int64("from"),
+		ToBlock:   c.Int64("to"),
+		Filters:   c.StringSlice("filter"),
+	}
+	if opts.FromBlock < 0 || opts.ToBlock < 0 {
+		return s.errorOut(errors.New("Block range must not be negative"))
+	}
+
+	lggr := logger.Sugared(s.Logger.Named("ExportLogPollerData"))
+	app, ctx, chainID, err := s.newLogPollerDataApp(c, lggr)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to create archive file"))
+	}
+	stats, err := app.ExportLogPollerData(ctx, chainID, f, opts)
+	if err = stderrors.Join(err, f.Close()); err != nil {
+		if rmErr := os.Remove(path); rmErr != nil {
+			lggr.Warnw("Failed to remove incomplete archive file", "path", path, "err", rmErr)
+		}
+		return s.errorOut(err)
+	}
+
+	lggr.Infow("ExportLogPollerData: successfully exported LogPoller data", "path", path, "fromBlock", stats.FromBlock, "toBlock", stats.ToBlock,
+		"filters", stats.Filters, "blocks", stats.Blocks, "logs", stats.Logs)
+
+	return nil
+}
+
+// ImportLogPollerData inserts LogPoller logs and blocks from an archive file.
+func (s *Shell) ImportLogPollerData(c *cli.Context) error {
+	path := c.String("file")
+	if path == "" {
+		return s.errorOut(errors.New("Must pass the archive path in '--file' parameter"))
+	}
+
+	lggr := logger.Sugared(s.Logger.Named("ImportLogPollerData"))
+	app, ctx, chainID, err := s.newLogPollerDataApp(c, lggr)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to open archive file"))
+	}
+	defer f.Close()
+
+	stats, err := app.ImportLogPollerData(ctx, chainID, f)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	lggr.Infow("ImportLogPollerData: successfully imported LogPoller data", "path", path, "fromBlock", stats.FromBlock, "toBlock", stats.ToBlock,
+		"filters", stats.Filters, "blocks", stats.Blocks, "logs", stats.Logs)
+
+	return nil
+}
+
+// newLogPollerDataApp validates the configuration and instantiates the application for the chain given by --evm-chain-id.
+func (s *Shell) newLogPollerDataApp(c *cli.Context, lggr logger.SugaredLogger) (chainlink.Application, context.Context, *big.Int, error) {
+	chainID := big.NewInt(0)
+	if err := chainID.UnmarshalText([]byte(c.String("evm-chain-id"))); err != nil {
+		return nil, nil, nil, err
+	}
+
+	if err := s.Config.Validate(); err != nil {
+		return nil, nil, nil, fmt.Errorf("error validating configuration: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go shutdown.HandleShutdown(func(sig string) {
+		cancel()
+		lggr.Info("received signal to stop - closing the database and releasing lock")
+
+		s.afterNode(lggr)
+	})
+
+	app, err := s.AppFactory.NewApplication(ctx, s.Config, s.Logger, s.Registerer, s.DS, s.KeyStore)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "fatal error instantiating application")
+	}
+	return app, ctx, chainID, nil
+}
+
 // beforeNode performs the actual initialization of DB, keystore, and telemetry.
 // It handles password loading, database connection, keystore authentication, and Beholder setup.
 func (s *Shell) beforeNode(c *cli.Context) error {
@@ -1113,7 +1370,7 @@ func (s *Shell) beforeNode(c *cli.Context) error {
 	// From now on, DB locks and DB connection will be released on every return.
 	// Keep watching on logger.Fatal* calls and os.Exit(), because defer will not be executed.
 
-	err := handleNodeVersioning(ctx, db, lggr, cfg.RootDir(), cfg.Database(), cfg.WebServer().HTTPPort())
+	err := handleNodeVersioning(ctx, db, lggr, cfg.RootDir(), cfg.Database(), cfg.Password().Keystore(), cfg.WebServer().HTTPPort())
 	if err != nil {
 		return err
 	}
@@ -3,8 +3,10 @@ package cmd_test
 import (
 	"errors"
 	"flag"
+	"io"
 	"math/big"
 	"os"
+	"path/filepath"
 	"strconv"
 	"sync"
 	"testing"
@@ -24,6 +26,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mailbox"
 	"github.com/smartcontractkit/chainlink-evm/pkg/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink-evm/pkg/client/clienttest"
+	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
 	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr/txmgrtest"
 	"github.com/smartcontractkit/chainlink-framework/multinode"
 
@@ -505,6 +508,85 @@ func TestShell_RemoveBlocks(t *testing.T) {
 	})
 }
 
+func TestShell_LogPollerData(t *testing.T) {
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		s.Password.Keystore = models.NewSecret("dummy")
+		c.EVM[0].Nodes[0].Name = ptr("fake")
+		c.EVM[0].Nodes[0].HTTPURL = commonconfig.MustParseURL("http://fake.com")
+		c.EVM[0].Nodes[0].WSURL = commonconfig.MustParseURL("WSS://fake.com/ws")
+		// seems to be needed for config validate
+		c.Insecure.OCRDevelopmentMode = nil
+	})
+
+	app := mocks.NewApplication(t)
+	shell := cmd.Shell{
+		Config:                 cfg,
+		AppFactory:             cltest.InstanceAppFactory{App: app},
+		FallbackAPIInitializer: cltest.NewMockAPIInitializer(t),
+		Runner:                 cltest.EmptyRunner{},
+		Logger:                 logger.TestLogger(t),
+	}
+	archive := []byte("archive")
+
+	t.Run("export", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "logs.gz")
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(shell.ExportLogPollerData, set, "")
+		require.NoError(t, set.Set("evm-chain-id", "12"))
+		require.NoError(t, set.Set("file", path))
+		require.NoError(t, set.Set("filter", "f1"))
+		require.NoError(t, set.Set("from", "100"))
+		opts := logpoller.ArchiveOpts{FromBlock: 100, Filters: []string{"f1"}}
+		app.On("ExportLogPollerData", mock.Anything, big.NewInt(12), mock.Anything, opts).Return(logpoller.ArchiveStats{}, nil).Run(func(args mock.Arguments) {
+			_, err := args.Get(2).(io.Writer).Write(archive)
+			assert.NoError(t, err)
+		}).Once()
+		require.NoError(t, shell.ExportLogPollerData(cli.NewContext(nil, set, nil)))
+
+		b, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, archive, b)
+
+		// existing archives are not overwritten
+		require.ErrorContains(t, shell.ExportLogPollerData(cli.NewContext(nil, set, nil)), "failed to create archive file")
+	})
+
+	t.Run("export removes the file on failure", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "logs.gz")
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(shell.ExportLogPollerData, set, "")
+		require.NoError(t, set.Set("evm-chain-id", "12"))
+		require.NoError(t, set.Set("file", path))
+		expectedError := errors.New("failed to export LogPoller data")
+		app.On("ExportLogPollerData", mock.Anything, big.NewInt(12), mock.Anything, logpoller.ArchiveOpts{}).Return(logpoller.ArchiveStats{}, expectedError).Once()
+		require.ErrorContains(t, shell.ExportLogPollerData(cli.NewContext(nil, set, nil)), expectedError.Error())
+		assert.NoFileExists(t, path)
+	})
+
+	t.Run("import", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "logs.gz")
+		require.NoError(t, os.WriteFile(path, archive, 0600))
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(shell.ImportLogPollerData, set, "")
+		require.NoError(t, set.Set("evm-chain-id", "12"))
+		require.NoError(t, set.Set("file", path))
+		app.On("ImportLogPollerData", mock.Anything, big.NewInt(12), mock.Anything).Return(logpoller.ArchiveStats{}, nil).Run(func(args mock.Arguments) {
+			b, err := io.ReadAll(args.Get(2).(io.Reader))
+			assert.NoError(t, err)
+			assert.Equal(t, archive, b)
+		}).Once()
+		require.NoError(t, shell.ImportLogPollerData(cli.NewContext(nil, set, nil)))
+	})
+
+	t.Run("import fails for missing file", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(shell.ImportLogPollerData, set, "")
+		require.NoError(t, set.Set("evm-chain-id", "12"))
+		require.NoError(t, set.Set("file", filepath.Join(t.TempDir(), "missing.gz")))
+		require.ErrorContains(t, shell.ImportLogPollerData(cli.NewContext(nil, set, nil)), "failed to open archive file")
+	})
+}
+
 func TestShell_BeforeNode(t *testing.T) {
 	tests := []struct {
 		name         string
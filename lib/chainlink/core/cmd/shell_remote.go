@@ -69,6 +69,11 @@ func initRemoteConfigSubCmds(s *Shell) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:   "reload",
+			Usage:  "Reload the configuration, applying the changes which do not require a restart",
+			Action: s.ReloadConfig,
+		},
 		{
 			Name:  "validate",
 			Usage: "DEPRECATED. Use `chainlink node validate`",
@@ -399,6 +404,44 @@ func (s *Shell) SetLogSQL(c *cli.Context) (err error) {
 	return err
 }
 
+// ConfigReloadPresenter wraps the result of reloading the config for the CLI
+type ConfigReloadPresenter struct {
+	webpresenters.ConfigReloadResource
+}
+
+// RenderTable implements TableRenderer
+func (p *ConfigReloadPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Path", "Old", "New"})
+	for _, ch := range p.Applied {
+		table.Append([]string{ch.Path, ch.Old, ch.New})
+	}
+	render("Applied", table)
+
+	table = rt.newTable([]string{"Path", "Reason"})
+	for _, ch := range p.Rejected {
+		table.Append([]string{ch.Path, ch.Reason})
+	}
+	render("Rejected", table)
+	return nil
+}
+
+// ReloadConfig reloads the configuration of the node
+func (s *Shell) ReloadConfig(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/config/reload", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = stderrors.Join(err, cerr)
+		}
+	}()
+
+	var report ConfigReloadPresenter
+	err = s.renderAPIResponse(resp, &report)
+	return err
+}
+
 func getBufferFromJSON(s string) (*bytes.Buffer, error) {
 	if gjson.Valid(s) {
 		return bytes.NewBufferString(s), nil
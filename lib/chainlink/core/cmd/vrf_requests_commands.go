@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initVRFSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "requests",
+			Usage: "Commands for inspecting VRF v2 and v2.5 requests",
+			Subcommands: []cli.Command{
+				{
+					Name:      "show",
+					Usage:     "Show the state, last error and fulfillment transaction of a request, for every job which has seen it",
+					ArgsUsage: "REQUEST_ID",
+					Action:    s.ShowVRFRequest,
+				},
+			},
+		},
+	}
+}
+
+type VRFRequestPresenter struct {
+	presenters.VRFRequestResource
+}
+
+// RenderTable implements TableRenderer
+func (p *VRFRequestPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Job ID", "Request ID", "Version", "Subscription", "Sender", "Request Tx", "State", "Last Error"})
+	table.Append([]string{
+		strconv.Itoa(int(p.JobID)),
+		p.RequestID,
+		p.VRFVersion,
+		p.SubID,
+		p.Sender,
+		p.RequestTxHash,
+		p.State,
+		p.LastError,
+	})
+	render("VRF Request", table)
+
+	if p.EthTxID != nil {
+		table = rt.newTable([]string{"Tx ID", "State", "Hash", "Error"})
+		table.Append([]string{
+			strconv.FormatInt(*p.EthTxID, 10),
+			stringOrEmpty(p.EthTxState),
+			stringOrEmpty(p.EthTxHash),
+			stringOrEmpty(p.EthTxError),
+		})
+		render("Fulfillment Transaction", table)
+	}
+
+	table = rt.newTable([]string{"Time", "State", "Error", "Tx ID"})
+	for _, t := range p.Transitions {
+		var txID string
+		if t.EthTxID != nil {
+			txID = strconv.FormatInt(*t.EthTxID, 10)
+		}
+		table.Append([]string{
+			t.CreatedAt.String(),
+			t.State,
+			t.Error,
+			txID,
+		})
+	}
+	render("Transitions", table)
+	return nil
+}
+
+type VRFRequestPresenters []VRFRequestPresenter
+
+// RenderTable implements TableRenderer
+func (ps VRFRequestPresenters) RenderTable(rt RendererTable) error {
+	for _, p := range ps {
+		if err := p.RenderTable(rt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ShowVRFRequest shows the lifecycle of a VRF request, given its ID in decimal or 0x prefixed hex.
+func (s *Shell) ShowVRFRequest(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the ID of the request to be shown"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/vrf/requests/"+url.PathEscape(c.Args().First()))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &VRFRequestPresenters{})
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
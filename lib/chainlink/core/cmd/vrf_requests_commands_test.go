@@ -0,0 +1,93 @@
+package cmd_test
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestVRFRequestPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer  = bytes.NewBufferString("")
+		r       = cmd.RendererTable{Writer: buffer}
+		txID    = int64(11)
+		txState = "confirmed"
+	)
+
+	p := cmd.VRFRequestPresenter{
+		VRFRequestResource: presenters.VRFRequestResource{
+			JAID:       presenters.NewJAID("1"),
+			JobID:      3,
+			RequestID:  "1234",
+			VRFVersion: "V2Plus",
+			SubID:      "42",
+			State:      string(vrfcommon.RequestStateEnqueued),
+			EthTxID:    &txID,
+			EthTxState: &txState,
+			Transitions: []presenters.VRFRequestTransitionResource{
+				{State: string(vrfcommon.RequestStateInsufficientFunds), Error: "balance too low", CreatedAt: time.Now()},
+				{State: string(vrfcommon.RequestStateEnqueued), EthTxID: &txID, CreatedAt: time.Now()},
+			},
+		},
+	}
+
+	require.NoError(t, cmd.VRFRequestPresenters{p}.RenderTable(r))
+	output := buffer.String()
+	assert.Contains(t, output, "1234")
+	assert.Contains(t, output, "42")
+	assert.Contains(t, output, "enqueued")
+	assert.Contains(t, output, "confirmed")
+	assert.Contains(t, output, "balance too low")
+}
+
+func TestShell_ShowVRFRequest(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+	ctx := testutils.Context(t)
+
+	jb, _ := cltest.MustInsertWebhookSpec(t, app.GetDB())
+	require.NoError(t, app.VRFRequestORM().CreateRequest(ctx, &vrfcommon.Request{
+		JobID:              jb.ID,
+		RequestID:          "1234",
+		EVMChainID:         *ubig.New(testutils.FixtureChainID),
+		CoordinatorAddress: testutils.NewAddress(),
+		VRFVersion:         vrfcommon.V2,
+		SubID:              "42",
+		Sender:             testutils.NewAddress(),
+		RequestTxHash:      testutils.Random32Byte(),
+		State:              vrfcommon.RequestStateUnconfirmed,
+	}))
+
+	run := func(args ...string) error {
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(client.ShowVRFRequest, set, "")
+		require.NoError(t, set.Parse(args))
+		return client.ShowVRFRequest(cli.NewContext(nil, set, nil))
+	}
+
+	require.NoError(t, run("0x4d2"))
+	requests := *r.Renders[0].(*cmd.VRFRequestPresenters)
+	require.Len(t, requests, 1)
+	assert.Equal(t, "42", requests[0].SubID)
+	assert.Equal(t, string(vrfcommon.RequestStateUnconfirmed), requests[0].State)
+
+	require.Error(t, run())
+	require.Error(t, run("1"))
+}
@@ -64,6 +64,7 @@ type AppConfig interface {
 	CRE() CRE
 	Billing() Billing
 	BridgeStatusReporter() BridgeStatusReporter
+	RemoteSigner() RemoteSigner
 }
 
 type DatabaseBackupMode string
@@ -73,3 +74,11 @@ var (
 	DatabaseBackupModeLite DatabaseBackupMode = "lite"
 	DatabaseBackupModeFull DatabaseBackupMode = "full"
 )
+
+type DatabaseBackupTarget string
+
+var (
+	DatabaseBackupTargetLocal DatabaseBackupTarget = "local"
+	DatabaseBackupTargetS3    DatabaseBackupTarget = "s3"
+	DatabaseBackupTargetSFTP  DatabaseBackupTarget = "sftp"
+)
@@ -11,6 +11,27 @@ type Backup interface {
 	Mode() DatabaseBackupMode
 	OnVersionUpgrade() bool
 	URL() *url.URL
+	Target() DatabaseBackupTarget
+	Encrypt() bool
+	Retention() uint32
+	Verify() bool
+	S3() BackupS3
+	SFTP() BackupSFTP
+}
+
+type BackupS3 interface {
+	Endpoint() string
+	Bucket() string
+	Region() string
+	Prefix() string
+	UsePathStyle() bool
+}
+
+type BackupSFTP interface {
+	Addr() string
+	User() string
+	Dir() string
+	IdentityFile() string
 }
 
 type Lock interface {
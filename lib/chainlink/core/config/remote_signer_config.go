@@ -0,0 +1,17 @@
+package config
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/types"
+)
+
+// RemoteSigner configures the external signer holding the EVM keys, see package keystore/remotesigner.
+type RemoteSigner interface {
+	Enabled() bool
+	URL() *url.URL
+	Timeout() time.Duration
+	// OCR2OnchainAddress is the address of the key signing EVM OCR2 reports, or empty to sign them locally.
+	OCR2OnchainAddress() types.EIP55Address
+}
@@ -64,6 +64,7 @@ type Core struct {
 	CRE                  CreConfig            `toml:",omitempty"`
 	Billing              Billing              `toml:",omitempty"`
 	BridgeStatusReporter BridgeStatusReporter `toml:",omitempty"`
+	RemoteSigner         RemoteSigner         `toml:",omitempty"`
 }
 
 // SetFrom updates c with any non-nil values from f. (currently TOML field only!)
@@ -109,6 +110,7 @@ func (c *Core) SetFrom(f *Core) {
 	c.CRE.setFrom(&f.CRE)
 	c.Billing.setFrom(&f.Billing)
 	c.BridgeStatusReporter.setFrom(&f.BridgeStatusReporter)
+	c.RemoteSigner.setFrom(&f.RemoteSigner)
 }
 
 func (c *Core) ValidateConfig() (err error) {
@@ -712,6 +714,13 @@ type DatabaseBackup struct {
 	Frequency        *commonconfig.Duration
 	Mode             *config.DatabaseBackupMode
 	OnVersionUpgrade *bool
+	Target           *config.DatabaseBackupTarget
+	Encrypt          *bool
+	Retention        *uint32
+	Verify           *bool
+
+	S3   DatabaseBackupS3   `toml:",omitempty"`
+	SFTP DatabaseBackupSFTP `toml:",omitempty"`
 }
 
 func (d *DatabaseBackup) setFrom(f *DatabaseBackup) {
@@ -727,6 +736,91 @@ func (d *DatabaseBackup) setFrom(f *DatabaseBackup) {
 	if v := f.OnVersionUpgrade; v != nil {
 		d.OnVersionUpgrade = v
 	}
+	if v := f.Target; v != nil {
+		d.Target = v
+	}
+	if v := f.Encrypt; v != nil {
+		d.Encrypt = v
+	}
+	if v := f.Retention; v != nil {
+		d.Retention = v
+	}
+	if v := f.Verify; v != nil {
+		d.Verify = v
+	}
+	d.S3.setFrom(&f.S3)
+	d.SFTP.setFrom(&f.SFTP)
+}
+
+func (d *DatabaseBackup) ValidateConfig() (err error) {
+	if d.Target == nil {
+		return
+	}
+	switch *d.Target {
+	case config.DatabaseBackupTargetLocal:
+	case config.DatabaseBackupTargetS3:
+		if d.S3.Bucket == nil || *d.S3.Bucket == "" {
+			err = errors.Join(err, configutils.ErrMissing{Name: "S3.Bucket", Msg: "required when Target is s3"})
+		}
+	case config.DatabaseBackupTargetSFTP:
+		if d.SFTP.Addr == nil || *d.SFTP.Addr == "" {
+			err = errors.Join(err, configutils.ErrMissing{Name: "SFTP.Addr", Msg: "required when Target is sftp"})
+		}
+		if d.SFTP.Dir == nil || *d.SFTP.Dir == "" {
+			err = errors.Join(err, configutils.ErrMissing{Name: "SFTP.Dir", Msg: "required when Target is sftp"})
+		}
+	default:
+		err = errors.Join(err, configutils.ErrInvalid{Name: "Target", Value: *d.Target, Msg: "must be one of local, s3 or sftp"})
+	}
+	return
+}
+
+type DatabaseBackupS3 struct {
+	Endpoint     *commonconfig.URL
+	Bucket       *string
+	Region       *string
+	Prefix       *string
+	UsePathStyle *bool
+}
+
+func (d *DatabaseBackupS3) setFrom(f *DatabaseBackupS3) {
+	if v := f.Endpoint; v != nil {
+		d.Endpoint = v
+	}
+	if v := f.Bucket; v != nil {
+		d.Bucket = v
+	}
+	if v := f.Region; v != nil {
+		d.Region = v
+	}
+	if v := f.Prefix; v != nil {
+		d.Prefix = v
+	}
+	if v := f.UsePathStyle; v != nil {
+		d.UsePathStyle = v
+	}
+}
+
+type DatabaseBackupSFTP struct {
+	Addr         *string
+	User         *string
+	Dir          *string
+	IdentityFile *string
+}
+
+func (d *DatabaseBackupSFTP) setFrom(f *DatabaseBackupSFTP) {
+	if v := f.Addr; v != nil {
+		d.Addr = v
+	}
+	if v := f.User; v != nil {
+		d.User = v
+	}
+	if v := f.Dir; v != nil {
+		d.Dir = v
+	}
+	if v := f.IdentityFile; v != nil {
+		d.IdentityFile = v
+	}
 }
 
 type TelemetryIngress struct {
@@ -872,18 +966,20 @@ func (l *LogFile) setFrom(f *LogFile) {
 }
 
 type WebServer struct {
-	AuthenticationMethod    *string
-	AllowOrigins            *string
-	BridgeResponseURL       *commonconfig.URL
-	BridgeCacheTTL          *commonconfig.Duration
-	HTTPWriteTimeout        *commonconfig.Duration
-	HTTPPort                *uint16
-	SecureCookies           *bool
-	SessionTimeout          *commonconfig.Duration
-	SessionReaperExpiration *commonconfig.Duration
-	HTTPMaxSize             *utils.FileSize
-	StartTimeout            *commonconfig.Duration
-	ListenIP                *net.IP
+	AuthenticationMethod          *string
+	AllowOrigins                  *string
+	BridgeResponseURL             *commonconfig.URL
+	BridgeCacheTTL                *commonconfig.Duration
+	BridgeCircuitBreakerThreshold *uint32
+	BridgeCircuitBreakerCooldown  *commonconfig.Duration
+	HTTPWriteTimeout              *commonconfig.Duration
+	HTTPPort                      *uint16
+	SecureCookies                 *bool
+	SessionTimeout                *commonconfig.Duration
+	SessionReaperExpiration       *commonconfig.Duration
+	HTTPMaxSize                   *utils.FileSize
+	StartTimeout                  *commonconfig.Duration
+	ListenIP                      *net.IP
 
 	LDAP      WebServerLDAP      `toml:",omitempty"`
 	OIDC      WebServerOIDC      `toml:",omitempty"`
@@ -905,6 +1001,12 @@ func (w *WebServer) setFrom(f *WebServer) {
 	if v := f.BridgeCacheTTL; v != nil {
 		w.BridgeCacheTTL = v
 	}
+	if v := f.BridgeCircuitBreakerThreshold; v != nil {
+		w.BridgeCircuitBreakerThreshold = v
+	}
+	if v := f.BridgeCircuitBreakerCooldown; v != nil {
+		w.BridgeCircuitBreakerCooldown = v
+	}
 	if v := f.HTTPWriteTimeout; v != nil {
 		w.HTTPWriteTimeout = v
 	}
@@ -2627,6 +2729,44 @@ func (e *BridgeStatusReporter) ValidateConfig() error {
 	return nil
 }
 
+type RemoteSigner struct {
+	Enabled *bool
+	URL     *commonconfig.URL
+	Timeout *commonconfig.Duration
+	// Optional
+	OCR2OnchainAddress *types.EIP55Address
+}
+
+func (r *RemoteSigner) setFrom(f *RemoteSigner) {
+	if v := f.Enabled; v != nil {
+		r.Enabled = v
+	}
+	if v := f.URL; v != nil {
+		r.URL = v
+	}
+	if v := f.Timeout; v != nil {
+		r.Timeout = v
+	}
+	if v := f.OCR2OnchainAddress; v != nil {
+		r.OCR2OnchainAddress = v
+	}
+}
+
+func (r *RemoteSigner) ValidateConfig() (err error) {
+	if r.Enabled == nil || !*r.Enabled {
+		return nil
+	}
+	if r.URL == nil || r.URL.IsZero() {
+		err = errors.Join(err, configutils.ErrMissing{Name: "URL", Msg: "must be set when RemoteSigner is enabled"})
+	} else if s := r.URL.URL().Scheme; s != "http" && s != "https" {
+		err = errors.Join(err, configutils.ErrInvalid{Name: "URL", Value: r.URL.String(), Msg: "must be an http or https URL"})
+	}
+	if r.Timeout != nil && r.Timeout.Duration() <= 0 {
+		err = errors.Join(err, configutils.ErrInvalid{Name: "Timeout", Value: r.Timeout.Duration(), Msg: "must be positive"})
+	}
+	return err
+}
+
 type JobDistributor struct {
 	DisplayName *string
 }
@@ -857,6 +857,49 @@ func TestBridgeStatusReporter_ValidateConfig(t *testing.T) {
 	}
 }
 
+func TestRemoteSigner_ValidateConfig(t *testing.T) {
+	testCases := []struct {
+		name     string
+		config   *RemoteSigner
+		errorMsg string
+	}{
+		{
+			name:   "disabled with nil fields",
+			config: &RemoteSigner{Enabled: ptr(false)},
+		},
+		{
+			name:   "enabled with URL",
+			config: &RemoteSigner{Enabled: ptr(true), URL: commonconfig.MustParseURL("http://localhost:9000"), Timeout: durationPtr(10 * time.Second)},
+		},
+		{
+			name:     "enabled without URL",
+			config:   &RemoteSigner{Enabled: ptr(true), Timeout: durationPtr(10 * time.Second)},
+			errorMsg: "URL: missing: must be set when RemoteSigner is enabled",
+		},
+		{
+			name:     "enabled with websocket URL",
+			config:   &RemoteSigner{Enabled: ptr(true), URL: commonconfig.MustParseURL("ws://localhost:9000")},
+			errorMsg: "must be an http or https URL",
+		},
+		{
+			name:     "enabled with zero timeout",
+			config:   &RemoteSigner{Enabled: ptr(true), URL: commonconfig.MustParseURL("https://signer"), Timeout: durationPtr(0)},
+			errorMsg: "Timeout: invalid value (0s): must be positive",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateConfig()
+			if tc.errorMsg != "" {
+				require.ErrorContains(t, err, tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func durationPtr(d time.Duration) *commonconfig.Duration {
 	cd := *commonconfig.MustNewDuration(d)
 	return &cd
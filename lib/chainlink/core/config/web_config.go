@@ -74,6 +74,8 @@ type WebServer interface {
 	AuthenticationMethod() string
 	AllowOrigins() string
 	BridgeCacheTTL() time.Duration
+	BridgeCircuitBreakerThreshold() uint32
+	BridgeCircuitBreakerCooldown() time.Duration
 	BridgeResponseURL() *url.URL
 	HTTPMaxSize() int64
 	StartTimeout() time.Duration
@@ -17,6 +17,8 @@ import (
 
 	feeds "github.com/smartcontractkit/chainlink/v2/core/services/feeds"
 
+	io "io"
+
 	job "github.com/smartcontractkit/chainlink/v2/core/services/job"
 
 	jsonserializable "github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
@@ -43,6 +45,8 @@ import (
 
 	uuid "github.com/google/uuid"
 
+	vrfcommon "github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
+
 	webhook "github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 
 	zapcore "go.uber.org/zap/zapcore"
@@ -344,6 +348,65 @@ func (_c *Application_DeleteLogPollerDataAfter_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// ExportLogPollerData provides a mock function with given fields: ctx, chainID, w, opts
+func (_m *Application) ExportLogPollerData(ctx context.Context, chainID *big.Int, w io.Writer, opts logpoller.ArchiveOpts) (logpoller.ArchiveStats, error) {
+	ret := _m.Called(ctx, chainID, w, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportLogPollerData")
+	}
+
+	var r0 logpoller.ArchiveStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, io.Writer, logpoller.ArchiveOpts) (logpoller.ArchiveStats, error)); ok {
+		return rf(ctx, chainID, w, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, io.Writer, logpoller.ArchiveOpts) logpoller.ArchiveStats); ok {
+		r0 = rf(ctx, chainID, w, opts)
+	} else {
+		r0 = ret.Get(0).(logpoller.ArchiveStats)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int, io.Writer, logpoller.ArchiveOpts) error); ok {
+		r1 = rf(ctx, chainID, w, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_ExportLogPollerData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportLogPollerData'
+type Application_ExportLogPollerData_Call struct {
+	*mock.Call
+}
+
+// ExportLogPollerData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chainID *big.Int
+//   - w io.Writer
+//   - opts logpoller.ArchiveOpts
+func (_e *Application_Expecter) ExportLogPollerData(ctx interface{}, chainID interface{}, w interface{}, opts interface{}) *Application_ExportLogPollerData_Call {
+	return &Application_ExportLogPollerData_Call{Call: _e.mock.On("ExportLogPollerData", ctx, chainID, w, opts)}
+}
+
+func (_c *Application_ExportLogPollerData_Call) Run(run func(ctx context.Context, chainID *big.Int, w io.Writer, opts logpoller.ArchiveOpts)) *Application_ExportLogPollerData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*big.Int), args[2].(io.Writer), args[3].(logpoller.ArchiveOpts))
+	})
+	return _c
+}
+
+func (_c *Application_ExportLogPollerData_Call) Return(_a0 logpoller.ArchiveStats, _a1 error) *Application_ExportLogPollerData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_ExportLogPollerData_Call) RunAndReturn(run func(context.Context, *big.Int, io.Writer, logpoller.ArchiveOpts) (logpoller.ArchiveStats, error)) *Application_ExportLogPollerData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindLCA provides a mock function with given fields: ctx, chainID
 func (_m *Application) FindLCA(ctx context.Context, chainID *big.Int) (*logpoller.Block, error) {
 	ret := _m.Called(ctx, chainID)
@@ -1059,6 +1122,64 @@ func (_c *Application_ID_Call) RunAndReturn(run func() uuid.UUID) *Application_I
 	return _c
 }
 
+// ImportLogPollerData provides a mock function with given fields: ctx, chainID, r
+func (_m *Application) ImportLogPollerData(ctx context.Context, chainID *big.Int, r io.Reader) (logpoller.ArchiveStats, error) {
+	ret := _m.Called(ctx, chainID, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportLogPollerData")
+	}
+
+	var r0 logpoller.ArchiveStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, io.Reader) (logpoller.ArchiveStats, error)); ok {
+		return rf(ctx, chainID, r)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, io.Reader) logpoller.ArchiveStats); ok {
+		r0 = rf(ctx, chainID, r)
+	} else {
+		r0 = ret.Get(0).(logpoller.ArchiveStats)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int, io.Reader) error); ok {
+		r1 = rf(ctx, chainID, r)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_ImportLogPollerData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportLogPollerData'
+type Application_ImportLogPollerData_Call struct {
+	*mock.Call
+}
+
+// ImportLogPollerData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chainID *big.Int
+//   - r io.Reader
+func (_e *Application_Expecter) ImportLogPollerData(ctx interface{}, chainID interface{}, r interface{}) *Application_ImportLogPollerData_Call {
+	return &Application_ImportLogPollerData_Call{Call: _e.mock.On("ImportLogPollerData", ctx, chainID, r)}
+}
+
+func (_c *Application_ImportLogPollerData_Call) Run(run func(ctx context.Context, chainID *big.Int, r io.Reader)) *Application_ImportLogPollerData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*big.Int), args[2].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *Application_ImportLogPollerData_Call) Return(_a0 logpoller.ArchiveStats, _a1 error) *Application_ImportLogPollerData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_ImportLogPollerData_Call) RunAndReturn(run func(context.Context, *big.Int, io.Reader) (logpoller.ArchiveStats, error)) *Application_ImportLogPollerData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // JobORM provides a mock function with no fields
 func (_m *Application) JobORM() job.ORM {
 	ret := _m.Called()
@@ -1153,6 +1274,53 @@ func (_c *Application_JobSpawner_Call) RunAndReturn(run func() job.Spawner) *App
 	return _c
 }
 
+// PipelineFragmentORM provides a mock function with no fields
+func (_m *Application) PipelineFragmentORM() pipeline.FragmentORM {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PipelineFragmentORM")
+	}
+
+	var r0 pipeline.FragmentORM
+	if rf, ok := ret.Get(0).(func() pipeline.FragmentORM); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(pipeline.FragmentORM)
+		}
+	}
+
+	return r0
+}
+
+// Application_PipelineFragmentORM_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PipelineFragmentORM'
+type Application_PipelineFragmentORM_Call struct {
+	*mock.Call
+}
+
+// PipelineFragmentORM is a helper method to define mock.On call
+func (_e *Application_Expecter) PipelineFragmentORM() *Application_PipelineFragmentORM_Call {
+	return &Application_PipelineFragmentORM_Call{Call: _e.mock.On("PipelineFragmentORM")}
+}
+
+func (_c *Application_PipelineFragmentORM_Call) Run(run func()) *Application_PipelineFragmentORM_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_PipelineFragmentORM_Call) Return(_a0 pipeline.FragmentORM) *Application_PipelineFragmentORM_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_PipelineFragmentORM_Call) RunAndReturn(run func() pipeline.FragmentORM) *Application_PipelineFragmentORM_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PipelineORM provides a mock function with no fields
 func (_m *Application) PipelineORM() pipeline.ORM {
 	ret := _m.Called()
@@ -1200,6 +1368,61 @@ func (_c *Application_PipelineORM_Call) RunAndReturn(run func() pipeline.ORM) *A
 	return _c
 }
 
+// ReloadConfig provides a mock function with no fields
+func (_m *Application) ReloadConfig() (chainlink.ConfigReloadReport, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReloadConfig")
+	}
+
+	var r0 chainlink.ConfigReloadReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (chainlink.ConfigReloadReport, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() chainlink.ConfigReloadReport); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(chainlink.ConfigReloadReport)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_ReloadConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReloadConfig'
+type Application_ReloadConfig_Call struct {
+	*mock.Call
+}
+
+// ReloadConfig is a helper method to define mock.On call
+func (_e *Application_Expecter) ReloadConfig() *Application_ReloadConfig_Call {
+	return &Application_ReloadConfig_Call{Call: _e.mock.On("ReloadConfig")}
+}
+
+func (_c *Application_ReloadConfig_Call) Run(run func()) *Application_ReloadConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_ReloadConfig_Call) Return(_a0 chainlink.ConfigReloadReport, _a1 error) *Application_ReloadConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_ReloadConfig_Call) RunAndReturn(run func() (chainlink.ConfigReloadReport, error)) *Application_ReloadConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReplayFromBlock provides a mock function with given fields: ctx, chainFamily, chainID, number, forceBroadcast
 func (_m *Application) ReplayFromBlock(ctx context.Context, chainFamily string, chainID string, number uint64, forceBroadcast bool) error {
 	ret := _m.Called(ctx, chainFamily, chainID, number, forceBroadcast)
@@ -1508,6 +1731,65 @@ func (_c *Application_SetLogLevel_Call) RunAndReturn(run func(zapcore.Level) err
 	return _c
 }
 
+// SimulateJobV2 provides a mock function with given fields: ctx, jb, vars, fixtures
+func (_m *Application) SimulateJobV2(ctx context.Context, jb job.Job, vars map[string]interface{}, fixtures pipeline.SimulationFixtures) (pipeline.SimulationReport, error) {
+	ret := _m.Called(ctx, jb, vars, fixtures)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateJobV2")
+	}
+
+	var r0 pipeline.SimulationReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, job.Job, map[string]interface{}, pipeline.SimulationFixtures) (pipeline.SimulationReport, error)); ok {
+		return rf(ctx, jb, vars, fixtures)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, job.Job, map[string]interface{}, pipeline.SimulationFixtures) pipeline.SimulationReport); ok {
+		r0 = rf(ctx, jb, vars, fixtures)
+	} else {
+		r0 = ret.Get(0).(pipeline.SimulationReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, job.Job, map[string]interface{}, pipeline.SimulationFixtures) error); ok {
+		r1 = rf(ctx, jb, vars, fixtures)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_SimulateJobV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateJobV2'
+type Application_SimulateJobV2_Call struct {
+	*mock.Call
+}
+
+// SimulateJobV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jb job.Job
+//   - vars map[string]interface{}
+//   - fixtures pipeline.SimulationFixtures
+func (_e *Application_Expecter) SimulateJobV2(ctx interface{}, jb interface{}, vars interface{}, fixtures interface{}) *Application_SimulateJobV2_Call {
+	return &Application_SimulateJobV2_Call{Call: _e.mock.On("SimulateJobV2", ctx, jb, vars, fixtures)}
+}
+
+func (_c *Application_SimulateJobV2_Call) Run(run func(ctx context.Context, jb job.Job, vars map[string]interface{}, fixtures pipeline.SimulationFixtures)) *Application_SimulateJobV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(job.Job), args[2].(map[string]interface{}), args[3].(pipeline.SimulationFixtures))
+	})
+	return _c
+}
+
+func (_c *Application_SimulateJobV2_Call) Return(_a0 pipeline.SimulationReport, _a1 error) *Application_SimulateJobV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_SimulateJobV2_Call) RunAndReturn(run func(context.Context, job.Job, map[string]interface{}, pipeline.SimulationFixtures) (pipeline.SimulationReport, error)) *Application_SimulateJobV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Application) Start(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -1646,6 +1928,53 @@ func (_c *Application_TxmStorageService_Call) RunAndReturn(run func() txmgr.EvmT
 	return _c
 }
 
+// VRFRequestORM provides a mock function with no fields
+func (_m *Application) VRFRequestORM() vrfcommon.RequestORM {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for VRFRequestORM")
+	}
+
+	var r0 vrfcommon.RequestORM
+	if rf, ok := ret.Get(0).(func() vrfcommon.RequestORM); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(vrfcommon.RequestORM)
+		}
+	}
+
+	return r0
+}
+
+// Application_VRFRequestORM_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VRFRequestORM'
+type Application_VRFRequestORM_Call struct {
+	*mock.Call
+}
+
+// VRFRequestORM is a helper method to define mock.On call
+func (_e *Application_Expecter) VRFRequestORM() *Application_VRFRequestORM_Call {
+	return &Application_VRFRequestORM_Call{Call: _e.mock.On("VRFRequestORM")}
+}
+
+func (_c *Application_VRFRequestORM_Call) Run(run func()) *Application_VRFRequestORM_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_VRFRequestORM_Call) Return(_a0 vrfcommon.RequestORM) *Application_VRFRequestORM_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_VRFRequestORM_Call) RunAndReturn(run func() vrfcommon.RequestORM) *Application_VRFRequestORM_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WakeSessionReaper provides a mock function with no fields
 func (_m *Application) WakeSessionReaper() {
 	_m.Called()
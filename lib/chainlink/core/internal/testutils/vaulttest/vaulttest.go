@@ -0,0 +1,82 @@
+// Package vaulttest provides a stand-in for the HTTP API of the KV secrets engine of HashiCorp Vault.
+package vaulttest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Server serves secrets from the version 2 KV engine, like GET /v1/<mount>/data/<path>, to clients with the token.
+type Server struct {
+	*httptest.Server
+	Token string
+
+	mu      sync.Mutex
+	secrets map[string]map[string]string // by API path, like secret/data/chainlink
+	reads   int
+}
+
+// NewServer returns a Server for token, which is closed when the test ends.
+func NewServer(t testing.TB, token string) *Server {
+	s := &Server{Token: token, secrets: make(map[string]map[string]string)}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Put sets the secret at path, like secret/data/chainlink.
+func (s *Server) Put(path string, data map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.secrets[strings.Trim(path, "/")] = data
+}
+
+// Reads returns the number of secrets read.
+func (s *Server) Reads() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.reads
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if r.Header.Get("X-Vault-Token") != s.Token {
+		writeErrors(w, http.StatusForbidden, "permission denied")
+		return
+	}
+	if r.Method != http.MethodGet || !strings.HasPrefix(r.URL.Path, "/v1/") {
+		writeErrors(w, http.StatusMethodNotAllowed)
+		return
+	}
+
+	s.mu.Lock()
+	data, ok := s.secrets[strings.Trim(strings.TrimPrefix(r.URL.Path, "/v1/"), "/")]
+	if ok {
+		s.reads++
+	}
+	s.mu.Unlock()
+	if !ok {
+		writeErrors(w, http.StatusNotFound)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"data": map[string]any{
+			"data": data,
+			"metadata": map[string]any{
+				"version": 1,
+			},
+		},
+	})
+}
+
+func writeErrors(w http.ResponseWriter, status int, errs ...string) {
+	w.WriteHeader(status)
+	if errs == nil {
+		errs = []string{}
+	}
+	_ = json.NewEncoder(w).Encode(map[string]any{"errors": errs})
+}
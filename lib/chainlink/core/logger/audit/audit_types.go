@@ -69,9 +69,15 @@ const (
 	BridgeUpdated EventID = "BRIDGE_UPDATED"
 	BridgeDeleted EventID = "BRIDGE_DELETED"
 
+	PipelineFragmentCreated EventID = "PIPELINE_FRAGMENT_CREATED"
+	PipelineFragmentUpdated EventID = "PIPELINE_FRAGMENT_UPDATED"
+	PipelineFragmentDeleted EventID = "PIPELINE_FRAGMENT_DELETED"
+
 	ForwarderCreated EventID = "FORWARDER_CREATED"
 	ForwarderDeleted EventID = "FORWARDER_DELETED"
 
+	LogPollerFilterReplayed EventID = "LOG_POLLER_FILTER_REPLAYED"
+
 	ExternalInitiatorCreated EventID = "EXTERNAL_INITIATOR_CREATED"
 	ExternalInitiatorDeleted EventID = "EXTERNAL_INITIATOR_DELETED"
 
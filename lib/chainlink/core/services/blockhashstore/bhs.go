@@ -33,13 +33,15 @@ type bpBHSDatabaseConfig interface {
 }
 
 // BulletproofBHS is an implementation of BHS that writes "store" transactions to a bulletproof
-// transaction manager, and reads BlockhashStore state from the contract.
+// transaction manager, and reads BlockhashStore state from the contract. If the chain batches
+// transactions, "store" transactions are sent through the batcher, since anyone may call "store".
 type BulletproofBHS struct {
 	config        bpBHSConfig
 	dbConfig      bpBHSDatabaseConfig
 	jobID         uuid.UUID
 	fromAddresses []types.EIP55Address
 	txm           txmgr.TxManager
+	batcher       *txmgr.Batcher
 	abi           *abi.ABI
 	trustedAbi    *abi.ABI
 	bhs           blockhash_store.BlockhashStoreInterface
@@ -48,11 +50,13 @@ type BulletproofBHS struct {
 }
 
 // NewBulletproofBHS creates a new instance with the given transaction manager and blockhash store.
+// The batcher is optional.
 func NewBulletproofBHS(
 	config bpBHSConfig,
 	dbConfig bpBHSDatabaseConfig,
 	fromAddresses []types.EIP55Address,
 	txm txmgr.TxManager,
+	batcher *txmgr.Batcher,
 	bhs blockhash_store.BlockhashStoreInterface,
 	trustedBHS *trusted_blockhash_store.TrustedBlockhashStore,
 	gethks evmkeystore.RoundRobin,
@@ -73,6 +77,7 @@ func NewBulletproofBHS(
 		dbConfig:      dbConfig,
 		fromAddresses: fromAddresses,
 		txm:           txm,
+		batcher:       batcher,
 		abi:           bhsABI,
 		trustedAbi:    trustedBHSAbi,
 		bhs:           bhs,
@@ -93,7 +98,7 @@ func (c *BulletproofBHS) Store(ctx context.Context, blockNum uint64) error {
 		return errors.Wrap(err, "getting next from address")
 	}
 
-	_, err = c.txm.CreateTransaction(ctx, txmgr.TxRequest{
+	txRequest := txmgr.TxRequest{
 		FromAddress:    fromAddress,
 		ToAddress:      c.bhs.Address(),
 		EncodedPayload: payload,
@@ -102,7 +107,17 @@ func (c *BulletproofBHS) Store(ctx context.Context, blockNum uint64) error {
 		// Set a queue size of 256. At most we store the blockhash of every block, and only the
 		// latest 256 can possibly be stored.
 		Strategy: txmgrcommon.NewQueueingTxStrategy(c.jobID, 256),
-	})
+	}
+	if c.batcher != nil {
+		// Anyone may call "store", so it can be batched through Multicall3. The feeder checks
+		// whether the block is stored before storing it again, so no callback is needed.
+		if err = c.batcher.CreateTransaction(ctx, txRequest, txmgr.BatchOptions{AnySender: true}); err != nil {
+			return errors.Wrap(err, "creating batched transaction")
+		}
+		return nil
+	}
+
+	_, err = c.txm.CreateTransaction(ctx, txRequest)
 	if err != nil {
 		return errors.Wrap(err, "creating transaction")
 	}
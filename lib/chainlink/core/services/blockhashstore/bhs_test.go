@@ -2,11 +2,14 @@ package blockhashstore_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
 	"github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/blockhash_store"
 	"github.com/smartcontractkit/chainlink-evm/pkg/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink-evm/pkg/client/clienttest"
@@ -58,6 +61,7 @@ func TestStoreRotatesFromAddresses(t *testing.T) {
 		cfg.Database(),
 		fromAddresses,
 		txm,
+		nil,
 		store,
 		nil,
 		ks,
@@ -78,3 +82,63 @@ func TestStoreRotatesFromAddresses(t *testing.T) {
 	err = bhs.Store(ctx, 2)
 	require.NoError(t, err)
 }
+
+func TestStoreBatched(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	ethClient := clienttest.NewClientWithDefaultChainID(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	kst := cltest.NewKeyStore(t, db)
+	require.NoError(t, kst.Unlock(ctx, cltest.Password))
+	legacyChains := evmtest.NewLegacyChains(t, evmtest.TestChainOpts{
+		ChainConfigs:   cfg.EVMConfigs(),
+		DatabaseConfig: cfg.Database(),
+		FeatureConfig:  cfg.Feature(),
+		ListenerConfig: cfg.Database().Listener(),
+		KeyStore:       kst.Eth(),
+		DB:             db,
+		Client:         ethClient,
+	})
+	chainService, err := legacyChains.Get(cltest.FixtureChainID.String())
+	require.NoError(t, err)
+	chain, ok := chainService.(legacyevm.Chain)
+	require.True(t, ok)
+	coreKS := keystest.NewMemoryChainStore()
+	ks := keys.NewStore(coreKS)
+	addr := coreKS.MustCreate(t)
+	txm := new(txmmocks.MockEvmTxManager)
+	bhsAddress := common.HexToAddress("0x31Ca8bf590360B3198749f852D5c516c642846F6")
+	multicall3Address := common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+	batcher := txmgr.NewBatcher(logger.Test(t), txm, ethClient, txmgr.BatcherConfig{
+		Window:            time.Hour,
+		MaxBatchSize:      2,
+		Multicall3Address: multicall3Address,
+		PollInterval:      time.Hour,
+	})
+	servicetest.Run(t, batcher)
+
+	store, err := blockhash_store.NewBlockhashStore(bhsAddress, chain.Client())
+	require.NoError(t, err)
+	bhs, err := blockhashstore.NewBulletproofBHS(
+		chain.Config().EVM().GasEstimator(),
+		cfg.Database(),
+		[]types.EIP55Address{types.EIP55AddressFromAddress(addr)},
+		txm,
+		batcher,
+		store,
+		nil,
+		ks,
+	)
+	require.NoError(t, err)
+
+	ethClient.On("CallContract", mock.Anything, mock.Anything, mock.Anything).Once().Return(nil, nil)
+	txm.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(tx txmgr.TxRequest) bool {
+		return tx.FromAddress == addr && tx.ToAddress == multicall3Address
+	})).Once().Return(txmgr.Tx{}, nil)
+
+	// both blocks are stored in a single transaction
+	require.NoError(t, bhs.Store(ctx, 1))
+	require.NoError(t, bhs.Store(ctx, 2))
+	txm.AssertExpectations(t)
+}
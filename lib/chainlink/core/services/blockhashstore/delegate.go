@@ -168,6 +168,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 		d.cfg.Database(),
 		fromAddresses,
 		chain.TxManager(),
+		chain.TxBatcher(),
 		bhs,
 		trustedBHS,
 		ks,
@@ -163,6 +163,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 		d.cfg.Database(),
 		fromAddresses,
 		chain.TxManager(),
+		chain.TxBatcher(),
 		bhs,
 		nil,
 		ks,
@@ -9,6 +9,7 @@ import (
 	"math/big"
 	"net/http"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -45,6 +46,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mailbox"
 	"github.com/smartcontractkit/chainlink-common/pkg/workflows/dontime"
 	"github.com/smartcontractkit/chainlink-evm/pkg/chains/legacyevm"
+	evmcfg "github.com/smartcontractkit/chainlink-evm/pkg/config/toml"
 	"github.com/smartcontractkit/chainlink-evm/pkg/keys"
 	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
 	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr"
@@ -74,6 +76,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/retirement"
 	"github.com/smartcontractkit/chainlink/v2/core/services/nodestatusreporter/bridgestatus"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
@@ -94,6 +97,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/telemetry"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf"
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
 	artifactsV1 "github.com/smartcontractkit/chainlink/v2/core/services/workflows/artifacts"
@@ -126,6 +130,8 @@ type Application interface {
 	GetDB() sqlutil.DataSource
 	GetConfig() GeneralConfig
 	SetLogLevel(lvl zapcore.Level) error
+	// ReloadConfig reads the config files again, and applies the changes which do not require a restart.
+	ReloadConfig() (ConfigReloadReport, error)
 	GetKeyStore() keystore.Master
 	WakeSessionReaper()
 	GetWebAuthnConfiguration() sessions.WebAuthnConfiguration
@@ -141,7 +147,9 @@ type Application interface {
 	JobSpawner() job.Spawner
 	JobORM() job.ORM
 	PipelineORM() pipeline.ORM
+	PipelineFragmentORM() pipeline.FragmentORM
 	BridgeORM() bridges.ORM
+	VRFRequestORM() vrfcommon.RequestORM
 	BasicAdminUsersORM() sessions.BasicAdminUsersORM
 	AuthenticationProvider() sessions.AuthenticationProvider
 	TxmStorageService() txmgr.EvmTxStore
@@ -151,6 +159,8 @@ type Application interface {
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]any) (int64, error)
+	// SimulateJobV2 executes the pipeline of an unsaved job without network or chain I/O.
+	SimulateJobV2(ctx context.Context, jb job.Job, vars map[string]any, fixtures pipeline.SimulationFixtures) (pipeline.SimulationReport, error)
 
 	// Feeds
 	GetFeedsService() feeds.Service
@@ -168,6 +178,10 @@ type Application interface {
 	FindLCA(ctx context.Context, chainID *big.Int) (*logpoller.Block, error)
 	// DeleteLogPollerDataAfter - delete LogPoller state starting from the specified block
 	DeleteLogPollerDataAfter(ctx context.Context, chainID *big.Int, start int64) error
+	// ExportLogPollerData - write an archive of finalized LogPoller logs and blocks to w
+	ExportLogPollerData(ctx context.Context, chainID *big.Int, w io.Writer, opts logpoller.ArchiveOpts) (logpoller.ArchiveStats, error)
+	// ImportLogPollerData - insert LogPoller logs and blocks from an archive, after verifying them against the chain
+	ImportLogPollerData(ctx context.Context, chainID *big.Int, r io.Reader) (logpoller.ArchiveStats, error)
 }
 
 // ChainlinkApplication contains fields for the JobSubscriber, Scheduler,
@@ -178,8 +192,10 @@ type ChainlinkApplication struct {
 	jobORM                   job.ORM
 	jobSpawner               job.Spawner
 	pipelineORM              pipeline.ORM
+	pipelineFragmentORM      pipeline.FragmentORM
 	pipelineRunner           pipeline.Runner
 	bridgeORM                bridges.ORM
+	vrfRequestORM            vrfcommon.RequestORM
 	localAdminUsersORM       sessions.BasicAdminUsersORM
 	authenticationProvider   sessions.AuthenticationProvider // Note: this will be OIDC instance
 	txmStorageService        txmgr.EvmTxStore
@@ -295,6 +311,18 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 		MercuryConfig: cfg.Mercury(),
 	}
 
+	var remoteSigner *remotesigner.Client
+	if cfg.RemoteSigner().Enabled() {
+		remoteSigner, err = remotesigner.NewClient(cfg.RemoteSigner().URL().String(), cfg.RemoteSigner().Timeout())
+		if err != nil {
+			return nil, err
+		}
+		evmFactoryCfg.RemoteSigner = remoteSigner
+		// accept the accounts of the remote signer wherever sending addresses are checked, e.g. by jobs
+		keyStore = remotesigner.NewKeystore(keyStore, remoteSigner)
+		globalLogger.Infow("Signing with EVM keys of the remote signer", "url", cfg.RemoteSigner().URL().Redacted())
+	}
+
 	if opts.EVMFactoryConfigFn != nil {
 		opts.EVMFactoryConfigFn(&evmFactoryCfg)
 	}
@@ -447,7 +475,7 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 	if backupCfg.Mode() != config.DatabaseBackupModeNone && backupCfg.Frequency() > 0 {
 		globalLogger.Infow("DatabaseBackup: periodic database backups are enabled", "frequency", backupCfg.Frequency())
 
-		databaseBackup, err := periodicbackup.NewDatabaseBackup(cfg.Database().URL(), cfg.RootDir(), backupCfg, globalLogger)
+		databaseBackup, err := periodicbackup.NewDatabaseBackup(cfg.Database().URL(), cfg.RootDir(), backupCfg, cfg.Password().Keystore(), globalLogger)
 		if err != nil {
 			return nil, errors.Wrap(err, "NewApplication: failed to initialize database backup")
 		}
@@ -581,6 +609,7 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 				globalLogger),
 			job.Cron: cron.NewDelegate(
 				pipelineRunner,
+				opts.DS,
 				globalLogger),
 			job.BlockhashStore: blockhashstore.NewDelegate(
 				cfg,
@@ -679,6 +708,11 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 
 		ocr2DelegateConfig := ocr2.NewDelegateConfig(cfg.OCR2(), cfg.Mercury(), cfg.Threshold(), cfg.Insecure(), cfg.JobPipeline(), loopRegistrarConfig)
 
+		ocr2Keystore := keyStore.OCR2()
+		if address := cfg.RemoteSigner().OCR2OnchainAddress(); remoteSigner != nil && address != "" {
+			ocr2Keystore = remotesigner.NewOCR2Keystore(ocr2Keystore, remoteSigner, address.Address())
+		}
+
 		ocr2Delegate := ocr2.NewDelegate(
 			ocr2.DelegateOpts{
 				Ds:                             opts.DS,
@@ -691,7 +725,7 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 				MonitoringEndpointGen:          telemetryManager,
 				LegacyChains:                   legacyEVMChains,
 				Lggr:                           globalLogger,
-				Ks:                             keyStore.OCR2(),
+				Ks:                             ocr2Keystore,
 				EthKs:                          keyStore.Eth(),
 				WorkflowKs:                     keyStore.Workflow(),
 				DKGRecipientKs:                 keyStore.DKGRecipient(),
@@ -743,6 +777,7 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 		globalLogger,
 	)
 	srvcs = append(srvcs, bridgeStatusReporter)
+	srvcs = append(srvcs, bridges.NewHealthProber(bridgeORM, unrestrictedHTTPClient, globalLogger))
 
 	healthCfg := commonsrv.HealthCheckerConfig{Ver: static.Version, Sha: static.Sha}
 	healthCfg = promhealth.ConfigureHooks(healthCfg)
@@ -803,6 +838,8 @@ func NewApplication(ctx context.Context, opts ApplicationOpts) (Application, err
 		jobSpawner:               jobSpawner,
 		pipelineRunner:           pipelineRunner,
 		pipelineORM:              pipelineORM,
+		pipelineFragmentORM:      pipeline.NewFragmentORM(opts.DS),
+		vrfRequestORM:            vrfcommon.NewRequestORM(opts.DS),
 		bridgeORM:                bridgeORM,
 		localAdminUsersORM:       localAdminUsersORM,
 		authenticationProvider:   authenticationProvider,
@@ -1318,6 +1355,47 @@ func (app *ChainlinkApplication) SetLogLevel(lvl zapcore.Level) error {
 	return nil
 }
 
+// ReloadConfig reads the config files again, and applies the hot-reloadable changes. Each applied change is recorded
+// in the audit log.
+func (app *ChainlinkApplication) ReloadConfig() (ConfigReloadReport, error) {
+	cfg, ok := app.Config.(*generalConfig)
+	if !ok {
+		return ConfigReloadReport{}, errors.New("config does not support reloading")
+	}
+	report, err := cfg.reload(configReloadHooks{
+		setLogLevel:     app.SetLogLevel,
+		setGasEstimator: app.setGasEstimator,
+	})
+	if err != nil {
+		return report, err
+	}
+	for _, c := range report.Applied {
+		app.logger.Infow("Applied config change", "path", c.Path, "old", c.Old, "new", c.New)
+		app.AuditLogger.Audit(audit.ConfigUpdated, map[string]any{"path": c.Path, "old": c.Old, "new": c.New})
+	}
+	for _, c := range report.Rejected {
+		app.logger.Warnw("Rejected config change", "path", c.Path, "reason", c.Reason)
+	}
+	return report, nil
+}
+
+func (app *ChainlinkApplication) setGasEstimator(chainID string, ge evmcfg.GasEstimator) error {
+	chain, err := app.GetRelayers().LegacyEVMChains().Get(chainID)
+	if err != nil {
+		return err
+	}
+	legacyChain, ok := chain.(legacyevm.Chain)
+	if !ok {
+		return errors.Errorf("chain %s does not support reloading the gas estimator", chainID)
+	}
+	cfg, ok := legacyChain.Config().(interface{ SetGasEstimator(evmcfg.GasEstimator) })
+	if !ok {
+		return errors.Errorf("chain %s does not support reloading the gas estimator", chainID)
+	}
+	cfg.SetGasEstimator(ge)
+	return nil
+}
+
 // Start all necessary services. If successful, nil will be returned.
 // Start sequence is aborted if the context gets cancelled.
 func (app *ChainlinkApplication) Start(ctx context.Context) error {
@@ -1478,6 +1556,14 @@ func (app *ChainlinkApplication) PipelineORM() pipeline.ORM {
 	return app.pipelineORM
 }
 
+func (app *ChainlinkApplication) PipelineFragmentORM() pipeline.FragmentORM {
+	return app.pipelineFragmentORM
+}
+
+func (app *ChainlinkApplication) VRFRequestORM() vrfcommon.RequestORM {
+	return app.vrfRequestORM
+}
+
 func (app *ChainlinkApplication) TxmStorageService() txmgr.EvmTxStore {
 	return app.txmStorageService
 }
@@ -1586,6 +1672,54 @@ func (app *ChainlinkApplication) RunJobV2(
 	return runID, err
 }
 
+// SimulateJobV2 runs the pipeline of a validated, unsaved job in simulation mode.
+// Tasks that would reach the network or the chain are served from fixtures and
+// nothing is written to the database.
+func (app *ChainlinkApplication) SimulateJobV2(
+	ctx context.Context,
+	jb job.Job,
+	vars map[string]any,
+	fixtures pipeline.SimulationFixtures,
+) (pipeline.SimulationReport, error) {
+	if strings.TrimSpace(jb.Pipeline.Source) == "" {
+		return pipeline.SimulationReport{}, errors.Errorf("job type %s has no pipeline to simulate", jb.Type)
+	}
+	fragments, err := app.pipelineFragmentORM.Lookup(ctx)
+	if err != nil {
+		return pipeline.SimulationReport{}, err
+	}
+	// the pipeline is expanded like it would be by CreateJob
+	source, _, err := pipeline.ExpandFragments(jb.Pipeline.Source, fragments)
+	if err != nil {
+		return pipeline.SimulationReport{}, err
+	}
+	spec := pipeline.Spec{
+		DotDagSource:      source,
+		MaxTaskDuration:   jb.MaxTaskDuration,
+		ForwardingAllowed: jb.ForwardingAllowed,
+		JobName:           jb.Name.ValueOrZero(),
+		JobType:           string(jb.Type),
+	}
+	if jb.GasLimit.Valid {
+		spec.GasLimit = &jb.GasLimit.Uint32
+	}
+	p, err := app.pipelineRunner.InitializePipeline(spec)
+	if err != nil {
+		return pipeline.SimulationReport{}, err
+	}
+	sim := pipeline.NewSimulation(fixtures)
+	if err = sim.Validate(p); err != nil {
+		return pipeline.SimulationReport{}, err
+	}
+	spec.Pipeline = p
+
+	run, _, err := app.pipelineRunner.ExecuteRun(pipeline.WithSimulation(ctx, sim), spec, pipeline.NewVarsFrom(vars))
+	if err != nil {
+		return pipeline.SimulationReport{}, err
+	}
+	return pipeline.NewSimulationReport(run, sim)
+}
+
 func (app *ChainlinkApplication) ResumeJobV2(
 	ctx context.Context,
 	taskID uuid.UUID,
@@ -1705,6 +1839,54 @@ func (app *ChainlinkApplication) DeleteLogPollerDataAfter(ctx context.Context, c
 	return nil
 }
 
+// ExportLogPollerData - write an archive of finalized LogPoller logs and blocks to w
+func (app *ChainlinkApplication) ExportLogPollerData(ctx context.Context, chainID *big.Int, w io.Writer, opts logpoller.ArchiveOpts) (logpoller.ArchiveStats, error) {
+	if _, err := app.logPollerChain(chainID, "ExportLogPollerData"); err != nil {
+		return logpoller.ArchiveStats{}, err
+	}
+
+	orm := logpoller.NewORM(chainID, app.ds, app.logger)
+	stats, err := logpoller.ExportArchive(ctx, orm, chainID, w, opts)
+	if err != nil {
+		return stats, fmt.Errorf("failed to export LogPoller data: %w", err)
+	}
+
+	return stats, nil
+}
+
+// ImportLogPollerData - insert LogPoller logs and blocks from an archive, after verifying them against the chain
+func (app *ChainlinkApplication) ImportLogPollerData(ctx context.Context, chainID *big.Int, r io.Reader) (logpoller.ArchiveStats, error) {
+	legacyChain, err := app.logPollerChain(chainID, "ImportLogPollerData")
+	if err != nil {
+		return logpoller.ArchiveStats{}, err
+	}
+
+	orm := logpoller.NewORM(chainID, app.ds, app.logger)
+	batchSize := int64(legacyChain.Config().EVM().RPCDefaultBatchSize())
+	stats, err := logpoller.ImportArchive(ctx, orm, legacyChain.Client(), r, batchSize)
+	if err != nil {
+		return stats, fmt.Errorf("failed to import LogPoller data: %w", err)
+	}
+
+	return stats, nil
+}
+
+// logPollerChain returns the legacy EVM chain for chainID, if it runs a LogPoller.
+func (app *ChainlinkApplication) logPollerChain(chainID *big.Int, op string) (legacyevm.Chain, error) {
+	chain, err := app.GetRelayers().LegacyEVMChains().Get(chainID.String())
+	if err != nil {
+		return nil, err
+	}
+	if !app.Config.Feature().LogPoller() {
+		return nil, fmt.Errorf("%s is only available if LogPoller is enabled", op)
+	}
+	legacyChain, ok := chain.(legacyevm.Chain)
+	if !ok {
+		return nil, ErrUnsupportedInLOOPPMode
+	}
+	return legacyChain, nil
+}
+
 var _ services.ServiceCtx = closerService{}
 
 // closerService extends an io.Closer to implement [services.ServiceCtx]
@@ -33,6 +33,84 @@ func (b *backupConfig) URL() *url.URL {
 	return b.s.BackupURL.URL()
 }
 
+func (b *backupConfig) Target() config.DatabaseBackupTarget {
+	return *b.c.Target
+}
+
+func (b *backupConfig) Encrypt() bool {
+	return *b.c.Encrypt
+}
+
+func (b *backupConfig) Retention() uint32 {
+	return *b.c.Retention
+}
+
+func (b *backupConfig) Verify() bool {
+	return *b.c.Verify
+}
+
+func (b *backupConfig) S3() config.BackupS3 {
+	return &backupS3Config{c: b.c.S3}
+}
+
+func (b *backupConfig) SFTP() config.BackupSFTP {
+	return &backupSFTPConfig{c: b.c.SFTP}
+}
+
+type backupS3Config struct {
+	c toml.DatabaseBackupS3
+}
+
+func (s *backupS3Config) Endpoint() string {
+	if s.c.Endpoint == nil {
+		return ""
+	}
+	return s.c.Endpoint.String()
+}
+
+func (s *backupS3Config) Bucket() string {
+	return stringOrEmpty(s.c.Bucket)
+}
+
+func (s *backupS3Config) Region() string {
+	return stringOrEmpty(s.c.Region)
+}
+
+func (s *backupS3Config) Prefix() string {
+	return stringOrEmpty(s.c.Prefix)
+}
+
+func (s *backupS3Config) UsePathStyle() bool {
+	return s.c.UsePathStyle != nil && *s.c.UsePathStyle
+}
+
+type backupSFTPConfig struct {
+	c toml.DatabaseBackupSFTP
+}
+
+func (s *backupSFTPConfig) Addr() string {
+	return stringOrEmpty(s.c.Addr)
+}
+
+func (s *backupSFTPConfig) User() string {
+	return stringOrEmpty(s.c.User)
+}
+
+func (s *backupSFTPConfig) Dir() string {
+	return stringOrEmpty(s.c.Dir)
+}
+
+func (s *backupSFTPConfig) IdentityFile() string {
+	return stringOrEmpty(s.c.IdentityFile)
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
 type lockConfig struct {
 	c toml.DatabaseLock
 }
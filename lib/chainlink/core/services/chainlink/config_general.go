@@ -38,6 +38,8 @@ type generalConfig struct {
 
 	warning error // warnings about inputTOML, e.g. deprecated fields
 
+	secretsTree map[string]any // as read from the secrets TOML, with secret references resolved
+
 	logLevelDefault zapcore.Level
 
 	appIDOnce sync.Once
@@ -45,6 +47,10 @@ type generalConfig struct {
 	logMu sync.RWMutex // for the mutable fields Log.Level & Log.SQL
 
 	passwordMu sync.RWMutex // passwords are set after initialization
+
+	reloadOpts GeneralConfigOpts // to parse the config again on reload
+	reloadMu   sync.Mutex        // serializes reloads
+	reloadedMu sync.RWMutex      // for the hot-reloadable sections JobPipeline & WebServer, and the TOML strings
 }
 
 // GeneralConfigOpts holds configuration options for creating a coreconfig.GeneralConfig via New().
@@ -61,6 +67,13 @@ type GeneralConfigOpts struct {
 	OverrideFn func(*Config, *Secrets)
 
 	SkipEnv bool
+
+	// secretsTree holds the secrets TOML with secret references resolved, see resolveSecretRefs.
+	secretsTree map[string]any
+
+	// setup is set if the TOML strings were read from files by Setup, which are read again on reload.
+	setup                     bool
+	configFiles, secretsFiles []string
 }
 
 func (o *GeneralConfigOpts) Setup(configFiles []string, secretsFiles []string) error {
@@ -89,6 +102,9 @@ func (o *GeneralConfigOpts) Setup(configFiles []string, secretsFiles []string) e
 	}
 
 	o.SecretsStrings = secrets
+	o.setup = true
+	o.configFiles = configFiles
+	o.secretsFiles = secretsFiles
 	return nil
 }
 
@@ -123,6 +139,24 @@ func (o *GeneralConfigOpts) parseSecrets(secrets string) error {
 
 // New returns a GeneralConfig for the given options.
 func (o GeneralConfigOpts) New() (GeneralConfig, error) {
+	cfg, err := o.new()
+	if err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (o GeneralConfigOpts) new() (*generalConfig, error) {
+	reloadOpts := GeneralConfigOpts{
+		ConfigStrings:  o.ConfigStrings,
+		SecretsStrings: o.SecretsStrings,
+		OverrideFn:     o.OverrideFn,
+		SkipEnv:        o.SkipEnv,
+		setup:          o.setup,
+		configFiles:    o.configFiles,
+		secretsFiles:   o.secretsFiles,
+	}
+
 	err := o.parse()
 	if err != nil {
 		return nil, err
@@ -161,9 +195,11 @@ func (o GeneralConfigOpts) New() (GeneralConfig, error) {
 		inputTOML:     input,
 		effectiveTOML: effective,
 		secretsTOML:   secrets,
+		secretsTree:   o.secretsTree,
 		c:             &o.Config,
 		secrets:       &o.Secrets,
 		warning:       warning,
+		reloadOpts:    reloadOpts,
 	}
 	if lvl := o.Config.Log.Level; lvl != nil {
 		cfg.logLevelDefault = zapcore.Level(*lvl)
@@ -180,7 +216,13 @@ func (o *GeneralConfigOpts) parse() (err error) {
 		}
 	}
 
-	for _, s := range o.SecretsStrings {
+	secrets, secretsTree, err := resolveSecretRefs(o.SecretsStrings)
+	if err != nil {
+		return fmt.Errorf("invalid secrets: %w", err)
+	}
+	o.secretsTree = secretsTree
+
+	for _, s := range secrets {
 		err := o.parseSecrets(s)
 		if err != nil {
 			return err
@@ -274,9 +316,10 @@ func validateEnv() (err error) {
 }
 
 func (g *generalConfig) LogConfiguration(log, warn coreconfig.LogfFn) {
+	input, effective := g.ConfigTOML()
 	log("# Secrets:\n%s\n", g.secretsTOML)
-	log("# Input Configuration:\n%s\n", g.inputTOML)
-	log("# Effective Configuration, with defaults applied:\n%s\n", g.effectiveTOML)
+	log("# Input Configuration:\n%s\n", input)
+	log("# Effective Configuration, with defaults applied:\n%s\n", effective)
 	if g.warning != nil {
 		warn("# Configuration warning:\n%s\n", g.warning)
 	}
@@ -284,6 +327,8 @@ func (g *generalConfig) LogConfiguration(log, warn coreconfig.LogfFn) {
 
 // ConfigTOML implements chainlink.ConfigV2
 func (g *generalConfig) ConfigTOML() (user, effective string) {
+	g.reloadedMu.RLock()
+	defer g.reloadedMu.RUnlock()
 	return g.inputTOML, g.effectiveTOML
 }
 
@@ -383,7 +428,7 @@ func (g *generalConfig) SuiEnabled() bool {
 }
 
 func (g *generalConfig) WebServer() config.WebServer {
-	return &webServerConfig{c: g.c.WebServer, s: g.secrets.WebServer, rootDir: g.RootDir}
+	return &webServerConfig{c: g.webServer, s: g.secrets.WebServer, rootDir: g.RootDir}
 }
 
 func (g *generalConfig) AutoPprofBlockProfileRate() int {
@@ -463,15 +508,15 @@ func (g *generalConfig) InsecurePPROFHeap() bool {
 }
 
 func (g *generalConfig) JobPipelineReaperInterval() time.Duration {
-	return g.c.JobPipeline.ReaperInterval.Duration()
+	return g.jobPipeline().ReaperInterval.Duration()
 }
 
 func (g *generalConfig) JobPipelineResultWriteQueueDepth() uint64 {
-	return uint64(*g.c.JobPipeline.ResultWriteQueueDepth)
+	return uint64(*g.jobPipeline().ResultWriteQueueDepth)
 }
 
 func (g *generalConfig) JobPipeline() coreconfig.JobPipeline {
-	return &jobPipelineConfig{c: g.c.JobPipeline}
+	return &jobPipelineConfig{c: g.jobPipeline}
 }
 
 func (g *generalConfig) Keeper() config.Keeper {
@@ -592,4 +637,8 @@ func (g *generalConfig) BridgeStatusReporter() coreconfig.BridgeStatusReporter {
 	return &bridgeStatusReporterConfig{c: g.c.BridgeStatusReporter}
 }
 
+func (g *generalConfig) RemoteSigner() coreconfig.RemoteSigner {
+	return &remoteSignerConfig{c: g.c.RemoteSigner}
+}
+
 var zeroSha256Hash = models.Sha256Hash{}
@@ -45,6 +45,18 @@ func (g *generalConfig) SetLogSQL(logSQL bool) {
 	g.logMu.Unlock()
 }
 
+func (g *generalConfig) jobPipeline() toml.JobPipeline {
+	g.reloadedMu.RLock()
+	defer g.reloadedMu.RUnlock()
+	return g.c.JobPipeline
+}
+
+func (g *generalConfig) webServer() toml.WebServer {
+	g.reloadedMu.RLock()
+	defer g.reloadedMu.RUnlock()
+	return g.c.WebServer
+}
+
 func (g *generalConfig) SetPasswords(keystore, vrf *string) {
 	g.passwordMu.Lock()
 	defer g.passwordMu.Unlock()
@@ -0,0 +1,377 @@
+package chainlink
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"go.uber.org/zap/zapcore"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	evmcfg "github.com/smartcontractkit/chainlink-evm/pkg/config/toml"
+
+	v2 "github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+// hotReloadable lists the fields of the config which are applied to a running node by ReloadConfig. The services
+// read them on every use, instead of once when they start. An element of '*' matches any key or index.
+var hotReloadable = []string{
+	"Log.Level",
+	"Database.LogQueries",
+	"JobPipeline.MaxRunDuration",
+	"JobPipeline.ReaperThreshold",
+	"JobPipeline.VerboseLogging",
+	"JobPipeline.HTTPRequest.DefaultTimeout",
+	"JobPipeline.HTTPRequest.MaxSize",
+	"WebServer.BridgeCacheTTL",
+	"WebServer.RateLimit.*",
+	"EVM.*.GasEstimator.BumpMin",
+	"EVM.*.GasEstimator.BumpPercent",
+	"EVM.*.GasEstimator.BumpThreshold",
+	"EVM.*.GasEstimator.FeeCapDefault",
+	"EVM.*.GasEstimator.LimitDefault",
+	"EVM.*.GasEstimator.LimitMax",
+	"EVM.*.GasEstimator.LimitMultiplier",
+	"EVM.*.GasEstimator.LimitTransfer",
+	"EVM.*.GasEstimator.PriceDefault",
+	"EVM.*.GasEstimator.PriceMax",
+	"EVM.*.GasEstimator.PriceMin",
+	"EVM.*.GasEstimator.TipCapDefault",
+	"EVM.*.GasEstimator.TipCapMin",
+}
+
+const reasonRestartRequired = "requires a restart"
+
+// ConfigReloadReport describes the changes found by reloading the config.
+type ConfigReloadReport struct {
+	Applied  []AppliedConfigChange  `json:"applied"`
+	Rejected []RejectedConfigChange `json:"rejected"`
+}
+
+// AppliedConfigChange is a change which took effect without a restart.
+type AppliedConfigChange struct {
+	Path string `json:"path"`
+	Old  string `json:"old"`
+	New  string `json:"new"`
+}
+
+// RejectedConfigChange is a change which was not applied, and only takes effect after a restart. The values are
+// omitted, since they may be sensitive, like RPC URLs with API keys.
+type RejectedConfigChange struct {
+	Path   string `json:"path"`
+	Reason string `json:"reason"`
+}
+
+// configReloadHooks apply reloaded values to the services which do not read them from the config.
+type configReloadHooks struct {
+	setLogLevel     func(zapcore.Level) error
+	setGasEstimator func(chainID string, ge evmcfg.GasEstimator) error
+}
+
+type configChange struct {
+	path     string
+	old, new any
+}
+
+// reload parses the config again from its sources and applies the hot-reloadable changes. The other changes are
+// rejected, and left for the next restart. Secrets, including their references, are read again, but only take
+// effect after a restart.
+func (g *generalConfig) reload(hooks configReloadHooks) (report ConfigReloadReport, err error) {
+	g.reloadMu.Lock()
+	defer g.reloadMu.Unlock()
+
+	opts := g.reloadOpts
+	if opts.setup {
+		if err = opts.Setup(opts.configFiles, opts.secretsFiles); err != nil {
+			return
+		}
+	}
+	next, err := opts.new()
+	if err != nil {
+		return
+	}
+	if err = next.Validate(); err != nil {
+		return report, fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	input, effective := g.ConfigTOML()
+	running, err := decodeTree(effective)
+	if err != nil {
+		return
+	}
+	// these may have been changed through the API since the last reload
+	setTreeValue(running, "Log.Level", v2.LogLevel(g.logLevel()).String())
+	setTreeValue(running, "Database.LogQueries", g.logSQL())
+	reloaded, err := decodeTree(next.effectiveTOML)
+	if err != nil {
+		return
+	}
+
+	var changes []configChange
+	diffTree("", running, reloaded, func(path string, old, new any) {
+		if !isHotReloadable(path) {
+			report.Rejected = append(report.Rejected, RejectedConfigChange{Path: path, Reason: reasonRestartRequired})
+			return
+		}
+		if chain, ok := evmChainPath(path); ok {
+			idPath := chain + ".ChainID"
+			if id, nextID := treeValue(running, idPath), treeValue(reloaded, idPath); !reflect.DeepEqual(id, nextID) {
+				report.Rejected = append(report.Rejected, RejectedConfigChange{Path: path, Reason: idPath + " changed"})
+				return
+			}
+		}
+		changes = append(changes, configChange{path: path, old: old, new: new})
+	})
+	diffTree("Secrets", g.secretsTree, next.secretsTree, func(path string, _, _ any) {
+		report.Rejected = append(report.Rejected, RejectedConfigChange{Path: path, Reason: reasonRestartRequired})
+	})
+	if len(changes) == 0 {
+		return
+	}
+
+	c, err := applyChanges(running, changes)
+	if err != nil {
+		return
+	}
+	changes, report.Rejected = g.runReloadHooks(hooks, c, changes, report.Rejected)
+	if c, err = applyChanges(running, changes); err != nil {
+		return
+	}
+	inputTree, err := decodeTree(input)
+	if err != nil {
+		return
+	}
+	inputCfg, err := applyChanges(inputTree, changes)
+	if err != nil {
+		return
+	}
+	if input, err = inputCfg.TOMLString(); err != nil {
+		return
+	}
+	if effective, err = c.TOMLString(); err != nil {
+		return
+	}
+
+	g.reloadedMu.Lock()
+	g.c.JobPipeline = c.JobPipeline
+	g.c.WebServer.BridgeCacheTTL = c.WebServer.BridgeCacheTTL
+	g.c.WebServer.RateLimit = c.WebServer.RateLimit
+	g.inputTOML, g.effectiveTOML = input, effective
+	g.reloadedMu.Unlock()
+
+	for _, ch := range changes {
+		report.Applied = append(report.Applied, AppliedConfigChange{Path: ch.path, Old: formatTreeValue(ch.old), New: formatTreeValue(ch.new)})
+	}
+	return
+}
+
+// runReloadHooks applies the changes which are not read from the config, and returns the changes which remain, and
+// the rejected ones, including those the hooks failed to apply.
+func (g *generalConfig) runReloadHooks(hooks configReloadHooks, c *Config, changes []configChange, rejected []RejectedConfigChange) ([]configChange, []RejectedConfigChange) {
+	failed := make(map[string]error)
+	for _, ch := range changes {
+		switch chain, isEVM := evmChainPath(ch.path); {
+		case ch.path == "Log.Level":
+			failed[ch.path] = hooks.setLogLevel(zapcore.Level(*c.Log.Level))
+		case ch.path == "Database.LogQueries":
+			g.SetLogSQL(*c.Database.LogQueries)
+		case isEVM:
+			if _, done := failed[chain]; done {
+				continue
+			}
+			i, _ := strconv.Atoi(strings.TrimPrefix(chain, "EVM."))
+			failed[chain] = hooks.setGasEstimator(c.EVM[i].ChainID.String(), c.EVM[i].GasEstimator)
+		}
+	}
+
+	var remaining []configChange
+	for _, ch := range changes {
+		key := ch.path
+		if chain, ok := evmChainPath(ch.path); ok {
+			key = chain
+		}
+		if err := failed[key]; err != nil {
+			rejected = append(rejected, RejectedConfigChange{Path: ch.path, Reason: err.Error()})
+			continue
+		}
+		remaining = append(remaining, ch)
+	}
+	return remaining, rejected
+}
+
+func isHotReloadable(path string) bool {
+	elems := strings.Split(path, ".")
+	return slices.ContainsFunc(hotReloadable, func(pattern string) bool {
+		return slices.EqualFunc(strings.Split(pattern, "."), elems, func(p, e string) bool {
+			return p == "*" || p == e
+		})
+	})
+}
+
+// evmChainPath returns the path of the EVM chain, like EVM.0, that path belongs to.
+func evmChainPath(path string) (string, bool) {
+	elems := strings.SplitN(path, ".", 3)
+	if len(elems) < 3 || elems[0] != "EVM" {
+		return "", false
+	}
+	return elems[0] + "." + elems[1], true
+}
+
+// applyChanges sets the new values of changes in tree, and returns it as a Config.
+func applyChanges(tree map[string]any, changes []configChange) (*Config, error) {
+	tree = cloneTree(tree).(map[string]any)
+	for _, ch := range changes {
+		setTreeValue(tree, ch.path, ch.new)
+	}
+	b, err := toml.Marshal(tree)
+	if err != nil {
+		return nil, err
+	}
+	var c Config
+	if err = commonconfig.DecodeTOML(bytes.NewReader(b), &c); err != nil {
+		return nil, fmt.Errorf("failed to decode reloaded config: %w", err)
+	}
+	return &c, nil
+}
+
+// decodeTree decodes s into nested tables and arrays.
+func decodeTree(s string) (map[string]any, error) {
+	tree := make(map[string]any)
+	if err := toml.Unmarshal([]byte(s), &tree); err != nil {
+		return nil, fmt.Errorf("failed to decode config: %w", err)
+	}
+	return tree, nil
+}
+
+// diffTree calls fn with the path and values of every field which differs between old and new. Arrays of tables are
+// compared by index.
+func diffTree(path string, old, new any, fn func(path string, old, new any)) {
+	join := func(key string) string {
+		if path == "" {
+			return key
+		}
+		return path + "." + key
+	}
+	oldTable, oldIsTable := old.(map[string]any)
+	newTable, newIsTable := new.(map[string]any)
+	if oldIsTable && newIsTable {
+		keys := slices.Collect(maps.Keys(oldTable))
+		for k := range newTable {
+			if _, ok := oldTable[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			diffTree(join(k), oldTable[k], newTable[k], fn)
+		}
+		return
+	}
+	oldList, oldIsList := old.([]any)
+	newList, newIsList := new.([]any)
+	if oldIsList && newIsList && isArrayOfTables(oldList) && isArrayOfTables(newList) {
+		for i := range max(len(oldList), len(newList)) {
+			var o, n any
+			if i < len(oldList) {
+				o = oldList[i]
+			}
+			if i < len(newList) {
+				n = newList[i]
+			}
+			diffTree(join(strconv.Itoa(i)), o, n, fn)
+		}
+		return
+	}
+	if !reflect.DeepEqual(old, new) {
+		fn(path, old, new)
+	}
+}
+
+func isArrayOfTables(l []any) bool {
+	return !slices.ContainsFunc(l, func(v any) bool {
+		_, ok := v.(map[string]any)
+		return !ok
+	})
+}
+
+// treeValue returns the value at path, or nil if there is none.
+func treeValue(tree map[string]any, path string) any {
+	var v any = tree
+	for _, elem := range strings.Split(path, ".") {
+		switch t := v.(type) {
+		case map[string]any:
+			v = t[elem]
+		case []any:
+			i, err := strconv.Atoi(elem)
+			if err != nil || i >= len(t) {
+				return nil
+			}
+			v = t[i]
+		default:
+			return nil
+		}
+	}
+	return v
+}
+
+// setTreeValue sets the value at path, creating any missing tables, or removes it if value is nil.
+func setTreeValue(tree map[string]any, path string, value any) {
+	elems := strings.Split(path, ".")
+	var parent any = tree
+	for _, elem := range elems[:len(elems)-1] {
+		switch t := parent.(type) {
+		case map[string]any:
+			child, ok := t[elem]
+			if !ok {
+				child = make(map[string]any)
+				t[elem] = child
+			}
+			parent = child
+		case []any:
+			i, err := strconv.Atoi(elem)
+			if err != nil || i >= len(t) {
+				return
+			}
+			parent = t[i]
+		}
+	}
+	if t, ok := parent.(map[string]any); ok {
+		last := elems[len(elems)-1]
+		if value == nil {
+			delete(t, last)
+		} else {
+			t[last] = value
+		}
+	}
+}
+
+func cloneTree(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(t))
+		for k, v := range t {
+			m[k] = cloneTree(v)
+		}
+		return m
+	case []any:
+		l := make([]any, len(t))
+		for i, v := range t {
+			l[i] = cloneTree(v)
+		}
+		return l
+	default:
+		return v
+	}
+}
+
+func formatTreeValue(v any) string {
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
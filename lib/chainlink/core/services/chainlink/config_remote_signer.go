@@ -0,0 +1,39 @@
+package chainlink
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+var _ config.RemoteSigner = (*remoteSignerConfig)(nil)
+
+type remoteSignerConfig struct {
+	c toml.RemoteSigner
+}
+
+func (r *remoteSignerConfig) Enabled() bool {
+	return *r.c.Enabled
+}
+
+func (r *remoteSignerConfig) URL() *url.URL {
+	if r.c.URL == nil || r.c.URL.IsZero() {
+		return nil
+	}
+	return r.c.URL.URL()
+}
+
+func (r *remoteSignerConfig) Timeout() time.Duration {
+	return r.c.Timeout.Duration()
+}
+
+func (r *remoteSignerConfig) OCR2OnchainAddress() types.EIP55Address {
+	if r.c.OCR2OnchainAddress == nil {
+		return ""
+	}
+	return *r.c.OCR2OnchainAddress
+}
@@ -0,0 +1,372 @@
+package chainlink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+)
+
+// Secret references let a value of the secrets TOML be read from outside of it, when the secrets are parsed at
+// startup, by `chainlink node validate`, and again on reload. A reference is an inline table with a single key, which
+// names the provider, in place of the value:
+//
+//	[Database]
+//	URL = { File = '/run/secrets/database_url' }
+//
+//	[Password]
+//	Keystore = { Exec = ['/usr/local/bin/get-secret', 'keystore'] }
+//
+//	[Mercury.Credentials.cred1]
+//	Password = { Vault = 'secret/data/chainlink#mercury_password' }
+//
+// The Vault provider reads from the HTTP API of HashiCorp Vault, or a compatible server, which is configured in the
+// secrets TOML. Its Token may itself be a File or Exec reference:
+//
+//	[SecretProviders.Vault]
+//	URL = 'https://vault.example.com:8200'
+//	Token = { File = '/run/secrets/vault_token' }
+//	Namespace = 'chainlink' # Optional
+//
+// Only the secrets TOML is resolved, since the config is logged in plain text. The resolved values are redacted like
+// any other secret.
+const (
+	secretProvidersKey = "SecretProviders"
+
+	secretRefFile  = "File"
+	secretRefExec  = "Exec"
+	secretRefVault = "Vault"
+
+	secretProviderTimeout = 30 * time.Second
+)
+
+type secretProvidersConfig struct {
+	Vault *vaultProviderConfig
+}
+
+type vaultProviderConfig struct {
+	URL       *commonconfig.URL
+	Token     *string
+	Namespace *string
+}
+
+// resolveSecretRefs replaces the references in the secrets TOML strings with the values they refer to. It also returns
+// the resolved secrets, merged into a single tree, to find the secrets which changed on reload. Strings which fail to
+// decode are returned as is, for parseSecrets to report.
+func resolveSecretRefs(secrets []string) ([]string, map[string]any, error) {
+	ctx := context.Background()
+
+	trees := make([]map[string]any, len(secrets))
+	changed := make([]bool, len(secrets))
+	providers := make(map[string]any)
+	for i, s := range secrets {
+		tree, err := decodeTree(s)
+		if err != nil {
+			continue
+		}
+		if p, ok := tree[secretProvidersKey]; ok {
+			table, ok := p.(map[string]any)
+			if !ok {
+				return nil, nil, fmt.Errorf("%s: must be a table", secretProvidersKey)
+			}
+			if err = mergeTree(secretProvidersKey, providers, table); err != nil {
+				return nil, nil, err
+			}
+			delete(tree, secretProvidersKey)
+			changed[i] = true
+		}
+		trees[i] = tree
+	}
+
+	r, err := newSecretResolver(ctx, providers)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resolved := slices.Clone(secrets)
+	merged := make(map[string]any)
+	for i, tree := range trees {
+		if tree == nil {
+			continue
+		}
+		n, err := r.resolveTree(ctx, "", tree)
+		if err != nil {
+			return nil, nil, err
+		}
+		if n > 0 || changed[i] {
+			b, err := toml.Marshal(tree)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to encode resolved secrets: %w", err)
+			}
+			resolved[i] = string(b)
+		}
+		// overrides are reported by parseSecrets
+		_ = mergeTree("", merged, cloneTree(tree).(map[string]any))
+	}
+	return resolved, merged, nil
+}
+
+// mergeTree sets the values of src in dst, and errors if a value is set in both.
+func mergeTree(path string, dst, src map[string]any) error {
+	for k, v := range src {
+		p := k
+		if path != "" {
+			p = path + "." + k
+		}
+		existing, ok := dst[k]
+		if !ok {
+			dst[k] = v
+			continue
+		}
+		dstTable, dstIsTable := existing.(map[string]any)
+		srcTable, srcIsTable := v.(map[string]any)
+		if !dstIsTable || !srcIsTable {
+			return fmt.Errorf("%s: set more than once", p)
+		}
+		if err := mergeTree(p, dstTable, srcTable); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type secretResolver struct {
+	vault *vaultClient
+}
+
+func newSecretResolver(ctx context.Context, providers map[string]any) (*secretResolver, error) {
+	r := &secretResolver{}
+	if len(providers) == 0 {
+		return r, nil
+	}
+	// the token of the Vault provider may be a File or Exec reference
+	if _, err := r.resolveTree(ctx, secretProvidersKey, providers); err != nil {
+		return nil, err
+	}
+	b, err := toml.Marshal(providers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s: %w", secretProvidersKey, err)
+	}
+	var c secretProvidersConfig
+	if err = commonconfig.DecodeTOML(bytes.NewReader(b), &c); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", secretProvidersKey, err)
+	}
+	if v := c.Vault; v != nil {
+		if v.URL == nil {
+			return nil, commonconfig.ErrMissing{Name: secretProvidersKey + ".Vault.URL", Msg: "must be set"}
+		}
+		if v.Token == nil || *v.Token == "" {
+			return nil, commonconfig.ErrMissing{Name: secretProvidersKey + ".Vault.Token", Msg: "must be set"}
+		}
+		r.vault = &vaultClient{
+			url:    v.URL.URL(),
+			token:  *v.Token,
+			client: &http.Client{Timeout: secretProviderTimeout},
+			cache:  make(map[string]map[string]any),
+		}
+		if v.Namespace != nil {
+			r.vault.namespace = *v.Namespace
+		}
+	}
+	return r, nil
+}
+
+// resolveTree replaces the references in v with the values they refer to, and returns how many there were.
+func (r *secretResolver) resolveTree(ctx context.Context, path string, v any) (n int, err error) {
+	join := func(key string) string {
+		if path == "" {
+			return key
+		}
+		return path + "." + key
+	}
+	switch t := v.(type) {
+	case map[string]any:
+		for _, k := range slices.Sorted(maps.Keys(t)) {
+			child := t[k]
+			if ref, ok := parseSecretRef(child); ok {
+				if t[k], err = r.resolve(ctx, ref); err != nil {
+					return n, fmt.Errorf("%s: failed to resolve secret: %w", join(k), err)
+				}
+				n++
+				continue
+			}
+			m, err := r.resolveTree(ctx, join(k), child)
+			if err != nil {
+				return n, err
+			}
+			n += m
+		}
+	case []any:
+		for i, child := range t {
+			m, err := r.resolveTree(ctx, join(fmt.Sprint(i)), child)
+			if err != nil {
+				return n, err
+			}
+			n += m
+		}
+	}
+	return n, nil
+}
+
+type secretRef struct {
+	provider string
+	arg      any
+}
+
+// parseSecretRef returns the reference v, if it is one.
+func parseSecretRef(v any) (secretRef, bool) {
+	t, ok := v.(map[string]any)
+	if !ok || len(t) != 1 {
+		return secretRef{}, false
+	}
+	for provider, arg := range t {
+		switch provider {
+		case secretRefFile, secretRefExec, secretRefVault:
+			return secretRef{provider: provider, arg: arg}, true
+		}
+	}
+	return secretRef{}, false
+}
+
+func (r *secretResolver) resolve(ctx context.Context, ref secretRef) (string, error) {
+	switch arg := ref.arg; ref.provider {
+	case secretRefFile:
+		name, ok := arg.(string)
+		if !ok || name == "" {
+			return "", errors.New("File must be a path")
+		}
+		b, err := os.ReadFile(name)
+		if err != nil {
+			return "", fmt.Errorf("failed to read file: %w", err)
+		}
+		return strings.TrimRight(string(b), "\r\n"), nil
+
+	case secretRefExec:
+		l, ok := arg.([]any)
+		if !ok || len(l) == 0 {
+			return "", errors.New("Exec must be a list of the command and its arguments")
+		}
+		args := make([]string, len(l))
+		for i, a := range l {
+			if args[i], ok = a.(string); !ok {
+				return "", errors.New("Exec must be a list of the command and its arguments")
+			}
+		}
+		ctx, cancel := context.WithTimeout(ctx, secretProviderTimeout)
+		defer cancel()
+		var stderr bytes.Buffer
+		cmd := exec.CommandContext(ctx, args[0], args[1:]...) //nolint:gosec // the command is configured by the operator
+		cmd.Stderr = &stderr
+		out, err := cmd.Output()
+		if err != nil {
+			if msg := strings.TrimSpace(stderr.String()); msg != "" {
+				return "", fmt.Errorf("command %s failed: %w: %s", args[0], err, msg)
+			}
+			return "", fmt.Errorf("command %s failed: %w", args[0], err)
+		}
+		return strings.TrimRight(string(out), "\r\n"), nil
+
+	case secretRefVault:
+		s, ok := arg.(string)
+		if !ok {
+			return "", errors.New("Vault must be of the form <path>#<key>")
+		}
+		if r.vault == nil {
+			return "", fmt.Errorf("%s.Vault is not configured", secretProvidersKey)
+		}
+		return r.vault.read(ctx, s)
+	}
+	return "", fmt.Errorf("unknown provider %s", ref.provider)
+}
+
+// vaultClient reads secrets from the KV secrets engine of a HashiCorp Vault compatible server. Both versions of the
+// engine are supported, by the API path of the secret, like secret/data/chainlink for version 2.
+type vaultClient struct {
+	url       *url.URL
+	token     string
+	namespace string
+	client    *http.Client
+
+	cache map[string]map[string]any // by path, to read each secret once
+}
+
+func (v *vaultClient) read(ctx context.Context, ref string) (string, error) {
+	path, key, ok := strings.Cut(ref, "#")
+	if !ok || path == "" || key == "" {
+		return "", fmt.Errorf("Vault must be of the form <path>#<key>, got %q", ref)
+	}
+	data, ok := v.cache[path]
+	if !ok {
+		var err error
+		if data, err = v.get(ctx, path); err != nil {
+			return "", err
+		}
+		v.cache[path] = data
+	}
+	val, ok := data[key]
+	if !ok {
+		return "", fmt.Errorf("key %s not found in %s", key, path)
+	}
+	s, ok := val.(string)
+	if !ok {
+		return "", fmt.Errorf("key %s in %s is not a string", key, path)
+	}
+	return s, nil
+}
+
+func (v *vaultClient) get(ctx context.Context, path string) (map[string]any, error) {
+	ctx, cancel := context.WithTimeout(ctx, secretProviderTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.url.JoinPath("v1", path).String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", v.token)
+	if v.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.namespace)
+	}
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s from vault: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s from vault: %w", path, err)
+	}
+	var body struct {
+		Data   map[string]any `json:"data"`
+		Errors []string       `json:"errors"`
+	}
+	if err = json.Unmarshal(b, &body); err != nil && resp.StatusCode == http.StatusOK {
+		return nil, fmt.Errorf("failed to decode %s from vault: %w", path, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		if len(body.Errors) > 0 {
+			return nil, fmt.Errorf("failed to read %s from vault: %s: %s", path, resp.Status, strings.Join(body.Errors, ", "))
+		}
+		return nil, fmt.Errorf("failed to read %s from vault: %s", path, resp.Status)
+	}
+	// version 2 of the KV engine nests the secret with its metadata
+	if inner, ok := body.Data["data"].(map[string]any); ok {
+		if _, ok = body.Data["metadata"]; ok {
+			return inner, nil
+		}
+	}
+	return body.Data, nil
+}
@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/gagliardetto/solana-go"
 	"github.com/kylelemons/godebug/diff"
 	"github.com/shopspring/decimal"
@@ -312,6 +313,23 @@ func TestConfig_Marshal(t *testing.T) {
 			Frequency:        &hour,
 			Mode:             &config.DatabaseBackupModeFull,
 			OnVersionUpgrade: ptr(true),
+			Target:           &config.DatabaseBackupTargetS3,
+			Encrypt:          ptr(true),
+			Retention:        ptr[uint32](7),
+			Verify:           ptr(true),
+			S3: toml.DatabaseBackupS3{
+				Endpoint:     mustURL("https://s3.example.com"),
+				Bucket:       ptr("chainlink-backups"),
+				Region:       ptr("eu-west-1"),
+				Prefix:       ptr("node-1/"),
+				UsePathStyle: ptr(true),
+			},
+			SFTP: toml.DatabaseBackupSFTP{
+				Addr:         ptr("backups.example.com:22"),
+				User:         ptr("chainlink"),
+				Dir:          ptr("/backups/node-1"),
+				IdentityFile: ptr("/home/chainlink/.ssh/id_ed25519"),
+			},
 		},
 	}
 	full.TelemetryIngress = toml.TelemetryIngress{
@@ -342,18 +360,20 @@ func TestConfig_Marshal(t *testing.T) {
 		},
 	}
 	full.WebServer = toml.WebServer{
-		AuthenticationMethod:    ptr("local"),
-		AllowOrigins:            ptr("*"),
-		BridgeResponseURL:       mustURL("https://bridge.response"),
-		BridgeCacheTTL:          commoncfg.MustNewDuration(10 * time.Second),
-		HTTPWriteTimeout:        commoncfg.MustNewDuration(time.Minute),
-		HTTPPort:                ptr[uint16](56),
-		SecureCookies:           ptr(true),
-		SessionTimeout:          commoncfg.MustNewDuration(time.Hour),
-		SessionReaperExpiration: commoncfg.MustNewDuration(7 * 24 * time.Hour),
-		HTTPMaxSize:             ptr(utils.FileSize(uint64(32770))),
-		StartTimeout:            commoncfg.MustNewDuration(15 * time.Second),
-		ListenIP:                mustIP("192.158.1.37"),
+		AuthenticationMethod:          ptr("local"),
+		AllowOrigins:                  ptr("*"),
+		BridgeResponseURL:             mustURL("https://bridge.response"),
+		BridgeCacheTTL:                commoncfg.MustNewDuration(10 * time.Second),
+		BridgeCircuitBreakerThreshold: ptr[uint32](5),
+		BridgeCircuitBreakerCooldown:  commoncfg.MustNewDuration(time.Minute),
+		HTTPWriteTimeout:              commoncfg.MustNewDuration(time.Minute),
+		HTTPPort:                      ptr[uint16](56),
+		SecureCookies:                 ptr(true),
+		SessionTimeout:                commoncfg.MustNewDuration(time.Hour),
+		SessionReaperExpiration:       commoncfg.MustNewDuration(7 * 24 * time.Hour),
+		HTTPMaxSize:                   ptr(utils.FileSize(uint64(32770))),
+		StartTimeout:                  commoncfg.MustNewDuration(15 * time.Second),
+		ListenIP:                      mustIP("192.158.1.37"),
 		MFA: toml.WebServerMFA{
 			RPID:     ptr("test-rpid"),
 			RPOrigin: ptr("test-rp-origin"),
@@ -641,6 +661,12 @@ func TestConfig_Marshal(t *testing.T) {
 		IgnoreInvalidBridges: ptr(true),
 		IgnoreJoblessBridges: ptr(false),
 	}
+	full.RemoteSigner = toml.RemoteSigner{
+		Enabled:            ptr(true),
+		URL:                mustURL("https://signer.example.com:9000"),
+		Timeout:            commoncfg.MustNewDuration(5 * time.Second),
+		OCR2OnchainAddress: ptr(types.MustEIP55Address("0xa0788FC17B1dEe36f057c42B6F373A34B014687e")),
+	}
 	full.JobDistributor = toml.JobDistributor{
 		DisplayName: ptr("test-node"),
 	}
@@ -651,7 +677,18 @@ func TestConfig_Marshal(t *testing.T) {
 			Chain: evmcfg.Chain{
 				AutoCreateKey: ptr(false),
 				BalanceMonitor: evmcfg.BalanceMonitor{
-					Enabled: ptr(true),
+					Enabled:         ptr(true),
+					MinBalance:      assets.Ether(1),
+					BurnRateWindow:  commoncfg.MustNewDuration(12 * time.Hour),
+					AlertWebhookURL: mustURL("https://balance-alerts.example.com"),
+					Tokens: []evmcfg.BalanceMonitorToken{
+						{
+							Symbol:     ptr("LINK"),
+							Address:    mustAddress("0x538aAaB4ea120b2bC2fe5D296852D948F07D849e"),
+							Decimals:   ptr[uint8](18),
+							MinBalance: mustDecimal("10.5"),
+						},
+					},
 				},
 				BlockBackfillDepth:   ptr[uint32](100),
 				BlockBackfillSkip:    ptr(true),
@@ -702,6 +739,12 @@ func TestConfig_Marshal(t *testing.T) {
 					FeeHistory: evmcfg.FeeHistoryEstimator{
 						CacheTimeout: &second,
 					},
+					Composite: evmcfg.CompositeEstimator{
+						Modes:       []string{"BlockHistory", "FeeHistory"},
+						Aggregation: ptr("Max"),
+						PriceFloor:  assets.GWei(1),
+						MinSources:  ptr[uint32](2),
+					},
 				},
 
 				KeySpecific: []evmcfg.KeySpecific{
@@ -710,6 +753,9 @@ func TestConfig_Marshal(t *testing.T) {
 						GasEstimator: evmcfg.KeySpecificGasEstimator{
 							PriceMax: assets.NewWei(mustHexToBig(t, "FFFFFFFFFFFFFFFFFFFFFFFF")),
 						},
+						BalanceMonitor: evmcfg.KeySpecificBalanceMonitor{
+							MinBalance: assets.UEther(500_000),
+						},
 					},
 				},
 
@@ -741,7 +787,19 @@ func TestConfig_Marshal(t *testing.T) {
 						Enabled: ptr(false),
 					},
 					TransactionManagerV2: evmcfg.TransactionManagerV2Config{
-						Enabled: ptr(false),
+						Enabled:    ptr(false),
+						Persistent: ptr(false),
+					},
+					LifecycleEvents: evmcfg.LifecycleEventsConfig{
+						Enabled:    ptr(false),
+						WebhookURL: mustURL("https://tx-events.example.com"),
+						FilePath:   ptr("/var/log/chainlink/tx-events.jsonl"),
+					},
+					Batching: evmcfg.BatchingConfig{
+						Enabled:           ptr(false),
+						Window:            commoncfg.MustNewDuration(2 * time.Second),
+						MaxBatchSize:      ptr[uint32](10),
+						Multicall3Address: mustAddress("0xcA11bde05977b3631167028862bE2a173976CA11"),
 					},
 					ConfirmationTimeout: &minute,
 				},
@@ -787,6 +845,19 @@ func TestConfig_Marshal(t *testing.T) {
 						TooManyResults:                    ptr[string]("(: |^)too many results"),
 						MissingBlocks:                     ptr[string]("(: |^)missing blocks"),
 					},
+					ConsistencyProbe: evmcfg.ConsistencyProbe{
+						Enabled:            ptr(true),
+						Interval:           commoncfg.MustNewDuration(30 * time.Second),
+						BlockDepth:         ptr[uint32](5),
+						StaleHeadThreshold: ptr[uint32](10),
+						QuarantineScore:    ptr[uint32](40),
+						Calls: []evmcfg.ConsistencyProbeCall{
+							{
+								To:   mustAddress("0x538aAaB4ea120b2bC2fe5D296852D948F07D849e"),
+								Data: ptr(hexutil.Bytes{0x31, 0x3c, 0xe5, 0x67}),
+							},
+						},
+					},
 				},
 				OCR: evmcfg.OCR{
 					ContractConfirmations:              ptr[uint16](11),
@@ -971,6 +1042,23 @@ Dir = 'test/backup/dir'
 Frequency = '1h0m0s'
 Mode = 'full'
 OnVersionUpgrade = true
+Target = 's3'
+Encrypt = true
+Retention = 7
+Verify = true
+
+[Database.Backup.S3]
+Endpoint = 'https://s3.example.com'
+Bucket = 'chainlink-backups'
+Region = 'eu-west-1'
+Prefix = 'node-1/'
+UsePathStyle = true
+
+[Database.Backup.SFTP]
+Addr = 'backups.example.com:22'
+User = 'chainlink'
+Dir = '/backups/node-1'
+IdentityFile = '/home/chainlink/.ssh/id_ed25519'
 
 [Database.Listener]
 MaxReconnectDuration = '1m0s'
@@ -1014,6 +1102,8 @@ AuthenticationMethod = 'local'
 AllowOrigins = '*'
 BridgeResponseURL = 'https://bridge.response'
 BridgeCacheTTL = '10s'
+BridgeCircuitBreakerThreshold = 5
+BridgeCircuitBreakerCooldown = '1m0s'
 HTTPWriteTimeout = '1m0s'
 HTTPPort = 56
 SecureCookies = true
@@ -1221,9 +1311,30 @@ Enabled = false
 
 [EVM.Transactions.TransactionManagerV2]
 Enabled = false
+Persistent = false
+
+[EVM.Transactions.LifecycleEvents]
+Enabled = false
+WebhookURL = 'https://tx-events.example.com'
+FilePath = '/var/log/chainlink/tx-events.jsonl'
+
+[EVM.Transactions.Batching]
+Enabled = false
+Window = '2s'
+MaxBatchSize = 10
+Multicall3Address = '0xcA11bde05977b3631167028862bE2a173976CA11'
 
 [EVM.BalanceMonitor]
 Enabled = true
+MinBalance = '1 ether'
+BurnRateWindow = '12h0m0s'
+AlertWebhookURL = 'https://balance-alerts.example.com'
+
+[[EVM.BalanceMonitor.Tokens]]
+Symbol = 'LINK'
+Address = '0x538aAaB4ea120b2bC2fe5D296852D948F07D849e'
+Decimals = 18
+MinBalance = '10.5'
 
 [EVM.GasEstimator]
 Mode = 'SuggestedPrice'
@@ -1263,6 +1374,12 @@ TransactionPercentile = 15
 [EVM.GasEstimator.FeeHistory]
 CacheTimeout = '1s'
 
+[EVM.GasEstimator.Composite]
+Modes = ['BlockHistory', 'FeeHistory']
+Aggregation = 'Max'
+PriceFloor = '1 gwei'
+MinSources = 2
+
 [EVM.HeadTracker]
 HistoryDepth = 15
 MaxBufferSize = 17
@@ -1278,6 +1395,9 @@ Key = '0x2a3e23c6f242F5345320814aC8a1b4E58707D292'
 [EVM.KeySpecific.GasEstimator]
 PriceMax = '79.228162514264337593543950335 gether'
 
+[EVM.KeySpecific.BalanceMonitor]
+MinBalance = '500 milli'
+
 [EVM.NodePool]
 PollFailureThreshold = 5
 PollInterval = '1m0s'
@@ -1310,6 +1430,17 @@ ServiceUnavailable = '(: |^)service unavailable'
 TooManyResults = '(: |^)too many results'
 MissingBlocks = '(: |^)missing blocks'
 
+[EVM.NodePool.ConsistencyProbe]
+Enabled = true
+Interval = '30s'
+BlockDepth = 5
+StaleHeadThreshold = 10
+QuarantineScore = 40
+
+[[EVM.NodePool.ConsistencyProbe.Calls]]
+To = '0x538aAaB4ea120b2bC2fe5D296852D948F07D849e'
+Data = '0x313ce567'
+
 [EVM.OCR]
 ContractConfirmations = 11
 ContractTransmitterTransmitTimeout = '1m0s'
@@ -1521,6 +1652,9 @@ func TestConfig_full(t *testing.T) {
 		if got.EVM[c].GasEstimator.SenderAddress == nil {
 			got.EVM[c].GasEstimator.SenderAddress = new(types.EIP55Address)
 		}
+		if got.EVM[c].Transactions.LifecycleEvents.WebhookSecret == nil {
+			got.EVM[c].Transactions.LifecycleEvents.WebhookSecret = new(commoncfg.SecretString)
+		}
 	}
 
 	for c := range got.Solana {
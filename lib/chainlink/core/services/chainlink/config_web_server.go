@@ -97,72 +97,80 @@ func (m *mfaConfig) RPOrigin() string {
 }
 
 type webServerConfig struct {
-	c       toml.WebServer
+	c       func() toml.WebServer
 	s       toml.WebServerSecrets
 	rootDir func() string
 }
 
 func (w *webServerConfig) TLS() config.TLS {
-	return &tlsConfig{c: w.c.TLS, rootDir: w.rootDir}
+	return &tlsConfig{c: w.c().TLS, rootDir: w.rootDir}
 }
 
 func (w *webServerConfig) RateLimit() config.RateLimit {
-	return &rateLimitConfig{c: w.c.RateLimit}
+	return &rateLimitConfig{c: w.c().RateLimit}
 }
 
 func (w *webServerConfig) MFA() config.MFA {
-	return &mfaConfig{c: w.c.MFA}
+	return &mfaConfig{c: w.c().MFA}
 }
 
 func (w *webServerConfig) LDAP() config.LDAP {
-	return &ldapConfig{c: w.c.LDAP, s: w.s.LDAP}
+	return &ldapConfig{c: w.c().LDAP, s: w.s.LDAP}
 }
 
 func (w *webServerConfig) OIDC() config.OIDC {
-	return &oidcConfig{c: w.c.OIDC, s: w.s.OIDC}
+	return &oidcConfig{c: w.c().OIDC, s: w.s.OIDC}
 }
 
 func (w *webServerConfig) AuthenticationMethod() string {
-	return *w.c.AuthenticationMethod
+	return *w.c().AuthenticationMethod
 }
 
 func (w *webServerConfig) AllowOrigins() string {
-	return *w.c.AllowOrigins
+	return *w.c().AllowOrigins
 }
 
 func (w *webServerConfig) BridgeResponseURL() *url.URL {
-	if w.c.BridgeResponseURL.IsZero() {
+	if w.c().BridgeResponseURL.IsZero() {
 		return nil
 	}
-	return w.c.BridgeResponseURL.URL()
+	return w.c().BridgeResponseURL.URL()
 }
 
 func (w *webServerConfig) BridgeCacheTTL() time.Duration {
-	return w.c.BridgeCacheTTL.Duration()
+	return w.c().BridgeCacheTTL.Duration()
+}
+
+func (w *webServerConfig) BridgeCircuitBreakerThreshold() uint32 {
+	return *w.c().BridgeCircuitBreakerThreshold
+}
+
+func (w *webServerConfig) BridgeCircuitBreakerCooldown() time.Duration {
+	return w.c().BridgeCircuitBreakerCooldown.Duration()
 }
 
 func (w *webServerConfig) HTTPMaxSize() int64 {
-	return int64(*w.c.HTTPMaxSize)
+	return int64(*w.c().HTTPMaxSize)
 }
 
 func (w *webServerConfig) StartTimeout() time.Duration {
-	return w.c.StartTimeout.Duration()
+	return w.c().StartTimeout.Duration()
 }
 
 func (w *webServerConfig) HTTPWriteTimeout() time.Duration {
-	return w.c.HTTPWriteTimeout.Duration()
+	return w.c().HTTPWriteTimeout.Duration()
 }
 
 func (w *webServerConfig) HTTPPort() uint16 {
-	return *w.c.HTTPPort
+	return *w.c().HTTPPort
 }
 
 func (w *webServerConfig) SessionReaperExpiration() commonconfig.Duration {
-	return *w.c.SessionReaperExpiration
+	return *w.c().SessionReaperExpiration
 }
 
 func (w *webServerConfig) SecureCookies() bool {
-	return *w.c.SecureCookies
+	return *w.c().SecureCookies
 }
 
 func (w *webServerConfig) SessionOptions() sessions.Options {
@@ -175,11 +183,11 @@ func (w *webServerConfig) SessionOptions() sessions.Options {
 }
 
 func (w *webServerConfig) SessionTimeout() commonconfig.Duration {
-	return *commonconfig.MustNewDuration(w.c.SessionTimeout.Duration())
+	return *commonconfig.MustNewDuration(w.c().SessionTimeout.Duration())
 }
 
 func (w *webServerConfig) ListenIP() net.IP {
-	return *w.c.ListenIP
+	return *w.c().ListenIP
 }
 
 type ldapConfig struct {
@@ -21,6 +21,8 @@ func TestWebServerConfig(t *testing.T) {
 	assert.Equal(t, "*", ws.AllowOrigins())
 	assert.Equal(t, "https://bridge.response", ws.BridgeResponseURL().String())
 	assert.Equal(t, 10*time.Second, ws.BridgeCacheTTL())
+	assert.Equal(t, uint32(5), ws.BridgeCircuitBreakerThreshold())
+	assert.Equal(t, time.Minute, ws.BridgeCircuitBreakerCooldown())
 	assert.Equal(t, 1*time.Minute, ws.HTTPWriteTimeout())
 	assert.Equal(t, uint16(56), ws.HTTPPort())
 	assert.True(t, ws.SecureCookies())
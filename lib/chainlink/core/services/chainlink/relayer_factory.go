@@ -4,6 +4,7 @@ import (
 	"cmp"
 	"errors"
 	"fmt"
+	"math/big"
 	"net/http"
 	"reflect"
 
@@ -24,6 +25,7 @@ import (
 	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/env"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/retirement"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/dummy"
@@ -56,6 +58,8 @@ type EVMFactoryConfig struct {
 	EthKeystore   keystore.Eth
 	CSAKeystore   coretypes.Keystore
 	MercuryConfig coreconfig.Mercury
+	// RemoteSigner optionally replaces EthKeystore for signing.
+	RemoteSigner *remotesigner.Client
 }
 
 func (r *RelayerFactory) NewEVM(config EVMFactoryConfig) (map[types.RelayID]evmrelay.RelayAdapter, error) {
@@ -99,12 +103,23 @@ func (r *RelayerFactory) NewEVM(config EVMFactoryConfig) (map[types.RelayID]evmr
 				return nil, fmt.Errorf("failed to create EVM LOOP command: %w", err)
 			}
 
-			ks := keystore.NewEthSigner(config.EthKeystore, chain.ChainID.ToInt())
+			var ks coretypes.Keystore = keystore.NewEthSigner(config.EthKeystore, chain.ChainID.ToInt())
+			if config.RemoteSigner != nil {
+				ks = remotesigner.NewEthSigner(config.RemoteSigner, chain.ChainID.ToInt())
+			}
 			relayers[relayID] = evmrelay.NewLOOPAdapter(loop.NewRelayerService(logger.Named(lggr, relayID.ChainID), r.GRPCOpts, solCmdFn, string(cfgTOML), ks, config.CSAKeystore, r.CapabilitiesRegistry))
 		}
 		return relayers, nil
 	}
 
+	if config.RemoteSigner != nil {
+		genChainStore := newChainStore
+		config.GenChainStore = func(_ coretypes.Keystore, chainID *big.Int) keys.ChainStore {
+			return genChainStore(remotesigner.NewEthSigner(config.RemoteSigner, chainID), chainID)
+		}
+		newChainStore = config.GenChainStore
+	}
+
 	legacyChains, err := evmrelay.NewLegacyChains(lggr, config.EthKeystore, config.ChainOpts)
 	if err != nil {
 		return nil, err
@@ -3,6 +3,10 @@ package cron
 import (
 	"context"
 	"fmt"
+	"math/rand/v2"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/robfig/cron/v3"
 
@@ -13,19 +17,35 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
 
+// parser parses schedules the same way as cron.WithSeconds.
+var parser = cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
 // Cron runs a cron jobSpec from a CronSpec
 type Cron struct {
 	cronRunner     *cron.Cron
 	logger         logger.Logger
 	jobSpec        job.Job
 	pipelineRunner pipeline.Runner
+	orm            ORM
 	chStop         services.StopChan
+	wg             sync.WaitGroup
+
+	// running is held by a run in progress, when the overlap policy is not
+	// allow
+	running sync.Mutex
+
+	mu          sync.Mutex
+	lastFiredAt time.Time
+
+	// for testing
+	now func() time.Time
 }
 
 // NewCronFromJobSpec instantiates a job that executes on a predefined schedule.
 func NewCronFromJobSpec(
 	jobSpec job.Job,
 	pipelineRunner pipeline.Runner,
+	orm ORM,
 	logger logger.Logger,
 ) (*Cron, error) {
 	cronLogger := logger.Named("Cron").With(
@@ -36,25 +56,50 @@ func NewCronFromJobSpec(
 		cronLogger = logger.With("evmChainID", id)
 	}
 
-	return &Cron{
+	cr := &Cron{
 		cronRunner:     cronRunner(),
 		logger:         cronLogger,
 		jobSpec:        jobSpec,
 		pipelineRunner: pipelineRunner,
+		orm:            orm,
 		chStop:         make(chan struct{}),
-	}, nil
+		now:            time.Now,
+	}
+	if jobSpec.CronSpec.LastFiredAt != nil {
+		cr.lastFiredAt = *jobSpec.CronSpec.LastFiredAt
+	}
+	return cr, nil
 }
 
 // Start implements the job.Service interface.
 func (cr *Cron) Start(context.Context) error {
 	cr.logger.Debug("Starting")
 
-	_, err := cr.cronRunner.AddFunc(cr.jobSpec.CronSpec.CronSchedule, cr.runPipeline)
+	schedule, err := parser.Parse(Schedule(*cr.jobSpec.CronSpec))
 	if err != nil {
 		cr.logger.Errorw(fmt.Sprintf("Error running cron job %d", cr.jobSpec.ID), "err", err)
 		return err
 	}
+
+	missed := cr.missedRuns(schedule)
+	cr.cronRunner.Schedule(schedule, cron.FuncJob(func() {
+		cr.run(cr.now(), true)
+	}))
 	cr.cronRunner.Start()
+
+	cr.wg.Add(1)
+	go func() {
+		defer cr.wg.Done()
+		for _, scheduledAt := range missed {
+			select {
+			case <-cr.chStop:
+				return
+			default:
+			}
+			cr.logger.Infow("Catching up missed run", "scheduledAt", scheduledAt)
+			cr.run(scheduledAt, false)
+		}
+	}()
 	return nil
 }
 
@@ -62,10 +107,82 @@ func (cr *Cron) Start(context.Context) error {
 // running and cleans up resources.
 func (cr *Cron) Close() error {
 	cr.logger.Debug("Closing")
-	cr.cronRunner.Stop()
+	close(cr.chStop)
+	<-cr.cronRunner.Stop().Done()
+	cr.wg.Wait()
 	return nil
 }
 
+// missedRuns returns the times the job was due since it last fired, up to
+// MaxCatchUpRuns of the most recent ones.
+func (cr *Cron) missedRuns(schedule cron.Schedule) []time.Time {
+	spec := cr.jobSpec.CronSpec
+	if spec.MaxCatchUpRuns == 0 || spec.LastFiredAt == nil {
+		return nil
+	}
+	var missed []time.Time
+	now := cr.now()
+	for t := schedule.Next(*spec.LastFiredAt); !t.IsZero() && !t.After(now); t = schedule.Next(t) {
+		missed = append(missed, t)
+		if len(missed) > int(spec.MaxCatchUpRuns) {
+			missed = missed[1:]
+		}
+	}
+	return missed
+}
+
+// run runs the job due at dueAt according to its overlap policy, and records
+// dueAt once the run is done, so that a run interrupted by the node stopping is
+// caught up on the next start. A run skipped by the overlap policy is not
+// recorded. Catch-up runs are not delayed by the jitter.
+func (cr *Cron) run(dueAt time.Time, withJitter bool) {
+	// a run waiting for its jitter counts as in progress
+	switch cr.jobSpec.CronSpec.OverlapPolicy {
+	case job.CronOverlapSkip:
+		if !cr.running.TryLock() {
+			cr.logger.Infow("Skipping run, the previous one is still in progress", "dueAt", dueAt)
+			return
+		}
+		defer cr.running.Unlock()
+	case job.CronOverlapQueue:
+		cr.running.Lock()
+		defer cr.running.Unlock()
+	}
+
+	if jitter := cr.jobSpec.CronSpec.Jitter; withJitter && jitter > 0 {
+		select {
+		case <-time.After(rand.N(jitter)):
+		case <-cr.chStop:
+			return
+		}
+	}
+	cr.runPipeline()
+
+	select {
+	case <-cr.chStop:
+		return
+	default:
+	}
+	cr.recordFire(dueAt)
+}
+
+// recordFire persists the time the job was due. Catch-up runs can overlap with
+// scheduled ones, so an earlier time never replaces a later one.
+func (cr *Cron) recordFire(firedAt time.Time) {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	if !firedAt.After(cr.lastFiredAt) {
+		return
+	}
+	cr.lastFiredAt = firedAt
+
+	ctx, cancel := cr.chStop.NewCtx()
+	defer cancel()
+	if err := cr.orm.UpdateLastFiredAt(ctx, cr.jobSpec.CronSpec.ID, firedAt); err != nil {
+		cr.logger.Errorw("Failed to record cron fire time", "err", err)
+	}
+}
+
 func (cr *Cron) runPipeline() {
 	ctx, cancel := cr.chStop.NewCtx()
 	defer cancel()
@@ -94,6 +211,14 @@ func (cr *Cron) runPipeline() {
 	}
 }
 
+// Schedule returns the schedule of the spec, with its Timezone applied.
+func Schedule(spec job.CronSpec) string {
+	if spec.Timezone == "" || strings.HasPrefix(spec.CronSchedule, "CRON_TZ=") {
+		return spec.CronSchedule
+	}
+	return "CRON_TZ=" + spec.Timezone + " " + spec.CronSchedule
+}
+
 func cronRunner() *cron.Cron {
-	return cron.New(cron.WithSeconds())
+	return cron.New(cron.WithParser(parser))
 }
@@ -1,7 +1,11 @@
 package cron_test
 
 import (
+	"context"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -15,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/cron"
+	cronmocks "github.com/smartcontractkit/chainlink/v2/core/services/cron/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	pipelinemocks "github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
@@ -38,7 +43,7 @@ func TestCronV2Pipeline(t *testing.T) {
 		PipelineSpec:  &pipeline.Spec{},
 		ExternalJobID: uuid.New(),
 	}
-	delegate := cron.NewDelegate(runner, lggr)
+	delegate := cron.NewDelegate(runner, db, lggr)
 
 	require.NoError(t, jobORM.CreateJob(testutils.Context(t), jb))
 	serviceArray, err := delegate.ServicesForSpec(testutils.Context(t), *jb)
@@ -67,7 +72,10 @@ func TestCronV2Schedule(t *testing.T) {
 		Return(false, nil).
 		Once()
 
-	service, err := cron.NewCronFromJobSpec(spec, runner, logger.TestLogger(t))
+	orm := cronmocks.NewORM(t)
+	orm.On("UpdateLastFiredAt", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	service, err := cron.NewCronFromJobSpec(spec, runner, orm, logger.TestLogger(t))
 	require.NoError(t, err)
 	err = service.Start(testutils.Context(t))
 	require.NoError(t, err)
@@ -75,3 +83,140 @@ func TestCronV2Schedule(t *testing.T) {
 
 	awaiter.AwaitOrFail(t)
 }
+
+func TestCronV2CatchUp(t *testing.T) {
+	t.Parallel()
+
+	lastFiredAt := time.Now().Add(-5*time.Hour - time.Minute)
+	spec := job.Job{
+		Type:          job.Cron,
+		SchemaVersion: 1,
+		CronSpec: &job.CronSpec{
+			ID:           1,
+			CronSchedule: "@every 1h",
+			// catch-up runs are not delayed by the jitter
+			Jitter:         time.Hour,
+			MaxCatchUpRuns: 2,
+			LastFiredAt:    &lastFiredAt,
+		},
+		PipelineSpec: &pipeline.Spec{},
+	}
+	var mu sync.Mutex
+	var runs int
+	var fired []time.Time
+
+	// a run is recorded only once it is done
+	runner := pipelinemocks.NewRunner(t)
+	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			mu.Lock()
+			defer mu.Unlock()
+			assert.Len(t, fired, runs)
+			runs++
+		}).
+		Return(false, nil).
+		Times(2)
+
+	// only the two most recent of the five missed runs are caught up, in order
+	orm := cronmocks.NewORM(t)
+	orm.On("UpdateLastFiredAt", mock.Anything, int32(1), mock.AnythingOfType("time.Time")).
+		Run(func(args mock.Arguments) {
+			mu.Lock()
+			defer mu.Unlock()
+			fired = append(fired, args.Get(2).(time.Time))
+		}).
+		Return(nil).
+		Times(2)
+
+	service, err := cron.NewCronFromJobSpec(spec, runner, orm, logger.TestLogger(t))
+	require.NoError(t, err)
+	require.NoError(t, service.Start(testutils.Context(t)))
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(fired) == 2
+	}, testutils.WaitTimeout(t), 10*time.Millisecond)
+	require.NoError(t, service.Close())
+
+	require.Len(t, fired, 2)
+	assert.WithinDuration(t, lastFiredAt.Add(4*time.Hour), fired[0], time.Second)
+	assert.WithinDuration(t, lastFiredAt.Add(5*time.Hour), fired[1], time.Second)
+}
+
+func TestCronV2CatchUpInterrupted(t *testing.T) {
+	t.Parallel()
+
+	lastFiredAt := time.Now().Add(-time.Hour - time.Minute)
+	spec := job.Job{
+		Type:          job.Cron,
+		SchemaVersion: 1,
+		CronSpec: &job.CronSpec{
+			ID:             1,
+			CronSchedule:   "@every 1h",
+			MaxCatchUpRuns: 1,
+			LastFiredAt:    &lastFiredAt,
+		},
+		PipelineSpec: &pipeline.Spec{},
+	}
+	started := make(chan struct{})
+	runner := pipelinemocks.NewRunner(t)
+	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			close(started)
+			<-args.Get(0).(context.Context).Done()
+		}).
+		Return(false, context.Canceled).
+		Once()
+	// the run is stopped before it is done, so it must not be recorded
+	orm := cronmocks.NewORM(t)
+
+	service, err := cron.NewCronFromJobSpec(spec, runner, orm, logger.TestLogger(t))
+	require.NoError(t, err)
+	require.NoError(t, service.Start(testutils.Context(t)))
+
+	select {
+	case <-started:
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for the missed run")
+	}
+	require.NoError(t, service.Close())
+}
+
+func TestCronV2OverlapSkip(t *testing.T) {
+	t.Parallel()
+
+	spec := job.Job{
+		Type:          job.Cron,
+		SchemaVersion: 1,
+		CronSpec:      &job.CronSpec{CronSchedule: "@every 1s", OverlapPolicy: job.CronOverlapSkip},
+		PipelineSpec:  &pipeline.Spec{},
+	}
+	runner := pipelinemocks.NewRunner(t)
+	release := make(chan struct{})
+	var runs atomic.Int32
+	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			runs.Add(1)
+			<-release
+		}).
+		Return(false, nil)
+	// only the runs which ran are recorded, not the ones skipped meanwhile
+	orm := cronmocks.NewORM(t)
+	var fired atomic.Int32
+	orm.On("UpdateLastFiredAt", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { fired.Add(1) }).
+		Return(nil)
+
+	service, err := cron.NewCronFromJobSpec(spec, runner, orm, logger.TestLogger(t))
+	require.NoError(t, err)
+	require.NoError(t, service.Start(testutils.Context(t)))
+
+	require.Eventually(t, func() bool { return runs.Load() == 1 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	assert.Never(t, func() bool { return runs.Load() > 1 || fired.Load() > 0 }, 2500*time.Millisecond, 100*time.Millisecond)
+
+	close(release)
+	require.Eventually(t, func() bool { return fired.Load() >= 1 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	require.NoError(t, service.Close())
+	assert.LessOrEqual(t, fired.Load(), runs.Load())
+}
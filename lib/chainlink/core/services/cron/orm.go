@@ -0,0 +1,31 @@
+package cron
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+type ORM interface {
+	// UpdateLastFiredAt records the last time a cron job was due, so that runs
+	// missed while it was not running can be caught up.
+	UpdateLastFiredAt(ctx context.Context, specID int32, firedAt time.Time) error
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(ds sqlutil.DataSource) ORM {
+	return &orm{ds: ds}
+}
+
+func (o *orm) UpdateLastFiredAt(ctx context.Context, specID int32, firedAt time.Time) error {
+	_, err := o.ds.ExecContext(ctx, `UPDATE cron_specs SET last_fired_at = $1, updated_at = NOW() WHERE id = $2`, firedAt, specID)
+	return errors.Wrap(err, "UpdateLastFiredAt failed")
+}
@@ -1,15 +1,22 @@
 package cron
 
 import (
+	"strings"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
-func ValidatedCronSpec(tomlString string) (job.Job, error) {
+// MaxCatchUpRunsLimit bounds maxCatchUpRuns, as every missed run is run when the job starts.
+const MaxCatchUpRunsLimit = 100
+
+func ValidatedCronSpec(tomlString string, opts ...pipeline.ParseOption) (job.Job, error) {
 	var jb = job.Job{
 		ExternalJobID: uuid.New(), // Default to generating a uuid, can be overwritten by the specified one in tomlString.
 	}
@@ -23,6 +30,9 @@ func ValidatedCronSpec(tomlString string) (job.Job, error) {
 	if err != nil {
 		return jb, errors.Wrap(err, "toml unmarshal error on spec")
 	}
+	if err = jb.ResolvePipeline(opts...); err != nil {
+		return jb, err
+	}
 
 	var spec job.CronSpec
 	err = tree.Unmarshal(&spec)
@@ -34,9 +44,30 @@ func ValidatedCronSpec(tomlString string) (job.Job, error) {
 	if jb.Type != job.Cron {
 		return jb, errors.Errorf("unsupported type %s", jb.Type)
 	}
-	if err := utils.ValidateCronSchedule(spec.CronSchedule); err != nil {
+	if spec.Timezone != "" {
+		if strings.HasPrefix(spec.CronSchedule, "CRON_TZ=") {
+			return jb, errors.New("timezone cannot be set when the schedule has a CRON_TZ prefix")
+		}
+		if _, err := time.LoadLocation(spec.Timezone); err != nil {
+			return jb, errors.Wrapf(err, "invalid timezone '%v'", spec.Timezone)
+		}
+	}
+	if err := utils.ValidateCronSchedule(Schedule(spec)); err != nil {
 		return jb, errors.Wrapf(err, "while validating cron schedule '%v'", spec.CronSchedule)
 	}
+	if spec.Jitter < 0 {
+		return jb, errors.Errorf("jitter must not be negative, got %v", spec.Jitter)
+	}
+	if spec.MaxCatchUpRuns > MaxCatchUpRunsLimit {
+		return jb, errors.Errorf("maxCatchUpRuns must be at most %d, got %d", MaxCatchUpRunsLimit, spec.MaxCatchUpRuns)
+	}
+	switch spec.OverlapPolicy {
+	case "":
+		jb.CronSpec.OverlapPolicy = job.CronOverlapAllow
+	case job.CronOverlapAllow, job.CronOverlapSkip, job.CronOverlapQueue:
+	default:
+		return jb, errors.Errorf("unknown overlapPolicy '%v', must be one of %s, %s or %s", spec.OverlapPolicy, job.CronOverlapAllow, job.CronOverlapSkip, job.CronOverlapQueue)
+	}
 
 	return jb, nil
 }
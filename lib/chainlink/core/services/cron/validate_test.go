@@ -2,6 +2,7 @@ package cron_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/stretchr/testify/assert"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/cron"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
 
 func TestValidatedCronJobSpec(t *testing.T) {
@@ -76,6 +78,141 @@ ds -> ds_parse -> ds_multiply;
 				assert.Contains(t, err.Error(), "invalid cron schedule")
 			},
 		},
+		{
+			name: "scheduling options",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "0 30 9 * * MON-FRI"
+timezone        = "Europe/Berlin"
+jitter          = "30s"
+overlapPolicy   = "skip"
+maxCatchUpRuns  = 3
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "Europe/Berlin", s.CronSpec.Timezone)
+				assert.Equal(t, 30*time.Second, s.CronSpec.Jitter)
+				assert.Equal(t, job.CronOverlapSkip, s.CronSpec.OverlapPolicy)
+				assert.Equal(t, uint32(3), s.CronSpec.MaxCatchUpRuns)
+				assert.Equal(t, "CRON_TZ=Europe/Berlin 0 30 9 * * MON-FRI", cron.Schedule(*s.CronSpec))
+			},
+		},
+		{
+			name: "too many catch-up runs",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "@every 1m"
+maxCatchUpRuns  = 101
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "maxCatchUpRuns must be at most 100, got 101")
+			},
+		},
+		{
+			name: "default overlap policy",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "@every 1m"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, job.CronOverlapAllow, s.CronSpec.OverlapPolicy)
+			},
+		},
+		{
+			name: "invalid timezone",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "0 0 1 1 * *"
+timezone        = "Mars/Olympus_Mons"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.ErrorContains(t, err, "invalid timezone")
+			},
+		},
+		{
+			name: "timezone and CRON_TZ",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "CRON_TZ=UTC 0 0 1 1 * *"
+timezone        = "UTC"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.ErrorContains(t, err, "timezone cannot be set when the schedule has a CRON_TZ prefix")
+			},
+		},
+		{
+			name: "invalid overlap policy",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "@every 1m"
+overlapPolicy   = "sometimes"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.ErrorContains(t, err, "unknown overlapPolicy 'sometimes'")
+			},
+		},
+		{
+			name: "negative jitter",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "@every 1m"
+jitter          = "-1s"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.ErrorContains(t, err, "jitter must not be negative")
+			},
+		},
 	}
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
@@ -84,3 +221,27 @@ ds -> ds_parse -> ds_multiply;
 		})
 	}
 }
+
+func TestValidatedCronSpec_fragments(t *testing.T) {
+	toml := `
+type              = "cron"
+schemaVersion     = 1
+schedule          = "CRON_TZ=UTC 0 0 1 1 * *"
+observationSource = """
+ds    [type=http method=GET url="https://chain.link/ETH-USD"];
+price [type=fragment name=parse input="$(ds)"];
+ds -> price;
+"""
+`
+	fragments := pipeline.WithFragments(func(name string) (string, bool) {
+		return `p [type=jsonparse data="$(fragment.input)" path="data,price"]`, name == "parse"
+	})
+
+	s, err := cron.ValidatedCronSpec(toml, fragments)
+	require.NoError(t, err)
+	assert.False(t, s.Pipeline.Unresolved())
+	assert.IsType(t, &pipeline.JSONParseTask{}, s.Pipeline.ByDotID("price"))
+
+	_, err = cron.ValidatedCronSpec(toml)
+	require.ErrorIs(t, err, pipeline.ErrFragmentNotFound)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/null"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
@@ -20,7 +21,7 @@ type DirectRequestToml struct {
 	MinIncomingConfirmations null.Uint32              `toml:"minIncomingConfirmations"`
 }
 
-func ValidatedDirectRequestSpec(tomlString string) (job.Job, error) {
+func ValidatedDirectRequestSpec(tomlString string, opts ...pipeline.ParseOption) (job.Job, error) {
 	var jb = job.Job{}
 	tree, err := toml.Load(tomlString)
 	if err != nil {
@@ -30,6 +31,9 @@ func ValidatedDirectRequestSpec(tomlString string) (job.Job, error) {
 	if err != nil {
 		return jb, err
 	}
+	if err = jb.ResolvePipeline(opts...); err != nil {
+		return jb, err
+	}
 	var spec DirectRequestToml
 	err = tree.Unmarshal(&spec)
 	if err != nil {
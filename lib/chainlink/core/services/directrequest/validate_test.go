@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
 
 func TestValidatedDirectRequestSpec(t *testing.T) {
@@ -72,3 +74,29 @@ func TestValidatedDirectRequestSpec_MinIncomingConfirmations(t *testing.T) {
 		assert.Equal(t, uint32(100), s.DirectRequestSpec.MinIncomingConfirmations.Uint32)
 	})
 }
+
+func TestValidatedDirectRequestSpec_fragments(t *testing.T) {
+	t.Parallel()
+
+	toml := `
+type                = "directrequest"
+schemaVersion       = 1
+contractAddress     = "0x613a38AC1659769640aaE063C651F48E0250454C"
+observationSource   = """
+    ds1          [type=http method=GET url="example.com" allowunrestrictednetworkaccess="true"];
+    ds1_parse    [type=fragment name=parse input="$(ds1)"];
+    ds1 -> ds1_parse;
+"""
+`
+	fragments := pipeline.WithFragments(func(name string) (string, bool) {
+		return `p [type=jsonparse data="$(fragment.input)" path="USD"]`, name == "parse"
+	})
+
+	s, err := ValidatedDirectRequestSpec(toml, fragments)
+	require.NoError(t, err)
+	assert.False(t, s.Pipeline.Unresolved())
+	assert.IsType(t, &pipeline.JSONParseTask{}, s.Pipeline.ByDotID("ds1_parse"))
+
+	_, err = ValidatedDirectRequestSpec(toml)
+	require.ErrorIs(t, err, pipeline.ErrFragmentNotFound)
+}
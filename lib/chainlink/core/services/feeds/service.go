@@ -845,7 +845,7 @@ func (s *service) ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64,
 		return 0, err
 	}
 	// auto approve workflow specs
-	if isWFSpec(logger, args.Spec) {
+	if s.isWFSpec(ctx, logger, args.Spec) {
 		promWorkflowRequests.Inc()
 		promFeedsWorkflowRequests.Inc()
 		err = s.ApproveSpec(ctx, specID, true)
@@ -871,8 +871,13 @@ func (s *service) ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64,
 	return id, nil
 }
 
-func isWFSpec(lggr logger.Logger, spec string) bool {
-	jobType, err := job.ValidateSpec(spec)
+func (s *service) isWFSpec(ctx context.Context, lggr logger.Logger, spec string) bool {
+	fragments, err := pipeline.NewFragmentORM(s.ds).Lookup(ctx)
+	if err != nil {
+		lggr.Errorw("Failed to load pipeline fragments while checking for workflow", "err", err)
+		return false
+	}
+	jobType, err := job.ValidateSpec(spec, pipeline.WithFragments(fragments))
 	if err != nil {
 		// this should not happen in practice
 		lggr.Errorw("Failed to validate spec while checking for workflow", "err", err)
@@ -1442,7 +1447,12 @@ func findExistingJobForOCRFlux(ctx context.Context, j *job.Job, tx job.ORM) (int
 
 // generateJob validates and generates a job from a spec.
 func (s *service) generateJob(ctx context.Context, spec string) (*job.Job, error) {
-	jobType, err := job.ValidateSpec(spec)
+	fragments, err := pipeline.NewFragmentORM(s.ds).Lookup(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load pipeline fragments: %w", err)
+	}
+	withFragments := pipeline.WithFragments(fragments)
+	jobType, err := job.ValidateSpec(spec, withFragments)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse job spec TOML'%s': %w", spec, err)
 	}
@@ -1453,25 +1463,25 @@ func (s *service) generateJob(ctx context.Context, spec string) (*job.Job, error
 		if !s.ocrCfg.Enabled() {
 			return nil, ErrOCRDisabled
 		}
-		js, err = ocr.ValidatedOracleSpecToml(s.gCfg, s.legacyChains, spec)
+		js, err = ocr.ValidatedOracleSpecToml(s.gCfg, s.legacyChains, spec, withFragments)
 	case job.OffchainReporting2:
 		if !s.ocr2cfg.Enabled() {
 			return nil, ErrOCR2Disabled
 		}
-		js, err = ocr2.ValidatedOracleSpecToml(ctx, s.ocr2cfg, s.insecureCfg, spec, s.loopRegistrarConfig)
+		js, err = ocr2.ValidatedOracleSpecToml(ctx, s.ocr2cfg, s.insecureCfg, spec, s.loopRegistrarConfig, withFragments)
 	case job.Bootstrap:
 		if !s.ocr2cfg.Enabled() {
 			return nil, ErrOCR2Disabled
 		}
 		js, err = ocrbootstrap.ValidatedBootstrapSpecToml(spec)
 	case job.FluxMonitor:
-		js, err = fluxmonitorv2.ValidatedFluxMonitorSpec(s.jobCfg, spec)
+		js, err = fluxmonitorv2.ValidatedFluxMonitorSpec(s.jobCfg, spec, withFragments)
 	case job.Workflow:
 		js, err = workflows.ValidatedWorkflowJobSpec(ctx, spec)
 	case job.CCIP:
 		js, err = ccip.ValidatedCCIPSpec(spec)
 	case job.Stream:
-		js, err = streams.ValidatedStreamSpec(spec)
+		js, err = streams.ValidatedStreamSpec(spec, withFragments)
 	case job.Gateway:
 		js, err = gateway.ValidatedGatewaySpec(spec)
 	case job.StandardCapabilities:
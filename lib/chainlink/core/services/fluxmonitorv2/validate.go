@@ -10,6 +10,7 @@ import (
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
@@ -17,7 +18,7 @@ type ValidationConfig interface {
 	DefaultHTTPTimeout() commonconfig.Duration
 }
 
-func ValidatedFluxMonitorSpec(config ValidationConfig, ts string) (job.Job, error) {
+func ValidatedFluxMonitorSpec(config ValidationConfig, ts string, opts ...pipeline.ParseOption) (job.Job, error) {
 	var jb = job.Job{
 		ExternalJobID: uuid.New(), // Default to generating a uuid, can be overwritten by the specified one in tomlString.
 	}
@@ -30,6 +31,9 @@ func ValidatedFluxMonitorSpec(config ValidationConfig, ts string) (job.Job, erro
 	if err != nil {
 		return jb, err
 	}
+	if err = jb.ResolvePipeline(opts...); err != nil {
+		return jb, err
+	}
 	err = tree.Unmarshal(&spec)
 	if err != nil {
 		return jb, err
@@ -9,6 +9,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/tomlutils"
 
 	"github.com/stretchr/testify/assert"
@@ -221,3 +222,41 @@ externalJobID = "cfa3fa6b-2850-446b-b973-8f4c3b29d519"
 		})
 	}
 }
+
+func TestValidate_fragments(t *testing.T) {
+	t.Parallel()
+
+	toml := `
+type              = "fluxmonitor"
+schemaVersion     = 1
+contractAddress   = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+threshold         = 0.5
+absoluteThreshold = 0.0
+idleTimerDisabled = true
+pollTimerPeriod   = "1m"
+observationSource = """
+prices [type=fragment name=median2];
+answer [type=multiply input="$(prices)" times=100];
+prices -> answer;
+"""
+`
+	fragments := pipeline.WithFragments(func(name string) (string, bool) {
+		return `
+ds1       [type=http method=GET url="https://pricesource1.com"];
+ds1_parse [type=jsonparse path="latest"];
+ds2       [type=http method=GET url="https://pricesource2.com"];
+ds2_parse [type=jsonparse path="latest"];
+median    [type=median];
+ds1 -> ds1_parse -> median;
+ds2 -> ds2_parse -> median;
+`, name == "median2"
+	})
+
+	s, err := ValidatedFluxMonitorSpec(testcfg{}, toml, fragments)
+	require.NoError(t, err)
+	assert.False(t, s.Pipeline.Unresolved())
+	assert.IsType(t, &pipeline.MedianTask{}, s.Pipeline.ByDotID("prices"))
+
+	_, err = ValidatedFluxMonitorSpec(testcfg{}, toml)
+	require.ErrorIs(t, err, pipeline.ErrFragmentNotFound)
+}
@@ -152,6 +152,7 @@ func newLegacyChainContainer(t *testing.T, db *sqlx.DB) legacyevm.LegacyChainCon
 		keyStore,
 		estimator,
 		ht,
+		nil,
 		nil)
 	require.NoError(t, err)
 
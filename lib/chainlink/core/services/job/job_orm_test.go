@@ -4,10 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/google/uuid"
 	"github.com/lib/pq"
 	"github.com/pelletier/go-toml/v2"
@@ -42,6 +44,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	ocr2validate "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
@@ -964,6 +967,58 @@ func TestORM_CreateJob_OCR2_Sending_Keys_Transmitter_Keys_Validations(t *testing
 	})
 }
 
+func TestORM_CreateJob_OCR2_RemoteSignerTransmitter(t *testing.T) {
+	ctx := testutils.Context(t)
+	config := configtest.NewGeneralConfig(t, nil)
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db)
+	require.NoError(t, keyStore.OCR2().Add(ctx, cltest.DefaultOCR2Key))
+
+	// the transmitter is only held by the remote signer
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer, err := remotesigner.NewReferenceSigner(testutils.FixtureChainID, key)
+	require.NoError(t, err)
+	t.Cleanup(signer.Stop)
+	srv := httptest.NewServer(signer)
+	t.Cleanup(srv.Close)
+	client, err := remotesigner.NewClient(srv.URL, testutils.WaitTimeout(t))
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+	transmitter := crypto.PubkeyToAddress(key.PublicKey)
+
+	lggr := logger.TestLogger(t)
+	pipelineORM := pipeline.NewORM(db, lggr, config.JobPipeline().MaxSuccessfulRuns())
+	bridgesORM := bridges.NewORM(db)
+
+	newJob := func(t *testing.T) job.Job {
+		jb, err := ocr2validate.ValidatedOracleSpecToml(testutils.Context(t), config.OCR2(), config.Insecure(), testspecs.GetOCR2EVMSpecMinimal(), nil)
+		require.NoError(t, err)
+		jb.OCR2OracleSpec.TransmitterID = null.StringFrom(transmitter.String())
+		return jb
+	}
+
+	t.Run("rejected without the remote signer", func(t *testing.T) {
+		jb := newJob(t)
+		jobORM := NewTestORM(t, db, pipelineORM, bridgesORM, keyStore)
+		require.ErrorIs(t, jobORM.CreateJob(testutils.Context(t), &jb), job.ErrNoSuchTransmitterKey)
+	})
+
+	t.Run("accepted with the remote signer", func(t *testing.T) {
+		jb := newJob(t)
+		jobORM := NewTestORM(t, db, pipelineORM, bridgesORM, remotesigner.NewKeystore(keyStore, client))
+		require.NoError(t, jobORM.CreateJob(testutils.Context(t), &jb))
+	})
+
+	t.Run("validates sending keys", func(t *testing.T) {
+		ks := remotesigner.NewKeystore(keyStore, client)
+		jb := newJob(t)
+		require.NoError(t, job.ValidateKeyStoreMatch(ctx, jb.OCR2OracleSpec, ks, transmitter.String()))
+		err := job.ValidateKeyStoreMatch(ctx, jb.OCR2OracleSpec, ks, testutils.NewAddress().String())
+		require.ErrorContains(t, err, "no EVM key matching")
+	})
+}
+
 func TestORM_ValidateKeyStoreMatch(t *testing.T) {
 	ctx := testutils.Context(t)
 	config := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {})
@@ -228,6 +228,22 @@ func (j *Job) SetID(value string) error {
 	return nil
 }
 
+// ResolvePipeline parses the pipeline of a job decoded from TOML again with
+// opts if it references pipeline fragments, see pipeline.WithFragments. The
+// Source keeps the references, they are only expanded in the pipeline spec
+// stored by CreateJob.
+func (j *Job) ResolvePipeline(opts ...pipeline.ParseOption) error {
+	if !j.Pipeline.Unresolved() {
+		return nil
+	}
+	p, err := pipeline.Parse(j.Pipeline.Source, opts...)
+	if err != nil {
+		return errors.Wrap(err, "invalid pipeline")
+	}
+	j.Pipeline = *p
+	return nil
+}
+
 type PipelineSpec struct {
 	JobID          int32 `json:"-"`
 	PipelineSpecID int32 `json:"-"`
@@ -499,12 +515,38 @@ type DirectRequestSpec struct {
 	UpdatedAt                time.Time                `toml:"-"`
 }
 
+// CronOverlapPolicy decides what happens when a cron job is due while its previous run is still in progress.
+type CronOverlapPolicy string
+
+const (
+	// CronOverlapAllow starts the run regardless. It is the default.
+	CronOverlapAllow CronOverlapPolicy = "allow"
+	// CronOverlapSkip drops the run.
+	CronOverlapSkip CronOverlapPolicy = "skip"
+	// CronOverlapQueue starts the run once the previous one has finished.
+	CronOverlapQueue CronOverlapPolicy = "queue"
+)
+
 type CronSpec struct {
-	ID           int32     `toml:"-"`
-	CronSchedule string    `toml:"schedule"`
-	EVMChainID   *big.Big  `toml:"evmChainID"`
-	CreatedAt    time.Time `toml:"-"`
-	UpdatedAt    time.Time `toml:"-"`
+	ID           int32    `toml:"-"`
+	CronSchedule string   `toml:"schedule"`
+	EVMChainID   *big.Big `toml:"evmChainID"`
+	// Timezone is the IANA time zone the schedule is evaluated in, as an
+	// alternative to a CRON_TZ prefix on the schedule.
+	Timezone string `toml:"timezone"`
+	// Jitter delays every scheduled run by a random duration up to this value.
+	// Catch-up runs are not delayed.
+	Jitter        time.Duration     `toml:"jitter"`
+	OverlapPolicy CronOverlapPolicy `toml:"overlapPolicy"`
+	// MaxCatchUpRuns is the number of runs missed while the job was not
+	// running, e.g. during a node restart, which are run on start. The most
+	// recent ones are run. Zero disables catch-up, and it is at most 100.
+	MaxCatchUpRuns uint32 `toml:"maxCatchUpRuns"`
+	// LastFiredAt is the last time the job was due and ran, used to find
+	// missed runs.
+	LastFiredAt *time.Time `toml:"-"`
+	CreatedAt   time.Time  `toml:"-"`
+	UpdatedAt   time.Time  `toml:"-"`
 }
 
 func (s CronSpec) GetID() string {
@@ -601,6 +643,15 @@ type VRFSpec struct {
 	// only.
 	BackoffMaxDelay time.Duration `toml:"backoffMaxDelay"`
 
+	// SubscriptionAlertThreshold raises an alert for a served subscription once its balance is
+	// forecast to run out within this duration, or can no longer pay for a fulfillment at the
+	// recent average cost. Optional, alerts are disabled if not provided. V2 only.
+	SubscriptionAlertThreshold time.Duration `toml:"subscriptionAlertThreshold"`
+
+	// SubscriptionAlertWebhookURL is posted to when a subscription alert is raised or cleared.
+	// Optional, requires subscriptionAlertThreshold. V2 only.
+	SubscriptionAlertWebhookURL string `toml:"subscriptionAlertWebhookURL"`
+
 	CreatedAt time.Time `toml:"-"`
 	UpdatedAt time.Time `toml:"-"`
 }
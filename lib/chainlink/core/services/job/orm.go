@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"reflect"
 	"slices"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -175,13 +177,18 @@ func (o *orm) CreateJob(ctx context.Context, jb *Job) error {
 		o.lggr.Warnw("Job of this type will not be supported in chainlink v3", "type", jb.Type)
 	}
 
+	fragments, err := o.expandFragments(ctx, jb)
+	if err != nil {
+		return err
+	}
+
 	p := jb.Pipeline
 	if err := o.AssertBridgesExist(ctx, p); err != nil {
 		return err
 	}
 
 	var jobID int32
-	err := o.transact(ctx, false, func(tx *orm) error {
+	err = o.transact(ctx, false, func(tx *orm) error {
 		// Autogenerate a job ID if not specified
 		if jb.ExternalJobID == (uuid.UUID{}) {
 			jb.ExternalJobID = uuid.New()
@@ -218,7 +225,7 @@ func (o *orm) CreateJob(ctx context.Context, jb *Job) error {
 				}
 			}
 			if jb.OCROracleSpec.TransmitterAddress != nil {
-				_, err := tx.keyStore.Eth().Get(ctx, jb.OCROracleSpec.TransmitterAddress.Hex())
+				err := checkEVMKey(ctx, tx.keyStore.Eth(), jb.OCROracleSpec.TransmitterAddress.Hex())
 				if err != nil {
 					return errors.Wrapf(ErrNoSuchTransmitterKey, "no key matching transmitter address: %s", jb.OCROracleSpec.TransmitterAddress.Hex())
 				}
@@ -520,7 +527,10 @@ func (o *orm) CreateJob(ctx context.Context, jb *Job) error {
 
 		err = tx.InsertJob(ctx, jb)
 		jobID = jb.ID
-		return errors.Wrap(err, "failed to insert job")
+		if err != nil {
+			return errors.Wrap(err, "failed to insert job")
+		}
+		return pipeline.NewFragmentORM(tx.ds).InsertJobFragments(ctx, jobID, fragments)
 	})
 	if err != nil {
 		return errors.Wrap(err, "CreateJobFailed")
@@ -529,6 +539,66 @@ func (o *orm) CreateJob(ctx context.Context, jb *Job) error {
 	return o.findJob(ctx, jb, "id", jobID)
 }
 
+// expandFragments replaces the references to pipeline fragments in the
+// pipelines of the job by the fragments, so that the job is not affected by
+// later changes to them, and returns the names of the fragments used.
+func (o *orm) expandFragments(ctx context.Context, jb *Job) ([]string, error) {
+	lookup, err := pipeline.NewFragmentORM(o.ds).Lookup(ctx)
+	if err != nil {
+		return nil, err
+	}
+	used := make(map[string]struct{})
+	expand := func(source string) (string, error) {
+		expanded, names, err := pipeline.ExpandFragments(source, lookup)
+		for _, name := range names {
+			used[name] = struct{}{}
+		}
+		return expanded, err
+	}
+
+	if strings.TrimSpace(jb.Pipeline.Source) != "" {
+		source, err := expand(jb.Pipeline.Source)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse pipeline")
+		}
+		if source != jb.Pipeline.Source {
+			p, err := pipeline.Parse(source)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to parse pipeline")
+			}
+			jb.Pipeline = *p
+		}
+	}
+
+	// pipelines in the plugin config are run by the plugins
+	if jb.Type == OffchainReporting2 && jb.OCR2OracleSpec != nil {
+		var keys []string
+		switch jb.OCR2OracleSpec.PluginType {
+		case types.Median:
+			keys = []string{"juelsPerFeeCoinSource", "gasPriceSubunitsSource"}
+		case types.CCIPCommit:
+			keys = []string{"tokenPricesUSDPipeline"}
+		default:
+		}
+		for _, key := range keys {
+			source, ok := jb.OCR2OracleSpec.PluginConfig[key].(string)
+			if !ok || strings.TrimSpace(source) == "" {
+				continue
+			}
+			if jb.OCR2OracleSpec.PluginConfig[key], err = expand(source); err != nil {
+				return nil, errors.Wrapf(err, "failed to parse %s pipeline", key)
+			}
+		}
+	}
+
+	names := make([]string, 0, len(used))
+	for name := range used {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
 func (o *orm) prepareQuerySpecID(ctx context.Context, sql string, arg any) (specID int32, err error) {
 	var stmt *sqlx.NamedStmt
 	stmt, err = o.ds.PrepareNamedContext(ctx, sql)
@@ -581,8 +651,8 @@ func (o *orm) insertKeeperSpec(ctx context.Context, spec *KeeperSpec) (specID in
 }
 
 func (o *orm) insertCronSpec(ctx context.Context, spec *CronSpec) (specID int32, err error) {
-	return o.prepareQuerySpecID(ctx, `INSERT INTO cron_specs (cron_schedule, evm_chain_id, created_at, updated_at)
-			VALUES (:cron_schedule, :evm_chain_id, NOW(), NOW())
+	return o.prepareQuerySpecID(ctx, `INSERT INTO cron_specs (cron_schedule, evm_chain_id, timezone, jitter, overlap_policy, max_catch_up_runs, created_at, updated_at)
+			VALUES (:cron_schedule, :evm_chain_id, :timezone, :jitter, :overlap_policy, :max_catch_up_runs, NOW(), NOW())
 			RETURNING id;`, spec)
 }
 
@@ -593,6 +663,7 @@ func (o *orm) insertVRFSpec(ctx context.Context, spec *VRFSpec) (specID int32, e
 				request_timeout, chunk_size, batch_coordinator_address, batch_fulfillment_enabled,
 				batch_fulfillment_gas_multiplier, backoff_initial_delay, backoff_max_delay, gas_lane_price,
                 vrf_owner_address, custom_reverts_pipeline_enabled,
+				subscription_alert_threshold, subscription_alert_webhook_url,
 				created_at, updated_at)
 			VALUES (
 				:coordinator_address, :public_key, :min_incoming_confirmations,
@@ -600,6 +671,7 @@ func (o *orm) insertVRFSpec(ctx context.Context, spec *VRFSpec) (specID int32, e
 				:request_timeout, :chunk_size, :batch_coordinator_address, :batch_fulfillment_enabled,
 				:batch_fulfillment_gas_multiplier, :backoff_initial_delay, :backoff_max_delay, :gas_lane_price,
 			    :vrf_owner_address, :custom_reverts_pipeline_enabled,
+				:subscription_alert_threshold, :subscription_alert_webhook_url,
 				NOW(), NOW())
 			RETURNING id;`, toVRFSpecRow(spec))
 }
@@ -661,8 +733,7 @@ func ValidateKeyStoreMatch(ctx context.Context, spec *OCR2OracleSpec, keyStore k
 func validateKeyStoreMatchForRelay(ctx context.Context, network string, keyStore keystore.Master, key string) error {
 	switch network {
 	case relay.NetworkEVM:
-		_, err := keyStore.Eth().Get(ctx, key)
-		if err != nil {
+		if err := checkEVMKey(ctx, keyStore.Eth(), key); err != nil {
 			return errors.Errorf("no EVM key matching: %q", key)
 		}
 	case relay.NetworkCosmos:
@@ -704,6 +775,19 @@ func validateKeyStoreMatchForRelay(ctx context.Context, network string, keyStore
 	return nil
 }
 
+// checkEVMKey returns an error if there is no EVM key with the address key. The keys of a remote signer are not in the
+// keystore, but are enabled for its Eth keystore, see remotesigner.NewEthKeystore.
+func checkEVMKey(ctx context.Context, ks keystore.Eth, key string) error {
+	if _, err := ks.Get(ctx, key); err == nil {
+		return nil
+	}
+	if !common.IsHexAddress(key) {
+		return errors.Errorf("invalid address %q", key)
+	}
+	// the keystore has no such key, so only a remote signer may hold it, for every chain
+	return ks.CheckEnabled(ctx, common.HexToAddress(key), nil)
+}
+
 func areSendingKeysDefined(ctx context.Context, jb *Job, keystore keystore.Master) (bool, error) {
 	if jb.OCR2OracleSpec.RelayConfig["sendingKeys"] != nil {
 		sendingKeys, err := SendingKeysForJob(jb.OCR2OracleSpec)
@@ -5,6 +5,8 @@ import (
 
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
 
 var (
@@ -33,8 +35,9 @@ var (
 	}
 )
 
-// ValidateSpec is the common spec validation
-func ValidateSpec(ts string) (Type, error) {
+// ValidateSpec is the common spec validation. opts are used to parse a
+// pipeline which references pipeline fragments.
+func ValidateSpec(ts string, opts ...pipeline.ParseOption) (Type, error) {
 	var jb Job
 	// Note we can't use:
 	//   toml.NewDecoder(bytes.NewReader([]byte(ts))).Strict(true).Decode(&jb)
@@ -64,6 +67,9 @@ func ValidateSpec(ts string) (Type, error) {
 	if jb.Type.RequiresPipelineSpec() && (jb.Pipeline.Source == "") {
 		return "", ErrNoPipelineSpec
 	}
+	if err = jb.ResolvePipeline(opts...); err != nil {
+		return "", err
+	}
 	if jb.Pipeline.RequiresPreInsert() && !jb.Type.SupportsAsync() {
 		return "", errors.Errorf("async=true tasks are not supported for %v", jb.Type)
 	}
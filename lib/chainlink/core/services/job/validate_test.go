@@ -5,6 +5,8 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
 
 func TestValidate(t *testing.T) {
@@ -111,3 +113,37 @@ ds [type=http]
 		})
 	}
 }
+
+func TestValidate_fragments(t *testing.T) {
+	fragments := pipeline.WithFragments(func(name string) (string, bool) {
+		return `ds [type=bridge name=voter_turnout async=true]`, name == "async"
+	})
+
+	_, err := ValidateSpec(`
+type="offchainreporting"
+schemaVersion=1
+observationSource="""
+ds [type=fragment name=async]
+"""
+`, fragments)
+	require.ErrorContains(t, err, "async=true tasks are not supported")
+
+	jobType, err := ValidateSpec(`
+type="webhook"
+schemaVersion=1
+observationSource="""
+ds [type=fragment name=async]
+"""
+`, fragments)
+	require.NoError(t, err)
+	require.Equal(t, Webhook, jobType)
+
+	_, err = ValidateSpec(`
+type="webhook"
+schemaVersion=1
+observationSource="""
+ds [type=fragment name=async]
+"""
+`)
+	require.ErrorIs(t, err, pipeline.ErrFragmentNotFound)
+}
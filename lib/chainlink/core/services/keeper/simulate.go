@@ -0,0 +1,288 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+
+	ocr2keepers "github.com/smartcontractkit/chainlink-common/pkg/types/automation"
+
+	autotypes "github.com/smartcontractkit/chainlink-automation/pkg/v3/types"
+
+	"github.com/smartcontractkit/chainlink-evm/gethwrappers/shared/generated/initial/type_and_version"
+	evmclient "github.com/smartcontractkit/chainlink-evm/pkg/client"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/core"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/encoding"
+)
+
+// upkeepFailureReasons names the onchain reasons a v2.1+ registry gives for an upkeep not being eligible.
+var upkeepFailureReasons = map[encoding.UpkeepFailureReason]string{
+	encoding.UpkeepFailureReasonNone:                    "NONE",
+	encoding.UpkeepFailureReasonUpkeepCancelled:         "UPKEEP_CANCELLED",
+	encoding.UpkeepFailureReasonUpkeepPaused:            "UPKEEP_PAUSED",
+	encoding.UpkeepFailureReasonTargetCheckReverted:     "TARGET_CHECK_REVERTED",
+	encoding.UpkeepFailureReasonUpkeepNotNeeded:         "UPKEEP_NOT_NEEDED",
+	encoding.UpkeepFailureReasonPerformDataExceedsLimit: "PERFORM_DATA_EXCEEDS_LIMIT",
+	encoding.UpkeepFailureReasonInsufficientBalance:     "INSUFFICIENT_BALANCE",
+	encoding.UpkeepFailureReasonMercuryCallbackReverted: "CALLBACK_REVERTED",
+	encoding.UpkeepFailureReasonRevertDataExceedsLimit:  "REVERT_DATA_EXCEEDS_LIMIT",
+	encoding.UpkeepFailureReasonRegistryPaused:          "REGISTRY_PAUSED",
+}
+
+// UpkeepSimulation is the outcome of running the check and perform path of an upkeep
+// against its registry at a given block, without sending any transaction.
+type UpkeepSimulation struct {
+	Registry       common.Address
+	TypeAndVersion string
+	UpkeepID       *big.Int
+	BlockNumber    int64
+	// From is the keeper the calls were made on behalf of, v1 registries only.
+	From *common.Address
+	// Eligible is true if checkUpkeep found the upkeep needed and it can be paid for.
+	Eligible bool
+	// FailureReason is why the upkeep is not eligible, as reported by v2.1+ registries.
+	FailureReason string
+	PerformData   []byte
+	// PerformSuccess is nil if performUpkeep was not simulated, as the upkeep was not eligible.
+	PerformSuccess *bool
+	// GasEstimate is the gas used by performUpkeep, if it was simulated.
+	GasEstimate *uint64
+	// RevertReason is set when checkUpkeep or performUpkeep reverted.
+	RevertReason string
+}
+
+// SimulateUpkeep runs checkUpkeep for an upkeep at the given block, the latest if nil, and
+// simulates performUpkeep with the returned perform data if it is eligible, the same way the
+// UpkeepExecuter does for v1 registries and the v2.1 EvmRegistry for conditional upkeeps.
+// For v1 registries, from is the keeper to check and perform as and defaults to the first
+// keeper of the registry. Reverts are reported in the simulation rather than as errors.
+func SimulateUpkeep(ctx context.Context, client evmclient.Client, registry common.Address, upkeepID *big.Int, block *big.Int, from *common.Address) (UpkeepSimulation, error) {
+	if block == nil {
+		head, err := client.HeadByNumber(ctx, nil)
+		if err != nil {
+			return UpkeepSimulation{}, errors.Wrap(err, "failed to get latest block")
+		}
+		if head == nil {
+			return UpkeepSimulation{}, errors.New("no latest block")
+		}
+		block = big.NewInt(head.Number)
+	}
+	sim := UpkeepSimulation{
+		Registry:    registry,
+		UpkeepID:    upkeepID,
+		BlockNumber: block.Int64(),
+	}
+
+	tv, err := type_and_version.NewITypeAndVersion(registry, client)
+	if err != nil {
+		return sim, errors.Wrap(err, "unable to create type and interface wrapper")
+	}
+	sim.TypeAndVersion, err = tv.TypeAndVersion(&bind.CallOpts{Context: ctx, BlockNumber: block})
+	if err != nil && evmclient.ExtractRPCErrorOrNil(err) == nil {
+		return sim, errors.Wrap(err, "unable to fetch version of registry")
+	}
+
+	switch {
+	case strings.HasPrefix(sim.TypeAndVersion, "KeeperRegistry 2.1"),
+		strings.HasPrefix(sim.TypeAndVersion, "AutomationRegistry 2."):
+		err = simulateUpkeepV2(ctx, client, &sim, block)
+	default:
+		// version 1.0 does not support typeAndVersion, the wrapper rejects anything else it does not know
+		var rw *RegistryWrapper
+		rw, err = NewRegistryWrapper(evmtypes.EIP55AddressFromAddress(registry), client)
+		if err != nil {
+			return sim, err
+		}
+		if sim.TypeAndVersion == "" {
+			sim.TypeAndVersion = "KeeperRegistry 1.0"
+		}
+		err = simulateUpkeepV1(ctx, client, rw, &sim, block, from)
+	}
+	return sim, err
+}
+
+func simulateUpkeepV1(ctx context.Context, client evmclient.Client, rw *RegistryWrapper, sim *UpkeepSimulation, block *big.Int, from *common.Address) error {
+	if from == nil {
+		config, err := rw.GetConfig(&bind.CallOpts{Context: ctx, BlockNumber: block})
+		if err != nil {
+			return err
+		}
+		if len(config.KeeperAddresses) == 0 {
+			return errors.New("registry has no keepers, a from address is required")
+		}
+		from = &config.KeeperAddresses[0]
+	}
+	sim.From = from
+
+	registryABI := Registry1_1ABI
+	switch rw.Version {
+	case RegistryVersion_1_2:
+		registryABI = Registry1_2ABI
+	case RegistryVersion_1_3:
+		registryABI = Registry1_3ABI
+	}
+
+	// checkUpkeep can only be called from the zero address, as in the keepers observation source
+	data, err := registryABI.Pack("checkUpkeep", sim.UpkeepID, *from)
+	if err != nil {
+		return errors.Wrap(err, "failed to pack checkUpkeep")
+	}
+	out, err := client.CallContract(ctx, ethereum.CallMsg{To: &sim.Registry, Data: data}, block)
+	if err != nil {
+		reason, rerr := revertReason(err)
+		if rerr != nil {
+			return errors.Wrap(rerr, "checkUpkeep call failed")
+		}
+		sim.RevertReason = reason
+		return nil
+	}
+	values, err := registryABI.Methods["checkUpkeep"].Outputs.UnpackValues(out)
+	if err != nil {
+		return errors.Wrap(err, "failed to unpack checkUpkeep result")
+	}
+	sim.Eligible = true
+	sim.PerformData = *abi.ConvertType(values[0], new([]byte)).(*[]byte)
+
+	data, err = registryABI.Pack("performUpkeep", sim.UpkeepID, sim.PerformData)
+	if err != nil {
+		return errors.Wrap(err, "failed to pack performUpkeep")
+	}
+	call := ethereum.CallMsg{From: *from, To: &sim.Registry, Data: data}
+	out, err = client.CallContract(ctx, call, block)
+	if err != nil {
+		reason, rerr := revertReason(err)
+		if rerr != nil {
+			return errors.Wrap(rerr, "performUpkeep call failed")
+		}
+		success := false
+		sim.PerformSuccess = &success
+		sim.RevertReason = reason
+		return nil
+	}
+	values, err = registryABI.Methods["performUpkeep"].Outputs.UnpackValues(out)
+	if err != nil {
+		return errors.Wrap(err, "failed to unpack performUpkeep result")
+	}
+	success := *abi.ConvertType(values[0], new(bool)).(*bool)
+	sim.PerformSuccess = &success
+	if !success {
+		return nil
+	}
+
+	var gas hexutil.Uint64
+	if err = client.CallContext(ctx, &gas, "eth_estimateGas", map[string]any{
+		"from":  call.From,
+		"to":    call.To,
+		"input": hexutil.Bytes(call.Data),
+	}, hexutil.EncodeBig(block)); err != nil {
+		return errors.Wrap(err, "failed to estimate performUpkeep gas")
+	}
+	estimate := uint64(gas)
+	sim.GasEstimate = &estimate
+	return nil
+}
+
+func simulateUpkeepV2(ctx context.Context, client evmclient.Client, sim *UpkeepSimulation, block *big.Int) error {
+	var id ocr2keepers.UpkeepIdentifier
+	if !id.FromBigInt(sim.UpkeepID) {
+		return errors.Errorf("invalid upkeep ID %s", sim.UpkeepID)
+	}
+	if core.GetUpkeepType(id) != autotypes.ConditionTrigger {
+		return errors.New("only conditional upkeeps can be simulated, log trigger upkeeps are checked against the log that triggered them")
+	}
+
+	// checkUpkeep is overloaded on the contract for conditionals and log upkeeps, checkUpkeep0 is the conditional one
+	data, err := core.AutoV2CommonABI.Pack("checkUpkeep0", sim.UpkeepID)
+	if err != nil {
+		return errors.Wrap(err, "failed to pack checkUpkeep")
+	}
+	out, err := client.CallContract(ctx, ethereum.CallMsg{To: &sim.Registry, Data: data}, block)
+	if err != nil {
+		reason, rerr := revertReason(err)
+		if rerr != nil {
+			return errors.Wrap(rerr, "checkUpkeep call failed")
+		}
+		sim.RevertReason = reason
+		return nil
+	}
+	result, err := encoding.NewAbiPacker().UnpackCheckResult(ocr2keepers.UpkeepPayload{UpkeepID: id}, hexutil.Encode(out))
+	if err != nil {
+		return err
+	}
+	failure := encoding.UpkeepFailureReason(result.IneligibilityReason)
+	sim.Eligible = result.Eligible
+	sim.FailureReason = upkeepFailureReasons[failure]
+	if sim.FailureReason == "" {
+		sim.FailureReason = fmt.Sprintf("UNKNOWN(%d)", failure)
+	}
+	sim.PerformData = result.PerformData
+	if failure == encoding.UpkeepFailureReasonTargetCheckReverted {
+		// the perform data holds the revert data of the target
+		sim.RevertReason = decodeRevert(result.PerformData)
+	}
+	if !result.Eligible {
+		return nil
+	}
+
+	data, err = core.AutoV2CommonABI.Pack("simulatePerformUpkeep", sim.UpkeepID, result.PerformData)
+	if err != nil {
+		return errors.Wrap(err, "failed to pack simulatePerformUpkeep")
+	}
+	out, err = client.CallContract(ctx, ethereum.CallMsg{To: &sim.Registry, Data: data}, block)
+	if err != nil {
+		reason, rerr := revertReason(err)
+		if rerr != nil {
+			return errors.Wrap(rerr, "simulatePerformUpkeep call failed")
+		}
+		success := false
+		sim.PerformSuccess = &success
+		sim.RevertReason = reason
+		return nil
+	}
+	values, err := core.AutoV2CommonABI.Methods["simulatePerformUpkeep"].Outputs.UnpackValues(out)
+	if err != nil {
+		return errors.Wrap(err, "failed to unpack simulatePerformUpkeep result")
+	}
+	success := *abi.ConvertType(values[0], new(bool)).(*bool)
+	gasUsed := (*abi.ConvertType(values[1], new(*big.Int)).(**big.Int)).Uint64()
+	sim.PerformSuccess = &success
+	sim.GasEstimate = &gasUsed
+	return nil
+}
+
+// revertReason returns the revert reason of a failed call, or an error if it did not revert.
+func revertReason(err error) (string, error) {
+	jsonErr, extractErr := evmclient.ExtractRPCError(err)
+	if extractErr != nil {
+		return "", err
+	}
+	if s, ok := jsonErr.Data.(string); ok {
+		if b, decodeErr := hexutil.Decode(s); decodeErr == nil && len(b) > 0 {
+			if reason, unpackErr := abi.UnpackRevert(b); unpackErr == nil {
+				return reason, nil
+			}
+		}
+	}
+	return jsonErr.String(), nil
+}
+
+// decodeRevert returns the Error(string) reason of revert data, or the data itself in hex for custom errors.
+func decodeRevert(data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason
+	}
+	return hexutil.Encode(data)
+}
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-evm/gethwrappers/shared/generated/initial/type_and_version"
+	evmclient "github.com/smartcontractkit/chainlink-evm/pkg/client"
+	"github.com/smartcontractkit/chainlink-evm/pkg/client/clienttest"
+	evmtypes "github.com/smartcontractkit/chainlink-evm/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/ocr2keeper/evmregistry/v21/core"
+)
+
+var typeAndVersionABI = evmtypes.MustGetABI(type_and_version.ITypeAndVersionABI)
+
+// mockRegistryCall answers calls to method of the given ABI with the given result or error.
+func mockRegistryCall(t *testing.T, ethClient *clienttest.Client, contractABI abi.ABI, method string, block *big.Int, result []byte, err error) {
+	selector := contractABI.Methods[method].ID
+	ethClient.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+		return len(msg.Data) >= 4 && string(msg.Data[:4]) == string(selector)
+	}), block).Return(result, err).Once()
+}
+
+func packRevert(t *testing.T, reason string) []byte {
+	stringType, err := abi.NewType("string", "", nil)
+	require.NoError(t, err)
+	data, err := abi.Arguments{{Type: stringType}}.Pack(reason)
+	require.NoError(t, err)
+	return append(hexutil.MustDecode("0x08c379a0"), data...)
+}
+
+func TestSimulateUpkeep(t *testing.T) {
+	t.Parallel()
+
+	registry := testutils.NewAddress()
+	block := big.NewInt(100)
+	upkeepID := big.NewInt(7)
+
+	t.Run("v2 eligible", func(t *testing.T) {
+		ethClient := clienttest.NewClient(t)
+		tv, err := typeAndVersionABI.Methods["typeAndVersion"].Outputs.Pack("AutomationRegistry 2.2.0")
+		require.NoError(t, err)
+		mockRegistryCall(t, ethClient, typeAndVersionABI, "typeAndVersion", block, tv, nil)
+		check, err := core.AutoV2CommonABI.Methods["checkUpkeep0"].Outputs.Pack(
+			true, []byte{1, 2}, uint8(0), big.NewInt(5000), big.NewInt(500000), big.NewInt(10), big.NewInt(20))
+		require.NoError(t, err)
+		mockRegistryCall(t, ethClient, core.AutoV2CommonABI, "checkUpkeep0", block, check, nil)
+		perform, err := core.AutoV2CommonABI.Methods["simulatePerformUpkeep"].Outputs.Pack(true, big.NewInt(80000))
+		require.NoError(t, err)
+		mockRegistryCall(t, ethClient, core.AutoV2CommonABI, "simulatePerformUpkeep", block, perform, nil)
+
+		sim, err := keeper.SimulateUpkeep(testutils.Context(t), ethClient, registry, upkeepID, block, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "AutomationRegistry 2.2.0", sim.TypeAndVersion)
+		assert.Equal(t, int64(100), sim.BlockNumber)
+		assert.True(t, sim.Eligible)
+		assert.Equal(t, "NONE", sim.FailureReason)
+		assert.Equal(t, []byte{1, 2}, sim.PerformData)
+		require.NotNil(t, sim.PerformSuccess)
+		assert.True(t, *sim.PerformSuccess)
+		require.NotNil(t, sim.GasEstimate)
+		assert.Equal(t, uint64(80000), *sim.GasEstimate)
+		assert.Empty(t, sim.RevertReason)
+	})
+
+	t.Run("v2 target check reverted", func(t *testing.T) {
+		ethClient := clienttest.NewClient(t)
+		tv, err := typeAndVersionABI.Methods["typeAndVersion"].Outputs.Pack("KeeperRegistry 2.1.0")
+		require.NoError(t, err)
+		mockRegistryCall(t, ethClient, typeAndVersionABI, "typeAndVersion", block, tv, nil)
+		check, err := core.AutoV2CommonABI.Methods["checkUpkeep0"].Outputs.Pack(
+			false, packRevert(t, "raffle not open"), uint8(3), big.NewInt(5000), big.NewInt(500000), big.NewInt(10), big.NewInt(20))
+		require.NoError(t, err)
+		mockRegistryCall(t, ethClient, core.AutoV2CommonABI, "checkUpkeep0", block, check, nil)
+
+		sim, err := keeper.SimulateUpkeep(testutils.Context(t), ethClient, registry, upkeepID, block, nil)
+		require.NoError(t, err)
+		assert.False(t, sim.Eligible)
+		assert.Equal(t, "TARGET_CHECK_REVERTED", sim.FailureReason)
+		assert.Equal(t, "raffle not open", sim.RevertReason)
+		assert.Nil(t, sim.PerformSuccess)
+		assert.Nil(t, sim.GasEstimate)
+	})
+
+	t.Run("v1 check reverted", func(t *testing.T) {
+		ethClient := clienttest.NewClient(t)
+		tv, err := typeAndVersionABI.Methods["typeAndVersion"].Outputs.Pack("KeeperRegistry 1.3.0")
+		require.NoError(t, err)
+		// once for the version of the simulation, once for the registry wrapper
+		mockRegistryCall(t, ethClient, typeAndVersionABI, "typeAndVersion", block, tv, nil)
+		mockRegistryCall(t, ethClient, typeAndVersionABI, "typeAndVersion", nil, tv, nil)
+		mockRegistryCall(t, ethClient, keeper.Registry1_3ABI, "checkUpkeep", block, nil, evmclient.JsonError{
+			Code:    3,
+			Message: "execution reverted",
+			Data:    hexutil.Encode(packRevert(t, "upkeep not needed")),
+		})
+
+		from := testutils.NewAddress()
+		sim, err := keeper.SimulateUpkeep(testutils.Context(t), ethClient, registry, upkeepID, block, &from)
+		require.NoError(t, err)
+		assert.Equal(t, "KeeperRegistry 1.3.0", sim.TypeAndVersion)
+		assert.Equal(t, &from, sim.From)
+		assert.False(t, sim.Eligible)
+		assert.Equal(t, "upkeep not needed", sim.RevertReason)
+		assert.Nil(t, sim.PerformSuccess)
+	})
+
+	t.Run("unsupported registry", func(t *testing.T) {
+		ethClient := clienttest.NewClient(t)
+		tv, err := typeAndVersionABI.Methods["typeAndVersion"].Outputs.Pack("KeeperRegistry 2.0.0")
+		require.NoError(t, err)
+		mockRegistryCall(t, ethClient, typeAndVersionABI, "typeAndVersion", block, tv, nil)
+		mockRegistryCall(t, ethClient, typeAndVersionABI, "typeAndVersion", nil, tv, nil)
+
+		_, err = keeper.SimulateUpkeep(testutils.Context(t), ethClient, registry, upkeepID, block, nil)
+		require.ErrorContains(t, err, "KeeperRegistry 2.0.0 not supported")
+	})
+}
@@ -0,0 +1,240 @@
+package keystore
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"go.dedis.ch/kyber/v3/share"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-evm/pkg/types"
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/signatures/secp256k1"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const bundleVersion = 1
+
+// ErrKeystoreNotEmpty is returned by ImportBundle if the node already has keys.
+var ErrKeystoreNotEmpty = errors.New("keystore is not empty")
+
+// Bundle is an encrypted backup of the whole keystore: the keys of every type, and the states of the EVM keys, which
+// record the chains they are enabled for.
+type Bundle struct {
+	Version   int       `json:"version"`
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	// Sharing is set if the password of the bundle was split into shares.
+	Sharing *BundleSharing          `json:"sharing,omitempty"`
+	Crypto  gethkeystore.CryptoJSON `json:"crypto"`
+}
+
+// BundleSharing describes how the password of a bundle was split.
+type BundleSharing struct {
+	Threshold int `json:"threshold"`
+	Total     int `json:"total"`
+}
+
+// BundleShare is one of the Shamir shares of the password of a bundle. Threshold shares are needed to decrypt it.
+type BundleShare struct {
+	BundleID  uuid.UUID     `json:"bundleID"`
+	Threshold int           `json:"threshold"`
+	Index     int           `json:"index"`
+	Value     hexutil.Bytes `json:"value"`
+}
+
+type bundleContent struct {
+	KeyRing   json.RawMessage  `json:"keyRing"`
+	KeyStates []bundleKeyState `json:"keyStates"`
+}
+
+type bundleKeyState struct {
+	Address    types.EIP55Address `json:"address"`
+	EVMChainID *big.Big           `json:"evmChainID"`
+	Disabled   bool               `json:"disabled"`
+	CreatedAt  time.Time          `json:"createdAt"`
+	UpdatedAt  time.Time          `json:"updatedAt"`
+}
+
+// ExportBundle returns a backup of the keystore encrypted with bundlePassword. The keystore is decrypted with password.
+func ExportBundle(ctx context.Context, ds sqlutil.DataSource, password, bundlePassword string, scryptParams utils.ScryptParams) (*Bundle, error) {
+	if bundlePassword == "" {
+		return nil, errors.New("bundle password must not be empty")
+	}
+	return exportBundle(ctx, ds, password, bundlePassword, scryptParams)
+}
+
+// ExportBundleShares returns a backup of the keystore encrypted with a random password, which is split into total
+// shares, any threshold of which can decrypt the backup. The keystore is decrypted with password.
+func ExportBundleShares(ctx context.Context, ds sqlutil.DataSource, password string, threshold, total int, scryptParams utils.ScryptParams) (*Bundle, []BundleShare, error) {
+	if threshold < 1 || threshold > total {
+		return nil, nil, errors.Errorf("threshold must be between 1 and the number of shares, got %d of %d", threshold, total)
+	}
+	suite := secp256k1.NewBlakeKeccackSecp256k1()
+	secret := suite.Scalar().Pick(suite.RandomStream())
+	bundlePassword, err := secret.MarshalBinary()
+	if err != nil {
+		return nil, nil, err
+	}
+	bundle, err := exportBundle(ctx, ds, password, hexutil.Encode(bundlePassword), scryptParams)
+	if err != nil {
+		return nil, nil, err
+	}
+	bundle.Sharing = &BundleSharing{Threshold: threshold, Total: total}
+
+	priShares := share.NewPriPoly(suite, threshold, secret, suite.RandomStream()).Shares(total)
+	shares := make([]BundleShare, total)
+	for i, s := range priShares {
+		value, err := s.V.MarshalBinary()
+		if err != nil {
+			return nil, nil, err
+		}
+		shares[i] = BundleShare{BundleID: bundle.ID, Threshold: threshold, Index: s.I, Value: value}
+	}
+	return bundle, shares, nil
+}
+
+// CombineBundleShares returns the password of bundle, recovered from shares.
+func CombineBundleShares(bundle *Bundle, shares []BundleShare) (string, error) {
+	if bundle.Sharing == nil {
+		return "", errors.New("bundle password was not split into shares")
+	}
+	suite := secp256k1.NewBlakeKeccackSecp256k1()
+	var priShares []*share.PriShare
+	seen := make(map[int]bool)
+	for _, s := range shares {
+		if s.BundleID != bundle.ID {
+			return "", errors.Errorf("share %d is for bundle %s, not %s", s.Index, s.BundleID, bundle.ID)
+		}
+		if s.Index < 0 || s.Index >= bundle.Sharing.Total {
+			return "", errors.Errorf("invalid share index %d", s.Index)
+		}
+		if seen[s.Index] {
+			return "", errors.Errorf("duplicate share %d", s.Index)
+		}
+		seen[s.Index] = true
+		v := suite.Scalar()
+		if err := v.UnmarshalBinary(s.Value); err != nil {
+			return "", errors.Wrapf(err, "invalid share %d", s.Index)
+		}
+		priShares = append(priShares, &share.PriShare{I: s.Index, V: v})
+	}
+	if len(priShares) < bundle.Sharing.Threshold {
+		return "", errors.Errorf("%d shares are needed, got %d", bundle.Sharing.Threshold, len(priShares))
+	}
+	secret, err := share.RecoverSecret(suite, priShares, bundle.Sharing.Threshold, bundle.Sharing.Total)
+	if err != nil {
+		return "", err
+	}
+	bundlePassword, err := secret.MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+	return hexutil.Encode(bundlePassword), nil
+}
+
+func exportBundle(ctx context.Context, ds sqlutil.DataSource, password, bundlePassword string, scryptParams utils.ScryptParams) (*Bundle, error) {
+	orm := NewORM(ds)
+	ekr, err := orm.getEncryptedKeyRing(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get encrypted key ring")
+	}
+	if len(ekr.EncryptedKeys) == 0 {
+		return nil, errors.New("keystore is empty, there is nothing to export")
+	}
+	kr, err := ekr.Decrypt(password)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to decrypt encrypted key ring")
+	}
+	var content bundleContent
+	if content.KeyRing, err = kr.marshal(); err != nil {
+		return nil, errors.Wrap(err, "unable to marshal key ring")
+	}
+	states, err := orm.loadKeyStates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range states.All {
+		content.KeyStates = append(content.KeyStates, bundleKeyState{
+			Address:    s.Address,
+			EVMChainID: &s.EVMChainID,
+			Disabled:   s.Disabled,
+			CreatedAt:  s.CreatedAt,
+			UpdatedAt:  s.UpdatedAt,
+		})
+	}
+	plaintext, err := json.Marshal(content)
+	if err != nil {
+		return nil, err
+	}
+	cryptoJSON, err := gethkeystore.EncryptDataV3(plaintext, []byte(adulteratedBundlePassword(bundlePassword)), scryptParams.N, scryptParams.P)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encrypt bundle")
+	}
+	return &Bundle{
+		Version:   bundleVersion,
+		ID:        uuid.New(),
+		CreatedAt: time.Now(),
+		Crypto:    cryptoJSON,
+	}, nil
+}
+
+// ImportBundle restores bundle, decrypted with bundlePassword, into an empty keystore, which is encrypted with password.
+// The keystore must not be unlocked by a running node while the bundle is imported.
+func ImportBundle(ctx context.Context, ds sqlutil.DataSource, bundle *Bundle, bundlePassword, password string, scryptParams utils.ScryptParams) error {
+	if bundle.Version != bundleVersion {
+		return errors.Errorf("unsupported bundle version %d", bundle.Version)
+	}
+	plaintext, err := gethkeystore.DecryptDataV3(bundle.Crypto, adulteratedBundlePassword(bundlePassword))
+	if err != nil {
+		return errors.Wrap(err, "unable to decrypt bundle")
+	}
+	var content bundleContent
+	if err = json.Unmarshal(plaintext, &content); err != nil {
+		return errors.Wrap(err, "unable to decode bundle")
+	}
+	kr, err := unmarshalKeyRing(content.KeyRing)
+	if err != nil {
+		return errors.Wrap(err, "unable to decode key ring")
+	}
+	ekr, err := kr.Encrypt(password, scryptParams)
+	if err != nil {
+		return errors.Wrap(err, "unable to encrypt key ring")
+	}
+	return sqlutil.TransactDataSource(ctx, ds, nil, func(tx sqlutil.DataSource) error {
+		orm := NewORM(tx)
+		prev, err := orm.getEncryptedKeyRing(ctx)
+		if err != nil {
+			return errors.Wrap(err, "unable to get encrypted key ring")
+		}
+		states, err := orm.loadKeyStates(ctx)
+		if err != nil {
+			return err
+		}
+		if len(prev.EncryptedKeys) > 0 || len(states.All) > 0 {
+			return ErrKeystoreNotEmpty
+		}
+		if err = orm.saveEncryptedKeyRing(ctx, &ekr); err != nil {
+			return err
+		}
+		for _, s := range content.KeyStates {
+			_, err = tx.ExecContext(ctx, `INSERT INTO evm.key_states (address, evm_chain_id, disabled, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5)`, s.Address.Address(), s.EVMChainID.String(), s.Disabled, s.CreatedAt, s.UpdatedAt)
+			if err != nil {
+				return errors.Wrapf(err, "failed to insert key state of %s for chain %s", s.Address, s.EVMChainID)
+			}
+		}
+		return nil
+	})
+}
+
+// adulteration prevents a bundle from being decrypted with the password of a key ring, and vice versa
+func adulteratedBundlePassword(password string) string {
+	return "keystore-bundle-" + password
+}
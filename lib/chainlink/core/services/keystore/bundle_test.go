@@ -0,0 +1,112 @@
+package keystore_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestBundle(t *testing.T) {
+	t.Parallel()
+
+	const bundlePassword = "b4ndl3-p4ssw0rd-for-the-keystore"
+	const newPassword = "n3w-p4ssw0rd-for-the-keystore"
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+
+	t.Run("fails on an empty keystore", func(t *testing.T) {
+		_, err := keystore.ExportBundle(ctx, db, cltest.Password, bundlePassword, utils.FastScryptParams)
+		require.ErrorContains(t, err, "keystore is empty")
+	})
+
+	keyStore := keystore.ExposedNewMaster(t, db)
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	ethKey, address := cltest.MustInsertRandomKey(t, keyStore.Eth())
+	otherChainID := big.NewInt(1337)
+	require.NoError(t, keyStore.Eth().Add(ctx, address, otherChainID))
+	require.NoError(t, keyStore.Eth().Disable(ctx, address, otherChainID))
+	ocr2Key, err := keyStore.OCR2().Create(ctx, chaintype.EVM)
+	require.NoError(t, err)
+	p2pKey, err := keyStore.P2P().Create(ctx)
+	require.NoError(t, err)
+	csaKey, err := keyStore.CSA().Create(ctx)
+	require.NoError(t, err)
+	states, err := keyStore.Eth().GetStatesForKeys(ctx, []ethkey.KeyV2{ethKey})
+	require.NoError(t, err)
+	require.Len(t, states, 2)
+
+	requireRestored := func(t *testing.T, target *keystore.Bundle, targetPassword string) {
+		targetDB := pgtest.NewSqlxDB(t)
+		require.NoError(t, keystore.ImportBundle(ctx, targetDB, target, targetPassword, newPassword, utils.FastScryptParams))
+
+		restored := keystore.ExposedNewMaster(t, targetDB)
+		require.NoError(t, restored.Unlock(ctx, newPassword))
+		gotEth, err := restored.Eth().Get(ctx, ethKey.ID())
+		require.NoError(t, err)
+		requireEqualKeys(t, ethKey, gotEth)
+		gotOCR2, err := restored.OCR2().Get(ocr2Key.ID())
+		require.NoError(t, err)
+		assert.Equal(t, ocr2Key.Raw(), gotOCR2.Raw())
+		gotP2P, err := restored.P2P().Get(p2pKey.PeerID())
+		require.NoError(t, err)
+		requireEqualKeys(t, p2pKey, gotP2P)
+		gotCSA, err := restored.CSA().Get(csaKey.ID())
+		require.NoError(t, err)
+		requireEqualKeys(t, csaKey, gotCSA)
+
+		gotStates, err := restored.Eth().GetStatesForKeys(ctx, []ethkey.KeyV2{gotEth})
+		require.NoError(t, err)
+		assert.Equal(t, disabledByChain(states), disabledByChain(gotStates))
+
+		require.ErrorIs(t, keystore.ImportBundle(ctx, targetDB, target, targetPassword, newPassword, utils.FastScryptParams), keystore.ErrKeystoreNotEmpty)
+	}
+
+	t.Run("exports and imports with a password", func(t *testing.T) {
+		bundle, err := keystore.ExportBundle(ctx, db, cltest.Password, bundlePassword, utils.FastScryptParams)
+		require.NoError(t, err)
+		assert.Nil(t, bundle.Sharing)
+
+		err = keystore.ImportBundle(ctx, pgtest.NewSqlxDB(t), bundle, "wrong password", newPassword, utils.FastScryptParams)
+		require.ErrorContains(t, err, "unable to decrypt bundle")
+		requireRestored(t, bundle, bundlePassword)
+	})
+
+	t.Run("exports and imports with shares", func(t *testing.T) {
+		bundle, shares, err := keystore.ExportBundleShares(ctx, db, cltest.Password, 2, 3, utils.FastScryptParams)
+		require.NoError(t, err)
+		require.Len(t, shares, 3)
+		assert.Equal(t, &keystore.BundleSharing{Threshold: 2, Total: 3}, bundle.Sharing)
+
+		_, err = keystore.CombineBundleShares(bundle, shares[:1])
+		require.ErrorContains(t, err, "2 shares are needed, got 1")
+		_, err = keystore.CombineBundleShares(bundle, []keystore.BundleShare{shares[0], shares[0]})
+		require.ErrorContains(t, err, "duplicate share")
+
+		other, otherShares, err := keystore.ExportBundleShares(ctx, db, cltest.Password, 2, 3, utils.FastScryptParams)
+		require.NoError(t, err)
+		_, err = keystore.CombineBundleShares(other, []keystore.BundleShare{shares[0], otherShares[1]})
+		require.ErrorContains(t, err, "is for bundle")
+
+		password, err := keystore.CombineBundleShares(bundle, []keystore.BundleShare{shares[2], shares[0]})
+		require.NoError(t, err)
+		requireRestored(t, bundle, password)
+	})
+}
+
+func disabledByChain(states []ethkey.State) map[string]bool {
+	m := make(map[string]bool)
+	for _, s := range states {
+		m[s.Address.String()+"@"+s.EVMChainID.String()] = s.Disabled
+	}
+	return m
+}
@@ -49,8 +49,13 @@ func (ekr encryptedKeyRing) Decrypt(password string) (*keyRing, error) {
 	if err != nil {
 		return nil, err
 	}
+	return unmarshalKeyRing(marshalledRawKeyRingJson)
+}
+
+// unmarshalKeyRing is the inverse of keyRing.marshal.
+func unmarshalKeyRing(marshalledRawKeyRingJson []byte) (*keyRing, error) {
 	var rawKeys rawKeyRing
-	err = json.Unmarshal(marshalledRawKeyRingJson, &rawKeys)
+	err := json.Unmarshal(marshalledRawKeyRingJson, &rawKeys)
 	if err != nil {
 		return nil, err
 	}
@@ -194,16 +199,11 @@ func newKeyRing() *keyRing {
 }
 
 func (kr *keyRing) Encrypt(password string, scryptParams utils.ScryptParams) (ekr encryptedKeyRing, err error) {
-	marshalledRawKeyRingJson, err := json.Marshal(kr.raw())
+	marshalledRawKeyRingJson, err := kr.marshal()
 	if err != nil {
 		return ekr, err
 	}
 
-	marshalledRawKeyRingJson, err = kr.LegacyKeys.UnloadUnsupported(marshalledRawKeyRingJson)
-	if err != nil {
-		return encryptedKeyRing{}, err
-	}
-
 	cryptoJSON, err := gethkeystore.EncryptDataV3(
 		marshalledRawKeyRingJson,
 		[]byte(adulteratedPassword(password)),
@@ -222,6 +222,15 @@ func (kr *keyRing) Encrypt(password string, scryptParams utils.ScryptParams) (ek
 	}, nil
 }
 
+// marshal returns the plaintext of the encrypted key ring, which includes the legacy keys.
+func (kr *keyRing) marshal() ([]byte, error) {
+	marshalledRawKeyRingJson, err := json.Marshal(kr.raw())
+	if err != nil {
+		return nil, err
+	}
+	return kr.LegacyKeys.UnloadUnsupported(marshalledRawKeyRingJson)
+}
+
 func (kr *keyRing) raw() (rawKeys rawKeyRing) {
 	for _, csaKey := range kr.CSA {
 		rawKeys.CSA = append(rawKeys.CSA, internal.RawBytes(csaKey))
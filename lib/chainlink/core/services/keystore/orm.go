@@ -48,6 +48,37 @@ func (orm ksORM) saveEncryptedKeyRing(ctx context.Context, kr *encryptedKeyRing,
 	})
 }
 
+// rotateEncryptedKeyRing backs up the previous key ring and replaces it with the next one, unless it was modified in
+// the meantime.
+func (orm ksORM) rotateEncryptedKeyRing(ctx context.Context, prev, next encryptedKeyRing) (backupID int64, err error) {
+	err = sqlutil.TransactDataSource(ctx, orm.ds, nil, func(tx sqlutil.DataSource) error {
+		err := tx.QueryRowxContext(ctx, `
+		INSERT INTO encrypted_key_ring_backups (encrypted_keys, created_at)
+		VALUES ($1, NOW()) RETURNING id
+	`, prev.EncryptedKeys).Scan(&backupID)
+		if err != nil {
+			return errors.Wrap(err, "while backing up keyring")
+		}
+		res, err := tx.ExecContext(ctx, `
+		UPDATE encrypted_key_rings
+		SET encrypted_keys = $1, updated_at = NOW()
+		WHERE encrypted_keys = $2
+	`, next.EncryptedKeys, prev.EncryptedKeys)
+		if err != nil {
+			return errors.Wrap(err, "while saving keyring")
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if n != 1 {
+			return ErrKeyRingModified
+		}
+		return nil
+	})
+	return
+}
+
 func (orm ksORM) getEncryptedKeyRing(ctx context.Context) (kr encryptedKeyRing, err error) {
 	err = orm.ds.GetContext(ctx, &kr, `SELECT * FROM encrypted_key_rings LIMIT 1`)
 	if errors.Is(err, sql.ErrNoRows) {
@@ -74,3 +105,15 @@ func (orm ksORM) loadKeyStates(ctx context.Context) (*keyStates, error) {
 	}
 	return ks, nil
 }
+
+// deleteEncryptedKeyRingBackups deletes the backed up key rings, except the keep most recent ones.
+func (orm ksORM) deleteEncryptedKeyRingBackups(ctx context.Context, keep int) (int64, error) {
+	res, err := orm.ds.ExecContext(ctx, `
+		DELETE FROM encrypted_key_ring_backups
+		WHERE id NOT IN (SELECT id FROM encrypted_key_ring_backups ORDER BY id DESC LIMIT $1)
+	`, keep)
+	if err != nil {
+		return 0, errors.Wrap(err, "while deleting keyring backups")
+	}
+	return res.RowsAffected()
+}
@@ -0,0 +1,249 @@
+// Package remotesigner delegates signing with EVM keys to an external signer, so that the node only holds the addresses
+// of the keys.
+//
+// The signer is called over JSON-RPC 2.0 on HTTP, with the following methods. The first three are the ones of
+// Web3Signer's Eth1 JSON-RPC API:
+//
+//   - eth_accounts() returns the addresses of the keys held by the signer.
+//   - eth_signTransaction(tx) signs the transaction described by tx, see TxArgs, and returns it RLP encoded.
+//   - eth_sign(address, data) signs the EIP-191 hash of data, "\x19Ethereum Signed Message:\n" + len(data) + data.
+//   - chainlink_signHash(address, hash) signs the given 32 bytes hash as is. It is used for the reports of OCR2 and
+//     the other hashes signed by the node, e.g. by LOOP plugins, which are not prefixed like the ones of eth_sign. It
+//     is not implemented by Web3Signer, which must be fronted by a proxy implementing it for those to be signed; the
+//     Client returns ErrSignHashUnsupported otherwise. Transactions and messages only need the Web3Signer methods.
+//
+// Signatures are 65 bytes [R || S || V], where V may either be 0/1 or 27/28. The signatures and transactions returned
+// by the signer are checked against the expected address before they are used.
+package remotesigner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var (
+	// ErrUnsupportedTxType is returned for transactions which cannot be described by TxArgs, e.g. blob transactions.
+	ErrUnsupportedTxType = errors.New("transaction type is not supported by the remote signer")
+	// ErrSignHashUnsupported is returned by SignHash if the signer does not implement chainlink_signHash, e.g. Web3Signer.
+	ErrSignHashUnsupported = errors.New("remote signer does not implement chainlink_signHash, which is required to sign OCR2 reports and the hashes of LOOP plugins")
+)
+
+// methodNotFound is the JSON-RPC 2.0 error code for an unknown method.
+const methodNotFound = -32601
+
+// TxArgs describes a transaction to sign with eth_signTransaction. Legacy transactions set GasPrice, dynamic fee
+// transactions set MaxFeePerGas and MaxPriorityFeePerGas, and access list transactions set GasPrice and AccessList.
+type TxArgs struct {
+	From                 common.Address    `json:"from"`
+	To                   *common.Address   `json:"to,omitempty"`
+	Gas                  hexutil.Uint64    `json:"gas"`
+	GasPrice             *hexutil.Big      `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big      `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big      `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *hexutil.Big      `json:"value,omitempty"`
+	Nonce                hexutil.Uint64    `json:"nonce"`
+	Data                 hexutil.Bytes     `json:"data,omitempty"`
+	AccessList           *types.AccessList `json:"accessList,omitempty"`
+	ChainID              *hexutil.Big      `json:"chainId,omitempty"`
+}
+
+// NewTxArgs describes tx, to be signed by from for chainID.
+func NewTxArgs(from common.Address, tx *types.Transaction, chainID *big.Int) (TxArgs, error) {
+	args := TxArgs{
+		From:    from,
+		To:      tx.To(),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   (*hexutil.Big)(tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    tx.Data(),
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	switch tx.Type() {
+	case types.LegacyTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	case types.AccessListTxType:
+		al := tx.AccessList()
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+		args.AccessList = &al
+	case types.DynamicFeeTxType:
+		al := tx.AccessList()
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+		args.AccessList = &al
+	default:
+		return TxArgs{}, fmt.Errorf("%w: %d", ErrUnsupportedTxType, tx.Type())
+	}
+	return args, nil
+}
+
+// Transaction returns the unsigned transaction described by args, for chainID if args does not include one.
+func (args TxArgs) Transaction(chainID *big.Int) *types.Transaction {
+	if args.ChainID != nil {
+		chainID = args.ChainID.ToInt()
+	}
+	value := new(big.Int)
+	if args.Value != nil {
+		value = args.Value.ToInt()
+	}
+	var al types.AccessList
+	if args.AccessList != nil {
+		al = *args.AccessList
+	}
+	switch {
+	case args.MaxFeePerGas != nil:
+		var tip *big.Int
+		if args.MaxPriorityFeePerGas != nil {
+			tip = args.MaxPriorityFeePerGas.ToInt()
+		}
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:    chainID,
+			Nonce:      uint64(args.Nonce),
+			GasTipCap:  tip,
+			GasFeeCap:  args.MaxFeePerGas.ToInt(),
+			Gas:        uint64(args.Gas),
+			To:         args.To,
+			Value:      value,
+			Data:       args.Data,
+			AccessList: al,
+		})
+	case args.AccessList != nil:
+		return types.NewTx(&types.AccessListTx{
+			ChainID:    chainID,
+			Nonce:      uint64(args.Nonce),
+			GasPrice:   args.GasPrice.ToInt(),
+			Gas:        uint64(args.Gas),
+			To:         args.To,
+			Value:      value,
+			Data:       args.Data,
+			AccessList: al,
+		})
+	default:
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    uint64(args.Nonce),
+			GasPrice: args.GasPrice.ToInt(),
+			Gas:      uint64(args.Gas),
+			To:       args.To,
+			Value:    value,
+			Data:     args.Data,
+		})
+	}
+}
+
+// Client calls a remote signer.
+type Client struct {
+	rpc     *rpc.Client
+	timeout time.Duration
+}
+
+// NewClient returns a Client for the signer at url, giving up on each request after timeout. No request is made until
+// the Client is used.
+func NewClient(url string, timeout time.Duration) (*Client, error) {
+	c, err := rpc.DialOptions(context.Background(), url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial remote signer: %w", err)
+	}
+	return &Client{rpc: c, timeout: timeout}, nil
+}
+
+func (c *Client) Close() {
+	c.rpc.Close()
+}
+
+func (c *Client) call(ctx context.Context, result any, method string, args ...any) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	if err := c.rpc.CallContext(ctx, result, method, args...); err != nil {
+		return fmt.Errorf("remote signer %s failed: %w", method, err)
+	}
+	return nil
+}
+
+// Accounts returns the addresses of the keys held by the signer.
+func (c *Client) Accounts(ctx context.Context) ([]common.Address, error) {
+	var addresses []common.Address
+	err := c.call(ctx, &addresses, "eth_accounts")
+	return addresses, err
+}
+
+// SignTransaction returns tx signed by from, for chainID.
+func (c *Client) SignTransaction(ctx context.Context, from common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	args, err := NewTxArgs(from, tx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	var raw hexutil.Bytes
+	if err = c.call(ctx, &raw, "eth_signTransaction", args); err != nil {
+		return nil, err
+	}
+	signed := new(types.Transaction)
+	if err = signed.UnmarshalBinary(raw); err != nil {
+		return nil, fmt.Errorf("failed to decode transaction signed by remote signer: %w", err)
+	}
+	signer := types.LatestSignerForChainID(chainID)
+	if signer.Hash(signed) != signer.Hash(tx) {
+		return nil, errors.New("remote signer signed a different transaction")
+	}
+	sender, err := types.Sender(signer, signed)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature from remote signer: %w", err)
+	}
+	if sender != from {
+		return nil, fmt.Errorf("remote signer signed with %s instead of %s", sender, from)
+	}
+	return signed, nil
+}
+
+// SignMessage signs the EIP-191 hash of message with the key of address.
+func (c *Client) SignMessage(ctx context.Context, address common.Address, message []byte) ([]byte, error) {
+	var sig hexutil.Bytes
+	if err := c.call(ctx, &sig, "eth_sign", address, hexutil.Bytes(message)); err != nil {
+		return nil, err
+	}
+	return checkSignature(address, accounts.TextHash(message), sig)
+}
+
+// SignHash signs hash as is with the key of address.
+func (c *Client) SignHash(ctx context.Context, address common.Address, hash []byte) ([]byte, error) {
+	if len(hash) != common.HashLength {
+		return nil, fmt.Errorf("hash must be %d bytes, got %d", common.HashLength, len(hash))
+	}
+	var sig hexutil.Bytes
+	if err := c.call(ctx, &sig, "chainlink_signHash", address, hexutil.Bytes(hash)); err != nil {
+		var rpcErr rpc.Error
+		if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == methodNotFound {
+			return nil, fmt.Errorf("%w: %w", ErrSignHashUnsupported, err)
+		}
+		return nil, err
+	}
+	return checkSignature(address, hash, sig)
+}
+
+// checkSignature makes sure that sig was made by address, and returns it with a V of 0 or 1, like the signatures of the
+// local keys.
+func checkSignature(address common.Address, hash []byte, sig []byte) ([]byte, error) {
+	if len(sig) != crypto.SignatureLength {
+		return nil, fmt.Errorf("remote signer returned a signature of %d bytes", len(sig))
+	}
+	sig = common.CopyBytes(sig)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	pub, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature from remote signer: %w", err)
+	}
+	if signer := crypto.PubkeyToAddress(*pub); signer != address {
+		return nil, fmt.Errorf("remote signer signed with %s instead of %s", signer, address)
+	}
+	return sig, nil
+}
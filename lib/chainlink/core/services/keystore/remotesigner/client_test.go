@@ -0,0 +1,162 @@
+package remotesigner_test
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/keys"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
+)
+
+func newReferenceSigner(t *testing.T, chainID *big.Int) (*remotesigner.Client, *ecdsa.PrivateKey) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return newReferenceSignerWithKeys(t, chainID, key), key
+}
+
+func newReferenceSignerWithKeys(t *testing.T, chainID *big.Int, keys ...*ecdsa.PrivateKey) *remotesigner.Client {
+	signer, err := remotesigner.NewReferenceSigner(chainID, keys...)
+	require.NoError(t, err)
+	t.Cleanup(signer.Stop)
+	return newClient(t, signer)
+}
+
+func newClient(t *testing.T, handler http.Handler) *remotesigner.Client {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	client, err := remotesigner.NewClient(srv.URL, 5*time.Second)
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+	return client
+}
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	chainID := testutils.FixtureChainID
+	client, key := newReferenceSigner(t, chainID)
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	to := testutils.NewAddress()
+	unknown := testutils.NewAddress()
+
+	t.Run("Accounts", func(t *testing.T) {
+		got, err := client.Accounts(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []common.Address{address}, got)
+	})
+
+	t.Run("SignTransaction", func(t *testing.T) {
+		for _, tx := range []*types.Transaction{
+			types.NewTx(&types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(10), Gas: 21000, To: &to, Value: big.NewInt(1)}),
+			types.NewTx(&types.AccessListTx{ChainID: chainID, Nonce: 2, GasPrice: big.NewInt(10), Gas: 21000, To: &to,
+				AccessList: types.AccessList{{Address: to, StorageKeys: []common.Hash{{1}}}}}),
+			types.NewTx(&types.DynamicFeeTx{ChainID: chainID, Nonce: 3, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(10), Gas: 50000, Data: []byte{1, 2, 3}}),
+		} {
+			signed, err := client.SignTransaction(ctx, address, tx, chainID)
+			require.NoError(t, err)
+			sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+			require.NoError(t, err)
+			assert.Equal(t, address, sender)
+			assert.Equal(t, tx.Type(), signed.Type())
+			assert.Equal(t, tx.Nonce(), signed.Nonce())
+		}
+
+		tx := types.NewTx(&types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(10), Gas: 21000, To: &to})
+		_, err := client.SignTransaction(ctx, unknown, tx, chainID)
+		require.ErrorContains(t, err, "unknown account")
+		_, err = client.SignTransaction(ctx, address, tx, big.NewInt(1))
+		require.ErrorContains(t, err, "chain ID 1 is not supported")
+		_, err = client.SignTransaction(ctx, address, types.NewTx(&types.BlobTx{}), chainID)
+		require.ErrorIs(t, err, remotesigner.ErrUnsupportedTxType)
+	})
+
+	t.Run("SignMessage", func(t *testing.T) {
+		msg := []byte("hello")
+		sig, err := client.SignMessage(ctx, address, msg)
+		require.NoError(t, err)
+		expected, err := crypto.Sign(accounts.TextHash(msg), key)
+		require.NoError(t, err)
+		assert.Equal(t, expected, sig)
+	})
+
+	t.Run("SignHash", func(t *testing.T) {
+		hash := crypto.Keccak256([]byte("hello"))
+		sig, err := client.SignHash(ctx, address, hash)
+		require.NoError(t, err)
+		expected, err := crypto.Sign(hash, key)
+		require.NoError(t, err)
+		assert.Equal(t, expected, sig)
+
+		_, err = client.SignHash(ctx, address, []byte("short"))
+		require.ErrorContains(t, err, "hash must be 32 bytes")
+		_, err = client.SignHash(ctx, unknown, hash)
+		require.ErrorContains(t, err, "unknown account")
+	})
+
+	t.Run("SignHash is not implemented by Web3Signer", func(t *testing.T) {
+		web3Signer := newClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				ID json.RawMessage `json:"id"`
+			}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			w.Header().Set("Content-Type", "application/json")
+			_, err := fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"error":{"code":-32601,"message":"Method not found"}}`, req.ID)
+			assert.NoError(t, err)
+		}))
+		_, err := web3Signer.SignHash(ctx, address, crypto.Keccak256([]byte("hello")))
+		require.ErrorIs(t, err, remotesigner.ErrSignHashUnsupported)
+	})
+}
+
+func TestEthSigner(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	chainID := testutils.FixtureChainID
+	client, key := newReferenceSigner(t, chainID)
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	signer := remotesigner.NewEthSigner(client, chainID)
+
+	t.Run("Sign checks the existence of the account with nil data", func(t *testing.T) {
+		_, err := signer.Sign(ctx, address.String(), nil)
+		require.NoError(t, err)
+		_, err = signer.Sign(ctx, testutils.NewAddress().String(), nil)
+		require.ErrorContains(t, err, "remote signer does not hold a key")
+	})
+
+	t.Run("ChainStore signs with the remote signer", func(t *testing.T) {
+		store := keys.NewChainStore(signer, chainID)
+		require.NoError(t, store.CheckEnabled(ctx, address))
+
+		to := testutils.NewAddress()
+		tx := types.NewTx(&types.DynamicFeeTx{ChainID: chainID, Nonce: 1, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(10), Gas: 21000, To: &to})
+		signed, err := store.SignTx(ctx, address, tx)
+		require.NoError(t, err)
+		sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+		require.NoError(t, err)
+		assert.Equal(t, address, sender)
+
+		msg := []byte("hello")
+		sig, err := store.SignMessage(ctx, address, msg)
+		require.NoError(t, err)
+		pub, err := crypto.SigToPub(accounts.TextHash(msg), sig)
+		require.NoError(t, err)
+		assert.Equal(t, address, crypto.PubkeyToAddress(*pub))
+	})
+}
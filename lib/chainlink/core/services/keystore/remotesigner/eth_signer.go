@@ -0,0 +1,67 @@
+package remotesigner
+
+import (
+	"context"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/loop"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/core"
+)
+
+var _ loop.Keystore = &EthSigner{}
+
+// EthSigner is the remote counterpart of keystore.EthSigner. Besides the hashes signed by core.Keystore, it signs whole
+// transactions and messages, so that keys.NewChainStore uses eth_signTransaction and eth_sign rather than signing their
+// hashes.
+type EthSigner struct {
+	core.UnimplementedKeystore
+	client  *Client
+	chainID *big.Int
+}
+
+func NewEthSigner(client *Client, chainID *big.Int) *EthSigner {
+	return &EthSigner{client: client, chainID: chainID}
+}
+
+func (e *EthSigner) Accounts(ctx context.Context) (accounts []string, err error) {
+	as, err := e.client.Accounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range as {
+		accounts = append(accounts, a.String())
+	}
+	return
+}
+
+func (e *EthSigner) Sign(ctx context.Context, account string, data []byte) (signed []byte, err error) {
+	if !common.IsHexAddress(account) {
+		return nil, errors.Errorf("invalid address %q", account)
+	}
+	address := common.HexToAddress(account)
+	// loopp spec requires passing nil hash to check existence of id
+	if data == nil {
+		as, err := e.client.Accounts(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(as, address) {
+			return nil, errors.Errorf("remote signer does not hold a key for %s", address)
+		}
+		return nil, nil
+	}
+	return e.client.SignHash(ctx, address, data)
+}
+
+func (e *EthSigner) SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction) (*types.Transaction, error) {
+	return e.client.SignTransaction(ctx, fromAddress, tx, e.chainID)
+}
+
+func (e *EthSigner) SignMessage(ctx context.Context, address common.Address, message []byte) ([]byte, error) {
+	return e.client.SignMessage(ctx, address, message)
+}
@@ -0,0 +1,96 @@
+package remotesigner
+
+import (
+	"context"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+)
+
+// NewKeystore returns ks, with its Eth keystore replaced by NewEthKeystore.
+func NewKeystore(ks keystore.Master, client *Client) keystore.Master {
+	return &master{Master: ks, eth: NewEthKeystore(ks.Eth(), client)}
+}
+
+type master struct {
+	keystore.Master
+	eth keystore.Eth
+}
+
+func (ks *master) Eth() keystore.Eth {
+	return ks.eth
+}
+
+// NewEthKeystore returns ks, with the methods which only deal with addresses, e.g. CheckEnabled, answered with the
+// accounts of the remote signer, so that its keys are accepted wherever the node checks a sending address. The
+// remote signer holds its keys for every chain, so chain IDs are ignored. The methods returning keys, e.g. Get, only
+// see the keys of the keystore.
+func NewEthKeystore(ks keystore.Eth, client *Client) keystore.Eth {
+	return &ethKeystore{Eth: ks, client: client, lastUsed: make(map[lastUsedKey]time.Time)}
+}
+
+type ethKeystore struct {
+	keystore.Eth
+	client *Client
+
+	mu       sync.Mutex
+	lastUsed map[lastUsedKey]time.Time
+}
+
+type lastUsedKey struct {
+	chainID string
+	address common.Address
+}
+
+func (ks *ethKeystore) CheckEnabled(ctx context.Context, address common.Address, chainID *big.Int) error {
+	accounts, err := ks.client.Accounts(ctx)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(accounts, address) {
+		return errors.Errorf("remote signer does not hold a key for %s", address)
+	}
+	return nil
+}
+
+func (ks *ethKeystore) EnabledAddressesForChain(ctx context.Context, chainID *big.Int) ([]common.Address, error) {
+	return ks.client.Accounts(ctx)
+}
+
+// GetRoundRobinAddress returns the least recently used account of the remote signer for chainID, among addresses if
+// any are given.
+func (ks *ethKeystore) GetRoundRobinAddress(ctx context.Context, chainID *big.Int, addresses ...common.Address) (common.Address, error) {
+	if chainID == nil {
+		return common.Address{}, errors.New("chainID must be non-nil")
+	}
+	accounts, err := ks.client.Accounts(ctx)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if len(addresses) > 0 {
+		accounts = slices.DeleteFunc(accounts, func(a common.Address) bool { return !slices.Contains(addresses, a) })
+	}
+	if len(accounts) == 0 {
+		if len(addresses) == 0 {
+			return common.Address{}, errors.Errorf("no sending keys available for chain %s", chainID)
+		}
+		return common.Address{}, errors.Errorf("no sending keys available for chain %s that match whitelist: %v", chainID, addresses)
+	}
+
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	next := accounts[0]
+	for _, a := range accounts[1:] {
+		if ks.lastUsed[lastUsedKey{chainID.String(), a}].Before(ks.lastUsed[lastUsedKey{chainID.String(), next}]) {
+			next = a
+		}
+	}
+	ks.lastUsed[lastUsedKey{chainID.String(), next}] = time.Now()
+	return next, nil
+}
@@ -0,0 +1,68 @@
+package remotesigner_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
+)
+
+func TestEthKeystore(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	chainID := testutils.FixtureChainID
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db)
+	localKey, _ := cltest.MustInsertRandomKey(t, keyStore.Eth())
+
+	key1, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	key2, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address1, address2 := crypto.PubkeyToAddress(key1.PublicKey), crypto.PubkeyToAddress(key2.PublicKey)
+	client := newReferenceSignerWithKeys(t, chainID, key1, key2)
+	ks := remotesigner.NewKeystore(keyStore, client).Eth()
+
+	t.Run("CheckEnabled", func(t *testing.T) {
+		require.NoError(t, ks.CheckEnabled(ctx, address1, chainID))
+		require.ErrorContains(t, ks.CheckEnabled(ctx, localKey.Address, chainID), "remote signer does not hold a key")
+	})
+
+	t.Run("EnabledAddressesForChain", func(t *testing.T) {
+		addresses, err := ks.EnabledAddressesForChain(ctx, chainID)
+		require.NoError(t, err)
+		assert.Equal(t, []common.Address{address1, address2}, addresses)
+	})
+
+	t.Run("GetRoundRobinAddress", func(t *testing.T) {
+		first, err := ks.GetRoundRobinAddress(ctx, chainID)
+		require.NoError(t, err)
+		second, err := ks.GetRoundRobinAddress(ctx, chainID)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []common.Address{address1, address2}, []common.Address{first, second})
+
+		for range 2 {
+			next, err := ks.GetRoundRobinAddress(ctx, chainID, address2, localKey.Address)
+			require.NoError(t, err)
+			assert.Equal(t, address2, next)
+		}
+		_, err = ks.GetRoundRobinAddress(ctx, chainID, localKey.Address)
+		require.ErrorContains(t, err, "no sending keys available")
+	})
+
+	t.Run("Get only sees the keys of the keystore", func(t *testing.T) {
+		got, err := ks.Get(ctx, localKey.ID())
+		require.NoError(t, err)
+		assert.Equal(t, localKey.Address, got.Address)
+		_, err = ks.Get(ctx, address1.String())
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,91 @@
+package remotesigner
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+)
+
+// NewOCR2Keystore returns ks, with the onchain keyring of its EVM key bundles replaced by the key of address held by
+// the remote signer. The offchain keyrings are left untouched.
+func NewOCR2Keystore(ks keystore.OCR2, client *Client, address common.Address) keystore.OCR2 {
+	return &ocr2Keystore{OCR2: ks, client: client, address: address}
+}
+
+type ocr2Keystore struct {
+	keystore.OCR2
+	client  *Client
+	address common.Address
+}
+
+func (ks *ocr2Keystore) wrap(kb ocr2key.KeyBundle) ocr2key.KeyBundle {
+	if kb.ChainType() != chaintype.EVM {
+		return kb
+	}
+	return &keyBundle{KeyBundle: kb, client: ks.client, address: ks.address}
+}
+
+func (ks *ocr2Keystore) wrapAll(kbs []ocr2key.KeyBundle, err error) ([]ocr2key.KeyBundle, error) {
+	if err != nil {
+		return kbs, err
+	}
+	wrapped := make([]ocr2key.KeyBundle, len(kbs))
+	for i, kb := range kbs {
+		wrapped[i] = ks.wrap(kb)
+	}
+	return wrapped, nil
+}
+
+func (ks *ocr2Keystore) Get(id string) (ocr2key.KeyBundle, error) {
+	kb, err := ks.OCR2.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	return ks.wrap(kb), nil
+}
+
+func (ks *ocr2Keystore) GetAll() ([]ocr2key.KeyBundle, error) {
+	return ks.wrapAll(ks.OCR2.GetAll())
+}
+
+func (ks *ocr2Keystore) GetAllOfType(chainType chaintype.ChainType) ([]ocr2key.KeyBundle, error) {
+	return ks.wrapAll(ks.OCR2.GetAllOfType(chainType))
+}
+
+// keyBundle signs reports with the remote signer. Verification does not need the private key, and is left to the
+// local bundle.
+type keyBundle struct {
+	ocr2key.KeyBundle
+	client  *Client
+	address common.Address
+}
+
+// PublicKey returns the address of the remote key, like the local EVM keyring.
+func (kb *keyBundle) PublicKey() ocrtypes.OnchainPublicKey {
+	return kb.address.Bytes()
+}
+
+func (kb *keyBundle) OnChainPublicKey() string {
+	return hex.EncodeToString(kb.PublicKey())
+}
+
+func (kb *keyBundle) Sign(reportCtx ocrtypes.ReportContext, report ocrtypes.Report) ([]byte, error) {
+	return kb.SignBlob(ocr2key.ReportToSigData(reportCtx, report))
+}
+
+func (kb *keyBundle) Sign3(digest ocrtypes.ConfigDigest, seqNr uint64, r ocrtypes.Report) (signature []byte, err error) {
+	return kb.SignBlob(ocr2key.ReportToSigData3(digest, seqNr, r))
+}
+
+// SignBlob signs b, which must be a hash. The OCR interfaces do not take a context, so the call is only bound by the
+// timeout of the client.
+func (kb *keyBundle) SignBlob(b []byte) (sig []byte, err error) {
+	return kb.client.SignHash(context.Background(), kb.address, b)
+}
@@ -0,0 +1,79 @@
+package remotesigner_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/types"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
+)
+
+func TestOCR2Keystore(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db)
+	evmKey, err := keyStore.OCR2().Create(ctx, chaintype.EVM)
+	require.NoError(t, err)
+	solanaKey, err := keyStore.OCR2().Create(ctx, chaintype.Solana)
+	require.NoError(t, err)
+
+	client, key := newReferenceSigner(t, testutils.FixtureChainID)
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	ks := remotesigner.NewOCR2Keystore(keyStore.OCR2(), client, address)
+
+	t.Run("replaces the onchain key of EVM bundles", func(t *testing.T) {
+		kb, err := ks.Get(evmKey.ID())
+		require.NoError(t, err)
+		assert.Equal(t, evmKey.ID(), kb.ID())
+		assert.Equal(t, address.Bytes(), []byte(kb.PublicKey()))
+		assert.Equal(t, hex.EncodeToString(address.Bytes()), kb.OnChainPublicKey())
+		assert.Equal(t, evmKey.OffchainPublicKey(), kb.OffchainPublicKey())
+
+		all, err := ks.GetAll()
+		require.NoError(t, err)
+		require.Len(t, all, 2)
+		for _, kb := range all {
+			if kb.ID() == evmKey.ID() {
+				assert.Equal(t, address.Bytes(), []byte(kb.PublicKey()))
+			}
+		}
+	})
+
+	t.Run("leaves other bundles untouched", func(t *testing.T) {
+		kbs, err := ks.GetAllOfType(chaintype.Solana)
+		require.NoError(t, err)
+		require.Len(t, kbs, 1)
+		assert.Equal(t, solanaKey.PublicKey(), kbs[0].PublicKey())
+	})
+
+	t.Run("signs reports with the remote signer", func(t *testing.T) {
+		kb, err := ks.Get(evmKey.ID())
+		require.NoError(t, err)
+
+		reportCtx := ocrtypes.ReportContext{}
+		report := ocrtypes.Report(testutils.MustRandBytes(100))
+		sig, err := kb.Sign(reportCtx, report)
+		require.NoError(t, err)
+		assert.True(t, kb.Verify(kb.PublicKey(), reportCtx, report, sig))
+		assert.True(t, evmKey.Verify(kb.PublicKey(), reportCtx, report, sig))
+		assert.False(t, evmKey.Verify(evmKey.PublicKey(), reportCtx, report, sig))
+
+		digest, err := types.BytesToConfigDigest(testutils.MustRandBytes(32))
+		require.NoError(t, err)
+		sig, err = kb.Sign3(digest, 42, report)
+		require.NoError(t, err)
+		assert.True(t, kb.Verify3(kb.PublicKey(), digest, 42, report, sig))
+	})
+}
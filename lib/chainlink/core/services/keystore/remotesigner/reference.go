@@ -0,0 +1,115 @@
+package remotesigner
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// ReferenceSigner implements the protocol of the package with keys held in memory. It is meant for tests and local
+// development, not for production.
+type ReferenceSigner struct {
+	server *rpc.Server
+}
+
+var _ http.Handler = (*ReferenceSigner)(nil)
+
+// NewReferenceSigner returns a ReferenceSigner signing transactions for chainID with keys.
+func NewReferenceSigner(chainID *big.Int, keys ...*ecdsa.PrivateKey) (*ReferenceSigner, error) {
+	svc := &referenceService{chainID: chainID, keys: make(map[common.Address]*ecdsa.PrivateKey, len(keys))}
+	for _, k := range keys {
+		address := crypto.PubkeyToAddress(k.PublicKey)
+		svc.keys[address] = k
+		svc.addresses = append(svc.addresses, address)
+	}
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", svc); err != nil {
+		return nil, err
+	}
+	if err := server.RegisterName("chainlink", &referenceChainlinkService{svc}); err != nil {
+		return nil, err
+	}
+	return &ReferenceSigner{server: server}, nil
+}
+
+func (s *ReferenceSigner) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.server.ServeHTTP(w, r)
+}
+
+func (s *ReferenceSigner) Stop() {
+	s.server.Stop()
+}
+
+type referenceService struct {
+	chainID   *big.Int
+	keys      map[common.Address]*ecdsa.PrivateKey
+	addresses []common.Address
+}
+
+func (s *referenceService) key(address common.Address) (*ecdsa.PrivateKey, error) {
+	k, ok := s.keys[address]
+	if !ok {
+		return nil, fmt.Errorf("unknown account %s", address)
+	}
+	return k, nil
+}
+
+// Accounts implements eth_accounts.
+func (s *referenceService) Accounts() []common.Address {
+	return s.addresses
+}
+
+// SignTransaction implements eth_signTransaction.
+func (s *referenceService) SignTransaction(args TxArgs) (hexutil.Bytes, error) {
+	k, err := s.key(args.From)
+	if err != nil {
+		return nil, err
+	}
+	if args.ChainID != nil && args.ChainID.ToInt().Cmp(s.chainID) != 0 {
+		return nil, fmt.Errorf("chain ID %s is not supported", args.ChainID.ToInt())
+	}
+	signed, err := types.SignTx(args.Transaction(s.chainID), types.LatestSignerForChainID(s.chainID), k)
+	if err != nil {
+		return nil, err
+	}
+	return signed.MarshalBinary()
+}
+
+// Sign implements eth_sign, returning a signature with a V of 27 or 28 like Web3Signer.
+func (s *referenceService) Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	k, err := s.key(address)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := crypto.Sign(accounts.TextHash(data), k)
+	if err != nil {
+		return nil, err
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return sig, nil
+}
+
+// referenceChainlinkService holds the chainlink_ methods, without exposing the eth_ ones under that namespace.
+type referenceChainlinkService struct {
+	eth *referenceService
+}
+
+// SignHash implements chainlink_signHash.
+func (s *referenceChainlinkService) SignHash(address common.Address, hash hexutil.Bytes) (hexutil.Bytes, error) {
+	k, err := s.eth.key(address)
+	if err != nil {
+		return nil, err
+	}
+	if len(hash) != common.HashLength {
+		return nil, fmt.Errorf("hash must be %d bytes, got %d", common.HashLength, len(hash))
+	}
+	return crypto.Sign(hash, k)
+}
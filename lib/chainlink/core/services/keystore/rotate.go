@@ -0,0 +1,82 @@
+package keystore
+
+import (
+	"context"
+	"reflect"
+	"slices"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// ErrKeyRingModified is returned by RotatePassword if the key ring was saved by someone else during the rotation.
+var ErrKeyRingModified = errors.New("key ring was modified during the password rotation")
+
+// RotatePassword re-encrypts the key ring, which holds the keys of every type, with newPassword and scryptParams.
+// The previous ciphertext is backed up to encrypted_key_ring_backups in the same transaction as the key ring is
+// replaced, so that the key ring is always encrypted with either the old or the new password, even after a crash.
+// It returns the ID of the backup, which stays decryptable with oldPassword until it is deleted by PrunePasswordBackups.
+//
+// The keystore must not be unlocked by a running node while its password is rotated.
+func RotatePassword(ctx context.Context, ds sqlutil.DataSource, oldPassword, newPassword string, scryptParams utils.ScryptParams) (backupID int64, err error) {
+	if newPassword == "" {
+		return 0, errors.New("new password must not be empty")
+	}
+	if newPassword == oldPassword {
+		return 0, errors.New("new password must be different from the old one")
+	}
+	orm := NewORM(ds)
+	prev, err := orm.getEncryptedKeyRing(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to get encrypted key ring")
+	}
+	if len(prev.EncryptedKeys) == 0 {
+		return 0, errors.New("keystore is empty, there is no password to rotate")
+	}
+	kr, err := prev.Decrypt(oldPassword)
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to decrypt encrypted key ring with the old password")
+	}
+	next, err := kr.Encrypt(newPassword, scryptParams)
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to encrypt key ring with the new password")
+	}
+	// make sure nothing was lost before replacing the only copy of the keys
+	check, err := next.Decrypt(newPassword)
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to decrypt re-encrypted key ring")
+	}
+	if !reflect.DeepEqual(kr.keyIDs(), check.keyIDs()) || kr.LegacyKeys.legacyRawKeys.len() != check.LegacyKeys.legacyRawKeys.len() {
+		return 0, errors.New("re-encrypted key ring does not hold the same keys")
+	}
+	return orm.rotateEncryptedKeyRing(ctx, prev, next)
+}
+
+// PrunePasswordBackups deletes the key rings backed up by RotatePassword, except the keep most recent ones, and
+// returns how many were deleted. A backup can be decrypted with the password the key ring had before its rotation.
+func PrunePasswordBackups(ctx context.Context, ds sqlutil.DataSource, keep int) (int64, error) {
+	if keep < 0 {
+		return 0, errors.New("number of backups to keep must not be negative")
+	}
+	return NewORM(ds).deleteEncryptedKeyRingBackups(ctx, keep)
+}
+
+// keyIDs returns the sorted IDs of the keys held by the key ring, by type.
+func (kr *keyRing) keyIDs() map[string][]string {
+	ids := make(map[string][]string)
+	v := reflect.Indirect(reflect.ValueOf(kr))
+	for i := range v.NumField() {
+		field := v.Field(i)
+		if field.Kind() != reflect.Map {
+			continue
+		}
+		name := v.Type().Field(i).Name
+		for _, id := range field.MapKeys() {
+			ids[name] = append(ids[name], id.String())
+		}
+		slices.Sort(ids[name])
+	}
+	return ids
+}
@@ -0,0 +1,110 @@
+package keystore_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestRotatePassword(t *testing.T) {
+	t.Parallel()
+
+	const newPassword = "n3w-p4ssw0rd-for-the-keystore"
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+
+	t.Run("fails on an empty keystore", func(t *testing.T) {
+		_, err := keystore.RotatePassword(ctx, db, cltest.Password, newPassword, utils.FastScryptParams)
+		require.ErrorContains(t, err, "keystore is empty")
+	})
+
+	keyStore := keystore.ExposedNewMaster(t, db)
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	ethKey, _ := cltest.MustInsertRandomKey(t, keyStore.Eth())
+	ocr2Key, err := keyStore.OCR2().Create(ctx, chaintype.EVM)
+	require.NoError(t, err)
+	p2pKey, err := keyStore.P2P().Create(ctx)
+	require.NoError(t, err)
+	csaKey, err := keyStore.CSA().Create(ctx)
+	require.NoError(t, err)
+	vrfKey, err := keyStore.VRF().Create(ctx)
+	require.NoError(t, err)
+	workflowKey, err := keyStore.Workflow().Create(ctx)
+	require.NoError(t, err)
+
+	var prev []byte
+	require.NoError(t, db.Get(&prev, `SELECT encrypted_keys FROM encrypted_key_rings`))
+
+	t.Run("fails with the wrong old password", func(t *testing.T) {
+		_, err := keystore.RotatePassword(ctx, db, "wrong password", newPassword, utils.FastScryptParams)
+		require.ErrorContains(t, err, "unable to decrypt encrypted key ring with the old password")
+		cltest.AssertCount(t, db, "encrypted_key_ring_backups", 0)
+	})
+
+	t.Run("fails if the password does not change", func(t *testing.T) {
+		_, err := keystore.RotatePassword(ctx, db, cltest.Password, cltest.Password, utils.FastScryptParams)
+		require.Error(t, err)
+		cltest.AssertCount(t, db, "encrypted_key_ring_backups", 0)
+	})
+
+	t.Run("re-encrypts all keys and backs up the previous key ring", func(t *testing.T) {
+		backupID, err := keystore.RotatePassword(ctx, db, cltest.Password, newPassword, utils.FastScryptParams)
+		require.NoError(t, err)
+
+		var backup []byte
+		require.NoError(t, db.Get(&backup, `SELECT encrypted_keys FROM encrypted_key_ring_backups WHERE id = $1`, backupID))
+		assert.JSONEq(t, string(prev), string(backup))
+
+		rotated := keystore.ExposedNewMaster(t, db)
+		require.Error(t, rotated.Unlock(ctx, cltest.Password))
+		require.NoError(t, rotated.Unlock(ctx, newPassword))
+
+		gotEth, err := rotated.Eth().Get(ctx, ethKey.ID())
+		require.NoError(t, err)
+		requireEqualKeys(t, ethKey, gotEth)
+		gotOCR2, err := rotated.OCR2().Get(ocr2Key.ID())
+		require.NoError(t, err)
+		assert.Equal(t, ocr2Key.Raw(), gotOCR2.Raw())
+		gotP2P, err := rotated.P2P().Get(p2pKey.PeerID())
+		require.NoError(t, err)
+		requireEqualKeys(t, p2pKey, gotP2P)
+		gotCSA, err := rotated.CSA().Get(csaKey.ID())
+		require.NoError(t, err)
+		requireEqualKeys(t, csaKey, gotCSA)
+		gotVRF, err := rotated.VRF().Get(vrfKey.ID())
+		require.NoError(t, err)
+		requireEqualKeys(t, vrfKey, gotVRF)
+		gotWorkflow, err := rotated.Workflow().Get(workflowKey.ID())
+		require.NoError(t, err)
+		requireEqualKeys(t, workflowKey, gotWorkflow)
+	})
+
+	t.Run("prunes the backups", func(t *testing.T) {
+		backupID, err := keystore.RotatePassword(ctx, db, newPassword, "an0ther-p4ssw0rd-for-the-keystore", utils.FastScryptParams)
+		require.NoError(t, err)
+		cltest.AssertCount(t, db, "encrypted_key_ring_backups", 2)
+
+		_, err = keystore.PrunePasswordBackups(ctx, db, -1)
+		require.Error(t, err)
+
+		deleted, err := keystore.PrunePasswordBackups(ctx, db, 1)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), deleted)
+		var remaining []int64
+		require.NoError(t, db.Select(&remaining, `SELECT id FROM encrypted_key_ring_backups`))
+		assert.Equal(t, []int64{backupID}, remaining)
+
+		deleted, err = keystore.PrunePasswordBackups(ctx, db, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), deleted)
+		cltest.AssertCount(t, db, "encrypted_key_ring_backups", 0)
+	})
+}
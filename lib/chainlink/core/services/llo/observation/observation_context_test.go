@@ -188,6 +188,12 @@ func (m *mockBridgeConfig) BridgeResponseURL() *url.URL {
 func (m *mockBridgeConfig) BridgeCacheTTL() time.Duration {
 	return 0
 }
+func (m *mockBridgeConfig) BridgeCircuitBreakerThreshold() uint32 {
+	return 0
+}
+func (m *mockBridgeConfig) BridgeCircuitBreakerCooldown() time.Duration {
+	return 0
+}
 
 func createBridge(t testing.TB, name string, val string, borm bridges.ORM, maxCalls int64) {
 	callcount := atomic.NewInt64(0)
@@ -27,6 +27,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
 
 type GeneralConfig interface {
@@ -53,7 +54,7 @@ type insecureConfig interface {
 }
 
 // ValidatedOracleSpecToml validates an oracle spec that came from TOML
-func ValidatedOracleSpecToml(gcfg GeneralConfig, legacyChains legacyevm.LegacyChainContainer, tomlString string) (job.Job, error) {
+func ValidatedOracleSpecToml(gcfg GeneralConfig, legacyChains legacyevm.LegacyChainContainer, tomlString string, opts ...pipeline.ParseOption) (job.Job, error) {
 	return ValidatedOracleSpecTomlCfg(gcfg, func(id *big.Int, contractAddress types.EIP55Address) (evmconfig.ChainScopedConfig, error) {
 		chainService, err := legacyChains.Get(id.String())
 		if err != nil {
@@ -70,10 +71,10 @@ func ValidatedOracleSpecToml(gcfg GeneralConfig, legacyChains legacyevm.LegacyCh
 			}
 		}
 		return c.Config(), nil
-	}, tomlString)
+	}, tomlString, opts...)
 }
 
-func ValidatedOracleSpecTomlCfg(gcfg GeneralConfig, configFn func(id *big.Int, contractAddress types.EIP55Address) (evmconfig.ChainScopedConfig, error), tomlString string) (job.Job, error) {
+func ValidatedOracleSpecTomlCfg(gcfg GeneralConfig, configFn func(id *big.Int, contractAddress types.EIP55Address) (evmconfig.ChainScopedConfig, error), tomlString string, opts ...pipeline.ParseOption) (job.Job, error) {
 	var jb = job.Job{}
 	var spec job.OCROracleSpec
 	tree, err := toml.Load(tomlString)
@@ -103,6 +104,9 @@ func ValidatedOracleSpecTomlCfg(gcfg GeneralConfig, configFn func(id *big.Int, c
 	if !tree.Has("isBootstrapPeer") {
 		return jb, errors.New("isBootstrapPeer is not defined")
 	}
+	if err = jb.ResolvePipeline(opts...); err != nil {
+		return jb, err
+	}
 
 	if len(spec.P2PV2Bootstrappers) > 0 {
 		_, err = ocrcommon.ParseBootstrapPeers(spec.P2PV2Bootstrappers)
@@ -31,6 +31,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
 
 func TestValidateOracleSpec(t *testing.T) {
@@ -446,6 +447,46 @@ answer1      [type=median index=0];
 	}
 }
 
+func TestValidateOracleSpec_fragments(t *testing.T) {
+	toml := `
+type               = "offchainreporting"
+schemaVersion      = 1
+contractAddress    = "0x613a38AC1659769640aaE063C651F48E0250454C"
+isBootstrapPeer    = false
+observationTimeout = "10s"
+observationSource = """
+answer1 [type=fragment name=turnout index=0];
+"""
+`
+	c := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.Insecure.OCRDevelopmentMode = null.BoolFrom(false).Ptr()
+	})
+	validate := func(timeout string) (job.Job, error) {
+		fragments := pipeline.WithFragments(func(name string) (string, bool) {
+			return fmt.Sprintf(`
+ds1       [type=bridge name=voter_turnout timeout=%q];
+ds1_parse [type=jsonparse path="one,two"];
+ds1 -> ds1_parse;
+`, timeout), name == "turnout"
+		})
+		return ocr.ValidatedOracleSpecTomlCfg(c, func(id *big.Int, contractAddress types.EIP55Address) (evmconfig.ChainScopedConfig, error) {
+			return evmtest.NewChainScopedConfig(t, c), nil
+		}, toml, fragments)
+	}
+
+	s, err := validate("5s")
+	require.NoError(t, err)
+	assert.IsType(t, &pipeline.JSONParseTask{}, s.Pipeline.ByDotID("answer1"))
+
+	_, err = validate("30s")
+	require.ErrorContains(t, err, "individual max task duration must be < observation timeout")
+
+	_, err = ocr.ValidatedOracleSpecTomlCfg(c, func(id *big.Int, contractAddress types.EIP55Address) (evmconfig.ChainScopedConfig, error) {
+		return evmtest.NewChainScopedConfig(t, c), nil
+	}, toml)
+	require.ErrorIs(t, err, pipeline.ErrFragmentNotFound)
+}
+
 func TestOnChainContractAvailability(t *testing.T) {
 	// Because some RPCs prune logs we have scenarios in which a job spec update will lead to outages because of the inability to get the logs. We need to safeguard against these outages by checking if the node can access the OCR configuration
 	// There are 4 possible scenarios:
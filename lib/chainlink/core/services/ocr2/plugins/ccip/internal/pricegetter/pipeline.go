@@ -48,6 +48,8 @@ func NewPipelineGetter(
 	sourceChainSelector uint64,
 	destChainSelector uint64,
 ) (*PipelineGetter, error) {
+	// the job stores tokenPricesUSDPipeline with its pipeline fragments
+	// expanded, so source is run as is
 	_, err := pipeline.Parse(source)
 	if err != nil {
 		return nil, err
@@ -32,9 +32,10 @@ type JuelsPerFeeCoinCache struct {
 	StalenessAlertThreshold sqlutil.Interval `json:"stalenessAlertThreshold"`
 }
 
-// ValidatePluginConfig validates the arguments for the Median plugin.
-func (config *PluginConfig) ValidatePluginConfig() error {
-	if _, err := pipeline.Parse(config.JuelsPerFeeCoinPipeline); err != nil {
+// ValidatePluginConfig validates the arguments for the Median plugin. opts are
+// used to parse pipelines which reference pipeline fragments.
+func (config *PluginConfig) ValidatePluginConfig(opts ...pipeline.ParseOption) error {
+	if _, err := pipeline.Parse(config.JuelsPerFeeCoinPipeline, opts...); err != nil {
 		return errors.Wrap(err, "invalid juelsPerFeeCoinSource pipeline")
 	}
 
@@ -51,7 +52,7 @@ func (config *PluginConfig) ValidatePluginConfig() error {
 	// Gas price pipeline is optional
 	if !config.HasGasPriceSubunitsPipeline() {
 		return nil
-	} else if _, err := pipeline.Parse(config.GasPriceSubunitsPipeline); err != nil {
+	} else if _, err := pipeline.Parse(config.GasPriceSubunitsPipeline, opts...); err != nil {
 		return errors.Wrap(err, "invalid gasPriceSubunitsSource pipeline")
 	}
 
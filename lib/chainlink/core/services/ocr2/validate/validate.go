@@ -34,7 +34,7 @@ import (
 )
 
 // ValidatedOracleSpecToml validates an oracle spec that came from TOML
-func ValidatedOracleSpecToml(ctx context.Context, config OCR2Config, insConf InsecureConfig, tomlString string, rc plugins.RegistrarConfig) (job.Job, error) {
+func ValidatedOracleSpecToml(ctx context.Context, config OCR2Config, insConf InsecureConfig, tomlString string, rc plugins.RegistrarConfig, opts ...pipeline.ParseOption) (job.Job, error) {
 	var jb = job.Job{}
 	var spec job.OCR2OracleSpec
 	tree, err := toml.Load(tomlString)
@@ -60,6 +60,9 @@ func ValidatedOracleSpecToml(ctx context.Context, config OCR2Config, insConf Ins
 	if jb.Type != job.OffchainReporting2 {
 		return jb, pkgerrors.Errorf("the only supported type is currently 'offchainreporting2', got %s", jb.Type)
 	}
+	if err = jb.ResolvePipeline(opts...); err != nil {
+		return jb, err
+	}
 	if _, ok := relay.SupportedNetworks[spec.Relay]; !ok {
 		return jb, pkgerrors.Errorf("no such relay %v supported", spec.Relay)
 	}
@@ -70,7 +73,7 @@ func ValidatedOracleSpecToml(ctx context.Context, config OCR2Config, insConf Ins
 		}
 	}
 
-	if err = validateSpec(ctx, tree, jb, rc); err != nil {
+	if err = validateSpec(ctx, tree, jb, rc, opts...); err != nil {
 		return jb, err
 	}
 	if err = validateTimingParameters(config, insConf, spec); err != nil {
@@ -103,7 +106,7 @@ func validateTimingParameters(ocr2Conf OCR2Config, insConf InsecureConfig, spec
 	return libocr2.SanityCheckLocalConfig(lc)
 }
 
-func validateSpec(ctx context.Context, tree *toml.Tree, spec job.Job, rc plugins.RegistrarConfig) error {
+func validateSpec(ctx context.Context, tree *toml.Tree, spec job.Job, rc plugins.RegistrarConfig, opts ...pipeline.ParseOption) error {
 	expected, notExpected := ocrcommon.CloneSet(params), ocrcommon.CloneSet(notExpectedParams)
 	if err := ocrcommon.ValidateExplicitlySetKeys(tree, expected, notExpected, "ocr2"); err != nil {
 		return err
@@ -124,7 +127,7 @@ func validateSpec(ctx context.Context, tree *toml.Tree, spec job.Job, rc plugins
 	case types.CCIPExecution:
 		return validateOCR2CCIPExecutionSpec(spec.OCR2OracleSpec.PluginConfig)
 	case types.CCIPCommit:
-		return validateOCR2CCIPCommitSpec(spec.OCR2OracleSpec.PluginConfig)
+		return validateOCR2CCIPCommitSpec(spec.OCR2OracleSpec.PluginConfig, opts...)
 	case types.LLO:
 		return validateOCR2LLOSpec(spec.OCR2OracleSpec.PluginConfig)
 	case types.GenericPlugin:
@@ -361,7 +364,7 @@ func validateDonTimePluginSpec(jsonConfig job.JSONConfig) error {
 	return nil
 }
 
-func validateOCR2CCIPCommitSpec(jsonConfig job.JSONConfig) error {
+func validateOCR2CCIPCommitSpec(jsonConfig job.JSONConfig, opts ...pipeline.ParseOption) error {
 	if jsonConfig == nil {
 		return errors.New("pluginConfig is empty")
 	}
@@ -382,7 +385,7 @@ func validateOCR2CCIPCommitSpec(jsonConfig job.JSONConfig) error {
 	}
 
 	if !emptyPipeline {
-		_, err = pipeline.Parse(cfg.TokenPricesUSDPipeline)
+		_, err = pipeline.Parse(cfg.TokenPricesUSDPipeline, opts...)
 		if err != nil {
 			return pkgerrors.Wrap(err, "invalid token prices pipeline")
 		}
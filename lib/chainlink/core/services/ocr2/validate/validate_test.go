@@ -19,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	medianconfig "github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/median/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
 
 func TestValidateOracleSpec(t *testing.T) {
@@ -607,6 +608,79 @@ UpdateInterval="1m"
 	}
 }
 
+func TestValidateOracleSpec_fragments(t *testing.T) {
+	fragments := pipeline.WithFragments(func(name string) (string, bool) {
+		return `
+ds1       [type=bridge name=voter_turnout];
+ds1_parse [type=jsonparse path="one,two"];
+ds1 -> ds1_parse;
+`, name == "turnout"
+	})
+	c := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.Insecure.OCRDevelopmentMode = testutils.Ptr(false)
+	})
+
+	t.Run("median", func(t *testing.T) {
+		toml := `
+type               = "offchainreporting2"
+pluginType         = "median"
+schemaVersion      = 1
+relay              = "evm"
+contractID         = "0x613a38AC1659769640aaE063C651F48E0250454C"
+observationSource  = """
+answer1 [type=fragment name=turnout index=0];
+"""
+[relayConfig]
+chainID = 1337
+[onchainSigningStrategy]
+strategyName = "single-chain"
+[onchainSigningStrategy.config]
+evm = ""
+[pluginConfig]
+juelsPerFeeCoinSource = """
+answer1 [type=fragment name=turnout];
+"""
+`
+		s, err := validate.ValidatedOracleSpecToml(testutils.Context(t), c.OCR2(), c.Insecure(), toml, nil, fragments)
+		require.NoError(t, err)
+		assert.IsType(t, &pipeline.JSONParseTask{}, s.Pipeline.ByDotID("answer1"))
+
+		var pc medianconfig.PluginConfig
+		require.NoError(t, json.Unmarshal(s.OCR2OracleSpec.PluginConfig.Bytes(), &pc))
+		require.NoError(t, pc.ValidatePluginConfig(fragments))
+		require.ErrorIs(t, pc.ValidatePluginConfig(), pipeline.ErrFragmentNotFound)
+
+		_, err = validate.ValidatedOracleSpecToml(testutils.Context(t), c.OCR2(), c.Insecure(), toml, nil)
+		require.ErrorIs(t, err, pipeline.ErrFragmentNotFound)
+	})
+
+	t.Run("ccip commit", func(t *testing.T) {
+		toml := `
+type               = "offchainreporting2"
+pluginType         = "ccip-commit"
+schemaVersion      = 1
+relay              = "evm"
+contractID         = "0x613a38AC1659769640aaE063C651F48E0250454C"
+[relayConfig]
+chainID = 1337
+[onchainSigningStrategy]
+strategyName = "single-chain"
+[onchainSigningStrategy.config]
+evm = ""
+[pluginConfig]
+offRamp = "0x613a38AC1659769640aaE063C651F48E0250454C"
+tokenPricesUSDPipeline = """
+answer1 [type=fragment name=turnout];
+"""
+`
+		_, err := validate.ValidatedOracleSpecToml(testutils.Context(t), c.OCR2(), c.Insecure(), toml, nil, fragments)
+		require.NoError(t, err)
+
+		_, err = validate.ValidatedOracleSpecToml(testutils.Context(t), c.OCR2(), c.Insecure(), toml, nil)
+		require.ErrorIs(t, err, pipeline.ErrFragmentNotFound)
+	})
+}
+
 type envelope struct {
 	PluginConfig *validate.OCR2GenericPluginConfig
 }
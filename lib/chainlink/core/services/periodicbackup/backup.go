@@ -2,11 +2,12 @@ package periodicbackup
 
 import (
 	"context"
-	"fmt"
+	stderrors "errors"
 	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -19,7 +20,6 @@ import (
 )
 
 var (
-	filePattern        = "cl_backup_%s.dump"
 	minBackupFrequency = time.Minute
 
 	excludedDataFromTables = []string{
@@ -39,16 +39,27 @@ type (
 	DatabaseBackup interface {
 		services.Service
 		RunBackup(version string) error
+		// Restore replaces the contents of the database with the backup stored as name, or
+		// the latest backup if name is empty. It returns the name of the restored backup.
+		Restore(ctx context.Context, name string) (string, error)
 	}
 
 	databaseBackup struct {
 		services.StateMachine
-		logger          logger.Logger
-		databaseURL     url.URL
+		logger      logger.Logger
+		databaseURL url.URL
+		// scratchURL is the node's database, which verification creates scratch databases
+		// next to, as databaseURL may point to a read replica.
+		scratchURL      url.URL
 		mode            config.DatabaseBackupMode
 		frequency       time.Duration
 		outputParentDir string
-		done            chan bool
+		target          Target
+		password        string
+		encrypt         bool
+		retention       uint32
+		verify          bool
+		stopCh          services.StopChan
 	}
 
 	BackupConfig interface {
@@ -56,12 +67,20 @@ type (
 		Dir() string
 		Mode() config.DatabaseBackupMode
 		Frequency() time.Duration
+		Target() config.DatabaseBackupTarget
+		Encrypt() bool
+		Retention() uint32
+		Verify() bool
+		S3() config.BackupS3
+		SFTP() config.BackupSFTP
 	}
 )
 
-// NewDatabaseBackup instantiates a *databaseBackup
-func NewDatabaseBackup(dbUrl url.URL, rootDir string, backupConfig BackupConfig, lggr logger.Logger) (DatabaseBackup, error) {
+// NewDatabaseBackup instantiates a *databaseBackup. The keystorePassword is used
+// to encrypt backups if Database.Backup.Encrypt is set.
+func NewDatabaseBackup(dbUrl url.URL, rootDir string, backupConfig BackupConfig, keystorePassword string, lggr logger.Logger) (DatabaseBackup, error) {
 	lggr = lggr.Named("DatabaseBackup")
+	scratchURL := dbUrl
 	dbBackupUrl := backupConfig.URL()
 	if dbBackupUrl != nil {
 		dbUrl = *dbBackupUrl
@@ -76,14 +95,28 @@ func NewDatabaseBackup(dbUrl url.URL, rootDir string, backupConfig BackupConfig,
 		outputParentDir = dir
 	}
 
+	if backupConfig.Encrypt() && keystorePassword == "" {
+		return nil, errors.New("Database.Backup.Encrypt requires the keystore password")
+	}
+
+	target, err := NewTarget(backupConfig, outputParentDir)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to initialize Database.Backup.Target")
+	}
+
 	return &databaseBackup{
-		services.StateMachine{},
-		lggr,
-		dbUrl,
-		backupConfig.Mode(),
-		backupConfig.Frequency(),
-		outputParentDir,
-		make(chan bool),
+		logger:          lggr,
+		databaseURL:     dbUrl,
+		scratchURL:      scratchURL,
+		mode:            backupConfig.Mode(),
+		frequency:       backupConfig.Frequency(),
+		outputParentDir: outputParentDir,
+		target:          target,
+		password:        keystorePassword,
+		encrypt:         backupConfig.Encrypt(),
+		retention:       backupConfig.Retention(),
+		verify:          backupConfig.Verify(),
+		stopCh:          make(chan struct{}),
 	}, nil
 }
 
@@ -102,7 +135,7 @@ func (backup *databaseBackup) Start(context.Context) error {
 		go func() {
 			for {
 				select {
-				case <-backup.done:
+				case <-backup.stopCh:
 					ticker.Stop()
 					return
 				case <-ticker.C:
@@ -119,7 +152,7 @@ func (backup *databaseBackup) Start(context.Context) error {
 
 func (backup *databaseBackup) Close() error {
 	return backup.StopOnce("DatabaseBackup", func() (err error) {
-		backup.done <- true
+		close(backup.stopCh)
 		return nil
 	})
 }
@@ -136,10 +169,15 @@ func (backup *databaseBackup) frequencyIsTooSmall() bool {
 	return backup.frequency < minBackupFrequency
 }
 
+// RunBackup takes a backup, and prunes old ones if Database.Backup.Retention is set.
+// It is cancelled when DatabaseBackup is closed.
 func (backup *databaseBackup) RunBackup(version string) error {
+	ctx, cancel := backup.stopCh.NewCtx()
+	defer cancel()
+
 	backup.logger.Debugw("Starting backup", "mode", backup.mode, "directory", backup.outputParentDir)
 	startAt := time.Now()
-	result, err := backup.runBackup(version)
+	result, err := backup.runBackup(ctx, version)
 	duration := time.Since(startAt)
 	if err != nil {
 		backup.logger.Criticalw("Backup failed", "duration", duration, "err", err)
@@ -147,10 +185,16 @@ func (backup *databaseBackup) RunBackup(version string) error {
 		return err
 	}
 	backup.logger.Infow("Backup completed successfully.", "duration", duration, "fileSize", result.size, "filePath", result.path)
+	if backup.retention > 0 {
+		if err = backup.prune(ctx); err != nil {
+			// the backup itself succeeded, old ones will be pruned next time
+			backup.logger.Errorw("Failed to prune old backups", "retention", backup.retention, "err", err)
+		}
+	}
 	return nil
 }
 
-func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
+func (backup *databaseBackup) runBackup(ctx context.Context, version string) (*backupResult, error) {
 	err := os.MkdirAll(backup.outputParentDir, os.ModePerm)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Failed to create directories on the path: %s", backup.outputParentDir)
@@ -186,7 +230,7 @@ func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
 	maskedArgs := maskArgs(args)
 	backup.logger.Debugf("Running pg_dump with: %v", maskedArgs)
 
-	cmd := exec.Command(
+	cmd := exec.CommandContext(ctx,
 		"pg_dump", args...,
 	)
 
@@ -206,26 +250,105 @@ func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
 		return partialResult, errors.Wrap(err, "pg_dump failed")
 	}
 
-	if version == "" {
-		version = "unknown"
+	dumpPath := tmpFile.Name()
+	defer os.Remove(dumpPath)
+
+	if backup.verify {
+		if err = backup.verifyBackup(ctx, dumpPath); err != nil {
+			return nil, errors.Wrap(err, "Backup verification failed")
+		}
+		backup.logger.Debug("Backup verified")
 	}
-	finalFilePath := filepath.Join(backup.outputParentDir, fmt.Sprintf(filePattern, version))
-	_ = os.Remove(finalFilePath)
-	err = os.Rename(tmpFile.Name(), finalFilePath)
-	if err != nil {
-		_ = os.Remove(tmpFile.Name())
-		return nil, errors.Wrap(err, "Failed to rename the temp file to the final backup file")
+
+	if backup.encrypt {
+		encryptedPath := dumpPath + encryptedExtension
+		if err = encryptFile(dumpPath, encryptedPath, backup.password); err != nil {
+			return nil, errors.Wrap(err, "Failed to encrypt the backup")
+		}
+		defer os.Remove(encryptedPath)
+		dumpPath = encryptedPath
 	}
 
-	file, err := os.Stat(finalFilePath)
+	file, err := os.Stat(dumpPath)
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to access the final backup file")
 	}
 
+	if version == "" {
+		version = "unknown"
+	}
+	name := fileName(version, backup.retention > 0, backup.encrypt, time.Now())
+	location, err := backup.target.Put(ctx, name, dumpPath)
+	if err != nil {
+		return nil, err
+	}
+
 	return &backupResult{
 		size:            file.Size(),
-		path:            finalFilePath,
+		path:            location,
 		maskedArguments: maskedArgs,
 		pgDumpArguments: args,
 	}, nil
 }
+
+// prune deletes the oldest timestamped backups, keeping the configured number of them.
+func (backup *databaseBackup) prune(ctx context.Context) error {
+	names, err := backup.target.List(ctx)
+	if err != nil {
+		return err
+	}
+	files := timestampedFiles(names)
+	if len(files) <= int(backup.retention) {
+		return nil
+	}
+	var errs error
+	for _, f := range files[:len(files)-int(backup.retention)] {
+		backup.logger.Debugw("Deleting old backup", "name", f.name)
+		if err = backup.target.Delete(ctx, f.name); err != nil {
+			errs = stderrors.Join(errs, err)
+		}
+	}
+	return errs
+}
+
+func (backup *databaseBackup) Restore(ctx context.Context, name string) (string, error) {
+	if name == "" {
+		names, err := backup.target.List(ctx)
+		if err != nil {
+			return "", err
+		}
+		if name, err = latestFile(names); err != nil {
+			return "", err
+		}
+	} else if err := validateFileName(name); err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(backup.outputParentDir, os.ModePerm); err != nil {
+		return "", errors.Wrapf(err, "Failed to create directories on the path: %s", backup.outputParentDir)
+	}
+	dumpPath := filepath.Join(backup.outputParentDir, "cl_restore_tmp_"+name)
+	if err := backup.target.Get(ctx, name, dumpPath); err != nil {
+		return "", err
+	}
+	defer os.Remove(dumpPath)
+
+	if strings.HasSuffix(name, encryptedExtension) {
+		if backup.password == "" {
+			return "", errors.Errorf("backup %s is encrypted, the keystore password is required to restore it", name)
+		}
+		decryptedPath := strings.TrimSuffix(dumpPath, encryptedExtension)
+		if err := decryptFile(dumpPath, decryptedPath, backup.password); err != nil {
+			return "", err
+		}
+		defer os.Remove(decryptedPath)
+		dumpPath = decryptedPath
+	}
+
+	backup.logger.Infow("Restoring backup", "name", name)
+	// restore into the node's database, the backup URL may point to a read replica
+	if err := pgRestore(ctx, backup.scratchURL, dumpPath, "--clean", "--if-exists", "--single-transaction"); err != nil {
+		return "", err
+	}
+	return name, nil
+}
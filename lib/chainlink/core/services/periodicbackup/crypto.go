@@ -0,0 +1,175 @@
+package periodicbackup
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Encrypted backups are a header followed by chunks of AES-256-GCM ciphertext.
+// The header holds the salt for deriving the key from the password, and the
+// random prefix of the chunk nonces. The last chunk is authenticated as such,
+// so that a truncated file fails to decrypt.
+const (
+	encryptedMagic = "CLBACKUP1"
+	saltSize       = 32
+	noncePrefixLen = 4
+	chunkSize      = 64 * 1024
+
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+)
+
+var errDecrypt = errors.New("failed to decrypt backup, the password is wrong or the file is corrupted")
+
+func deriveKey(password string, salt []byte) ([]byte, error) {
+	return scrypt.Key([]byte(password), salt, scryptN, scryptR, scryptP, 32)
+}
+
+func newGCM(password string, salt []byte) (cipher.AEAD, error) {
+	key, err := deriveKey(password, salt)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func chunkNonce(prefix []byte, counter uint64) []byte {
+	nonce := make([]byte, noncePrefixLen+8)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint64(nonce[noncePrefixLen:], counter)
+	return nonce
+}
+
+func chunkAdditionalData(last bool) []byte {
+	if last {
+		return []byte{1}
+	}
+	return []byte{0}
+}
+
+// encrypt writes the encryption of r with a key derived from password to w.
+func encrypt(w io.Writer, r io.Reader, password string) error {
+	if password == "" {
+		return errors.New("cannot encrypt backup without a keystore password")
+	}
+	salt := make([]byte, saltSize)
+	prefix := make([]byte, noncePrefixLen)
+	if _, err := rand.Read(salt); err != nil {
+		return err
+	}
+	if _, err := rand.Read(prefix); err != nil {
+		return err
+	}
+	gcm, err := newGCM(password, salt)
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(append(append([]byte(encryptedMagic), salt...), prefix...)); err != nil {
+		return err
+	}
+
+	br := bufio.NewReaderSize(r, chunkSize)
+	buf := make([]byte, chunkSize)
+	for counter := uint64(0); ; counter++ {
+		n, err := io.ReadFull(br, buf)
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+			return err
+		}
+		_, peekErr := br.Peek(1)
+		last := errors.Is(peekErr, io.EOF)
+		if peekErr != nil && !last {
+			return peekErr
+		}
+		if _, err = w.Write(gcm.Seal(nil, chunkNonce(prefix, counter), buf[:n], chunkAdditionalData(last))); err != nil {
+			return err
+		}
+		if last {
+			return nil
+		}
+	}
+}
+
+// decrypt writes the decryption of r, which was encrypted with password, to w.
+func decrypt(w io.Writer, r io.Reader, password string) error {
+	header := make([]byte, len(encryptedMagic)+saltSize+noncePrefixLen)
+	if _, err := io.ReadFull(r, header); err != nil || string(header[:len(encryptedMagic)]) != encryptedMagic {
+		return errors.New("not an encrypted backup")
+	}
+	salt := header[len(encryptedMagic) : len(encryptedMagic)+saltSize]
+	prefix := header[len(encryptedMagic)+saltSize:]
+	gcm, err := newGCM(password, salt)
+	if err != nil {
+		return err
+	}
+
+	br := bufio.NewReaderSize(r, chunkSize+gcm.Overhead())
+	buf := make([]byte, chunkSize+gcm.Overhead())
+	for counter := uint64(0); ; counter++ {
+		n, err := io.ReadFull(br, buf)
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+			return err
+		}
+		_, peekErr := br.Peek(1)
+		last := errors.Is(peekErr, io.EOF)
+		if peekErr != nil && !last {
+			return peekErr
+		}
+		plaintext, err := gcm.Open(nil, chunkNonce(prefix, counter), buf[:n], chunkAdditionalData(last))
+		if err != nil {
+			return errDecrypt
+		}
+		if _, err = w.Write(plaintext); err != nil {
+			return err
+		}
+		if last {
+			return nil
+		}
+	}
+}
+
+// transformFile writes the result of fn on the file at src to a new file at dst.
+func transformFile(src, dst string, fn func(w io.Writer, r io.Reader) error) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := out.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			_ = os.Remove(dst)
+		}
+	}()
+	bw := bufio.NewWriter(out)
+	if err = fn(bw, in); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func encryptFile(src, dst, password string) error {
+	return transformFile(src, dst, func(w io.Writer, r io.Reader) error { return encrypt(w, r, password) })
+}
+
+func decryptFile(src, dst, password string) error {
+	return transformFile(src, dst, func(w io.Writer, r io.Reader) error { return decrypt(w, r, password) })
+}
@@ -0,0 +1,41 @@
+package periodicbackup
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	t.Parallel()
+
+	headerSize := len(encryptedMagic) + saltSize + noncePrefixLen
+	for _, size := range []int{0, 1, chunkSize, 2*chunkSize + 1} {
+		t.Run(fmt.Sprint(size), func(t *testing.T) {
+			plaintext := make([]byte, size)
+			_, err := rand.Read(plaintext)
+			require.NoError(t, err)
+
+			var encrypted bytes.Buffer
+			require.NoError(t, encrypt(&encrypted, bytes.NewReader(plaintext), "password"))
+
+			var decrypted bytes.Buffer
+			require.NoError(t, decrypt(&decrypted, bytes.NewReader(encrypted.Bytes()), "password"))
+			assert.True(t, bytes.Equal(plaintext, decrypted.Bytes()))
+
+			require.ErrorIs(t, decrypt(&bytes.Buffer{}, bytes.NewReader(encrypted.Bytes()), "wrong"), errDecrypt)
+			require.ErrorIs(t, decrypt(&bytes.Buffer{}, bytes.NewReader(encrypted.Bytes()[:encrypted.Len()-1]), "password"), errDecrypt)
+			if size > chunkSize {
+				// cut at a chunk boundary
+				require.ErrorIs(t, decrypt(&bytes.Buffer{}, bytes.NewReader(encrypted.Bytes()[:headerSize+chunkSize+16]), "password"), errDecrypt)
+			}
+		})
+	}
+
+	require.Error(t, encrypt(&bytes.Buffer{}, bytes.NewReader(nil), ""))
+	require.ErrorContains(t, decrypt(&bytes.Buffer{}, bytes.NewReader([]byte("not encrypted")), "password"), "not an encrypted backup")
+}
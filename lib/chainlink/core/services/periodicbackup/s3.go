@@ -0,0 +1,315 @@
+package periodicbackup
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+)
+
+const (
+	defaultS3Region = "us-east-1"
+	// unsignedPayload skips hashing the request body, which would mean reading the whole dump twice.
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+	amzDateLayout   = "20060102T150405Z"
+	// s3Timeout bounds a whole request, including uploading or downloading the dump.
+	s3Timeout = time.Hour
+	// s3ResponseHeaderTimeout catches an unresponsive endpoint long before s3Timeout.
+	s3ResponseHeaderTimeout = time.Minute
+)
+
+// s3Target stores backups in an S3 compatible object store. Requests are
+// signed with AWS Signature Version 4, using the credentials from the
+// AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN environment
+// variables. Requests are anonymous if no credentials are set.
+type s3Target struct {
+	client    *http.Client
+	endpoint  url.URL
+	bucket    string
+	region    string
+	prefix    string
+	pathStyle bool
+
+	accessKeyID     string
+	secretAccessKey string
+	sessionToken    string
+}
+
+func newS3Target(cfg config.BackupS3) (*s3Target, error) {
+	t := &s3Target{
+		client:    newS3Client(),
+		bucket:    cfg.Bucket(),
+		region:    cfg.Region(),
+		prefix:    cfg.Prefix(),
+		pathStyle: cfg.UsePathStyle(),
+
+		accessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+		secretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		sessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+	}
+	if t.bucket == "" {
+		return nil, errors.New("Database.Backup.S3.Bucket is required")
+	}
+	if t.region == "" {
+		t.region = defaultS3Region
+	}
+	endpoint := cfg.Endpoint()
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", t.region)
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid Database.Backup.S3.Endpoint: %s", endpoint)
+	}
+	t.endpoint = *u
+	if (t.accessKeyID == "") != (t.secretAccessKey == "") {
+		return nil, errors.New("both AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY must be set to upload backups to S3")
+	}
+	return t, nil
+}
+
+func newS3Client() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ResponseHeaderTimeout = s3ResponseHeaderTimeout
+	return &http.Client{Transport: transport, Timeout: s3Timeout}
+}
+
+func (t *s3Target) Put(ctx context.Context, name, path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return "", err
+	}
+
+	req, err := t.newRequest(ctx, http.MethodPut, t.prefix+name, nil, f)
+	if err != nil {
+		return "", err
+	}
+	req.ContentLength = info.Size()
+	if _, err = t.do(req); err != nil {
+		return "", errors.Wrapf(err, "failed to upload backup %s", name)
+	}
+	_ = os.Remove(path)
+	return fmt.Sprintf("s3://%s/%s%s", t.bucket, t.prefix, name), nil
+}
+
+func (t *s3Target) Get(ctx context.Context, name, path string) error {
+	req, err := t.newRequest(ctx, http.MethodGet, t.prefix+name, nil, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to download backup %s", name)
+	}
+	defer resp.Body.Close()
+	if err = checkS3Response(resp); err != nil {
+		return errors.Wrapf(err, "failed to download backup %s", name)
+	}
+
+	out, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(out, resp.Body); err != nil {
+		_ = out.Close()
+		return errors.Wrapf(err, "failed to download backup %s", name)
+	}
+	return out.Close()
+}
+
+type s3ListBucketResult struct {
+	Contents []struct {
+		Key string
+	}
+	IsTruncated           bool
+	NextContinuationToken string
+}
+
+func (t *s3Target) List(ctx context.Context) ([]string, error) {
+	var names []string
+	query := url.Values{"list-type": {"2"}, "prefix": {t.prefix + filePrefix}}
+	for {
+		req, err := t.newRequest(ctx, http.MethodGet, "", query, nil)
+		if err != nil {
+			return nil, err
+		}
+		body, err := t.do(req)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list backups")
+		}
+		var result s3ListBucketResult
+		if err = xml.Unmarshal(body, &result); err != nil {
+			return nil, errors.Wrap(err, "failed to parse list of backups")
+		}
+		for _, c := range result.Contents {
+			name := strings.TrimPrefix(c.Key, t.prefix)
+			if !strings.Contains(name, "/") && fileNameRegexp.MatchString(name) {
+				names = append(names, name)
+			}
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return names, nil
+		}
+		query.Set("continuation-token", result.NextContinuationToken)
+	}
+}
+
+func (t *s3Target) Delete(ctx context.Context, name string) error {
+	req, err := t.newRequest(ctx, http.MethodDelete, t.prefix+name, nil, nil)
+	if err != nil {
+		return err
+	}
+	if _, err = t.do(req); err != nil {
+		return errors.Wrapf(err, "failed to delete backup %s", name)
+	}
+	return nil
+}
+
+func (t *s3Target) do(req *http.Request) ([]byte, error) {
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if err = checkS3Response(resp); err != nil {
+		return nil, err
+	}
+	return io.ReadAll(resp.Body)
+}
+
+func checkS3Response(resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	return errors.Errorf("unexpected status %s: %s", resp.Status, strings.TrimSpace(string(body)))
+}
+
+// newRequest returns a signed request for the object key, or the bucket if key is empty.
+func (t *s3Target) newRequest(ctx context.Context, method, key string, query url.Values, body io.Reader) (*http.Request, error) {
+	u := t.endpoint
+	if t.pathStyle {
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/" + t.bucket + "/" + key
+	} else {
+		u.Host = t.bucket + "." + u.Host
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/" + key
+	}
+	u.RawPath = uriEncode(u.Path, false)
+	u.RawQuery = canonicalQuery(query)
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	t.sign(req, time.Now())
+	return req, nil
+}
+
+// sign adds an AWS Signature Version 4 to req.
+// See https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html
+func (t *s3Target) sign(req *http.Request, now time.Time) {
+	amzDate := now.UTC().Format(amzDateLayout)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", unsignedPayload)
+	if t.accessKeyID == "" {
+		return
+	}
+	if t.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", t.sessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for k := range req.Header {
+		headers[strings.ToLower(k)] = strings.TrimSpace(req.Header.Get(k))
+	}
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, k := range names {
+		canonicalHeaders.WriteString(k + ":" + headers[k] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		unsignedPayload,
+	}, "\n")
+
+	date := amzDate[:8]
+	scope := date + "/" + t.region + "/s3/aws4_request"
+	hash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(hash[:])
+
+	key := hmacSHA256([]byte("AWS4"+t.secretAccessKey), date)
+	key = hmacSHA256(key, t.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		t.accessKeyID, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// canonicalQuery encodes query sorted by key, as required for signing.
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var parts []string
+	for _, k := range keys {
+		for _, v := range query[k] {
+			parts = append(parts, uriEncode(k, true)+"="+uriEncode(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// uriEncode escapes everything but unreserved characters, and slashes unless encodeSlash is set.
+func uriEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9', c == '-', c == '.', c == '_', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,127 @@
+package periodicbackup
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+)
+
+// sftpCommand is the sftp client to run, replaced in tests.
+var sftpCommand = "sftp"
+
+// sftpTarget stores backups on an SFTP server by running the OpenSSH sftp
+// client in batch mode. The server must be in the known hosts, as there is
+// nobody to confirm its key.
+type sftpTarget struct {
+	command      string
+	host         string
+	port         string
+	user         string
+	dir          string
+	identityFile string
+}
+
+func newSFTPTarget(cfg config.BackupSFTP) (*sftpTarget, error) {
+	if cfg.Addr() == "" || cfg.Dir() == "" {
+		return nil, errors.New("Database.Backup.SFTP.Addr and Database.Backup.SFTP.Dir are required")
+	}
+	host, port, err := net.SplitHostPort(cfg.Addr())
+	if err != nil {
+		// no port
+		host, port = cfg.Addr(), ""
+	}
+	return &sftpTarget{
+		command:      sftpCommand,
+		host:         host,
+		port:         port,
+		user:         cfg.User(),
+		dir:          cfg.Dir(),
+		identityFile: cfg.IdentityFile(),
+	}, nil
+}
+
+func (t *sftpTarget) Put(ctx context.Context, name, localPath string) (string, error) {
+	remotePath := path.Join(t.dir, name)
+	tmpPath := remotePath + ".tmp"
+	// upload under a temporary name, so that a partial upload is never mistaken for a backup
+	if _, err := t.run(ctx, fmt.Sprintf("put %s %s", quote(localPath), quote(tmpPath)), "-rm "+quote(remotePath), fmt.Sprintf("rename %s %s", quote(tmpPath), quote(remotePath))); err != nil {
+		return "", errors.Wrapf(err, "failed to upload backup %s", name)
+	}
+	_ = os.Remove(localPath)
+	return fmt.Sprintf("sftp://%s%s", t.destination(), remotePath), nil
+}
+
+func (t *sftpTarget) Get(ctx context.Context, name, localPath string) error {
+	if _, err := t.run(ctx, fmt.Sprintf("get %s %s", quote(path.Join(t.dir, name)), quote(localPath))); err != nil {
+		return errors.Wrapf(err, "failed to download backup %s", name)
+	}
+	return nil
+}
+
+func (t *sftpTarget) List(ctx context.Context) ([]string, error) {
+	out, err := t.run(ctx, "ls -1 "+quote(t.dir))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list backups")
+	}
+	var names []string
+	for _, line := range strings.Split(string(out), "\n") {
+		line = strings.TrimSpace(line)
+		// batch mode echoes the commands
+		if line == "" || strings.HasPrefix(line, "sftp>") {
+			continue
+		}
+		if name := path.Base(line); fileNameRegexp.MatchString(name) {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+func (t *sftpTarget) Delete(ctx context.Context, name string) error {
+	if _, err := t.run(ctx, "rm "+quote(path.Join(t.dir, name))); err != nil {
+		return errors.Wrapf(err, "failed to delete backup %s", name)
+	}
+	return nil
+}
+
+func (t *sftpTarget) destination() string {
+	if t.user == "" {
+		return t.host
+	}
+	return t.user + "@" + t.host
+}
+
+// run executes the batch commands, and returns the output.
+func (t *sftpTarget) run(ctx context.Context, commands ...string) ([]byte, error) {
+	args := []string{"-b", "-", "-o", "BatchMode=yes"}
+	if t.port != "" {
+		args = append(args, "-P", t.port)
+	}
+	if t.identityFile != "" {
+		args = append(args, "-i", t.identityFile)
+	}
+	args = append(args, t.destination())
+
+	cmd := exec.CommandContext(ctx, t.command, args...)
+	cmd.Stdin = strings.NewReader(strings.Join(commands, "\n") + "\n")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, errors.Wrapf(err, "sftp failed with output: %s", strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
+
+func quote(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
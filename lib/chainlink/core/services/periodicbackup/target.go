@@ -0,0 +1,194 @@
+package periodicbackup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+)
+
+const (
+	filePrefix          = "cl_backup_"
+	fileExtension       = ".dump"
+	encryptedExtension  = ".enc"
+	fileTimestampLayout = "20060102T150405Z"
+)
+
+// fileNameRegexp matches backup file names, with an optional timestamp when
+// Database.Backup.Retention is set.
+var fileNameRegexp = regexp.MustCompile(`^cl_backup_(.+?)(?:_(\d{8}T\d{6}Z))?\.dump(\.enc)?$`)
+
+// Target stores backup files. Names are plain file names like cl_backup_2.0.0.dump.
+type Target interface {
+	// Put stores the local file at path as name, the local file may be moved or removed.
+	// It returns a description of where the backup was stored.
+	Put(ctx context.Context, name, path string) (string, error)
+	// Get copies the backup stored as name to the local file at path.
+	Get(ctx context.Context, name, path string) error
+	// List returns the names of all stored backups.
+	List(ctx context.Context) ([]string, error)
+	Delete(ctx context.Context, name string) error
+}
+
+// NewTarget returns the Target configured by Database.Backup.Target. Backups are
+// always dumped to dir first, which is also where the local target keeps them.
+func NewTarget(cfg BackupConfig, dir string) (Target, error) {
+	switch cfg.Target() {
+	case config.DatabaseBackupTargetLocal, "":
+		return &localTarget{dir: dir}, nil
+	case config.DatabaseBackupTargetS3:
+		return newS3Target(cfg.S3())
+	case config.DatabaseBackupTargetSFTP:
+		return newSFTPTarget(cfg.SFTP())
+	default:
+		return nil, errors.Errorf("unknown Database.Backup.Target: %s", cfg.Target())
+	}
+}
+
+type localTarget struct {
+	dir string
+}
+
+func (l *localTarget) Put(_ context.Context, name, path string) (string, error) {
+	finalPath := filepath.Join(l.dir, name)
+	_ = os.Remove(finalPath)
+	if err := os.Rename(path, finalPath); err != nil {
+		return "", errors.Wrap(err, "Failed to rename the temp file to the final backup file")
+	}
+	return finalPath, nil
+}
+
+func (l *localTarget) Get(_ context.Context, name, path string) error {
+	return copyFile(filepath.Join(l.dir, name), path)
+}
+
+func (l *localTarget) List(context.Context) ([]string, error) {
+	entries, err := os.ReadDir(l.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "failed to list backups in %s", l.dir)
+	}
+	var names []string
+	for _, e := range entries {
+		if !e.IsDir() && fileNameRegexp.MatchString(e.Name()) {
+			names = append(names, e.Name())
+		}
+	}
+	return names, nil
+}
+
+func (l *localTarget) Delete(_ context.Context, name string) error {
+	return os.Remove(filepath.Join(l.dir, name))
+}
+
+func copyFile(src, dst string) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := out.Close(); err == nil {
+			err = cerr
+		}
+	}()
+	_, err = io.Copy(out, in)
+	return err
+}
+
+// fileName returns the name of a backup of version. Backups are timestamped
+// when retention is enabled, otherwise every backup replaces the previous one
+// of the same version.
+func fileName(version string, timestamped bool, encrypted bool, now time.Time) string {
+	name := filePrefix + version
+	if timestamped {
+		name += "_" + now.UTC().Format(fileTimestampLayout)
+	}
+	name += fileExtension
+	if encrypted {
+		name += encryptedExtension
+	}
+	return name
+}
+
+type backupFile struct {
+	name      string
+	version   string
+	timestamp time.Time
+}
+
+// parseFileNames returns the backups in names, ignoring other files, oldest first.
+// Timestamped backups are ordered by time, and are newer than the ones without a
+// timestamp, which are ordered by version.
+func parseFileNames(names []string) []backupFile {
+	var files []backupFile
+	for _, name := range names {
+		m := fileNameRegexp.FindStringSubmatch(name)
+		if m == nil {
+			continue
+		}
+		f := backupFile{name: name, version: m[1]}
+		if m[2] != "" {
+			if ts, err := time.Parse(fileTimestampLayout, m[2]); err == nil {
+				f.timestamp = ts
+			} else {
+				f.version = m[1] + "_" + m[2]
+			}
+		}
+		files = append(files, f)
+	}
+	sort.SliceStable(files, func(i, j int) bool {
+		a, b := files[i], files[j]
+		if !a.timestamp.Equal(b.timestamp) {
+			return a.timestamp.Before(b.timestamp)
+		}
+		va, errA := semver.NewVersion(a.version)
+		vb, errB := semver.NewVersion(b.version)
+		if errA == nil && errB == nil && !va.Equal(vb) {
+			return va.LessThan(vb)
+		}
+		return strings.Compare(a.name, b.name) < 0
+	})
+	return files
+}
+
+// timestampedFiles returns the backups which are subject to retention, oldest first.
+func timestampedFiles(names []string) []backupFile {
+	var files []backupFile
+	for _, f := range parseFileNames(names) {
+		if !f.timestamp.IsZero() {
+			files = append(files, f)
+		}
+	}
+	return files
+}
+
+func latestFile(names []string) (string, error) {
+	files := parseFileNames(names)
+	if len(files) == 0 {
+		return "", errors.New("no backups found")
+	}
+	return files[len(files)-1].name, nil
+}
+
+func validateFileName(name string) error {
+	if !fileNameRegexp.MatchString(name) || strings.ContainsAny(name, `/\`) {
+		return fmt.Errorf("invalid backup name %q, must look like %s<VERSION>%s", name, filePrefix, fileExtension)
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+package periodicbackup
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+// fakeS3 is a minimal path style S3 server, holding objects in memory.
+type fakeS3 struct {
+	t      *testing.T
+	bucket string
+
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=test-key-id/") || !strings.Contains(auth, "SignedHeaders=host;x-amz-content-sha256;x-amz-date") {
+		http.Error(w, "bad signature: "+auth, http.StatusForbidden)
+		return
+	}
+	key, ok := strings.CutPrefix(r.URL.Path, "/"+f.bucket+"/")
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch {
+	case r.Method == http.MethodPut:
+		b, err := io.ReadAll(r.Body)
+		require.NoError(f.t, err)
+		f.objects[key] = b
+	case r.Method == http.MethodGet && key == "":
+		assert.Equal(f.t, "2", r.URL.Query().Get("list-type"))
+		var result struct {
+			XMLName  xml.Name `xml:"ListBucketResult"`
+			Contents []struct{ Key string }
+		}
+		var keys []string
+		for k := range f.objects {
+			if strings.HasPrefix(k, r.URL.Query().Get("prefix")) {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			result.Contents = append(result.Contents, struct{ Key string }{k})
+		}
+		require.NoError(f.t, xml.NewEncoder(w).Encode(result))
+	case r.Method == http.MethodGet:
+		b, ok := f.objects[key]
+		if !ok {
+			http.Error(w, "<Error><Code>NoSuchKey</Code></Error>", http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(b)
+	case r.Method == http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func testTarget(t *testing.T, target Target) {
+	ctx := testutils.Context(t)
+	local := t.TempDir()
+
+	for _, name := range []string{"cl_backup_1.0.0.dump", "cl_backup_1.1.0_20240101T000000Z.dump.enc"} {
+		path := filepath.Join(local, "upload")
+		require.NoError(t, os.WriteFile(path, []byte(name), 0600))
+		_, err := target.Put(ctx, name, path)
+		require.NoError(t, err)
+	}
+
+	names, err := target.List(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"cl_backup_1.0.0.dump", "cl_backup_1.1.0_20240101T000000Z.dump.enc"}, names)
+
+	download := filepath.Join(local, "download")
+	require.NoError(t, target.Get(ctx, "cl_backup_1.0.0.dump", download))
+	b, err := os.ReadFile(download)
+	require.NoError(t, err)
+	assert.Equal(t, "cl_backup_1.0.0.dump", string(b))
+
+	require.NoError(t, target.Delete(ctx, "cl_backup_1.0.0.dump"))
+	names, err = target.List(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cl_backup_1.1.0_20240101T000000Z.dump.enc"}, names)
+
+	require.Error(t, target.Get(ctx, "cl_backup_1.0.0.dump", download))
+}
+
+func TestLocalTarget(t *testing.T) {
+	t.Parallel()
+	target, err := NewTarget(&testConfig{target: config.DatabaseBackupTargetLocal}, t.TempDir())
+	require.NoError(t, err)
+	testTarget(t, target)
+}
+
+func TestS3Target(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "test-key-id")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test-secret")
+
+	srv := httptest.NewServer(&fakeS3{t: t, bucket: "backups", objects: map[string][]byte{
+		"node-1/unrelated.txt":        nil,
+		"node-2/cl_backup_1.0.0.dump": nil,
+	}})
+	t.Cleanup(srv.Close)
+
+	target, err := NewTarget(&testConfig{
+		target: config.DatabaseBackupTargetS3,
+		s3:     testS3Config{endpoint: srv.URL, bucket: "backups", prefix: "node-1/"},
+	}, t.TempDir())
+	require.NoError(t, err)
+	testTarget(t, target)
+
+	t.Run("requires complete credentials", func(t *testing.T) {
+		t.Setenv("AWS_SECRET_ACCESS_KEY", "")
+		_, err := NewTarget(&testConfig{target: config.DatabaseBackupTargetS3, s3: testS3Config{bucket: "backups"}}, t.TempDir())
+		require.ErrorContains(t, err, "AWS_SECRET_ACCESS_KEY")
+	})
+}
+
+// fakeSFTP handles the batch commands used by sftpTarget on the local file system, under $FAKE_SFTP_ROOT.
+const fakeSFTP = `#!/bin/sh
+while read -r cmd a b; do
+	a=$(echo "$a" | tr -d '"'); b=$(echo "$b" | tr -d '"')
+	echo "sftp> $cmd $a $b"
+	case "$cmd" in
+		put) cp "$a" "$FAKE_SFTP_ROOT$b" || exit 1 ;;
+		get) cp "$FAKE_SFTP_ROOT$a" "$b" || exit 1 ;;
+		rename) mv "$FAKE_SFTP_ROOT$a" "$FAKE_SFTP_ROOT$b" || exit 1 ;;
+		-rm) rm -f "$FAKE_SFTP_ROOT$a" ;;
+		rm) rm "$FAKE_SFTP_ROOT$a" || exit 1 ;;
+		ls) for f in "$FAKE_SFTP_ROOT$b"/*; do if [ -e "$f" ]; then echo "$b/$(basename "$f")"; fi; done ;;
+		*) exit 1 ;;
+	esac
+done
+exit 0
+`
+
+func TestSFTPTarget(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "backups"), 0700))
+	t.Setenv("FAKE_SFTP_ROOT", root)
+
+	command := filepath.Join(t.TempDir(), "sftp")
+	require.NoError(t, os.WriteFile(command, []byte(fakeSFTP), 0700)) //nolint:gosec // must be executable
+	sftpCommand = command
+	t.Cleanup(func() { sftpCommand = "sftp" })
+
+	target, err := NewTarget(&testConfig{
+		target: config.DatabaseBackupTargetSFTP,
+		sftp:   testSFTPConfig{addr: "localhost:2222", dir: "/backups"},
+	}, t.TempDir())
+	require.NoError(t, err)
+	assert.Equal(t, "2222", target.(*sftpTarget).port)
+	testTarget(t, target)
+}
+
+func TestParseFileNames(t *testing.T) {
+	t.Parallel()
+
+	files := parseFileNames([]string{
+		"cl_backup_2.0.0_20240102T000000Z.dump.enc",
+		"cl_backup_1.10.0.dump",
+		"cl_backup_2.0.0_20240101T000000Z.dump",
+		"cl_backup_1.9.0.dump",
+		"cl_backup_tmp_123",
+		"cl_backup_unknown.dump",
+	})
+	var names []string
+	for _, f := range files {
+		names = append(names, f.name)
+	}
+	assert.Equal(t, []string{
+		"cl_backup_1.9.0.dump",
+		"cl_backup_1.10.0.dump",
+		"cl_backup_unknown.dump",
+		"cl_backup_2.0.0_20240101T000000Z.dump",
+		"cl_backup_2.0.0_20240102T000000Z.dump.enc",
+	}, names)
+
+	latest, err := latestFile([]string{"cl_backup_1.9.0.dump", "cl_backup_1.10.0.dump"})
+	require.NoError(t, err)
+	assert.Equal(t, "cl_backup_1.10.0.dump", latest)
+	_, err = latestFile(nil)
+	require.Error(t, err)
+
+	assert.Equal(t, "cl_backup_1.0.0_20240102T030405Z.dump.enc", fileName("1.0.0", true, true, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)))
+	assert.Equal(t, "cl_backup_1.0.0.dump", fileName("1.0.0", false, false, time.Now()))
+
+	require.NoError(t, validateFileName("cl_backup_1.0.0.dump"))
+	require.Error(t, validateFileName("../cl_backup_1.0.0.dump"))
+	require.Error(t, validateFileName("secrets.toml"))
+}
@@ -0,0 +1,101 @@
+package periodicbackup
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"os/exec"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	pgcommon "github.com/smartcontractkit/chainlink-common/pkg/sqlutil/pg"
+)
+
+// databaseSummary is what a verified backup must have in common with the database it was taken from.
+type databaseSummary struct {
+	SchemaVersion int64 `db:"schema_version"`
+	KeyRings      int64 `db:"key_rings"`
+	EVMKeyStates  int64 `db:"evm_key_states"`
+}
+
+const databaseSummaryQuery = `SELECT
+	(SELECT COALESCE(MAX(version_id), 0) FROM goose_migrations WHERE is_applied) AS schema_version,
+	(SELECT COUNT(*) FROM encrypted_key_rings) AS key_rings,
+	(SELECT COUNT(*) FROM evm.key_states) AS evm_key_states`
+
+func summarizeDatabase(ctx context.Context, dbURL url.URL) (summary databaseSummary, err error) {
+	db, err := sqlx.Open(pgcommon.DriverPostgres, dbURL.String())
+	if err != nil {
+		return summary, err
+	}
+	defer db.Close()
+	err = db.GetContext(ctx, &summary, databaseSummaryQuery)
+	return summary, err
+}
+
+// verifyBackup restores the dump at path into a scratch database next to the
+// node's database, and checks that it has the same schema version and number of keys.
+func (backup *databaseBackup) verifyBackup(ctx context.Context, path string) (err error) {
+	expected, err := summarizeDatabase(ctx, backup.databaseURL)
+	if err != nil {
+		return errors.Wrap(err, "failed to summarize the database")
+	}
+
+	suffix := make([]byte, 4)
+	if _, err = rand.Read(suffix); err != nil {
+		return err
+	}
+	scratchURL := backup.scratchURL
+	scratchName := fmt.Sprintf("%s_backup_verify_%s", backup.scratchURL.Path[1:], hex.EncodeToString(suffix))
+	scratchURL.Path = "/" + scratchName
+
+	// cannot create or drop a database while connected to it, so use template1 like store.ResetDatabase
+	adminURL := backup.scratchURL
+	adminURL.Path = "/template1"
+	admin, err := sqlx.Open(pgcommon.DriverPostgres, adminURL.String())
+	if err != nil {
+		return errors.Wrap(err, "failed to connect to create the scratch database")
+	}
+	defer admin.Close()
+	if _, err = admin.ExecContext(ctx, fmt.Sprintf(`CREATE DATABASE "%s"`, scratchName)); err != nil {
+		return errors.Wrap(err, "failed to create the scratch database")
+	}
+	defer func() {
+		// the restore may be cancelled, but the scratch database must go regardless
+		if _, derr := admin.ExecContext(context.WithoutCancel(ctx), fmt.Sprintf(`DROP DATABASE IF EXISTS "%s"`, scratchName)); derr != nil {
+			backup.logger.Errorw("Failed to drop the scratch database", "database", scratchName, "err", derr)
+		}
+	}()
+
+	backup.logger.Debugw("Restoring backup into scratch database", "database", scratchName)
+	if err = pgRestore(ctx, scratchURL, path, "--exit-on-error"); err != nil {
+		return err
+	}
+
+	actual, err := summarizeDatabase(ctx, scratchURL)
+	if err != nil {
+		return errors.Wrap(err, "failed to summarize the restored backup")
+	}
+	if actual != expected {
+		return errors.Errorf("restored backup does not match the database: schema version %d, %d key rings and %d evm key states, expected %d, %d and %d",
+			actual.SchemaVersion, actual.KeyRings, actual.EVMKeyStates, expected.SchemaVersion, expected.KeyRings, expected.EVMKeyStates)
+	}
+	return nil
+}
+
+func pgRestore(ctx context.Context, dbURL url.URL, path string, extraArgs ...string) error {
+	args := append([]string{"-d", dbURL.String(), "--no-owner", "--no-privileges"}, extraArgs...)
+	args = append(args, path)
+	cmd := exec.CommandContext(ctx, "pg_restore", args...)
+	if _, err := cmd.Output(); err != nil {
+		var ee *exec.ExitError
+		if errors.As(err, &ee) {
+			return errors.Wrapf(err, "pg_restore failed with output: %s", string(ee.Stderr))
+		}
+		return errors.Wrap(err, "pg_restore failed")
+	}
+	return nil
+}
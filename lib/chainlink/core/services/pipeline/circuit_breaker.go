@@ -0,0 +1,198 @@
+package pipeline
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// ErrCircuitBreakerOpen is returned by bridge tasks that were not executed
+// because the bridge has failed too many times in a row.
+var ErrCircuitBreakerOpen = errors.New("bridge circuit breaker is open")
+
+type CircuitBreakerState string
+
+const (
+	CircuitBreakerDisabled CircuitBreakerState = "disabled"
+	CircuitBreakerClosed   CircuitBreakerState = "closed"
+	CircuitBreakerHalfOpen CircuitBreakerState = "half_open"
+	CircuitBreakerOpen     CircuitBreakerState = "open"
+)
+
+var promBridgeCircuitBreakerState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "bridge_circuit_breaker_state",
+	Help: "Bridge circuit breaker state scoped by name: 0 closed, 1 half open, 2 open",
+},
+	[]string{"name"},
+)
+
+func (s CircuitBreakerState) gaugeValue() float64 {
+	switch s {
+	case CircuitBreakerHalfOpen:
+		return 1
+	case CircuitBreakerOpen:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// circuitBreaker fails fast after threshold consecutive failures. Once cooldown
+// has passed, a single probe is let through: success closes the breaker again,
+// failure re-opens it for another cooldown.
+//
+// A nil *circuitBreaker is disabled and allows everything.
+type circuitBreaker struct {
+	name      string
+	threshold uint32
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	state    CircuitBreakerState
+	failures uint32
+	openedAt time.Time
+	probing  bool
+}
+
+func (cb *circuitBreaker) Allow() error {
+	if cb == nil {
+		return nil
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.state {
+	case CircuitBreakerOpen:
+		if cb.now().Sub(cb.openedAt) < cb.cooldown {
+			return fmt.Errorf("%w: %s", ErrCircuitBreakerOpen, cb.name)
+		}
+		cb.setState(CircuitBreakerHalfOpen)
+		cb.probing = true
+		return nil
+	case CircuitBreakerHalfOpen:
+		if cb.probing {
+			return fmt.Errorf("%w: %s", ErrCircuitBreakerOpen, cb.name)
+		}
+		cb.probing = true
+		return nil
+	default:
+		return nil
+	}
+}
+
+// Record reports the outcome of a request that was allowed by Allow.
+func (cb *circuitBreaker) Record(success bool) {
+	if cb == nil {
+		return
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.probing = false
+	if success {
+		cb.failures = 0
+		cb.setState(CircuitBreakerClosed)
+		return
+	}
+
+	cb.failures++
+	if cb.state == CircuitBreakerHalfOpen || cb.failures >= cb.threshold {
+		cb.openedAt = cb.now()
+		cb.setState(CircuitBreakerOpen)
+	}
+}
+
+func (cb *circuitBreaker) State() CircuitBreakerState {
+	if cb == nil {
+		return CircuitBreakerDisabled
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	return cb.state
+}
+
+func (cb *circuitBreaker) setState(state CircuitBreakerState) {
+	cb.state = state
+	promBridgeCircuitBreakerState.WithLabelValues(cb.name).Set(state.gaugeValue())
+}
+
+// BridgeCircuitBreakers holds one circuit breaker per bridge name, shared by
+// all bridge tasks of all jobs run by the same runner.
+type BridgeCircuitBreakers struct {
+	threshold uint32
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu       sync.RWMutex
+	breakers map[string]*circuitBreaker
+}
+
+// NewBridgeCircuitBreakers returns the breakers for a runner. A zero threshold disables them.
+func NewBridgeCircuitBreakers(threshold uint32, cooldown time.Duration) *BridgeCircuitBreakers {
+	return &BridgeCircuitBreakers{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+		breakers:  make(map[string]*circuitBreaker),
+	}
+}
+
+func (b *BridgeCircuitBreakers) get(name string) *circuitBreaker {
+	if b == nil || b.threshold == 0 {
+		return nil
+	}
+
+	b.mu.RLock()
+	cb, ok := b.breakers[name]
+	b.mu.RUnlock()
+	if ok {
+		return cb
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if cb, ok = b.breakers[name]; ok {
+		return cb
+	}
+	cb = &circuitBreaker{
+		name:      name,
+		threshold: b.threshold,
+		cooldown:  b.cooldown,
+		now:       b.now,
+		state:     CircuitBreakerClosed,
+	}
+	b.breakers[name] = cb
+	return cb
+}
+
+// States returns the current state of every bridge that has been called so far.
+func (b *BridgeCircuitBreakers) States() map[string]CircuitBreakerState {
+	states := make(map[string]CircuitBreakerState)
+	if b == nil {
+		return states
+	}
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for name, cb := range b.breakers {
+		states[name] = cb.State()
+	}
+	return states
+}
+
+// HealthReport reports every bridge whose breaker is not closed as unhealthy, keyed by prefix and bridge name.
+func (b *BridgeCircuitBreakers) HealthReport(prefix string) map[string]error {
+	report := make(map[string]error)
+	for name, state := range b.States() {
+		var err error
+		if state != CircuitBreakerClosed {
+			err = fmt.Errorf("%w: %s (%s)", ErrCircuitBreakerOpen, name, state)
+		}
+		report[prefix+".BridgeCircuitBreaker."+name] = err
+	}
+	return report
+}
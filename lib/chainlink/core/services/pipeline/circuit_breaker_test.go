@@ -0,0 +1,72 @@
+package pipeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridgeCircuitBreakers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled with zero threshold", func(t *testing.T) {
+		b := NewBridgeCircuitBreakers(0, time.Minute)
+		cb := b.get("foo")
+		assert.Nil(t, cb)
+		for i := 0; i < 10; i++ {
+			cb.Record(false)
+			require.NoError(t, cb.Allow())
+		}
+		assert.Equal(t, CircuitBreakerDisabled, cb.State())
+		assert.Empty(t, b.HealthReport("PipelineRunner"))
+	})
+
+	t.Run("opens after threshold and recovers after cooldown", func(t *testing.T) {
+		now := time.Now()
+		b := NewBridgeCircuitBreakers(3, time.Minute)
+		b.now = func() time.Time { return now }
+
+		cb := b.get("foo")
+		require.Same(t, cb, b.get("foo"))
+		assert.Nil(t, b.get("bar").Allow())
+
+		for i := 0; i < 2; i++ {
+			require.NoError(t, cb.Allow())
+			cb.Record(false)
+		}
+		assert.Equal(t, CircuitBreakerClosed, cb.State())
+
+		require.NoError(t, cb.Allow())
+		cb.Record(false)
+		assert.Equal(t, CircuitBreakerOpen, cb.State())
+		require.ErrorIs(t, cb.Allow(), ErrCircuitBreakerOpen)
+
+		report := b.HealthReport("PipelineRunner")
+		require.ErrorIs(t, report["PipelineRunner.BridgeCircuitBreaker.foo"], ErrCircuitBreakerOpen)
+		require.NoError(t, report["PipelineRunner.BridgeCircuitBreaker.bar"])
+
+		// a single probe is let through after the cooldown
+		now = now.Add(time.Minute)
+		require.NoError(t, cb.Allow())
+		assert.Equal(t, CircuitBreakerHalfOpen, cb.State())
+		require.ErrorIs(t, cb.Allow(), ErrCircuitBreakerOpen)
+
+		// a failed probe re-opens the breaker immediately
+		cb.Record(false)
+		assert.Equal(t, CircuitBreakerOpen, cb.State())
+		require.ErrorIs(t, cb.Allow(), ErrCircuitBreakerOpen)
+
+		now = now.Add(time.Minute)
+		require.NoError(t, cb.Allow())
+		cb.Record(true)
+		assert.Equal(t, CircuitBreakerClosed, cb.State())
+		require.NoError(t, cb.Allow())
+
+		assert.Equal(t, map[string]CircuitBreakerState{
+			"foo": CircuitBreakerClosed,
+			"bar": CircuitBreakerClosed,
+		}, b.States())
+	})
+}
@@ -77,6 +77,8 @@ type (
 	BridgeConfig interface {
 		BridgeResponseURL() *url.URL
 		BridgeCacheTTL() time.Duration
+		BridgeCircuitBreakerThreshold() uint32
+		BridgeCircuitBreakerCooldown() time.Duration
 	}
 )
 
@@ -325,6 +327,7 @@ const (
 	TaskTypeHexDecode        TaskType = "hexdecode"
 	TaskTypeHexEncode        TaskType = "hexencode"
 	TaskTypeJSONParse        TaskType = "jsonparse"
+	TaskTypeJSONSchema       TaskType = "jsonschema"
 	TaskTypeLength           TaskType = "length"
 	TaskTypeLessThan         TaskType = "lessthan"
 	TaskTypeLookup           TaskType = "lookup"
@@ -385,6 +388,8 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap any, ID int, dotID string)
 		task = &AnyTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeJSONParse:
 		task = &JSONParseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeJSONSchema:
+		task = &JSONSchemaTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMemo:
 		task = &MemoTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMultiply:
@@ -3,16 +3,28 @@ package pipeline
 import (
 	"bytes"
 	"context"
+	stderrors "errors"
 	"io"
 	"net/http"
 	"time"
 
 	"github.com/goccy/go-json"
+	"github.com/jpillora/backoff"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
 	clhttp "github.com/smartcontractkit/chainlink-common/pkg/http"
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 )
 
+var promHTTPRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "pipeline_task_http_retries_total",
+	Help: "Number of retried HTTP requests made by http and bridge tasks",
+},
+	[]string{"dot_id"},
+)
+
 func makeHTTPRequest(
 	ctx context.Context,
 	lggr logger.Logger,
@@ -126,3 +138,121 @@ func statusCodeGroup(status int) string {
 		return "unknown"
 	}
 }
+
+const (
+	defaultHTTPRetryBackoff    = 500 * time.Millisecond
+	defaultHTTPRetryMaxBackoff = 5 * time.Second
+)
+
+// HTTPRetryParams are the retry parameters shared by the http and bridge tasks.
+//
+//	maxAttempts: total number of attempts including the first one (default 1, i.e. no retries)
+//	retryBackoff: delay before the first retry, doubled for every further retry (default 500ms)
+//	retryMaxBackoff: upper bound for the delay between retries (default 5s)
+//	retryStatusCodes: JSON list of status codes to retry, e.g. "[429, 503]" (default: any 5xx)
+//
+// Requests failing without a response (e.g. connection refused) are always retried.
+type HTTPRetryParams struct {
+	MaxAttempts      string `json:"maxAttempts"`
+	RetryBackoff     string `json:"retryBackoff"`
+	RetryMaxBackoff  string `json:"retryMaxBackoff"`
+	RetryStatusCodes string `json:"retryStatusCodes"`
+}
+
+type httpRetryPolicy struct {
+	maxAttempts uint64
+	minBackoff  time.Duration
+	maxBackoff  time.Duration
+	// statusCodes is nil if unset, in which case isRetryableHTTPError decides
+	statusCodes map[int]struct{}
+}
+
+func (p HTTPRetryParams) resolve() (policy httpRetryPolicy, err error) {
+	var (
+		maxAttempts Uint64Param
+		minBackoff  DurationParam
+		maxBackoff  DurationParam
+		statusCodes SliceParam
+	)
+	err = stderrors.Join(
+		errors.Wrap(ResolveParam(&maxAttempts, From(NonemptyString(p.MaxAttempts), 1)), "maxAttempts"),
+		errors.Wrap(ResolveParam(&minBackoff, From(NonemptyString(p.RetryBackoff), defaultHTTPRetryBackoff)), "retryBackoff"),
+		errors.Wrap(ResolveParam(&maxBackoff, From(NonemptyString(p.RetryMaxBackoff), defaultHTTPRetryMaxBackoff)), "retryMaxBackoff"),
+		errors.Wrap(ResolveParam(&statusCodes, From(NonemptyString(p.RetryStatusCodes), nil)), "retryStatusCodes"),
+	)
+	if err != nil {
+		return policy, err
+	}
+	if maxAttempts == 0 {
+		return policy, errors.Wrap(ErrBadInput, "maxAttempts must be at least 1")
+	}
+	if maxBackoff < minBackoff {
+		return policy, errors.Wrap(ErrBadInput, "retryMaxBackoff must not be less than retryBackoff")
+	}
+
+	policy = httpRetryPolicy{
+		maxAttempts: uint64(maxAttempts),
+		minBackoff:  minBackoff.Duration(),
+		maxBackoff:  maxBackoff.Duration(),
+	}
+	if statusCodes != nil {
+		policy.statusCodes = make(map[int]struct{}, len(statusCodes))
+		for _, v := range statusCodes {
+			var code Uint64Param
+			if err = code.UnmarshalPipelineParam(v); err != nil {
+				return policy, errors.Wrap(err, "retryStatusCodes")
+			}
+			if code < 100 || code > 599 {
+				return policy, errors.Wrapf(ErrBadInput, "retryStatusCodes: invalid status code %d", code)
+			}
+			policy.statusCodes[int(code)] = struct{}{}
+		}
+	}
+	return policy, nil
+}
+
+func (p httpRetryPolicy) retryable(statusCode int, err error) bool {
+	if err == nil {
+		return false
+	}
+	if p.statusCodes == nil || statusCode == 0 {
+		return isRetryableHTTPError(statusCode, err)
+	}
+	_, ok := p.statusCodes[statusCode]
+	return ok
+}
+
+type httpRequestFunc func(ctx context.Context) (responseBytes []byte, statusCode int, respHeaders http.Header, start, finish time.Time, err error)
+
+// makeHTTPRequestWithRetries calls makeRequest until it succeeds, fails with a
+// non-retryable error, runs out of attempts or ctx is done. The result of the
+// last attempt is returned.
+func makeHTTPRequestWithRetries(ctx context.Context, lggr logger.Logger, t Task, policy httpRetryPolicy, makeRequest httpRequestFunc) (responseBytes []byte, statusCode int, respHeaders http.Header, start, finish time.Time, err error) {
+	b := backoff.Backoff{
+		Factor: 2,
+		Min:    policy.minBackoff,
+		Max:    policy.maxBackoff,
+	}
+	for attempt := uint64(1); ; attempt++ {
+		responseBytes, statusCode, respHeaders, start, finish, err = makeRequest(ctx)
+		if attempt >= policy.maxAttempts || ctx.Err() != nil || !policy.retryable(statusCode, err) {
+			return
+		}
+
+		delay := b.Duration()
+		promHTTPRetries.WithLabelValues(t.DotID()).Inc()
+		logger.Sugared(lggr).Debugw("HTTP request failed, retrying",
+			"attempt", attempt,
+			"maxAttempts", policy.maxAttempts,
+			"statusCode", statusCode,
+			"err", err,
+			"delay", delay,
+			"dotID", t.DotID(),
+		)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+}
@@ -150,6 +150,7 @@ func TestUnmarshalTaskFromMap(t *testing.T) {
 		{pipeline.TaskTypeMultiply, &pipeline.MultiplyTask{}},
 		{pipeline.TaskTypeDivide, &pipeline.DivideTask{}},
 		{pipeline.TaskTypeJSONParse, &pipeline.JSONParseTask{}},
+		{pipeline.TaskTypeJSONSchema, &pipeline.JSONSchemaTask{}},
 		{pipeline.TaskTypeCBORParse, &pipeline.CBORParseTask{}},
 		{pipeline.TaskTypeAny, &pipeline.AnyTask{}},
 		{pipeline.TaskTypeVRF, &pipeline.VRFTask{}},
@@ -0,0 +1,241 @@
+package pipeline
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+// ErrFragmentInUse is returned when deleting a fragment that is still referenced.
+var ErrFragmentInUse = errors.New("pipeline fragment is in use")
+
+// Fragment is a named, reusable piece of pipeline DOT. See fragments.go.
+type Fragment struct {
+	ID        int64
+	Name      string
+	Source    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// FragmentUsage lists the jobs created from a fragment and the fragments which
+// reference it, directly or through other fragments.
+type FragmentUsage struct {
+	JobIDs    []int32
+	Fragments []string
+}
+
+// FragmentORM stores pipeline fragments. Jobs do not reference the fragments
+// they were created from, see ExpandFragments, but the ORM records them.
+type FragmentORM interface {
+	// Lookup returns a lookup of the fragments currently in the database.
+	Lookup(ctx context.Context) (FragmentLookup, error)
+	// ValidateFragment validates the fragment against the fragments in the database.
+	ValidateFragment(ctx context.Context, name, source string) error
+
+	Fragments(ctx context.Context, offset, limit int) ([]Fragment, int, error)
+	// FindFragment returns sql.ErrNoRows if the fragment does not exist.
+	FindFragment(ctx context.Context, name string) (Fragment, error)
+	CreateFragment(ctx context.Context, f *Fragment) error
+	// UpdateFragment replaces the source of the fragment and returns the jobs
+	// created from it, which keep the previous version until they are
+	// recreated, and the fragments which use the new version. It fails, and
+	// nothing is changed, if any of those fragments would no longer be valid.
+	UpdateFragment(ctx context.Context, f *Fragment, source string) (FragmentUsage, error)
+	// DeleteFragment fails with ErrFragmentInUse if a job was created from the fragment or another fragment references it.
+	DeleteFragment(ctx context.Context, name string) error
+	FindFragmentUsage(ctx context.Context, name string) (FragmentUsage, error)
+	// InsertJobFragments records the fragments the job was created from.
+	InsertJobFragments(ctx context.Context, jobID int32, names []string) error
+}
+
+type fragmentORM struct {
+	ds sqlutil.DataSource
+}
+
+var _ FragmentORM = (*fragmentORM)(nil)
+
+func NewFragmentORM(ds sqlutil.DataSource) FragmentORM {
+	return &fragmentORM{ds: ds}
+}
+
+func (o *fragmentORM) transact(ctx context.Context, fn func(*fragmentORM) error) error {
+	return sqlutil.Transact(ctx, func(ds sqlutil.DataSource) *fragmentORM { return &fragmentORM{ds: ds} }, o.ds, nil, fn)
+}
+
+func (o *fragmentORM) Lookup(ctx context.Context) (FragmentLookup, error) {
+	sources, err := o.allSources(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return sourcesLookup(sources), nil
+}
+
+func (o *fragmentORM) ValidateFragment(ctx context.Context, name, source string) error {
+	lookup, err := o.Lookup(ctx)
+	if err != nil {
+		return err
+	}
+	return ValidateFragment(name, source, lookup)
+}
+
+func (o *fragmentORM) allSources(ctx context.Context) (map[string]string, error) {
+	var all []Fragment
+	if err := o.ds.SelectContext(ctx, &all, `SELECT * FROM pipeline_fragments`); err != nil {
+		return nil, errors.Wrap(err, "failed to load pipeline fragments")
+	}
+	sources := make(map[string]string, len(all))
+	for _, f := range all {
+		sources[f.Name] = f.Source
+	}
+	return sources, nil
+}
+
+func (o *fragmentORM) Fragments(ctx context.Context, offset, limit int) (fs []Fragment, count int, err error) {
+	err = o.transact(ctx, func(tx *fragmentORM) error {
+		if err = tx.ds.GetContext(ctx, &count, `SELECT COUNT(*) FROM pipeline_fragments`); err != nil {
+			return errors.Wrap(err, "Fragments failed to get count")
+		}
+		if err = tx.ds.SelectContext(ctx, &fs, `SELECT * FROM pipeline_fragments ORDER BY name ASC LIMIT $1 OFFSET $2`, limit, offset); err != nil {
+			return errors.Wrap(err, "Fragments failed to load pipeline_fragments")
+		}
+		return nil
+	})
+	return
+}
+
+func (o *fragmentORM) FindFragment(ctx context.Context, name string) (f Fragment, err error) {
+	err = o.ds.GetContext(ctx, &f, `SELECT * FROM pipeline_fragments WHERE name = $1`, name)
+	return
+}
+
+func (o *fragmentORM) CreateFragment(ctx context.Context, f *Fragment) error {
+	if err := o.ValidateFragment(ctx, f.Name, f.Source); err != nil {
+		return err
+	}
+	stmt := `INSERT INTO pipeline_fragments (name, source, created_at, updated_at) VALUES ($1, $2, now(), now()) RETURNING *`
+	if err := o.ds.GetContext(ctx, f, stmt, f.Name, f.Source); err != nil {
+		return errors.Wrap(err, "CreateFragment failed")
+	}
+	return nil
+}
+
+func (o *fragmentORM) UpdateFragment(ctx context.Context, f *Fragment, source string) (usage FragmentUsage, err error) {
+	err = o.transact(ctx, func(tx *fragmentORM) error {
+		if err := tx.ds.GetContext(ctx, f, `SELECT * FROM pipeline_fragments WHERE name = $1 FOR UPDATE`, f.Name); err != nil {
+			return err
+		}
+		sources, err := tx.allSources(ctx)
+		if err != nil {
+			return err
+		}
+		sources[f.Name] = source
+		if err = ValidateFragment(f.Name, source, sourcesLookup(sources)); err != nil {
+			return err
+		}
+
+		usage, err = tx.findUsage(ctx, f.Name, sources)
+		if err != nil {
+			return err
+		}
+		// the fragment may be fine on its own, but not in a fragment it is used in
+		for _, name := range usage.Fragments {
+			if err = ValidateFragment(name, sources[name], sourcesLookup(sources)); err != nil {
+				return errors.Wrapf(err, "fragment %q would no longer be valid", name)
+			}
+		}
+
+		return tx.ds.GetContext(ctx, f, `UPDATE pipeline_fragments SET source = $1, updated_at = now() WHERE name = $2 RETURNING *`, source, f.Name)
+	})
+	if err != nil {
+		return FragmentUsage{}, errors.Wrap(err, "UpdateFragment failed")
+	}
+	return usage, nil
+}
+
+func (o *fragmentORM) DeleteFragment(ctx context.Context, name string) error {
+	err := o.transact(ctx, func(tx *fragmentORM) error {
+		usage, err := tx.FindFragmentUsage(ctx, name)
+		if err != nil {
+			return err
+		}
+		if len(usage.JobIDs) > 0 || len(usage.Fragments) > 0 {
+			return errors.Wrapf(ErrFragmentInUse, "used by jobs %v and fragments %v", usage.JobIDs, usage.Fragments)
+		}
+		result, err := tx.ds.ExecContext(ctx, `DELETE FROM pipeline_fragments WHERE name = $1`, name)
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return sql.ErrNoRows
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "DeleteFragment failed")
+	}
+	return nil
+}
+
+func (o *fragmentORM) FindFragmentUsage(ctx context.Context, name string) (FragmentUsage, error) {
+	sources, err := o.allSources(ctx)
+	if err != nil {
+		return FragmentUsage{}, err
+	}
+	return o.findUsage(ctx, name, sources)
+}
+
+func (o *fragmentORM) InsertJobFragments(ctx context.Context, jobID int32, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+	stmt := `INSERT INTO job_pipeline_fragments (job_id, fragment_name) SELECT $1, unnest($2::text[])`
+	if _, err := o.ds.ExecContext(ctx, stmt, jobID, pq.Array(names)); err != nil {
+		return errors.Wrap(err, "InsertJobFragments failed")
+	}
+	return nil
+}
+
+// findUsage returns the fragments which reference name, directly or indirectly,
+// and the jobs created from name.
+func (o *fragmentORM) findUsage(ctx context.Context, name string, sources map[string]string) (usage FragmentUsage, err error) {
+	refs := make(map[string][]string, len(sources))
+	for fragment, source := range sources {
+		if refs[fragment], err = FragmentReferences(source); err != nil {
+			return usage, errors.Wrapf(err, "failed to parse fragment %q", fragment)
+		}
+	}
+
+	used := map[string]struct{}{name: {}}
+	for changed := true; changed; {
+		changed = false
+		for fragment, fragmentRefs := range refs {
+			if _, ok := used[fragment]; ok {
+				continue
+			}
+			if slices.ContainsFunc(fragmentRefs, func(ref string) bool { _, ok := used[ref]; return ok }) {
+				used[fragment] = struct{}{}
+				usage.Fragments = append(usage.Fragments, fragment)
+				changed = true
+			}
+		}
+	}
+	sort.Strings(usage.Fragments)
+
+	query := `SELECT job_id FROM job_pipeline_fragments WHERE fragment_name = $1 ORDER BY job_id`
+	if err = o.ds.SelectContext(ctx, &usage.JobIDs, query, name); err != nil {
+		return usage, errors.Wrap(err, "failed to load the jobs created from the fragment")
+	}
+	return usage, nil
+}
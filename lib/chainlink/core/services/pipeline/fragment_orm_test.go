@@ -0,0 +1,174 @@
+package pipeline_test
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func mustCreateJobWithSource(t *testing.T, jorm job.ORM, source string) job.Job {
+	t.Helper()
+	timestamp := time.Now()
+	jb := job.Job{
+		KeeperSpec: &job.KeeperSpec{
+			ContractAddress: cltest.NewEIP55Address(),
+			FromAddress:     cltest.NewEIP55Address(),
+			CreatedAt:       timestamp,
+			UpdatedAt:       timestamp,
+			EVMChainID:      (*big.Big)(&cltest.FixtureChainID),
+		},
+		Pipeline:        pipeline.Pipeline{Source: source},
+		Type:            job.Keeper,
+		SchemaVersion:   1,
+		Name:            null.StringFrom(uuid.NewString()),
+		MaxTaskDuration: sqlutil.Interval(1 * time.Minute),
+	}
+	require.NoError(t, jorm.CreateJob(testutils.Context(t), &jb))
+	return jb
+}
+
+func Test_FragmentORM(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db, porm, _ := setupLiteORM(t)
+	orm := pipeline.NewFragmentORM(db)
+	jorm := job.NewORM(db, porm, bridges.NewORM(db), cltest.NewKeyStore(t, db), logger.TestLogger(t))
+
+	scale, wrapper := "scale", "wrapper"
+
+	t.Run("creates and finds fragments", func(t *testing.T) {
+		f := pipeline.Fragment{Name: scale, Source: `mul [type=multiply input="$(fragment.input)" times=2]`}
+		require.NoError(t, orm.CreateFragment(ctx, &f))
+		assert.NotZero(t, f.ID)
+
+		found, err := orm.FindFragment(ctx, scale)
+		require.NoError(t, err)
+		assert.Equal(t, f.Source, found.Source)
+
+		_, err = orm.FindFragment(ctx, "does-not-exist")
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		w := pipeline.Fragment{Name: wrapper, Source: fmt.Sprintf(`inner [type=fragment name=%q input="$(fragment.input)"]`, scale)}
+		require.NoError(t, orm.CreateFragment(ctx, &w))
+
+		fs, count, err := orm.Fragments(ctx, 0, 100)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+		require.Len(t, fs, 2)
+	})
+
+	t.Run("rejects invalid fragments", func(t *testing.T) {
+		require.Error(t, orm.CreateFragment(ctx, &pipeline.Fragment{Name: "1nvalid", Source: `a [type=memo value=1]`}))
+		require.Error(t, orm.CreateFragment(ctx, &pipeline.Fragment{Name: "bad", Source: `a [type=nope]`}))
+		require.ErrorIs(t, orm.ValidateFragment(ctx, "dangling", `a [type=fragment name="does-not-exist"]`), pipeline.ErrFragmentNotFound)
+	})
+
+	t.Run("looks up fragments", func(t *testing.T) {
+		lookup, err := orm.Lookup(ctx)
+		require.NoError(t, err)
+
+		p, err := pipeline.Parse(fmt.Sprintf("ds [type=memo value=1]\nscaled [type=fragment name=%q input=\"$(ds)\"]", wrapper), pipeline.WithFragments(lookup))
+		require.NoError(t, err)
+		assert.IsType(t, &pipeline.MultiplyTask{}, p.ByDotID("scaled"))
+
+		_, ok := lookup("does-not-exist")
+		assert.False(t, ok)
+	})
+
+	direct := mustCreateJobWithSource(t, jorm, fmt.Sprintf("ds [type=memo value=1]\nscaled [type=fragment name=%q input=\"$(ds)\"]", scale))
+	indirect := mustCreateJobWithSource(t, jorm, fmt.Sprintf("ds [type=memo value=1]\nscaled [type=fragment name=%q input=\"$(ds)\"]", wrapper))
+	mustCreateJobWithSource(t, jorm, "ds [type=memo value=1]")
+
+	t.Run("expands fragments when creating jobs", func(t *testing.T) {
+		for _, jb := range []job.Job{direct, indirect} {
+			found, err := jorm.FindJob(ctx, jb.ID)
+			require.NoError(t, err)
+			assert.NotContains(t, found.PipelineSpec.DotDagSource, "fragment")
+
+			p, err := pipeline.Parse(found.PipelineSpec.DotDagSource)
+			require.NoError(t, err)
+			assert.Equal(t, "2", p.ByDotID("scaled").(*pipeline.MultiplyTask).Times)
+		}
+	})
+
+	t.Run("reports usage", func(t *testing.T) {
+		usage, err := orm.FindFragmentUsage(ctx, scale)
+		require.NoError(t, err)
+		assert.Equal(t, []int32{direct.ID, indirect.ID}, usage.JobIDs)
+		assert.Equal(t, []string{wrapper}, usage.Fragments)
+
+		usage, err = orm.FindFragmentUsage(ctx, wrapper)
+		require.NoError(t, err)
+		assert.Equal(t, []int32{indirect.ID}, usage.JobIDs)
+		assert.Empty(t, usage.Fragments)
+	})
+
+	t.Run("updates without changing the jobs created from the fragment", func(t *testing.T) {
+		f := pipeline.Fragment{Name: scale}
+		usage, err := orm.UpdateFragment(ctx, &f, `mul [type=multiply input="$(fragment.input)" times=3]`)
+		require.NoError(t, err)
+		assert.Equal(t, []int32{direct.ID, indirect.ID}, usage.JobIDs)
+		assert.Contains(t, f.Source, "times=3")
+
+		found, err := jorm.FindJob(ctx, direct.ID)
+		require.NoError(t, err)
+		p, err := pipeline.Parse(found.PipelineSpec.DotDagSource)
+		require.NoError(t, err)
+		assert.Equal(t, "2", p.ByDotID("scaled").(*pipeline.MultiplyTask).Times)
+
+		recreated := mustCreateJobWithSource(t, jorm, fmt.Sprintf("ds [type=memo value=1]\nscaled [type=fragment name=%q input=\"$(ds)\"]", scale))
+		found, err = jorm.FindJob(ctx, recreated.ID)
+		require.NoError(t, err)
+		p, err = pipeline.Parse(found.PipelineSpec.DotDagSource)
+		require.NoError(t, err)
+		assert.Equal(t, "3", p.ByDotID("scaled").(*pipeline.MultiplyTask).Times)
+	})
+
+	t.Run("refuses updates that break the fragments using it", func(t *testing.T) {
+		f := pipeline.Fragment{Name: scale}
+		_, err := orm.UpdateFragment(ctx, &f, `mul [type=multiply input="$(fragment.value)" times=3]`)
+		require.ErrorContains(t, err, "would no longer be valid")
+
+		found, err := orm.FindFragment(ctx, scale)
+		require.NoError(t, err)
+		assert.Contains(t, found.Source, "$(fragment.input)")
+
+		_, err = orm.UpdateFragment(ctx, &pipeline.Fragment{Name: "does-not-exist"}, `a [type=memo value=1]`)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("refuses to delete fragments in use", func(t *testing.T) {
+		require.ErrorIs(t, orm.DeleteFragment(ctx, scale), pipeline.ErrFragmentInUse)
+		require.ErrorIs(t, orm.DeleteFragment(ctx, "does-not-exist"), sql.ErrNoRows)
+	})
+
+	t.Run("deletes unused fragments", func(t *testing.T) {
+		unused := "unused"
+		require.NoError(t, orm.CreateFragment(ctx, &pipeline.Fragment{Name: unused, Source: `a [type=memo value=1]`}))
+		require.NoError(t, orm.DeleteFragment(ctx, unused))
+		_, err := orm.FindFragment(ctx, unused)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		lookup, err := orm.Lookup(ctx)
+		require.NoError(t, err)
+		_, err = pipeline.Parse(fmt.Sprintf("a [type=fragment name=%q]", unused), pipeline.WithFragments(lookup))
+		require.ErrorIs(t, err, pipeline.ErrFragmentNotFound)
+	})
+}
@@ -0,0 +1,417 @@
+package pipeline
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// A pipeline fragment is a named, reusable piece of DOT that a pipeline can
+// reference with a task of type "fragment":
+//
+//	prices [type=fragment name="median3" pair="ETH/USD" index=0]
+//
+// When the pipeline is parsed, the reference is replaced by the tasks of the
+// fragment:
+//   - the single task of the fragment without outputs takes over the dot ID of
+//     the reference, so that edges and $(prices) keep pointing at the fragment result
+//   - every other task is renamed to <reference>__<task>, e.g. prices__ds1, and
+//     references to it inside the fragment are rewritten accordingly
+//   - edges into the reference are connected to every task of the fragment without inputs
+//   - $(fragment.<key>) in the fragment is replaced by the value of attribute <key>
+//     of the reference, e.g. $(fragment.pair) by ETH/USD
+//
+// Fragments can reference other fragments, up to maxFragmentDepth levels deep.
+//
+// Fragments are expanded when a job is created: the job stores the pipeline
+// returned by ExpandFragments, so that changing or deleting a fragment later
+// does not change the jobs created from it. A job has to be recreated to pick
+// up a new version of a fragment.
+const (
+	TaskTypeFragment TaskType = "fragment"
+
+	fragmentNameAttr       = "name"
+	fragmentTaskSeparator  = "__"
+	fragmentParamNamespace = "fragment"
+	maxFragmentDepth       = 8
+)
+
+var (
+	ErrFragmentNotFound = errors.New("pipeline fragment not found")
+
+	fragmentNameRegexp = regexp.MustCompile(`\A[a-zA-Z][a-zA-Z0-9_\-]*\z`)
+)
+
+// ValidateFragmentName returns an error if name cannot be used as a fragment name.
+func ValidateFragmentName(name string) error {
+	if !fragmentNameRegexp.MatchString(name) {
+		return errors.Errorf("invalid fragment name %q: must start with a letter and contain only letters, digits, '_' and '-'", name)
+	}
+	return nil
+}
+
+// FragmentLookup returns the source of a fragment by name.
+type FragmentLookup func(name string) (string, bool)
+
+// noFragments is the lookup of a node without fragments.
+func noFragments(string) (string, bool) { return "", false }
+
+// sourcesLookup returns a lookup of the given sources.
+func sourcesLookup(sources map[string]string) FragmentLookup {
+	return func(name string) (string, bool) {
+		source, ok := sources[name]
+		return source, ok
+	}
+}
+
+// ExpandFragments returns the DOT source of the pipeline with every reference
+// to a fragment replaced by the tasks of the fragment, and the names of the
+// fragments it used, directly or through other fragments. The source is
+// returned unchanged if it does not reference any fragment.
+func ExpandFragments(text string, lookup FragmentLookup) (string, []string, error) {
+	g := NewGraph()
+	if err := g.unmarshalDOT([]byte(text)); err != nil {
+		return "", nil, err
+	}
+	if !g.hasFragments() {
+		return text, nil, nil
+	}
+	if lookup == nil {
+		lookup = noFragments
+	}
+	used := make(map[string]struct{})
+	err := g.expandFragments(func(name string) (string, bool) {
+		source, ok := lookup(name)
+		if ok {
+			used[name] = struct{}{}
+		}
+		return source, ok
+	}, nil)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "could not expand pipeline fragments")
+	}
+	names := make([]string, 0, len(used))
+	for name := range used {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return g.marshalDOT(), names, nil
+}
+
+// FragmentReferences returns the names of the fragments directly referenced by
+// the pipeline, without expanding them.
+func FragmentReferences(text string) ([]string, error) {
+	g := NewGraph()
+	if err := g.unmarshalDOT([]byte(text)); err != nil {
+		return nil, err
+	}
+	seen := make(map[string]struct{})
+	var names []string
+	for nodes := g.Nodes(); nodes.Next(); {
+		node := nodes.Node().(*GraphNode)
+		if !node.isFragment() {
+			continue
+		}
+		name := node.attrs[fragmentNameAttr]
+		if _, ok := seen[name]; !ok {
+			seen[name] = struct{}{}
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// ValidateFragment checks that the fragment can be used in a pipeline: the DOT
+// parses, all referenced fragments exist without forming a cycle, it has
+// exactly one task without outputs and all task types are known. Parameters
+// are only checked when the fragment is expanded.
+//
+// lookup resolves the fragments it references, if nil it may not reference any.
+func ValidateFragment(name, source string, lookup FragmentLookup) error {
+	if err := ValidateFragmentName(name); err != nil {
+		return err
+	}
+	if strings.TrimSpace(source) == "" {
+		return errors.New("empty fragment")
+	}
+
+	g := NewGraph()
+	if err := g.unmarshalDOT([]byte(source)); err != nil {
+		return err
+	}
+	if lookup == nil {
+		lookup = noFragments
+	}
+	if err := g.expandFragments(lookup, []string{name}); err != nil {
+		return err
+	}
+	g.AddImplicitDependenciesAsEdges()
+
+	var sinks int
+	for _, node := range g.sortedNodes() {
+		if g.From(node.ID()).Len() == 0 {
+			sinks++
+		}
+		if _, err := UnmarshalTaskFromMap(TaskType(node.attrs["type"]), map[string]string{}, 0, node.dotID); err != nil {
+			return errors.Wrapf(err, "task %s", node.dotID)
+		}
+	}
+	if sinks != 1 {
+		return errors.Errorf("fragment must have exactly one task without outputs, got %d", sinks)
+	}
+	return nil
+}
+
+func (n *GraphNode) isFragment() bool {
+	return TaskType(strings.ToLower(n.attrs["type"])) == TaskTypeFragment
+}
+
+func (g *Graph) hasFragments() bool {
+	for nodes := g.Nodes(); nodes.Next(); {
+		if nodes.Node().(*GraphNode).isFragment() {
+			return true
+		}
+	}
+	return false
+}
+
+// expandFragments replaces every fragment reference of the graph by the tasks of the fragment.
+// It must be called before implicit edges are added.
+func (g *Graph) expandFragments(lookup FragmentLookup, stack []string) error {
+	var refs []*GraphNode
+	for _, node := range g.sortedNodes() {
+		if node.isFragment() {
+			refs = append(refs, node)
+		}
+	}
+	for _, ref := range refs {
+		if err := g.expandFragment(ref, lookup, stack); err != nil {
+			return errors.Wrapf(err, "task %s", ref.dotID)
+		}
+	}
+	return nil
+}
+
+func (g *Graph) expandFragment(ref *GraphNode, lookup FragmentLookup, stack []string) error {
+	name := ref.attrs[fragmentNameAttr]
+	if name == "" {
+		return errors.New("fragment reference must set a name")
+	}
+	for _, s := range stack {
+		if s == name {
+			return errors.Errorf("fragment %q references itself", name)
+		}
+	}
+	if len(stack) >= maxFragmentDepth {
+		return errors.Errorf("fragments are nested more than %d levels deep", maxFragmentDepth)
+	}
+	source, ok := lookup(name)
+	if !ok {
+		return errors.Wrapf(ErrFragmentNotFound, "%q", name)
+	}
+
+	sub := NewGraph()
+	if err := sub.unmarshalDOT([]byte(source)); err != nil {
+		return errors.Wrapf(err, "fragment %q", name)
+	}
+	if err := sub.expandFragments(lookup, append(stack, name)); err != nil {
+		return errors.Wrapf(err, "fragment %q", name)
+	}
+	// implicit edges count when looking for the entry and exit tasks, but are not copied:
+	// they are added to the whole graph again once every fragment has been expanded.
+	sub.AddImplicitDependenciesAsEdges()
+
+	subNodes := sub.sortedNodes()
+	var sinks, sources []*GraphNode
+	for _, node := range subNodes {
+		if sub.From(node.ID()).Len() == 0 {
+			sinks = append(sinks, node)
+		}
+		if sub.To(node.ID()).Len() == 0 {
+			sources = append(sources, node)
+		}
+	}
+	if len(sinks) != 1 {
+		return errors.Errorf("fragment %q must have exactly one task without outputs, got %d", name, len(sinks))
+	}
+	sink := sinks[0]
+
+	params := make(map[string]string)
+	for key, value := range ref.attrs {
+		switch key {
+		case "type", fragmentNameAttr, "index":
+		default:
+			params[key] = value
+		}
+	}
+
+	renamed := make(map[string]string)
+	for _, node := range subNodes {
+		if node == sink {
+			renamed[node.dotID] = ref.dotID
+		} else {
+			renamed[node.dotID] = ref.dotID + fragmentTaskSeparator + node.dotID
+		}
+	}
+	for _, node := range g.sortedNodes() {
+		for _, dotID := range renamed {
+			if dotID != ref.dotID && node.dotID == dotID {
+				return errors.Errorf("fragment %q: task %s already exists", name, dotID)
+			}
+		}
+	}
+
+	var inputs []*GraphNode
+	for from := g.To(ref.ID()); from.Next(); {
+		inputs = append(inputs, from.Node().(*GraphNode))
+	}
+
+	// copy the tasks, the exit task replaces the reference in place
+	copied := make(map[int64]*GraphNode)
+	for _, node := range subNodes {
+		attrs := make(map[string]string, len(node.attrs))
+		for key, value := range node.attrs {
+			value, err := rewriteFragmentValue(value, renamed, params)
+			if err != nil {
+				return errors.Wrapf(err, "fragment %q: task %s", name, node.dotID)
+			}
+			attrs[key] = value
+		}
+
+		var target *GraphNode
+		if node == sink {
+			target = ref
+			if index, ok := ref.attrs["index"]; ok {
+				attrs["index"] = index
+			}
+		} else {
+			target = g.NewNode().(*GraphNode)
+			target.dotID = renamed[node.dotID]
+			g.AddNode(target)
+		}
+		target.attrs = attrs
+		copied[node.ID()] = target
+	}
+
+	for edges := sub.Edges(); edges.Next(); {
+		edge := edges.Edge().(*GraphEdge)
+		if edge.IsImplicit() {
+			continue
+		}
+		g.SetEdge(g.NewEdge(copied[edge.From().ID()], copied[edge.To().ID()]))
+	}
+
+	// inputs of the reference become inputs of the entry tasks
+	if len(sources) == 1 && sources[0] == sink {
+		return nil
+	}
+	for _, input := range inputs {
+		g.RemoveEdge(input.ID(), ref.ID())
+		for _, source := range sources {
+			g.SetEdge(g.NewEdge(input, copied[source.ID()]))
+		}
+	}
+	return nil
+}
+
+// rewriteFragmentValue renames references to tasks of the fragment and substitutes fragment parameters.
+func rewriteFragmentValue(value string, renamed map[string]string, params map[string]string) (string, error) {
+	var err error
+	rewritten := variableRegexp.ReplaceAllStringFunc(value, func(expr string) string {
+		keypath := strings.TrimSpace(expr[2 : len(expr)-1])
+		head, rest, _ := strings.Cut(keypath, ".")
+		if head == fragmentParamNamespace {
+			param, ok := params[rest]
+			if !ok {
+				err = errors.Errorf("missing fragment parameter %q", rest)
+				return expr
+			}
+			return param
+		}
+		if dotID, ok := renamed[head]; ok {
+			if rest == "" {
+				return "$(" + dotID + ")"
+			}
+			return "$(" + dotID + "." + rest + ")"
+		}
+		return expr
+	})
+	return rewritten, err
+}
+
+// marshalDOT encodes the tasks and explicit edges of the graph as DOT which
+// unmarshals into the same graph. Tasks are written in the order of their
+// node IDs, so that they get the same IDs when the DOT is parsed again.
+func (g *Graph) marshalDOT() string {
+	var nodes []*GraphNode
+	for it := g.Nodes(); it.Next(); {
+		nodes = append(nodes, it.Node().(*GraphNode))
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].ID() < nodes[j].ID() })
+
+	var b strings.Builder
+	for _, node := range nodes {
+		b.WriteString(quoteDOTID(node.dotID))
+		if attrs := node.Attributes(); len(attrs) > 0 {
+			b.WriteString(" [")
+			for i, attr := range attrs {
+				if i > 0 {
+					b.WriteString(" ")
+				}
+				b.WriteString(quoteDOTID(attr.Key) + "=" + quoteDOTValue(attr.Value))
+			}
+			b.WriteString("]")
+		}
+		b.WriteString("\n")
+	}
+	for _, from := range nodes {
+		var to []*GraphNode
+		for it := g.From(from.ID()); it.Next(); {
+			to = append(to, it.Node().(*GraphNode))
+		}
+		sort.Slice(to, func(i, j int) bool { return to[i].ID() < to[j].ID() })
+		for _, node := range to {
+			if !g.IsImplicitEdge(from.ID(), node.ID()) {
+				b.WriteString(quoteDOTID(from.dotID) + " -> " + quoteDOTID(node.dotID) + "\n")
+			}
+		}
+	}
+	return b.String()
+}
+
+var dotIdentifierRegexp = regexp.MustCompile(`\A[a-zA-Z_][a-zA-Z0-9_]*\z`)
+
+func quoteDOTID(id string) string {
+	switch strings.ToLower(id) {
+	case "node", "edge", "graph", "digraph", "subgraph", "strict":
+	default:
+		if dotIdentifierRegexp.MatchString(id) {
+			return id
+		}
+	}
+	return strconv.Quote(id)
+}
+
+func quoteDOTValue(value string) string {
+	// values which are still quoted in angle brackets after decoding came from
+	// nested HTML strings, which only decode to the same value unquoted
+	if strings.HasPrefix(value, "<") && strings.HasSuffix(value, ">") {
+		return value
+	}
+	return strconv.Quote(value)
+}
+
+// sortedNodes returns the nodes ordered by dot ID, so that nodes added while
+// expanding fragments get the same IDs every time the pipeline is parsed.
+func (g *Graph) sortedNodes() []*GraphNode {
+	var nodes []*GraphNode
+	for it := g.Nodes(); it.Next(); {
+		nodes = append(nodes, it.Node().(*GraphNode))
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].dotID < nodes[j].dotID })
+	return nodes
+}
@@ -0,0 +1,254 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+const median2Fragment = `
+ds1       [type=http method=GET url="$(fragment.url1)"]
+ds1_parse [type=jsonparse path="$(fragment.path)"]
+ds2       [type=http method=GET url="$(fragment.url2)"]
+ds2_parse [type=jsonparse path="$(fragment.path)"]
+answer    [type=median]
+
+ds1 -> ds1_parse -> answer
+ds2 -> ds2_parse -> answer
+`
+
+func dotIDs(p *pipeline.Pipeline) []string {
+	var ids []string
+	for _, task := range p.Tasks {
+		ids = append(ids, task.DotID())
+	}
+	return ids
+}
+
+func inputDotIDs(task pipeline.Task) []string {
+	var ids []string
+	for _, input := range task.Inputs() {
+		ids = append(ids, input.InputTask.DotID())
+	}
+	return ids
+}
+
+func parseWithFragments(source string, fragments map[string]string) (*pipeline.Pipeline, error) {
+	return pipeline.Parse(source, pipeline.WithFragments(func(name string) (string, bool) {
+		f, ok := fragments[name]
+		return f, ok
+	}))
+}
+
+func TestParse_fragments(t *testing.T) {
+	t.Parallel()
+
+	t.Run("fails without a lookup", func(t *testing.T) {
+		source := `prices [type=fragment name=median2 url1="a" url2="b" path="c"]`
+		_, err := pipeline.Parse(source)
+		require.ErrorIs(t, err, pipeline.ErrFragmentNotFound)
+
+		_, err = pipeline.Parse(source, pipeline.WithFragments(nil))
+		require.ErrorIs(t, err, pipeline.ErrFragmentNotFound)
+	})
+
+	t.Run("is unresolved when decoded from a job spec", func(t *testing.T) {
+		source := `prices [type=fragment name=median2 url1="a" url2="b" path="c"]`
+		var p pipeline.Pipeline
+		require.NoError(t, p.UnmarshalText([]byte(source)))
+		assert.True(t, p.Unresolved())
+		assert.Equal(t, source, p.Source)
+		assert.Empty(t, p.Tasks)
+
+		require.NoError(t, p.UnmarshalText([]byte(`a [type=memo value=1]`)))
+		assert.False(t, p.Unresolved())
+	})
+
+	t.Run("expands a fragment in its own namespace", func(t *testing.T) {
+		p, err := parseWithFragments(`
+trigger [type=memo value=1]
+prices  [type=fragment name=median2 url1="https://a.example" url2="https://b.example" path="data,result" index=0]
+scaled  [type=multiply input="$(prices)" times=100]
+
+trigger -> prices -> scaled
+`, map[string]string{"median2": median2Fragment})
+		require.NoError(t, err)
+
+		assert.ElementsMatch(t, []string{
+			"trigger", "prices__ds1", "prices__ds1_parse", "prices__ds2", "prices__ds2_parse", "prices", "scaled",
+		}, dotIDs(p))
+
+		ds1, ok := p.ByDotID("prices__ds1").(*pipeline.HTTPTask)
+		require.True(t, ok)
+		assert.Equal(t, "https://a.example", ds1.URL)
+		assert.Equal(t, []string{"trigger"}, inputDotIDs(ds1))
+
+		ds2Parse, ok := p.ByDotID("prices__ds2_parse").(*pipeline.JSONParseTask)
+		require.True(t, ok)
+		assert.Equal(t, "data,result", ds2Parse.Path)
+
+		answer, ok := p.ByDotID("prices").(*pipeline.MedianTask)
+		require.True(t, ok)
+		assert.Equal(t, int32(0), answer.OutputIndex())
+		assert.ElementsMatch(t, []string{"prices__ds1_parse", "prices__ds2_parse"}, inputDotIDs(answer))
+
+		scaled := p.ByDotID("scaled")
+		assert.Equal(t, []string{"prices"}, inputDotIDs(scaled))
+	})
+
+	t.Run("single task fragment and parameters referencing other tasks", func(t *testing.T) {
+		p, err := parseWithFragments(`
+ds     [type=memo value=10]
+scaled [type=fragment name=scale input="$(ds)" times=2]
+`, map[string]string{"scale": `mul [type=multiply input="$(fragment.input)" times="$(fragment.times)"]`})
+		require.NoError(t, err)
+
+		require.Equal(t, []string{"ds", "scaled"}, dotIDs(p))
+		scaled, ok := p.ByDotID("scaled").(*pipeline.MultiplyTask)
+		require.True(t, ok)
+		assert.Equal(t, "$(ds)", scaled.Input)
+		assert.Equal(t, "2", scaled.Times)
+		assert.Equal(t, []string{"ds"}, inputDotIDs(scaled))
+	})
+
+	t.Run("nested fragments", func(t *testing.T) {
+		p, err := parseWithFragments(`x [type=fragment name=outer]`, map[string]string{
+			"median2": median2Fragment,
+			"outer": `
+inner [type=fragment name=median2 url1="https://a.example" url2="https://b.example" path="result"]
+out   [type=multiply input="$(inner)" times=2]
+inner -> out
+`,
+		})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{
+			"x__inner__ds1", "x__inner__ds1_parse", "x__inner__ds2", "x__inner__ds2_parse", "x__inner", "x",
+		}, dotIDs(p))
+		out, ok := p.ByDotID("x").(*pipeline.MultiplyTask)
+		require.True(t, ok)
+		assert.Equal(t, "$(x__inner)", out.Input)
+	})
+
+	t.Run("is deterministic", func(t *testing.T) {
+		source := `prices [type=fragment name=median2 url1="a" url2="b" path="c"]`
+		fragments := map[string]string{"median2": median2Fragment}
+		first, err := parseWithFragments(source, fragments)
+		require.NoError(t, err)
+		for i := 0; i < 10; i++ {
+			p, err := parseWithFragments(source, fragments)
+			require.NoError(t, err)
+			require.Equal(t, dotIDs(first), dotIDs(p))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for _, tc := range []struct {
+			name      string
+			source    string
+			fragments map[string]string
+			err       string
+		}{
+			{"unknown fragment", `a [type=fragment name="does-not-exist"]`, nil, `pipeline fragment not found`},
+			{"missing name", `a [type=fragment]`, nil, `fragment reference must set a name`},
+			{"missing parameter", `a [type=fragment name=median2 url1="a" url2="b"]`, map[string]string{"median2": median2Fragment}, `missing fragment parameter "path"`},
+			{"cycle", `a [type=fragment name=f1]`, map[string]string{"f1": `b [type=fragment name=f2]`, "f2": `c [type=fragment name=f1]`}, `fragment "f1" references itself`},
+			{"multiple outputs", `a [type=fragment name=f1]`, map[string]string{"f1": "b [type=memo value=1]\nc [type=memo value=2]"}, `must have exactly one task without outputs, got 2`},
+			{"name collision", "a__b [type=memo value=1]\na [type=fragment name=f1]", map[string]string{"f1": "b [type=memo value=1]\nc [type=memo value=2]\nb -> c"}, `task a__b already exists`},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := parseWithFragments(tc.source, tc.fragments)
+				require.ErrorContains(t, err, tc.err)
+			})
+		}
+	})
+}
+
+func TestExpandFragments(t *testing.T) {
+	t.Parallel()
+
+	fragments := map[string]string{
+		"median2": median2Fragment,
+		"outer": `
+inner [type=fragment name=median2 url1="https://a.example?a=1&b=2" url2="https://b.example" path="data,result"]
+out   [type=multiply input="$(inner)" times=2]
+inner -> out
+`,
+	}
+	lookup := func(name string) (string, bool) {
+		f, ok := fragments[name]
+		return f, ok
+	}
+
+	t.Run("returns the pipeline with the fragments expanded", func(t *testing.T) {
+		source := `
+trigger [type=memo value=1]
+prices  [type=fragment name=outer index=0]
+body    [type=bridge name=foo requestData=<{"data": {"price": $(prices)}}>]
+
+trigger -> prices -> body
+`
+		expanded, names, err := pipeline.ExpandFragments(source, lookup)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"median2", "outer"}, names)
+		assert.NotContains(t, expanded, "fragment")
+
+		want, err := parseWithFragments(source, fragments)
+		require.NoError(t, err)
+		got, err := pipeline.Parse(expanded)
+		require.NoError(t, err)
+		require.Equal(t, dotIDs(want), dotIDs(got))
+		for _, task := range want.Tasks {
+			assert.IsType(t, task, got.ByDotID(task.DotID()), task.DotID())
+			assert.ElementsMatch(t, inputDotIDs(task), inputDotIDs(got.ByDotID(task.DotID())), task.DotID())
+		}
+		assert.Equal(t, "https://a.example?a=1&b=2", got.ByDotID("prices__inner__ds1").(*pipeline.HTTPTask).URL)
+		assert.Equal(t, "data,result", got.ByDotID("prices__inner__ds2_parse").(*pipeline.JSONParseTask).Path)
+		assert.Equal(t, "$(prices__inner)", got.ByDotID("prices").(*pipeline.MultiplyTask).Input)
+		assert.Equal(t, want.ByDotID("body").(*pipeline.BridgeTask).RequestData, got.ByDotID("body").(*pipeline.BridgeTask).RequestData)
+		assert.Equal(t, int32(0), got.ByDotID("prices").OutputIndex())
+	})
+
+	t.Run("returns a pipeline without fragments unchanged", func(t *testing.T) {
+		source := "a [type=memo value=1]\nb [type=multiply input=\"$(a)\" times=2]"
+		expanded, names, err := pipeline.ExpandFragments(source, lookup)
+		require.NoError(t, err)
+		assert.Equal(t, source, expanded)
+		assert.Empty(t, names)
+	})
+
+	t.Run("fails on unknown fragments", func(t *testing.T) {
+		_, _, err := pipeline.ExpandFragments(`a [type=fragment name="does-not-exist"]`, lookup)
+		require.ErrorIs(t, err, pipeline.ErrFragmentNotFound)
+	})
+}
+
+func TestFragmentReferences(t *testing.T) {
+	t.Parallel()
+
+	refs, err := pipeline.FragmentReferences(`
+a [type=fragment name=foo]
+b [type=FRAGMENT name=bar]
+c [type=fragment name=foo]
+d [type=http]
+`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bar", "foo"}, refs)
+
+	refs, err = pipeline.FragmentReferences(`a [type=memo value=1]`)
+	require.NoError(t, err)
+	assert.Empty(t, refs)
+}
+
+func TestValidateFragment(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, pipeline.ValidateFragment("median2", median2Fragment, nil))
+	require.ErrorContains(t, pipeline.ValidateFragment("1nvalid", median2Fragment, nil), "invalid fragment name")
+	require.ErrorContains(t, pipeline.ValidateFragment("f", "", nil), "empty fragment")
+	require.ErrorContains(t, pipeline.ValidateFragment("f", `a [type=nope]`, nil), `unknown task type: "nope"`)
+	require.ErrorContains(t, pipeline.ValidateFragment("f", `a [type=fragment name=f]`, nil), `references itself`)
+	require.ErrorContains(t, pipeline.ValidateFragment("f", "a [type=memo value=1]\nb [type=memo value=1]", nil), "exactly one task without outputs")
+}
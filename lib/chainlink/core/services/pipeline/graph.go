@@ -33,10 +33,32 @@ func (g *Graph) NewEdge(from, to graph.Node) graph.Edge {
 	return &GraphEdge{Edge: g.DirectedGraph.NewEdge(from, to)}
 }
 
+// UnmarshalText decodes DOT into the graph. References to pipeline fragments
+// are not expanded, see Parse.
 func (g *Graph) UnmarshalText(bs []byte) (err error) {
+	return g.unmarshalText(bs, nil)
+}
+
+// unmarshalText decodes DOT into the graph, expanding references to pipeline
+// fragments if lookup is not nil.
+func (g *Graph) unmarshalText(bs []byte, lookup FragmentLookup) (err error) {
 	if g.DirectedGraph == nil {
 		g.DirectedGraph = simple.NewDirectedGraph()
 	}
+	if err = g.unmarshalDOT(bs); err != nil {
+		return err
+	}
+	if lookup != nil {
+		if err = g.expandFragments(lookup, nil); err != nil {
+			return errors.Wrap(err, "could not expand pipeline fragments")
+		}
+	}
+	g.AddImplicitDependenciesAsEdges()
+	return nil
+}
+
+// unmarshalDOT decodes DOT into the graph without expanding fragments or adding implicit edges.
+func (g *Graph) unmarshalDOT(bs []byte) (err error) {
 	defer func() {
 		if rerr := recover(); rerr != nil {
 			err = fmt.Errorf("could not unmarshal DOT into a pipeline.Graph: %v", rerr)
@@ -48,7 +70,6 @@ func (g *Graph) UnmarshalText(bs []byte) (err error) {
 	if err != nil {
 		return errors.Wrap(err, "could not unmarshal DOT into a pipeline.Graph")
 	}
-	g.AddImplicitDependenciesAsEdges()
 	return nil
 }
 
@@ -160,10 +181,15 @@ type Pipeline struct {
 	Tasks  []Task
 	tree   *Graph
 	Source string
+
+	unresolved bool
 }
 
+// UnmarshalText parses the pipeline of a job spec, which is decoded before the
+// fragments are known: a pipeline which references fragments is unresolved
+// until it is parsed again WithFragments.
 func (p *Pipeline) UnmarshalText(bs []byte) (err error) {
-	parsed, err := Parse(string(bs))
+	parsed, err := parse(string(bs), nil)
 	if err != nil {
 		return err
 	}
@@ -199,6 +225,12 @@ func (p *Pipeline) RequiresPreInsert() bool {
 	return false
 }
 
+// Unresolved returns true if the pipeline references fragments, but was decoded
+// by UnmarshalText. Only its Source is set.
+func (p *Pipeline) Unresolved() bool {
+	return p.unresolved
+}
+
 func (p *Pipeline) ByDotID(id string) Task {
 	for _, task := range p.Tasks {
 		if task.DotID() == id {
@@ -208,16 +240,49 @@ func (p *Pipeline) ByDotID(id string) Task {
 	return nil
 }
 
-func Parse(text string) (*Pipeline, error) {
+// ParseOption configures Parse.
+type ParseOption func(*parseOptions)
+
+type parseOptions struct {
+	fragments FragmentLookup
+}
+
+// WithFragments expands the references to pipeline fragments with lookup.
+func WithFragments(lookup FragmentLookup) ParseOption {
+	return func(o *parseOptions) {
+		o.fragments = lookup
+	}
+}
+
+// Parse parses the DOT source of a pipeline. References to pipeline fragments
+// fail to parse with ErrFragmentNotFound, unless a lookup is given WithFragments.
+func Parse(text string, opts ...ParseOption) (*Pipeline, error) {
+	var o parseOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.fragments == nil {
+		o.fragments = noFragments
+	}
+	return parse(text, o.fragments)
+}
+
+// parse parses the DOT source of a pipeline, expanding references to fragments
+// with lookup. If lookup is nil, a pipeline which references fragments is
+// returned unresolved.
+func parse(text string, lookup FragmentLookup) (*Pipeline, error) {
 	if strings.TrimSpace(text) == "" {
 		return nil, errors.New("empty pipeline")
 	}
 	g := NewGraph()
-	err := g.UnmarshalText([]byte(text))
+	err := g.unmarshalText([]byte(text), lookup)
 
 	if err != nil {
 		return nil, err
 	}
+	if lookup == nil && g.hasFragments() {
+		return &Pipeline{Source: text, unresolved: true}, nil
+	}
 
 	p := &Pipeline{
 		tree:   g,
@@ -3,6 +3,7 @@ package pipeline
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -45,6 +46,14 @@ func (t *BridgeTask) HelperSetDependencies(
 	t.specId = specId
 }
 
+func (t *BridgeTask) HelperSetCircuitBreakers(circuitBreakers *BridgeCircuitBreakers) {
+	t.circuitBreakers = circuitBreakers
+}
+
+func (b *BridgeCircuitBreakers) HelperSetNow(now func() time.Time) {
+	b.now = now
+}
+
 func (t *HTTPTask) HelperSetDependencies(config Config, restrictedHTTPClient, unrestrictedHTTPClient *http.Client) {
 	t.config = config
 	t.httpClient = restrictedHTTPClient
@@ -34,7 +34,7 @@ type Spec struct {
 }
 
 func (s *Spec) GetOrParsePipeline() (*Pipeline, error) {
-	if s.Pipeline != nil {
+	if s.Pipeline != nil && !s.Pipeline.Unresolved() {
 		return s.Pipeline, nil
 	}
 	return s.ParsePipeline()
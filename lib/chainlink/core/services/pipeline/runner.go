@@ -69,6 +69,8 @@ type runner struct {
 	lggr                   logger.Logger
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	bridgeCircuitBreakers  *BridgeCircuitBreakers
+	jsonSchemas            *jsonSchemaCache
 
 	// test helper
 	runFinished func(*Run)
@@ -134,6 +136,10 @@ func NewRunner(
 		lggr:                   lggr,
 		httpClient:             httpClient,
 		unrestrictedHTTPClient: unrestrictedHTTPClient,
+		jsonSchemas:            newJSONSchemaCache(),
+	}
+	if bridgeCfg != nil {
+		r.bridgeCircuitBreakers = NewBridgeCircuitBreakers(bridgeCfg.BridgeCircuitBreakerThreshold(), bridgeCfg.BridgeCircuitBreakerCooldown())
 	}
 
 	r.runReaperWorker = commonutils.NewSleeperTask(
@@ -183,6 +189,7 @@ func (r *runner) Name() string {
 
 func (r *runner) HealthReport() map[string]error {
 	runnerHealth := map[string]error{r.Name(): r.Healthy()}
+	services.CopyHealth(runnerHealth, r.bridgeCircuitBreakers.HealthReport(r.Name()))
 
 	service, isService := r.btORM.(services.HealthReporter)
 	if !isService {
@@ -302,7 +309,7 @@ func (r *runner) ExecuteRun(ctx context.Context, spec Spec, vars Vars) (*Run, Ta
 	defer cancel()
 
 	var pipeline *Pipeline
-	if spec.Pipeline != nil {
+	if spec.Pipeline != nil && !spec.Pipeline.Unresolved() {
 		// assume if set that it has been pre-initialized
 		pipeline = spec.Pipeline
 	} else {
@@ -348,6 +355,7 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			// must use the unrestrictedHTTPClient because some node operators
 			// may run external adapters on their own hardware
 			task.(*BridgeTask).httpClient = r.unrestrictedHTTPClient
+			task.(*BridgeTask).circuitBreakers = r.bridgeCircuitBreakers
 		case TaskTypeETHCall:
 			task.(*ETHCallTask).legacyChains = r.legacyEVMChains
 			task.(*ETHCallTask).config = r.config
@@ -369,6 +377,8 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*ETHTxTask).specGasLimit = spec.GasLimit
 			task.(*ETHTxTask).jobType = spec.JobType
 			task.(*ETHTxTask).forwardingAllowed = spec.ForwardingAllowed
+		case TaskTypeJSONSchema:
+			task.(*JSONSchemaTask).schemas = r.jsonSchemas
 		default:
 		}
 	}
@@ -562,7 +572,17 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		defer cancel()
 	}
 
-	result, runInfo := taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	var (
+		result  Result
+		runInfo RunInfo
+	)
+	sim := getSimulation(ctx)
+	mocked := sim != nil && sim.intercepts(taskRun.task)
+	if mocked {
+		result, runInfo = sim.respond(taskRun.task)
+	} else {
+		result, runInfo = taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	}
 	loggerFields := []any{"runInfo", runInfo,
 		"resultValue", result.Value,
 		"resultError", result.Error,
@@ -579,6 +599,10 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 
 	now := time.Now()
 
+	if sim != nil {
+		sim.record(taskRun, result, mocked, start, now)
+	}
+
 	var finishedAt null.Time
 	if !runInfo.IsPending {
 		finishedAt = null.TimeFrom(now)
@@ -0,0 +1,217 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+)
+
+// ErrNoSimulationFixture is returned by a task that would perform network or
+// chain I/O during a simulated run, but has no canned response in the fixtures.
+var ErrNoSimulationFixture = errors.New("no simulation fixture for task")
+
+// simulatedTaskTypes are the task types that talk to the outside world. In a
+// simulated run they never execute and must be served from fixtures instead.
+var simulatedTaskTypes = map[TaskType]struct{}{
+	TaskTypeHTTP:             {},
+	TaskTypeBridge:           {},
+	TaskTypeETHCall:          {},
+	TaskTypeETHTx:            {},
+	TaskTypeEstimateGasLimit: {},
+}
+
+// IsSimulatedTaskType returns true if tasks of this type are always served
+// from fixtures when running in simulation mode.
+func IsSimulatedTaskType(taskType TaskType) bool {
+	_, ok := simulatedTaskTypes[taskType]
+	return ok
+}
+
+// SimulatedResponse is the canned result of a single task in a simulated run.
+// Exactly one of Value or Error must be set; a null Value counts as not set.
+type SimulatedResponse struct {
+	Value any    `json:"value"`
+	Error string `json:"error,omitempty"`
+}
+
+// SimulationFixtures maps task dot IDs to their canned responses.
+//
+// Example:
+//
+//	{
+//	  "tasks": {
+//	    "ds1": {"value": "{\"data\":{\"result\":3000}}"},
+//	    "ds2": {"error": "connection refused"}
+//	  }
+//	}
+type SimulationFixtures struct {
+	Tasks map[string]SimulatedResponse `json:"tasks"`
+}
+
+// ParseSimulationFixtures decodes a JSON fixtures document.
+func ParseSimulationFixtures(b []byte) (f SimulationFixtures, err error) {
+	if len(b) == 0 {
+		return f, nil
+	}
+	if err = json.Unmarshal(b, &f); err != nil {
+		return f, pkgerrors.Wrap(err, "failed to parse simulation fixtures")
+	}
+	for dotID, resp := range f.Tasks {
+		if resp.Value != nil && resp.Error != "" {
+			return f, pkgerrors.Errorf("fixture for task %q must set either value or error, not both", dotID)
+		}
+		if resp.Value == nil && resp.Error == "" {
+			return f, pkgerrors.Errorf("fixture for task %q must set either value or error", dotID)
+		}
+	}
+	return f, nil
+}
+
+// TaskTrace is the record of a single task execution in a simulated run.
+type TaskTrace struct {
+	DotID      string                            `json:"dotId"`
+	Type       TaskType                          `json:"type"`
+	Mocked     bool                              `json:"mocked"`
+	Inputs     []TaskTraceInput                  `json:"inputs"`
+	Output     jsonserializable.JSONSerializable `json:"output"`
+	Error      *string                           `json:"error"`
+	StartedAt  time.Time                         `json:"startedAt"`
+	FinishedAt time.Time                         `json:"finishedAt"`
+	Duration   time.Duration                     `json:"duration"`
+}
+
+// TaskTraceInput is one input of a traced task, as received from its upstream task.
+type TaskTraceInput struct {
+	Value jsonserializable.JSONSerializable `json:"value"`
+	Error *string                           `json:"error"`
+}
+
+// Simulation holds the fixtures and collects the trace of a simulated run.
+// Attach it to the context passed to Runner.ExecuteRun with WithSimulation;
+// no task of a type in simulatedTaskTypes will then reach the network or the chain.
+type Simulation struct {
+	fixtures SimulationFixtures
+
+	mu    sync.Mutex
+	trace []TaskTrace
+}
+
+func NewSimulation(fixtures SimulationFixtures) *Simulation {
+	return &Simulation{fixtures: fixtures}
+}
+
+const ctxSimulationKey contextKey = "simulation"
+
+// WithSimulation returns a context which makes the runner execute in simulation mode.
+func WithSimulation(ctx context.Context, sim *Simulation) context.Context {
+	if sim == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, ctxSimulationKey, sim)
+}
+
+func getSimulation(ctx context.Context) *Simulation {
+	sim, ok := ctx.Value(ctxSimulationKey).(*Simulation)
+	if !ok {
+		return nil
+	}
+	return sim
+}
+
+// Validate checks that every fixture refers to a task of the pipeline, so that
+// a typo in the fixtures does not go unnoticed.
+func (s *Simulation) Validate(p *Pipeline) error {
+	var errs []error
+	for dotID := range s.fixtures.Tasks {
+		if p.ByDotID(dotID) == nil {
+			errs = append(errs, pkgerrors.Errorf("fixture refers to unknown task %q", dotID))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// intercepts returns true if the task must not run and is served from fixtures instead.
+func (s *Simulation) intercepts(task Task) bool {
+	if _, ok := s.fixtures.Tasks[task.DotID()]; ok {
+		return true
+	}
+	return IsSimulatedTaskType(task.Type())
+}
+
+func (s *Simulation) respond(task Task) (Result, RunInfo) {
+	resp, ok := s.fixtures.Tasks[task.DotID()]
+	if !ok {
+		return Result{Error: pkgerrors.Wrapf(ErrNoSimulationFixture, "%s (%s)", task.DotID(), task.Type())}, RunInfo{}
+	}
+	if resp.Error != "" {
+		return Result{Error: errors.New(resp.Error)}, RunInfo{}
+	}
+	return Result{Value: resp.Value}, RunInfo{}
+}
+
+func (s *Simulation) record(taskRun *memoryTaskRun, result Result, mocked bool, start, finish time.Time) {
+	tt := TaskTrace{
+		DotID:      taskRun.task.DotID(),
+		Type:       taskRun.task.Type(),
+		Mocked:     mocked,
+		Output:     result.OutputDB(),
+		Error:      result.ErrorDB().Ptr(),
+		StartedAt:  start,
+		FinishedAt: finish,
+		Duration:   finish.Sub(start),
+	}
+	for _, input := range taskRun.inputs {
+		tt.Inputs = append(tt.Inputs, TaskTraceInput{
+			Value: input.OutputDB(),
+			Error: input.ErrorDB().Ptr(),
+		})
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.trace = append(s.trace, tt)
+}
+
+// Trace returns the executed tasks in the order they were started.
+func (s *Simulation) Trace() []TaskTrace {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	trace := make([]TaskTrace, len(s.trace))
+	copy(trace, s.trace)
+	sort.SliceStable(trace, func(i, j int) bool {
+		return trace[i].StartedAt.Before(trace[j].StartedAt)
+	})
+	return trace
+}
+
+// SimulationReport is the outcome of a simulated run.
+type SimulationReport struct {
+	Outputs     []any       `json:"outputs"`
+	AllErrors   []*string   `json:"allErrors"`
+	FatalErrors []*string   `json:"fatalErrors"`
+	Tasks       []TaskTrace `json:"tasks"`
+}
+
+// NewSimulationReport builds a report from the finished run and the trace collected by sim.
+func NewSimulationReport(run *Run, sim *Simulation) (SimulationReport, error) {
+	if run.Pending {
+		return SimulationReport{}, fmt.Errorf("simulated run for spec ID %v did not finish", run.PipelineSpecID)
+	}
+	report := SimulationReport{
+		AllErrors:   run.StringAllErrors(),
+		FatalErrors: run.StringFatalErrors(),
+		Tasks:       sim.Trace(),
+	}
+	if outputs, ok := run.Outputs.Val.([]any); ok {
+		report.Outputs = outputs
+	}
+	return report, nil
+}
@@ -0,0 +1,139 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func newSimulationRunner(t *testing.T) pipeline.Runner {
+	cfg := configtest.NewTestGeneralConfig(t)
+	return pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), nil, nil)
+}
+
+func Test_PipelineRunner_Simulation(t *testing.T) {
+	const dag = `
+ds1          [type=http method=GET url="https://example.invalid/price"]
+ds1_parse    [type=jsonparse path="data,result"]
+ds1_multiply [type=multiply times=100]
+ds2          [type=bridge name="does-not-exist"]
+ds2_parse    [type=jsonparse path="data,result"]
+answer       [type=median]
+
+ds1 -> ds1_parse -> ds1_multiply -> answer
+ds2 -> ds2_parse -> answer
+`
+
+	t.Run("serves I/O tasks from fixtures and traces every task", func(t *testing.T) {
+		r := newSimulationRunner(t)
+		fixtures, err := pipeline.ParseSimulationFixtures([]byte(`{
+			"tasks": {
+				"ds1": {"value": "{\"data\":{\"result\":3}}"},
+				"ds2": {"value": "{\"data\":{\"result\":500}}"}
+			}
+		}`))
+		require.NoError(t, err)
+		sim := pipeline.NewSimulation(fixtures)
+
+		run, trrs, err := r.ExecuteRun(pipeline.WithSimulation(testutils.Context(t), sim), pipeline.Spec{DotDagSource: dag}, pipeline.NewVarsFrom(nil))
+		require.NoError(t, err)
+		require.Len(t, trrs, 6)
+		require.False(t, run.HasErrors())
+
+		final := trrs.FinalResult()
+		require.Len(t, final.Values, 1)
+		assert.Equal(t, "400", final.Values[0].(decimal.Decimal).String())
+
+		trace := sim.Trace()
+		require.Len(t, trace, 6)
+		mocked := map[string]bool{}
+		for _, tt := range trace {
+			mocked[tt.DotID] = tt.Mocked
+			assert.False(t, tt.FinishedAt.Before(tt.StartedAt))
+			if tt.DotID == "answer" {
+				assert.Len(t, tt.Inputs, 2)
+			}
+		}
+		assert.Equal(t, map[string]bool{
+			"ds1": true, "ds1_parse": false, "ds1_multiply": false,
+			"ds2": true, "ds2_parse": false, "answer": false,
+		}, mocked)
+
+		report, err := pipeline.NewSimulationReport(run, sim)
+		require.NoError(t, err)
+		require.Len(t, report.Outputs, 1)
+		assert.Len(t, report.Tasks, 6)
+	})
+
+	t.Run("fails I/O tasks without a fixture instead of reaching the network", func(t *testing.T) {
+		r := newSimulationRunner(t)
+		sim := pipeline.NewSimulation(pipeline.SimulationFixtures{Tasks: map[string]pipeline.SimulatedResponse{
+			"ds1": {Error: "connection refused"},
+		}})
+
+		run, trrs, err := r.ExecuteRun(pipeline.WithSimulation(testutils.Context(t), sim), pipeline.Spec{DotDagSource: dag}, pipeline.NewVarsFrom(nil))
+		require.NoError(t, err)
+		require.True(t, run.HasFatalErrors())
+
+		for _, trr := range trrs {
+			switch trr.Task.DotID() {
+			case "ds1":
+				require.EqualError(t, trr.Result.Error, "connection refused")
+			case "ds2":
+				require.ErrorIs(t, trr.Result.Error, pipeline.ErrNoSimulationFixture)
+			}
+		}
+	})
+}
+
+func TestSimulation_Validate(t *testing.T) {
+	p, err := pipeline.Parse(`ds1 [type=http method=GET url="https://example.invalid"]`)
+	require.NoError(t, err)
+
+	sim := pipeline.NewSimulation(pipeline.SimulationFixtures{Tasks: map[string]pipeline.SimulatedResponse{
+		"ds1": {Value: "{}"},
+	}})
+	require.NoError(t, sim.Validate(p))
+
+	sim = pipeline.NewSimulation(pipeline.SimulationFixtures{Tasks: map[string]pipeline.SimulatedResponse{
+		"ds_typo": {Value: "{}"},
+	}})
+	require.ErrorContains(t, sim.Validate(p), `unknown task "ds_typo"`)
+}
+
+func TestParseSimulationFixtures(t *testing.T) {
+	f, err := pipeline.ParseSimulationFixtures(nil)
+	require.NoError(t, err)
+	assert.Empty(t, f.Tasks)
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{"value", `{"tasks": {"ds1": {"value": "1"}}}`, ""},
+		{"error", `{"tasks": {"ds1": {"error": "boom"}}}`, ""},
+		{"no tasks", `{}`, ""},
+		{"value and error", `{"tasks": {"ds1": {"value": "1", "error": "boom"}}}`, "either value or error, not both"},
+		{"neither value nor error", `{"tasks": {"ds1": {}}}`, `fixture for task "ds1" must set either value or error`},
+		{"null value", `{"tasks": {"ds1": {"value": null}}}`, `fixture for task "ds1" must set either value or error`},
+		{"invalid JSON", `not json`, "failed to parse simulation fixtures"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := pipeline.ParseSimulationFixtures([]byte(tt.input))
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
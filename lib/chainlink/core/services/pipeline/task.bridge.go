@@ -64,12 +64,14 @@ type BridgeTask struct {
 	Async             string `json:"async"`
 	CacheTTL          string `json:"cacheTTL"`
 	Headers           string `json:"headers"`
-
-	specId       int32
-	orm          bridges.ORM
-	config       Config
-	bridgeConfig BridgeConfig
-	httpClient   *http.Client
+	HTTPRetryParams   `mapstructure:",squash"`
+
+	specId          int32
+	orm             bridges.ORM
+	config          Config
+	bridgeConfig    BridgeConfig
+	httpClient      *http.Client
+	circuitBreakers *BridgeCircuitBreakers
 }
 
 type BridgeTelemetry struct {
@@ -122,6 +124,11 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		return Result{Error: errors.Errorf("headers must have an even number of elements")}, runInfo
 	}
 
+	retryPolicy, err := t.HTTPRetryParams.resolve()
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
 	overtimeCtx, cancel := overtimeContext(ctx)
 	defer cancel()
 
@@ -172,13 +179,26 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		"url", url.String(),
 	)
 
-	requestCtx, cancel := httpRequestCtx(ctx, t, t.config)
-	defer cancel()
-
-	var cachedResponse bool
-	responseBytes, statusCode, headers, start, finish, err := makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
-	elapsed := finish.Sub(start)
-	promBridgeLatency.WithLabelValues(t.Name, statusCodeGroup(statusCode)).Set(elapsed.Seconds())
+	var (
+		cachedResponse bool
+		responseBytes  []byte
+		statusCode     int
+		headers        http.Header
+		start, finish  time.Time
+		elapsed        time.Duration
+	)
+	breaker := t.circuitBreakers.get(string(name))
+	err = breaker.Allow()
+	if err == nil {
+		responseBytes, statusCode, headers, start, finish, err = makeHTTPRequestWithRetries(ctx, lggr, t, retryPolicy,
+			func(ctx context.Context) ([]byte, int, http.Header, time.Time, time.Time, error) {
+				requestCtx, cancel := httpRequestCtx(ctx, t, t.config)
+				defer cancel()
+				return makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+			})
+		elapsed = finish.Sub(start)
+		promBridgeLatency.WithLabelValues(t.Name, statusCodeGroup(statusCode)).Set(elapsed.Seconds())
+	}
 
 	defer func() {
 		telemetryCh := GetTelemetryCh(ctx)
@@ -214,7 +234,12 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		statusCode = code
 	}
 
-	if err != nil || statusCode != http.StatusOK {
+	failed := err != nil || statusCode != http.StatusOK
+	if !errors.Is(err, ErrCircuitBreakerOpen) {
+		breaker.Record(!failed)
+	}
+
+	if failed {
 		if adapterErr := eautils.BestEffortExtractEAError(responseBytes); adapterErr != nil {
 			err = adapterErr
 		}
@@ -330,6 +330,81 @@ func TestBridgeTask_HandlesIntermittentFailure(t *testing.T) {
 	require.Equal(t, runInfo.IsRetryable, runInfo2.IsRetryable)
 }
 
+func TestBridgeTask_RetriesAndCircuitBreaker(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {})
+
+	var calls atomic.Int32
+	var failing atomic.Bool
+	s1 := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if failing.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, err := io.WriteString(w, `{"data":{"result":9700}}`)
+		require.NoError(t, err)
+	}))
+	defer s1.Close()
+
+	orm := bridges.NewORM(db)
+	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: s1.URL})
+
+	task := pipeline.BridgeTask{
+		BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+		Name:        bridge.Name.String(),
+		RequestData: btcUSDPairing,
+		HTTPRetryParams: pipeline.HTTPRetryParams{
+			MaxAttempts:     "3",
+			RetryBackoff:    "1ms",
+			RetryMaxBackoff: "1ms",
+		},
+	}
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	trORM := pipeline.NewORM(db, logger.TestLogger(t), cfg.JobPipeline().MaxSuccessfulRuns())
+	specID, err := trORM.CreateSpec(ctx, pipeline.Pipeline{}, *sqlutil.NewInterval(5 * time.Minute))
+	require.NoError(t, err)
+	task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, specID, uuid.UUID{}, c)
+
+	now := time.Now()
+	breakers := pipeline.NewBridgeCircuitBreakers(2, time.Minute)
+	breakers.HelperSetNow(func() time.Time { return now })
+	task.HelperSetCircuitBreakers(breakers)
+
+	run := func() (pipeline.Result, pipeline.RunInfo) {
+		return task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	}
+
+	failing.Store(true)
+	result, runInfo := run()
+	require.Error(t, result.Error)
+	assert.True(t, runInfo.IsRetryable)
+	assert.Equal(t, int32(3), calls.Load(), "every attempt is made")
+	assert.Equal(t, pipeline.CircuitBreakerClosed, breakers.States()[bridge.Name.String()])
+
+	result, _ = run()
+	require.Error(t, result.Error)
+	assert.Equal(t, int32(6), calls.Load())
+	assert.Equal(t, pipeline.CircuitBreakerOpen, breakers.States()[bridge.Name.String()])
+
+	// the bridge is not called while the breaker is open
+	failing.Store(false)
+	result, _ = run()
+	require.ErrorIs(t, result.Error, pipeline.ErrCircuitBreakerOpen)
+	assert.Equal(t, int32(6), calls.Load())
+
+	// a successful probe after the cooldown closes the breaker
+	now = now.Add(time.Minute)
+	result, _ = run()
+	require.NoError(t, result.Error)
+	assert.JSONEq(t, `{"data":{"result":9700}}`, result.Value.(string))
+	assert.Equal(t, int32(7), calls.Load())
+	assert.Equal(t, pipeline.CircuitBreakerClosed, breakers.States()[bridge.Name.String()])
+}
+
 func TestBridgeTask_DoesNotReturnStaleResults(t *testing.T) {
 	t.Parallel()
 
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	stderrors "errors"
 	"net/http"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -24,6 +25,7 @@ type HTTPTask struct {
 	RequestData                    string `json:"requestData"`
 	AllowUnrestrictedNetworkAccess string
 	Headers                        string
+	HTTPRetryParams                `mapstructure:",squash"`
 
 	config                 Config
 	httpClient             *http.Client
@@ -82,6 +84,11 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		return Result{Error: errors.Errorf("headers must have an even number of elements")}, runInfo
 	}
 
+	retryPolicy, err := t.HTTPRetryParams.resolve()
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
 	requestDataJSON, err := json.Marshal(requestData)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -94,16 +101,18 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		"allowUnrestrictedNetworkAccess", allowUnrestrictedNetworkAccess,
 	)
 
-	requestCtx, cancel := httpRequestCtx(ctx, t, t.config)
-	defer cancel()
-
 	var client *http.Client
 	if allowUnrestrictedNetworkAccess {
 		client = t.unrestrictedHTTPClient
 	} else {
 		client = t.httpClient
 	}
-	responseBytes, statusCode, respHeaders, start, finish, err := makeHTTPRequest(requestCtx, lggr, method, url, reqHeaders, requestData, client, t.config.DefaultHTTPLimit())
+	responseBytes, statusCode, respHeaders, start, finish, err := makeHTTPRequestWithRetries(ctx, lggr, t, retryPolicy,
+		func(ctx context.Context) ([]byte, int, http.Header, time.Time, time.Time, error) {
+			requestCtx, cancel := httpRequestCtx(ctx, t, t.config)
+			defer cancel()
+			return makeHTTPRequest(requestCtx, lggr, method, url, reqHeaders, requestData, client, t.config.DefaultHTTPLimit())
+		})
 	elapsed := finish.Sub(start).Milliseconds()
 	if err != nil {
 		if errors.Is(errors.Cause(err), clhttp.ErrDisallowedIP) {
@@ -6,6 +6,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"sort"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -410,3 +411,74 @@ func TestHTTPTask_Headers(t *testing.T) {
 		assert.Equal(t, []string{"Content-Length", "38", "Content-Type", "footype", "User-Agent", "Go-http-client/1.1", "X-Header-1", "foo", "X-Header-2", "bar"}, allHeaders(headers))
 	})
 }
+
+func TestHTTPTask_Retries(t *testing.T) {
+	t.Parallel()
+
+	config := configtest.NewTestGeneralConfig(t)
+
+	newServer := func(t *testing.T, failures int32, status int) (*httptest.Server, *atomic.Int32) {
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) <= failures {
+				w.WriteHeader(status)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, err := w.Write([]byte(`{"result": 42}`))
+			require.NoError(t, err)
+		}))
+		t.Cleanup(server.Close)
+		return server, &calls
+	}
+
+	newTask := func(url string, params pipeline.HTTPRetryParams) *pipeline.HTTPTask {
+		task := &pipeline.HTTPTask{
+			BaseTask:        pipeline.NewBaseTask(0, "http", nil, nil, 0),
+			Method:          "GET",
+			URL:             url,
+			HTTPRetryParams: params,
+		}
+		c := clhttptest.NewTestLocalOnlyHTTPClient()
+		task.HelperSetDependencies(config.JobPipeline(), c, c)
+		return task
+	}
+
+	t.Run("retries until success", func(t *testing.T) {
+		server, calls := newServer(t, 2, http.StatusServiceUnavailable)
+		task := newTask(server.URL, pipeline.HTTPRetryParams{MaxAttempts: "3", RetryBackoff: "1ms"})
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		assert.JSONEq(t, `{"result": 42}`, result.Value.(string))
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("gives up after maxAttempts", func(t *testing.T) {
+		server, calls := newServer(t, 5, http.StatusServiceUnavailable)
+		task := newTask(server.URL, pipeline.HTTPRetryParams{MaxAttempts: "2", RetryBackoff: "1ms"})
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.Error(t, result.Error)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("does not retry status codes that are not listed", func(t *testing.T) {
+		server, calls := newServer(t, 5, http.StatusServiceUnavailable)
+		task := newTask(server.URL, pipeline.HTTPRetryParams{MaxAttempts: "3", RetryBackoff: "1ms", RetryStatusCodes: "[429]"})
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.Error(t, result.Error)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("invalid params", func(t *testing.T) {
+		task := newTask("https://example.invalid", pipeline.HTTPRetryParams{MaxAttempts: "0"})
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorIs(t, result.Error, pipeline.ErrBadInput)
+
+		task = newTask("https://example.invalid", pipeline.HTTPRetryParams{RetryStatusCodes: "[42]"})
+		result, _ = task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorIs(t, result.Error, pipeline.ErrBadInput)
+	})
+}
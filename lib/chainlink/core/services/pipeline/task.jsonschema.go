@@ -0,0 +1,226 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+// ErrJSONSchemaValidation is wrapped by every JSONSchemaValidationError.
+var ErrJSONSchemaValidation = stderrors.New("value does not match JSON schema")
+
+// JSONSchemaViolation is a single reason why a value did not match its schema.
+type JSONSchemaViolation struct {
+	// Path is the JSON pointer of the offending value, e.g. /data/result
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// JSONSchemaValidationError is returned by the jsonschema task when its input
+// does not match the schema. It names every offending path.
+type JSONSchemaValidationError struct {
+	Violations []JSONSchemaViolation `json:"violations"`
+}
+
+func (e *JSONSchemaValidationError) Error() string {
+	var sb strings.Builder
+	sb.WriteString(ErrJSONSchemaValidation.Error())
+	for i, v := range e.Violations {
+		if i == 0 {
+			sb.WriteString(": ")
+		} else {
+			sb.WriteString("; ")
+		}
+		fmt.Fprintf(&sb, "at %q: %s", v.Path, v.Message)
+	}
+	return sb.String()
+}
+
+func (e *JSONSchemaValidationError) Unwrap() error {
+	return ErrJSONSchemaValidation
+}
+
+// Validates the input against the JSON schema given inline with `schema`.
+// String and byte inputs are decoded as JSON documents (e.g. the response of
+// an http or bridge task), any other value is validated as is. The input is
+// passed through unchanged on success.
+//
+// Remote $ref resolution is disabled: schemas must be self-contained.
+//
+// Return types:
+//
+//	interface{}
+type JSONSchemaTask struct {
+	BaseTask `mapstructure:",squash"`
+	Schema   string `json:"schema"`
+	Data     string `json:"data"`
+
+	schemas *jsonSchemaCache
+}
+
+var _ Task = (*JSONSchemaTask)(nil)
+
+func (t *JSONSchemaTask) Type() TaskType {
+	return TaskTypeJSONSchema
+}
+
+func (t *JSONSchemaTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		schema StringParam
+		data   jsonSchemaInstanceParam
+	)
+	err = stderrors.Join(
+		errors.Wrap(ResolveParam(&schema, From(NonemptyString(t.Schema))), "schema"),
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), Input(inputs, 0))), "data"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	compiled, err := t.schemas.get(string(schema))
+	if err != nil {
+		return Result{Error: stderrors.Join(ErrBadInput, err)}, runInfo
+	}
+
+	if err = compiled.Validate(data.decoded); err != nil {
+		var ve *jsonschema.ValidationError
+		if errors.As(err, &ve) {
+			return Result{Error: newJSONSchemaValidationError(ve)}, runInfo
+		}
+		return Result{Error: stderrors.Join(ErrBadInput, err)}, runInfo
+	}
+
+	return Result{Value: data.raw}, runInfo
+}
+
+const jsonSchemaTaskURL = "pipeline://jsonschema.json"
+
+// maxCachedJSONSchemas bounds the number of schemas kept by a jsonSchemaCache.
+const maxCachedJSONSchemas = 1000
+
+// jsonSchemaCache holds compiled schemas keyed by their source, so that the
+// schema of a task spec is compiled once rather than on every run.
+//
+// A nil *jsonSchemaCache caches nothing.
+type jsonSchemaCache struct {
+	mu      sync.RWMutex
+	schemas map[string]*jsonschema.Schema
+}
+
+func newJSONSchemaCache() *jsonSchemaCache {
+	return &jsonSchemaCache{schemas: make(map[string]*jsonschema.Schema)}
+}
+
+func (c *jsonSchemaCache) get(schema string) (*jsonschema.Schema, error) {
+	if c == nil {
+		return compileJSONSchema([]byte(schema))
+	}
+	c.mu.RLock()
+	compiled, ok := c.schemas[schema]
+	c.mu.RUnlock()
+	if ok {
+		return compiled, nil
+	}
+
+	compiled, err := compileJSONSchema([]byte(schema))
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.schemas) < maxCachedJSONSchemas {
+		c.schemas[schema] = compiled
+	}
+	return compiled, nil
+}
+
+func compileJSONSchema(schema []byte) (*jsonschema.Schema, error) {
+	c := jsonschema.NewCompiler()
+	c.LoadURL = func(s string) (io.ReadCloser, error) {
+		return nil, errors.Errorf("cannot load %s: schema references must be local", s)
+	}
+	if err := c.AddResource(jsonSchemaTaskURL, bytes.NewReader(schema)); err != nil {
+		return nil, errors.Wrap(err, "invalid schema")
+	}
+	compiled, err := c.Compile(jsonSchemaTaskURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid schema")
+	}
+	return compiled, nil
+}
+
+// newJSONSchemaValidationError flattens the tree of validation errors into its leaves.
+func newJSONSchemaValidationError(ve *jsonschema.ValidationError) *JSONSchemaValidationError {
+	var violations []JSONSchemaViolation
+	seen := make(map[JSONSchemaViolation]struct{})
+	var walk func(*jsonschema.ValidationError)
+	walk = func(e *jsonschema.ValidationError) {
+		if len(e.Causes) == 0 {
+			v := JSONSchemaViolation{Path: e.InstanceLocation, Message: e.Message}
+			if v.Path == "" {
+				v.Path = "/"
+			}
+			if _, ok := seen[v]; !ok {
+				seen[v] = struct{}{}
+				violations = append(violations, v)
+			}
+			return
+		}
+		for _, cause := range e.Causes {
+			walk(cause)
+		}
+	}
+	walk(ve)
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].Path < violations[j].Path
+	})
+	return &JSONSchemaValidationError{Violations: violations}
+}
+
+// jsonSchemaInstanceParam keeps the raw task input and its plain JSON representation,
+// which is what the validator understands.
+type jsonSchemaInstanceParam struct {
+	raw     any
+	decoded any
+}
+
+func (p *jsonSchemaInstanceParam) UnmarshalPipelineParam(val any) error {
+	var doc []byte
+	switch v := val.(type) {
+	case string:
+		doc = []byte(v)
+	case []byte:
+		doc = v
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return errors.Wrapf(ErrBadInput, "cannot convert %T to JSON: %v", val, err)
+		}
+		doc = b
+	}
+
+	d := json.NewDecoder(bytes.NewReader(doc))
+	d.UseNumber()
+	var decoded any
+	if err := d.Decode(&decoded); err != nil {
+		return errors.Wrapf(ErrBadInput, "input is not valid JSON: %v", err)
+	}
+	*p = jsonSchemaInstanceParam{raw: val, decoded: decoded}
+	return nil
+}
@@ -0,0 +1,44 @@
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONSchemaCache(t *testing.T) {
+	t.Parallel()
+
+	schema := `{"type": "object"}`
+
+	t.Run("compiles each schema once", func(t *testing.T) {
+		c := newJSONSchemaCache()
+		first, err := c.get(schema)
+		require.NoError(t, err)
+		second, err := c.get(schema)
+		require.NoError(t, err)
+		assert.Same(t, first, second)
+
+		other, err := c.get(`{"type": "array"}`)
+		require.NoError(t, err)
+		assert.NotSame(t, first, other)
+		assert.Len(t, c.schemas, 2)
+	})
+
+	t.Run("does not cache invalid schemas", func(t *testing.T) {
+		c := newJSONSchemaCache()
+		_, err := c.get(`{"type": 1}`)
+		require.ErrorContains(t, err, "invalid schema")
+		assert.Empty(t, c.schemas)
+	})
+
+	t.Run("nil cache compiles every time", func(t *testing.T) {
+		var c *jsonSchemaCache
+		first, err := c.get(schema)
+		require.NoError(t, err)
+		second, err := c.get(schema)
+		require.NoError(t, err)
+		assert.NotSame(t, first, second)
+	})
+}
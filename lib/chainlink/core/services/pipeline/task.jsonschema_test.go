@@ -0,0 +1,187 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+const priceSchema = `{
+	"type": "object",
+	"required": ["data"],
+	"properties": {
+		"data": {
+			"type": "object",
+			"required": ["result"],
+			"properties": {"result": {"type": "number"}}
+		}
+	}
+}`
+
+func TestJSONSchemaTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		schema            string
+		data              string
+		vars              pipeline.Vars
+		inputs            []pipeline.Result
+		wantData          any
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{
+			"valid JSON string input",
+			priceSchema,
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"data":{"result":3000.1}}`}},
+			`{"data":{"result":3000.1}}`,
+			nil,
+			"",
+		},
+		{
+			"valid decoded input",
+			priceSchema,
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: map[string]any{"data": map[string]any{"result": 42}}}},
+			map[string]any{"data": map[string]any{"result": 42}},
+			nil,
+			"",
+		},
+		{
+			"valid input from var",
+			priceSchema,
+			"$(foo)",
+			pipeline.NewVarsFrom(map[string]any{"foo": []byte(`{"data":{"result":1}}`)}),
+			nil,
+			[]byte(`{"data":{"result":1}}`),
+			nil,
+			"",
+		},
+		{
+			"wrong type",
+			priceSchema,
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"data":{"result":"3000.1"}}`}},
+			nil,
+			pipeline.ErrJSONSchemaValidation,
+			`at "/data/result": expected number, but got string`,
+		},
+		{
+			"missing property",
+			priceSchema,
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"data":{"price":3000.1}}`}},
+			nil,
+			pipeline.ErrJSONSchemaValidation,
+			`at "/data": missing properties: 'result'`,
+		},
+		{
+			"root mismatch",
+			priceSchema,
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `[]`}},
+			nil,
+			pipeline.ErrJSONSchemaValidation,
+			`at "/": expected object, but got array`,
+		},
+		{
+			"input is not JSON",
+			priceSchema,
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `<html>`}},
+			nil,
+			pipeline.ErrBadInput,
+			"input is not valid JSON",
+		},
+		{
+			"invalid schema",
+			`{"type": 42}`,
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{}`}},
+			nil,
+			pipeline.ErrBadInput,
+			"invalid schema",
+		},
+		{
+			"remote refs are not loaded",
+			`{"$ref": "https://example.com/schema.json"}`,
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{}`}},
+			nil,
+			pipeline.ErrBadInput,
+			"schema references must be local",
+		},
+		{
+			"missing schema",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{}`}},
+			nil,
+			pipeline.ErrParameterEmpty,
+			"schema",
+		},
+		{
+			"errored input",
+			priceSchema,
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Error: errors.New("uh oh")}},
+			nil,
+			pipeline.ErrTooManyErrors,
+			"task inputs",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.JSONSchemaTask{
+				BaseTask: pipeline.NewBaseTask(0, "validate", nil, nil, 0),
+				Schema:   test.schema,
+				Data:     test.data,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErrorCause != nil {
+				require.ErrorIs(t, result.Error, test.wantErrorCause)
+				require.ErrorContains(t, result.Error, test.wantErrorContains)
+				require.Nil(t, result.Value)
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.wantData, result.Value)
+			}
+		})
+	}
+}
+
+func TestJSONSchemaTask_Pipeline(t *testing.T) {
+	t.Parallel()
+
+	p, err := pipeline.Parse(`
+ds       [type=http method=GET url="https://example.invalid"]
+validate [type=jsonschema schema=<{"type":"object","properties":{"data":{"type":"object"}}}>]
+ds -> validate
+`)
+	require.NoError(t, err)
+	task, ok := p.ByDotID("validate").(*pipeline.JSONSchemaTask)
+	require.True(t, ok)
+	assert.Contains(t, task.Schema, `"data":{"type":"object"}`)
+}
@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
@@ -395,6 +396,31 @@ func (b *BoolParam) UnmarshalPipelineParam(val any) error {
 	return errors.Wrapf(ErrBadInput, "expected true or false, got %T", val)
 }
 
+type DurationParam time.Duration
+
+func (d *DurationParam) UnmarshalPipelineParam(val any) error {
+	switch v := val.(type) {
+	case time.Duration:
+		*d = DurationParam(v)
+		return nil
+	case string:
+		dur, err := time.ParseDuration(v)
+		if err != nil {
+			return errors.Wrap(ErrBadInput, err.Error())
+		}
+		*d = DurationParam(dur)
+		return nil
+	case []byte:
+		return d.UnmarshalPipelineParam(string(v))
+	}
+
+	return errors.Wrapf(ErrBadInput, "expected duration, got %T", val)
+}
+
+func (d DurationParam) Duration() time.Duration {
+	return time.Duration(d)
+}
+
 type DecimalParam decimal.Decimal
 
 func (d *DecimalParam) UnmarshalPipelineParam(val any) error {
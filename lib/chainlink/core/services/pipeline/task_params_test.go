@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -408,6 +409,32 @@ func TestBoolParam_UnmarshalPipelineParam(t *testing.T) {
 	}
 }
 
+func TestDurationParam_UnmarshalPipelineParam(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    any
+		expected any
+		err      error
+	}{
+		{"string", "150ms", pipeline.DurationParam(150 * time.Millisecond), nil},
+		{"bytes", []byte("2s"), pipeline.DurationParam(2 * time.Second), nil},
+		{"duration", time.Minute, pipeline.DurationParam(time.Minute), nil},
+		{"bad string", "soon", pipeline.DurationParam(0), pipeline.ErrBadInput},
+		{"int", 123, pipeline.DurationParam(0), pipeline.ErrBadInput},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var p pipeline.DurationParam
+			err := p.UnmarshalPipelineParam(test.input)
+			require.Equal(t, test.err, errors.Cause(err))
+			require.Equal(t, test.expected, p)
+		})
+	}
+}
+
 func TestDecimalParam_UnmarshalPipelineParam(t *testing.T) {
 	t.Parallel()
 
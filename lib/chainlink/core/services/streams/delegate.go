@@ -78,7 +78,7 @@ func (s *StreamService) Close() error {
 	return nil
 }
 
-func ValidatedStreamSpec(tomlString string) (job.Job, error) {
+func ValidatedStreamSpec(tomlString string, opts ...pipeline.ParseOption) (job.Job, error) {
 	var jb = job.Job{ExternalJobID: uuid.New()}
 
 	r := strings.NewReader(tomlString)
@@ -92,6 +92,9 @@ func ValidatedStreamSpec(tomlString string) (job.Job, error) {
 	if jb.Type != job.Stream {
 		return jb, errors.Errorf("unsupported type: %q", jb.Type)
 	}
+	if err = jb.ResolvePipeline(opts...); err != nil {
+		return jb, err
+	}
 
 	// The spec stream ID is optional, but if provided represents the final output of the pipeline run.
 	// nodes in the DAG may also contain streamID tags.
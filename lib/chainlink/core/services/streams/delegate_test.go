@@ -179,4 +179,29 @@ answer1      [type=median index=0];
 		})
 	}
 }
+
+func Test_ValidatedStreamSpec_fragments(t *testing.T) {
+	toml := `
+type              = "stream"
+name              = "voter-turnout"
+schemaVersion     = 1
+observationSource = """
+ds1       [type=bridge name=voter_turnout];
+ds1_parse [type=fragment name=turnout input="$(ds1)" streamID=12345];
+ds1 -> ds1_parse;
+"""
+`
+	fragments := pipeline.WithFragments(func(name string) (string, bool) {
+		return `p [type=jsonparse data="$(fragment.input)" path="one,two" streamID="$(fragment.streamID)"]`, name == "turnout"
+	})
+
+	jb, err := ValidatedStreamSpec(toml, fragments)
+	require.NoError(t, err)
+	require.NotNil(t, jb.Pipeline.ByDotID("ds1_parse").TaskStreamID())
+	assert.Equal(t, uint32(12345), *jb.Pipeline.ByDotID("ds1_parse").TaskStreamID())
+
+	_, err = ValidatedStreamSpec(toml)
+	require.ErrorIs(t, err, pipeline.ErrFragmentNotFound)
+}
+
 func ptr[T any](t T) *T { return &t }
@@ -3,6 +3,7 @@ package v2
 import (
 	"context"
 	"encoding/hex"
+	"maps"
 	"math/big"
 	"strings"
 	"sync"
@@ -105,6 +106,8 @@ func New(
 		aggregator:            aggregator,
 		inflightCache:         inflightCache,
 		fulfillmentLogDeduper: fulfillmentDeduper,
+		requestTracker:        newRequestTracker(vrfcommon.NewRequestORM(ds), logger.Sugared(l)),
+		subscriptionMonitor:   newSubscriptionMonitor(job.VRFSpec.SubscriptionAlertThreshold, job.VRFSpec.SubscriptionAlertWebhookURL),
 	}
 }
 
@@ -154,10 +157,18 @@ type listenerV2 struct {
 	// inflightCache is a cache of in-flight requests, used to prevent
 	// re-processing of requests that are in-flight or already fulfilled.
 	inflightCache vrfcommon.InflightCache
+
+	// requestTracker records the lifecycle of requests for inspection. Can be nil in tests.
+	requestTracker *requestTracker
+
+	// subscriptionMonitor forecasts the balances of served subscriptions. Can be nil in tests.
+	subscriptionMonitor *subscriptionMonitor
 }
 
 func (lsn *listenerV2) HealthReport() map[string]error {
-	return map[string]error{lsn.Name(): lsn.Healthy()}
+	report := map[string]error{lsn.Name(): lsn.Healthy()}
+	maps.Copy(report, lsn.subscriptionHealth())
+	return report
 }
 
 func (lsn *listenerV2) Name() string { return lsn.l.Name() }
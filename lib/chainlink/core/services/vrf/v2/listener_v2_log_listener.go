@@ -85,6 +85,8 @@ func (lsn *listenerV2) runLogListener(
 
 			// process pending requests and insert any fulfillments into the inflight cache
 			lsn.processPendingVRFRequests(ctx, pending)
+			lsn.pruneRequests(ctx)
+			lsn.checkSubscriptions()
 
 			lastProcessedBlock, err = lsn.updateLastProcessedBlock(ctx, lastProcessedBlock)
 			if err != nil {
@@ -277,9 +279,9 @@ func (lsn *listenerV2) pollLogs(ctx context.Context, minConfs uint32, lastProces
 		ll.Debugw("no unfulfilled logs found")
 	}
 
-	lsn.handleFulfilled(fulfilled)
+	lsn.handleFulfilled(ctx, fulfilled)
 
-	return lsn.handleRequested(unfulfilled, unfulfilledLP, minConfs), nil
+	return lsn.handleRequested(ctx, unfulfilled, unfulfilledLP, minConfs), nil
 }
 
 func (lsn *listenerV2) getUnfulfilled(logs []logpoller.Log, ll logger.Logger) (unfulfilled []RandomWordsRequested, unfulfilledLP []logpoller.Log, fulfilled map[string]RandomWordsFulfilled) {
@@ -361,7 +363,7 @@ func (lsn *listenerV2) getConfirmedAt(req RandomWordsRequested, nodeMinConfs uin
 	return req.Raw().BlockNumber + newConfs
 }
 
-func (lsn *listenerV2) handleFulfilled(fulfilled map[string]RandomWordsFulfilled) {
+func (lsn *listenerV2) handleFulfilled(ctx context.Context, fulfilled map[string]RandomWordsFulfilled) {
 	for _, v := range fulfilled {
 		// don't process same log over again
 		// log key includes block number and blockhash, so on re-orgs it would return true
@@ -370,6 +372,12 @@ func (lsn *listenerV2) handleFulfilled(fulfilled map[string]RandomWordsFulfilled
 			continue
 		}
 		lsn.l.Debugw("Received fulfilled log", "reqID", v.RequestID(), "success", v.Success())
+		var callbackErr error
+		if !v.Success() {
+			callbackErr = errors.New("consumer callback reverted")
+		}
+		lsn.setRequestState(ctx, v.RequestID(), vrfcommon.RequestStateFulfilled, callbackErr, nil)
+		lsn.observeSubscriptionPayment(v)
 		lsn.respCount[v.RequestID().String()]++
 		lsn.blockNumberToReqID.Insert(fulfilledReqV2{
 			blockNumber: v.Raw().BlockNumber,
@@ -378,7 +386,7 @@ func (lsn *listenerV2) handleFulfilled(fulfilled map[string]RandomWordsFulfilled
 	}
 }
 
-func (lsn *listenerV2) handleRequested(requested []RandomWordsRequested, requestedLP []logpoller.Log, minConfs uint32) (pendingRequests []pendingRequest) {
+func (lsn *listenerV2) handleRequested(ctx context.Context, requested []RandomWordsRequested, requestedLP []logpoller.Log, minConfs uint32) (pendingRequests []pendingRequest) {
 	for i, req := range requested {
 		// don't process same log over again
 		// log key includes block number and blockhash, so on re-orgs it would return true
@@ -396,6 +404,8 @@ func (lsn *listenerV2) handleRequested(requested []RandomWordsRequested, request
 			"confirmedAt", confirmedAt,
 			"subID", req.SubID(),
 			"sender", req.Sender())
+		lsn.trackRequest(ctx, req, confirmedAt)
+		lsn.observeSubscriptionRequest(req)
 		pendingRequests = append(pendingRequests, pendingRequest{
 			confirmedAtBlock: confirmedAt,
 			req:              req,
@@ -340,7 +340,11 @@ func (lsn *listenerV2) processRequestsPerSubBatchHelper(
 
 	l.Infow("Processing requests for subscription with batching")
 
-	ready, expired := lsn.getReadyAndExpired(l, reqs)
+	if subIsActive && startBalance != nil {
+		lsn.observeSubscriptionBalance(subID, nativePayment, startBalanceNoReserved)
+	}
+
+	ready, expired := lsn.getReadyAndExpired(ctx, l, reqs)
 	for _, reqID := range expired {
 		processed[reqID] = struct{}{}
 	}
@@ -363,6 +367,7 @@ func (lsn *listenerV2) processRequestsPerSubBatchHelper(
 		}
 		for i, a := range alreadyFulfilled {
 			if a {
+				lsn.setRequestState(ctx, chunk[i].req.RequestID(), vrfcommon.RequestStateFulfilled, nil, nil)
 				processed[chunk[i].req.RequestID().String()] = struct{}{}
 			} else {
 				unfulfilled = append(unfulfilled, chunk[i])
@@ -410,6 +415,7 @@ func (lsn *listenerV2) processRequestsPerSubBatchHelper(
 					// Running the blockhash store feeder in backwards mode will be required to
 					// resolve this.
 					ll.Criticalw("Pipeline error", "err", p.err)
+					lsn.setRequestState(ctx, p.req.req.RequestID(), vrfcommon.RequestStateSimulationFailed, p.err, nil)
 				} else if errors.Is(p.err, errProofVerificationFailed{}) {
 					// This occurs when the proof reverts in the simulation
 					// This is almost always (if not always) due to a proof generated with an out-of-date
@@ -417,6 +423,7 @@ func (lsn *listenerV2) processRequestsPerSubBatchHelper(
 					// we can simply mark as processed and move on, since we will eventually
 					// process the request with the right blockhash
 					ll.Infow("proof reverted in simulation, likely stale blockhash")
+					lsn.setRequestState(ctx, p.req.req.RequestID(), vrfcommon.RequestStateSimulationFailed, p.err, nil)
 					processed[p.req.req.RequestID().String()] = struct{}{}
 				} else {
 					ll.Errorw("Pipeline error", "err", p.err)
@@ -425,9 +432,11 @@ func (lsn *listenerV2) processRequestsPerSubBatchHelper(
 						etx, err := lsn.enqueueForceFulfillment(ctx, p, fromAddress)
 						if err != nil {
 							ll.Errorw("Error enqueuing force-fulfillment, re-queueing request", "err", err)
+							lsn.setRequestState(ctx, p.req.req.RequestID(), vrfcommon.RequestStateEnqueueFailed, err, nil)
 							continue
 						}
 						ll.Infow("Successfully enqueued force-fulfillment", "ethTxID", etx.ID)
+						lsn.setRequestState(ctx, p.req.req.RequestID(), vrfcommon.RequestStateEnqueued, nil, &etx.ID)
 						processed[p.req.req.RequestID().String()] = struct{}{}
 
 						// Need to put a continue here, otherwise the next if statement will be hit
@@ -439,6 +448,7 @@ func (lsn *listenerV2) processRequestsPerSubBatchHelper(
 
 					if startBalanceNoReserved.Cmp(p.fundsNeeded) < 0 && errors.Is(p.err, errPossiblyInsufficientFunds{}) {
 						ll.Infow("Insufficient balance to fulfill a request based on estimate, breaking", "err", p.err)
+						lsn.setRequestState(ctx, p.req.req.RequestID(), vrfcommon.RequestStateInsufficientFunds, p.err, nil)
 						outOfBalance = true
 
 						// break out of this inner loop to process the currently constructed batch
@@ -454,9 +464,11 @@ func (lsn *listenerV2) processRequestsPerSubBatchHelper(
 							"blockNumber", p.req.req.Raw().BlockNumber,
 							"blockHash", p.req.req.Raw().BlockHash,
 						)
+						lsn.setRequestState(ctx, p.req.req.RequestID(), vrfcommon.RequestStateInvalidConsumer, p.err, nil)
 						processed[p.req.req.RequestID().String()] = struct{}{}
 						continue
 					}
+					lsn.setRequestState(ctx, p.req.req.RequestID(), vrfcommon.RequestStateSimulationFailed, p.err, nil)
 				}
 				continue
 			}
@@ -466,6 +478,7 @@ func (lsn *listenerV2) processRequestsPerSubBatchHelper(
 				// Break out of the loop now and process what we are able to process
 				// in the constructed batches.
 				ll.Infow("Insufficient balance to fulfill a request, breaking")
+				lsn.setRequestState(ctx, p.req.req.RequestID(), vrfcommon.RequestStateInsufficientFunds, errInsufficientBalance(startBalanceNoReserved, p.maxFee), nil)
 				break
 			}
 
@@ -677,7 +690,11 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 
 	l.Infow("Processing requests for subscription")
 
-	ready, expired := lsn.getReadyAndExpired(l, reqs)
+	if subIsActive && startBalance != nil {
+		lsn.observeSubscriptionBalance(subID, nativePayment, startBalanceNoReserved)
+	}
+
+	ready, expired := lsn.getReadyAndExpired(ctx, l, reqs)
 	for _, reqID := range expired {
 		processed[reqID] = struct{}{}
 	}
@@ -697,6 +714,7 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 		}
 		for i, a := range alreadyFulfilled {
 			if a {
+				lsn.setRequestState(ctx, chunk[i].req.RequestID(), vrfcommon.RequestStateFulfilled, nil, nil)
 				processed[chunk[i].req.RequestID().String()] = struct{}{}
 			} else {
 				unfulfilled = append(unfulfilled, chunk[i])
@@ -733,6 +751,7 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 					// Running the blockhash store feeder in backwards mode will be required to
 					// resolve this.
 					ll.Criticalw("Pipeline error", "err", p.err)
+					lsn.setRequestState(ctx, p.req.req.RequestID(), vrfcommon.RequestStateSimulationFailed, p.err, nil)
 				} else if errors.Is(p.err, errProofVerificationFailed{}) {
 					// This occurs when the proof reverts in the simulation
 					// This is almost always (if not always) due to a proof generated with an out-of-date
@@ -740,6 +759,7 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 					// we can simply mark as processed and move on, since we will eventually
 					// process the request with the right blockhash
 					ll.Infow("proof reverted in simulation, likely stale blockhash")
+					lsn.setRequestState(ctx, p.req.req.RequestID(), vrfcommon.RequestStateSimulationFailed, p.err, nil)
 					processed[p.req.req.RequestID().String()] = struct{}{}
 				} else {
 					ll.Errorw("Pipeline error", "err", p.err)
@@ -749,9 +769,11 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 						etx, err2 := lsn.enqueueForceFulfillment(ctx, p, fromAddress)
 						if err2 != nil {
 							ll.Errorw("Error enqueuing force-fulfillment, re-queueing request", "err", err2)
+							lsn.setRequestState(ctx, p.req.req.RequestID(), vrfcommon.RequestStateEnqueueFailed, err2, nil)
 							continue
 						}
 						ll.Infow("Enqueued force-fulfillment", "ethTxID", etx.ID)
+						lsn.setRequestState(ctx, p.req.req.RequestID(), vrfcommon.RequestStateEnqueued, nil, &etx.ID)
 						processed[p.req.req.RequestID().String()] = struct{}{}
 
 						// Need to put a continue here, otherwise the next if statement will be hit
@@ -763,6 +785,7 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 
 					if startBalanceNoReserved.Cmp(p.fundsNeeded) < 0 {
 						ll.Infow("Insufficient balance to fulfill a request based on estimate, returning", "err", p.err)
+						lsn.setRequestState(ctx, p.req.req.RequestID(), vrfcommon.RequestStateInsufficientFunds, p.err, nil)
 						return processed
 					}
 
@@ -775,9 +798,11 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 							"blockNumber", p.req.req.Raw().BlockNumber,
 							"blockHash", p.req.req.Raw().BlockHash,
 						)
+						lsn.setRequestState(ctx, p.req.req.RequestID(), vrfcommon.RequestStateInvalidConsumer, p.err, nil)
 						processed[p.req.req.RequestID().String()] = struct{}{}
 						continue
 					}
+					lsn.setRequestState(ctx, p.req.req.RequestID(), vrfcommon.RequestStateSimulationFailed, p.err, nil)
 				}
 				continue
 			}
@@ -785,6 +810,7 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 			if startBalanceNoReserved.Cmp(p.maxFee) < 0 {
 				// Insufficient funds, have to wait for a user top up. Leave it unprocessed for now
 				ll.Infow("Insufficient balance to fulfill a request, returning")
+				lsn.setRequestState(ctx, p.req.req.RequestID(), vrfcommon.RequestStateInsufficientFunds, errInsufficientBalance(startBalanceNoReserved, p.maxFee), nil)
 				return processed
 			}
 
@@ -838,9 +864,11 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 			})
 			if err != nil {
 				ll.Errorw("Error enqueuing fulfillment, requeuing request", "err", err)
+				lsn.setRequestState(ctx, p.req.req.RequestID(), vrfcommon.RequestStateEnqueueFailed, err, nil)
 				continue
 			}
 			ll.Infow("Enqueued fulfillment", "ethTxID", transaction.GetID())
+			lsn.setRequestState(ctx, p.req.req.RequestID(), vrfcommon.RequestStateEnqueued, nil, &transaction.ID)
 
 			// If we successfully enqueued for the txm, subtract that balance
 			// And loop to attempt to enqueue another fulfillment
@@ -0,0 +1,136 @@
+package v2
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
+)
+
+const (
+	// requestRetention is how long requests are kept after their last change.
+	requestRetention = 7 * 24 * time.Hour
+	// requestPruneInterval is how often requests past retention are deleted.
+	requestPruneInterval = time.Hour
+)
+
+// requestTracker records the lifecycle of the requests seen by the listener, so
+// that operators can find out why a request was not fulfilled. It only writes
+// when something changed, and failures are logged rather than returned, so that
+// tracking never holds up fulfillment.
+type requestTracker struct {
+	orm vrfcommon.RequestORM
+	l   logger.SugaredLogger
+
+	mu        sync.Mutex
+	last      map[string]trackedRequest // by request ID
+	lastPrune time.Time
+}
+
+type trackedRequest struct {
+	state   vrfcommon.RequestState
+	err     string
+	ethTxID *int64
+	at      time.Time
+}
+
+func newRequestTracker(orm vrfcommon.RequestORM, l logger.SugaredLogger) *requestTracker {
+	return &requestTracker{
+		orm:  orm,
+		l:    l,
+		last: make(map[string]trackedRequest),
+	}
+}
+
+// trackRequest records a request the first time it is seen.
+func (lsn *listenerV2) trackRequest(ctx context.Context, req RandomWordsRequested, confirmedAt uint64) {
+	t := lsn.requestTracker
+	if t == nil {
+		return
+	}
+	reqID := req.RequestID().String()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.last[reqID]; ok {
+		return
+	}
+	err := t.orm.CreateRequest(ctx, &vrfcommon.Request{
+		JobID:              lsn.job.ID,
+		RequestID:          reqID,
+		EVMChainID:         *ubig.New(lsn.chainID),
+		CoordinatorAddress: lsn.coordinator.Address(),
+		VRFVersion:         lsn.coordinator.Version(),
+		SubID:              req.SubID().String(),
+		Sender:             req.Sender(),
+		RequestTxHash:      req.Raw().TxHash,
+		RequestBlockNumber: int64(req.Raw().BlockNumber), //nolint:gosec // block numbers fit
+		ConfirmedAtBlock:   int64(confirmedAt),           //nolint:gosec // block numbers fit
+		State:              vrfcommon.RequestStateUnconfirmed,
+	})
+	if err != nil {
+		t.l.Errorw("Failed to record VRF request", "reqID", reqID, "err", err)
+		return
+	}
+	t.last[reqID] = trackedRequest{state: vrfcommon.RequestStateUnconfirmed, at: time.Now()}
+}
+
+// setRequestState records a change of state of a request, with the error that
+// caused it and the fulfillment transaction, if any.
+func (lsn *listenerV2) setRequestState(ctx context.Context, reqID *big.Int, state vrfcommon.RequestState, cause error, ethTxID *int64) {
+	t := lsn.requestTracker
+	if t == nil {
+		return
+	}
+	var errStr string
+	if cause != nil {
+		errStr = cause.Error()
+	}
+	id := reqID.String()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if last, ok := t.last[id]; ok && last.state == state && last.err == errStr && (ethTxID == nil || last.ethTxID != nil && *last.ethTxID == *ethTxID) {
+		return
+	}
+	if err := t.orm.UpdateRequestState(ctx, lsn.job.ID, id, state, errStr, ethTxID); err != nil {
+		t.l.Errorw("Failed to record VRF request state", "reqID", id, "state", state, "err", err)
+		return
+	}
+	next := trackedRequest{state: state, err: errStr, ethTxID: ethTxID, at: time.Now()}
+	if ethTxID == nil {
+		next.ethTxID = t.last[id].ethTxID
+	}
+	t.last[id] = next
+}
+
+// pruneRequests deletes requests past retention, at most once per requestPruneInterval.
+func (lsn *listenerV2) pruneRequests(ctx context.Context) {
+	t := lsn.requestTracker
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if time.Since(t.lastPrune) < requestPruneInterval {
+		return
+	}
+	t.lastPrune = time.Now()
+	// requests are not redelivered after the request timeout, so there is no need to remember them
+	for id, r := range t.last {
+		if time.Since(r.at) > lsn.job.VRFSpec.RequestTimeout {
+			delete(t.last, id)
+		}
+	}
+	deleted, err := t.orm.DeleteRequestsOlderThan(ctx, lsn.job.ID, time.Now().Add(-requestRetention))
+	if err != nil {
+		t.l.Errorw("Failed to prune VRF requests", "err", err)
+		return
+	}
+	if deleted > 0 {
+		t.l.Debugw("Pruned VRF requests", "deleted", deleted)
+	}
+}
@@ -0,0 +1,234 @@
+package v2
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
+)
+
+// subscriptionWebhookTimeout bounds each post to the subscription alert webhook.
+const subscriptionWebhookTimeout = 10 * time.Second
+
+// subscriptionMonitor forecasts when the subscriptions served by the listener will
+// run dry, and raises alerts through metrics, the health report and an optional
+// webhook. Like the request tracker, it never holds up fulfillment.
+type subscriptionMonitor struct {
+	forecaster *vrfcommon.SubscriptionForecaster
+	webhookURL string
+	client     *http.Client
+
+	mu          sync.Mutex
+	requestSubs map[string]requestSub // by request ID, V2 fulfillment logs do not carry the subscription
+	alerts      map[string]error      // by subscription and currency
+	reported    map[string]vrfcommon.SubscriptionForecast
+}
+
+type requestSub struct {
+	subID  *big.Int
+	native bool
+	at     time.Time
+}
+
+// subscriptionAlert is the body posted to the webhook when an alert is raised or cleared.
+type subscriptionAlert struct {
+	JobID              int32     `json:"jobID"`
+	ExternalJobID      string    `json:"externalJobID"`
+	JobName            string    `json:"jobName"`
+	EVMChainID         string    `json:"evmChainID"`
+	CoordinatorAddress string    `json:"coordinatorAddress"`
+	SubID              string    `json:"subID"`
+	Currency           string    `json:"currency"`
+	Balance            string    `json:"balance"`
+	AveragePayment     *string   `json:"averagePayment"`
+	BurnRatePerHour    *string   `json:"burnRatePerHour"`
+	SecondsUntilEmpty  *float64  `json:"secondsUntilEmpty"`
+	Alerting           bool      `json:"alerting"`
+	Message            string    `json:"message"`
+	ObservedAt         time.Time `json:"observedAt"`
+}
+
+func newSubscriptionMonitor(threshold time.Duration, webhookURL string) *subscriptionMonitor {
+	return &subscriptionMonitor{
+		forecaster:  vrfcommon.NewSubscriptionForecaster(threshold),
+		webhookURL:  webhookURL,
+		client:      &http.Client{Timeout: subscriptionWebhookTimeout},
+		requestSubs: make(map[string]requestSub),
+		alerts:      make(map[string]error),
+		reported:    make(map[string]vrfcommon.SubscriptionForecast),
+	}
+}
+
+func subscriptionName(subID string, currency string) string {
+	return fmt.Sprintf("Subscription.%s.%s", subID, currency)
+}
+
+// observeSubscriptionRequest remembers the subscription paying for a request, so
+// that its fulfillment can be charged to it.
+func (lsn *listenerV2) observeSubscriptionRequest(req RandomWordsRequested) {
+	m := lsn.subscriptionMonitor
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requestSubs[req.RequestID().String()] = requestSub{subID: req.SubID(), native: req.NativePayment(), at: time.Now()}
+}
+
+// observeSubscriptionBalance records the balance of a subscription, less what is
+// reserved for in-flight fulfillments.
+func (lsn *listenerV2) observeSubscriptionBalance(subID *big.Int, native bool, balance *big.Int) {
+	m := lsn.subscriptionMonitor
+	if m == nil || balance == nil {
+		return
+	}
+	m.forecaster.ObserveBalance(subID, native, balance, time.Now())
+}
+
+// observeSubscriptionPayment charges the payment for a fulfillment to its subscription.
+func (lsn *listenerV2) observeSubscriptionPayment(fulfilled RandomWordsFulfilled) {
+	m := lsn.subscriptionMonitor
+	if m == nil || fulfilled.Payment() == nil {
+		return
+	}
+	m.mu.Lock()
+	sub, ok := m.requestSubs[fulfilled.RequestID().String()]
+	delete(m.requestSubs, fulfilled.RequestID().String())
+	m.mu.Unlock()
+	if !ok {
+		if lsn.coordinator.Version() != vrfcommon.V2Plus {
+			return
+		}
+		sub = requestSub{subID: fulfilled.SubID(), native: fulfilled.NativePayment()}
+	}
+	m.forecaster.ObservePayment(sub.subID, sub.native, fulfilled.Payment(), time.Now())
+}
+
+// checkSubscriptions updates the forecast metrics, and raises or clears alerts
+// for the subscriptions served by the listener.
+func (lsn *listenerV2) checkSubscriptions() {
+	m := lsn.subscriptionMonitor
+	if m == nil {
+		return
+	}
+	jobName := lsn.job.Name.ValueOrZero()
+	version := lsn.coordinator.Version()
+	forecasts := m.forecaster.Forecasts(time.Now())
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	// requests are not redelivered after the request timeout, so there is no need to remember them
+	for id, sub := range m.requestSubs {
+		if time.Since(sub.at) > lsn.job.VRFSpec.RequestTimeout {
+			delete(m.requestSubs, id)
+		}
+	}
+
+	seen := make(map[string]struct{}, len(forecasts))
+	for _, fc := range forecasts {
+		name := subscriptionName(fc.SubID, fc.Currency())
+		seen[name] = struct{}{}
+		vrfcommon.UpdateSubscriptionForecast(jobName, lsn.job.ExternalJobID, version, fc)
+		m.reported[name] = fc
+
+		_, alerting := m.alerts[name]
+		switch {
+		case fc.Alert != nil && !alerting:
+			lsn.l.Warnw("VRF subscription is running low", "subID", fc.SubID, "currency", fc.Currency(), "err", fc.Alert)
+			lsn.postSubscriptionAlert(fc, true, fc.Alert.Error())
+		case fc.Alert == nil && alerting:
+			lsn.l.Infow("VRF subscription is no longer running low", "subID", fc.SubID, "currency", fc.Currency())
+			lsn.postSubscriptionAlert(fc, false, fmt.Sprintf("subscription %s is no longer running low on %s", fc.SubID, fc.Currency()))
+		}
+		if fc.Alert != nil {
+			m.alerts[name] = fc.Alert
+		} else {
+			delete(m.alerts, name)
+		}
+	}
+	for name, fc := range m.reported {
+		if _, ok := seen[name]; !ok {
+			vrfcommon.DeleteSubscriptionForecast(jobName, lsn.job.ExternalJobID, version, fc.SubID, fc.Currency())
+			delete(m.reported, name)
+			delete(m.alerts, name)
+		}
+	}
+}
+
+// subscriptionHealth returns the raised alerts, keyed for the health report.
+func (lsn *listenerV2) subscriptionHealth() map[string]error {
+	m := lsn.subscriptionMonitor
+	if m == nil {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	report := make(map[string]error, len(m.alerts))
+	for name, err := range m.alerts {
+		report[lsn.Name()+"."+name] = err
+	}
+	return report
+}
+
+// postSubscriptionAlert posts to the webhook of the job, if any, in the background.
+func (lsn *listenerV2) postSubscriptionAlert(fc vrfcommon.SubscriptionForecast, alerting bool, message string) {
+	m := lsn.subscriptionMonitor
+	if m.webhookURL == "" {
+		return
+	}
+	alert := subscriptionAlert{
+		JobID:              lsn.job.ID,
+		ExternalJobID:      lsn.job.ExternalJobID.String(),
+		JobName:            lsn.job.Name.ValueOrZero(),
+		EVMChainID:         lsn.chainID.String(),
+		CoordinatorAddress: lsn.coordinator.Address().Hex(),
+		SubID:              fc.SubID,
+		Currency:           fc.Currency(),
+		Balance:            fc.Balance.String(),
+		Alerting:           alerting,
+		Message:            message,
+		ObservedAt:         fc.ObservedAt,
+	}
+	if fc.AveragePayment != nil {
+		avg := fc.AveragePayment.String()
+		alert.AveragePayment = &avg
+	}
+	if fc.BurnRatePerHour != nil {
+		rate := fc.BurnRatePerHour.String()
+		alert.BurnRatePerHour = &rate
+		secs := fc.TimeUntilEmpty.Seconds()
+		alert.SecondsUntilEmpty = &secs
+	}
+	body, err := json.Marshal(alert)
+	if err != nil {
+		lsn.l.Errorw("Failed to encode VRF subscription alert", "subID", fc.SubID, "err", err)
+		return
+	}
+
+	lsn.wg.Add(1)
+	go func() {
+		defer lsn.wg.Done()
+		ctx, cancel := lsn.chStop.CtxWithTimeout(subscriptionWebhookTimeout)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.webhookURL, bytes.NewReader(body))
+		if err != nil {
+			lsn.l.Errorw("Failed to create VRF subscription alert request", "subID", fc.SubID, "err", err)
+			return
+		}
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := m.client.Do(req)
+		if err != nil {
+			lsn.l.Errorw("Failed to post VRF subscription alert", "subID", fc.SubID, "err", err)
+			return
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode >= 300 {
+			lsn.l.Errorw("VRF subscription alert webhook returned an error", "subID", fc.SubID, "status", resp.Status)
+		}
+	}()
+}
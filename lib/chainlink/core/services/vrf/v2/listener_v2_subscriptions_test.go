@@ -0,0 +1,97 @@
+package v2
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-evm/gethwrappers/generated/vrf_coordinator_v2"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+func TestListener_SubscriptionAlerts(t *testing.T) {
+	t.Parallel()
+
+	alerts := make(chan subscriptionAlert, 2)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var alert subscriptionAlert
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&alert))
+		alerts <- alert
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := vrf_coordinator_v2.NewVRFCoordinatorV2(testutils.NewAddress(), nil)
+	require.NoError(t, err)
+	lsn := &listenerV2{
+		l:           logger.Sugared(logger.Test(t)),
+		chainID:     testutils.FixtureChainID,
+		coordinator: NewCoordinatorV2(c),
+		job: job.Job{
+			ID:            1,
+			ExternalJobID: uuid.New(),
+			VRFSpec:       &job.VRFSpec{RequestTimeout: time.Hour},
+		},
+		wg:                  &sync.WaitGroup{},
+		chStop:              make(chan struct{}),
+		subscriptionMonitor: newSubscriptionMonitor(24*time.Hour, srv.URL),
+	}
+	t.Cleanup(func() {
+		close(lsn.chStop)
+		lsn.wg.Wait()
+	})
+
+	subID := big.NewInt(5)
+	lsn.observeSubscriptionRequest(NewV2RandomWordsRequested(&vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{
+		RequestId: big.NewInt(1),
+		SubId:     subID.Uint64(),
+	}))
+	lsn.observeSubscriptionPayment(NewV2RandomWordsFulfilled(&vrf_coordinator_v2.VRFCoordinatorV2RandomWordsFulfilled{
+		RequestId: big.NewInt(1),
+		Payment:   big.NewInt(100),
+		Success:   true,
+	}))
+	// a fulfillment for a request which was not seen cannot be attributed on V2
+	lsn.observeSubscriptionPayment(NewV2RandomWordsFulfilled(&vrf_coordinator_v2.VRFCoordinatorV2RandomWordsFulfilled{
+		RequestId: big.NewInt(2),
+		Payment:   big.NewInt(100),
+	}))
+
+	lsn.observeSubscriptionBalance(subID, false, big.NewInt(50))
+	lsn.checkSubscriptions()
+
+	health := lsn.HealthReport()
+	require.Len(t, health, 2)
+	require.Error(t, health[lsn.Name()+".Subscription.5.LINK"])
+	assert.Contains(t, health[lsn.Name()+".Subscription.5.LINK"].Error(), "less than the average recent fulfillment cost of 100")
+
+	alert := <-alerts
+	assert.True(t, alert.Alerting)
+	assert.Equal(t, "5", alert.SubID)
+	assert.Equal(t, "LINK", alert.Currency)
+	assert.Equal(t, "50", alert.Balance)
+	require.NotNil(t, alert.AveragePayment)
+	assert.Equal(t, "100", *alert.AveragePayment)
+
+	// no repeated alert while the subscription keeps running low
+	lsn.checkSubscriptions()
+
+	lsn.observeSubscriptionBalance(subID, false, big.NewInt(1_000_000))
+	lsn.checkSubscriptions()
+	assert.Len(t, lsn.HealthReport(), 1)
+
+	alert = <-alerts
+	assert.False(t, alert.Alerting)
+	assert.Equal(t, "1000000", alert.Balance)
+	assert.Empty(t, alerts)
+}
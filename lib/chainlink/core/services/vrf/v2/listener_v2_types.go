@@ -1,6 +1,7 @@
 package v2
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"time"
@@ -34,6 +35,11 @@ func (errProofVerificationFailed) Error() string {
 	return "Proof verification failed"
 }
 
+// errInsufficientBalance is recorded for a request that the subscription cannot pay for at the max gas price.
+func errInsufficientBalance(balance, maxFee *big.Int) error {
+	return fmt.Errorf("subscription balance %s, after reserving funds for in-flight fulfillments, is less than the max fee %s", balance, maxFee)
+}
+
 type fulfilledReqV2 struct {
 	blockNumber uint64
 	reqID       string
@@ -259,6 +265,9 @@ func (lsn *listenerV2) processBatch(
 	})
 	if err != nil {
 		ll.Errorw("Error enqueuing batch fulfillments, requeuing requests", "err", err)
+		for _, reqID := range batch.reqIDs {
+			lsn.setRequestState(ctx, reqID, vrfcommon.RequestStateEnqueueFailed, err, nil)
+		}
 		return
 	}
 	ll.Infow("Enqueued fulfillment", "ethTxID", ethTX.GetID())
@@ -266,6 +275,7 @@ func (lsn *listenerV2) processBatch(
 	// mark requests as processed since the fulfillment has been successfully enqueued
 	// to the txm.
 	for _, reqID := range batch.reqIDs {
+		lsn.setRequestState(ctx, reqID, vrfcommon.RequestStateEnqueued, nil, &ethTX.ID)
 		processedRequestIDs = append(processedRequestIDs, reqID.String())
 		vrfcommon.IncProcessedReqs(lsn.job.Name.ValueOrZero(), lsn.job.ExternalJobID, vrfcommon.V2)
 	}
@@ -277,7 +287,7 @@ func (lsn *listenerV2) processBatch(
 
 // getReadyAndExpired filters out requests that are expired from the given pendingRequest slice
 // and returns requests that are ready for processing.
-func (lsn *listenerV2) getReadyAndExpired(l logger.Logger, reqs []pendingRequest) (ready []pendingRequest, expired []string) {
+func (lsn *listenerV2) getReadyAndExpired(ctx context.Context, l logger.Logger, reqs []pendingRequest) (ready []pendingRequest, expired []string) {
 	for _, req := range reqs {
 		// Check if we can ignore the request due to its age.
 		if time.Now().UTC().Sub(req.utcTimestamp) >= lsn.job.VRFSpec.RequestTimeout {
@@ -285,6 +295,7 @@ func (lsn *listenerV2) getReadyAndExpired(l logger.Logger, reqs []pendingRequest
 				"reqID", req.req.RequestID().String(),
 				"txHash", req.req.Raw().TxHash)
 			expired = append(expired, req.req.RequestID().String())
+			lsn.setRequestState(ctx, req.req.RequestID(), vrfcommon.RequestStateExpired, nil, nil)
 			vrfcommon.IncDroppedReqs(lsn.job.Name.ValueOrZero(), lsn.job.ExternalJobID, vrfcommon.V2, vrfcommon.ReasonAge)
 			continue
 		}
@@ -1,6 +1,7 @@
 package vrfcommon
 
 import (
+	"math/big"
 	"time"
 
 	"github.com/google/uuid"
@@ -73,6 +74,26 @@ var (
 			float64(5 * time.Minute),
 		},
 	}, []string{"job_name", "external_job_id", "vrf_version"})
+
+	MetricSubscriptionBalance = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "vrf_subscription_balance",
+		Help: "The last observed balance of a subscription served by a VRF job, less what is reserved for in-flight fulfillments.",
+	}, []string{"job_name", "external_job_id", "vrf_version", "sub_id", "currency"})
+
+	MetricSubscriptionBurnRate = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "vrf_subscription_burn_rate_per_hour",
+		Help: "The amount paid per hour for the recent fulfillments of a subscription served by a VRF job.",
+	}, []string{"job_name", "external_job_id", "vrf_version", "sub_id", "currency"})
+
+	MetricSubscriptionTimeUntilEmpty = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "vrf_subscription_seconds_until_empty",
+		Help: "How long until a subscription served by a VRF job is forecast to run out of funds, at its recent burn rate.",
+	}, []string{"job_name", "external_job_id", "vrf_version", "sub_id", "currency"})
+
+	MetricSubscriptionLowBalance = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "vrf_subscription_low_balance",
+		Help: "Set to 1 while a subscription served by a VRF job is forecast to run out of funds within the alert threshold of the job.",
+	}, []string{"job_name", "external_job_id", "vrf_version", "sub_id", "currency"})
 )
 
 func UpdateQueueSize(jobName string, extJobID uuid.UUID, vrfVersion Version, size int) {
@@ -92,3 +113,30 @@ func IncDroppedReqs(jobName string, extJobID uuid.UUID, vrfVersion Version, reas
 func IncDupeReqs(jobName string, extJobID uuid.UUID, vrfVersion Version) {
 	MetricDupeRequests.WithLabelValues(jobName, extJobID.String(), string(vrfVersion)).Inc()
 }
+
+func UpdateSubscriptionForecast(jobName string, extJobID uuid.UUID, vrfVersion Version, fc SubscriptionForecast) {
+	labels := []string{jobName, extJobID.String(), string(vrfVersion), fc.SubID, fc.Currency()}
+	balance, _ := new(big.Float).SetInt(fc.Balance).Float64()
+	MetricSubscriptionBalance.WithLabelValues(labels...).Set(balance)
+	if fc.BurnRatePerHour != nil {
+		rate, _ := new(big.Float).SetInt(fc.BurnRatePerHour).Float64()
+		MetricSubscriptionBurnRate.WithLabelValues(labels...).Set(rate)
+		MetricSubscriptionTimeUntilEmpty.WithLabelValues(labels...).Set(fc.TimeUntilEmpty.Seconds())
+	} else {
+		MetricSubscriptionBurnRate.DeleteLabelValues(labels...)
+		MetricSubscriptionTimeUntilEmpty.DeleteLabelValues(labels...)
+	}
+	var low float64
+	if fc.Alert != nil {
+		low = 1
+	}
+	MetricSubscriptionLowBalance.WithLabelValues(labels...).Set(low)
+}
+
+func DeleteSubscriptionForecast(jobName string, extJobID uuid.UUID, vrfVersion Version, subID string, currency string) {
+	labels := []string{jobName, extJobID.String(), string(vrfVersion), subID, currency}
+	MetricSubscriptionBalance.DeleteLabelValues(labels...)
+	MetricSubscriptionBurnRate.DeleteLabelValues(labels...)
+	MetricSubscriptionTimeUntilEmpty.DeleteLabelValues(labels...)
+	MetricSubscriptionLowBalance.DeleteLabelValues(labels...)
+}
@@ -0,0 +1,170 @@
+package vrfcommon
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+)
+
+// RequestState is the state of a VRF request, as last seen by the listener of a job.
+type RequestState string
+
+const (
+	// RequestStateUnconfirmed means the request is waiting for its block to be confirmed.
+	RequestStateUnconfirmed RequestState = "unconfirmed"
+	// RequestStateInsufficientFunds means the subscription cannot pay for the fulfillment
+	// until it is topped up.
+	RequestStateInsufficientFunds RequestState = "insufficient_funds"
+	// RequestStateSimulationFailed means the fulfillment reverted in simulation, it will be retried.
+	RequestStateSimulationFailed RequestState = "simulation_failed"
+	// RequestStateInvalidConsumer means the request was dropped because its sender is not a contract.
+	RequestStateInvalidConsumer RequestState = "invalid_consumer"
+	// RequestStateEnqueueFailed means the fulfillment transaction could not be created, it will be retried.
+	RequestStateEnqueueFailed RequestState = "enqueue_failed"
+	// RequestStateEnqueued means the fulfillment transaction was handed to the transaction manager.
+	RequestStateEnqueued RequestState = "enqueued"
+	// RequestStateFulfilled means the fulfillment was seen on chain.
+	RequestStateFulfilled RequestState = "fulfilled"
+	// RequestStateExpired means the request was dropped for being older than the job's request timeout.
+	RequestStateExpired RequestState = "expired"
+)
+
+// Request is a VRF request seen by the listener of a job.
+type Request struct {
+	ID                 int64
+	JobID              int32
+	RequestID          string // decimal
+	EVMChainID         ubig.Big
+	CoordinatorAddress common.Address
+	VRFVersion         Version
+	SubID              string
+	Sender             common.Address
+	RequestTxHash      common.Hash
+	RequestBlockNumber int64
+	ConfirmedAtBlock   int64
+	State              RequestState
+	LastError          string
+	EthTxID            *int64
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+
+	// The state of the linked fulfillment transaction, only set by FindRequests.
+	EthTxState *string
+	EthTxError *string
+	EthTxHash  *common.Hash
+}
+
+// RequestTransition is a change of state, error or transaction of a Request.
+type RequestTransition struct {
+	ID           int64
+	VRFRequestID int64
+	State        RequestState
+	Error        string
+	EthTxID      *int64
+	CreatedAt    time.Time
+}
+
+// RequestORM persists the lifecycle of VRF requests.
+type RequestORM interface {
+	// CreateRequest records a request in its initial state, unless the job has seen it already.
+	CreateRequest(ctx context.Context, r *Request) error
+	// UpdateRequestState records a transition of the request, if its state, error or
+	// transaction changed. A nil ethTxID keeps the transaction already linked.
+	UpdateRequestState(ctx context.Context, jobID int32, requestID string, state RequestState, lastError string, ethTxID *int64) error
+	// FindRequests returns the request with the given decimal ID, once for every job that has seen it.
+	FindRequests(ctx context.Context, requestID string) ([]Request, error)
+	FindRequestTransitions(ctx context.Context, vrfRequestID int64) ([]RequestTransition, error)
+	// DeleteRequestsOlderThan deletes the requests of the job which have not changed since before.
+	DeleteRequestsOlderThan(ctx context.Context, jobID int32, before time.Time) (int64, error)
+}
+
+type requestORM struct {
+	ds sqlutil.DataSource
+}
+
+var _ RequestORM = (*requestORM)(nil)
+
+func NewRequestORM(ds sqlutil.DataSource) RequestORM {
+	return &requestORM{ds: ds}
+}
+
+func (o *requestORM) CreateRequest(ctx context.Context, r *Request) error {
+	now := time.Now()
+	// A request which is seen again, for example after a restart, keeps its recorded state.
+	_, err := o.ds.ExecContext(ctx, `WITH inserted AS (
+	INSERT INTO vrf_requests (job_id, request_id, evm_chain_id, coordinator_address, vrf_version, sub_id, sender,
+		request_tx_hash, request_block_number, confirmed_at_block, state, last_error, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $13)
+	ON CONFLICT (job_id, request_id) DO NOTHING
+	RETURNING id, state, last_error, created_at
+)
+INSERT INTO vrf_request_transitions (vrf_request_id, state, error, created_at)
+SELECT id, state, last_error, created_at FROM inserted`,
+		r.JobID, r.RequestID, r.EVMChainID, r.CoordinatorAddress, r.VRFVersion, r.SubID, r.Sender,
+		r.RequestTxHash, r.RequestBlockNumber, r.ConfirmedAtBlock, r.State, r.LastError, now)
+	return errors.Wrap(err, "failed to create vrf request")
+}
+
+func (o *requestORM) UpdateRequestState(ctx context.Context, jobID int32, requestID string, state RequestState, lastError string, ethTxID *int64) error {
+	_, err := o.ds.ExecContext(ctx, `WITH updated AS (
+	UPDATE vrf_requests SET state = $3, last_error = $4, eth_tx_id = COALESCE($5, eth_tx_id), updated_at = $6
+	WHERE job_id = $1 AND request_id = $2
+	AND (state <> $3 OR last_error <> $4 OR eth_tx_id IS DISTINCT FROM COALESCE($5, eth_tx_id))
+	RETURNING id, state, last_error, eth_tx_id, updated_at
+)
+INSERT INTO vrf_request_transitions (vrf_request_id, state, error, eth_tx_id, created_at)
+SELECT id, state, last_error, eth_tx_id, updated_at FROM updated`,
+		jobID, requestID, state, lastError, ethTxID, time.Now())
+	return errors.Wrap(err, "failed to update vrf request state")
+}
+
+func (o *requestORM) FindRequests(ctx context.Context, requestID string) (requests []Request, err error) {
+	// the transaction is linked through the attempt which got a receipt, or else the latest attempt
+	err = o.ds.SelectContext(ctx, &requests, `SELECT r.*, t.state::text AS eth_tx_state, t.error AS eth_tx_error, a.hash AS eth_tx_hash
+FROM vrf_requests r
+LEFT JOIN evm.txes t ON t.id = r.eth_tx_id
+LEFT JOIN LATERAL (
+	SELECT hash FROM evm.tx_attempts
+	WHERE eth_tx_id = r.eth_tx_id
+	ORDER BY EXISTS (SELECT 1 FROM evm.receipts WHERE tx_hash = evm.tx_attempts.hash) DESC, id DESC
+	LIMIT 1
+) a ON true
+WHERE r.request_id = $1
+ORDER BY r.id`, requestID)
+	return requests, errors.Wrap(err, "failed to find vrf requests")
+}
+
+func (o *requestORM) FindRequestTransitions(ctx context.Context, vrfRequestID int64) (transitions []RequestTransition, err error) {
+	err = o.ds.SelectContext(ctx, &transitions, `SELECT * FROM vrf_request_transitions WHERE vrf_request_id = $1 ORDER BY id`, vrfRequestID)
+	return transitions, errors.Wrap(err, "failed to find vrf request transitions")
+}
+
+func (o *requestORM) DeleteRequestsOlderThan(ctx context.Context, jobID int32, before time.Time) (int64, error) {
+	res, err := o.ds.ExecContext(ctx, `DELETE FROM vrf_requests WHERE job_id = $1 AND updated_at < $2`, jobID, before)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to delete vrf requests")
+	}
+	return res.RowsAffected()
+}
+
+// ParseRequestID parses a request ID given in decimal or as 0x prefixed hex, and
+// returns it in decimal, as it is stored.
+func ParseRequestID(s string) (string, error) {
+	id, ok := new(big.Int), false
+	if hex, isHex := strings.CutPrefix(strings.ToLower(s), "0x"); isHex {
+		_, ok = id.SetString(hex, 16)
+	} else {
+		_, ok = id.SetString(s, 10)
+	}
+	if !ok || id.Sign() < 0 {
+		return "", errors.Errorf("invalid request ID %q, expected a decimal or 0x prefixed hex number", s)
+	}
+	return id.String(), nil
+}
@@ -0,0 +1,118 @@
+package vrfcommon_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr/txmgrtest"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
+)
+
+func TestRequestORM(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := vrfcommon.NewRequestORM(db)
+	jb, _ := cltest.MustInsertWebhookSpec(t, db)
+
+	req := vrfcommon.Request{
+		JobID:              jb.ID,
+		RequestID:          "1234",
+		EVMChainID:         *ubig.New(testutils.FixtureChainID),
+		CoordinatorAddress: testutils.NewAddress(),
+		VRFVersion:         vrfcommon.V2Plus,
+		SubID:              "42",
+		Sender:             testutils.NewAddress(),
+		RequestTxHash:      testutils.Random32Byte(),
+		RequestBlockNumber: 10,
+		ConfirmedAtBlock:   13,
+		State:              vrfcommon.RequestStateUnconfirmed,
+	}
+	require.NoError(t, orm.CreateRequest(ctx, &req))
+	// seeing the request again does not reset it
+	require.NoError(t, orm.UpdateRequestState(ctx, jb.ID, "1234", vrfcommon.RequestStateInsufficientFunds, "balance too low", nil))
+	require.NoError(t, orm.CreateRequest(ctx, &req))
+	// unchanged states are not recorded again
+	require.NoError(t, orm.UpdateRequestState(ctx, jb.ID, "1234", vrfcommon.RequestStateInsufficientFunds, "balance too low", nil))
+
+	txStore := txmgrtest.NewTestTxStore(t, db)
+	_, fromAddress := cltest.MustInsertRandomKey(t, cltest.NewKeyStore(t, db).Eth())
+	etx := txmgrtest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 0, fromAddress)
+	require.NoError(t, orm.UpdateRequestState(ctx, jb.ID, "1234", vrfcommon.RequestStateEnqueued, "", &etx.ID))
+	require.NoError(t, orm.UpdateRequestState(ctx, jb.ID, "1234", vrfcommon.RequestStateFulfilled, "", nil))
+
+	requests, err := orm.FindRequests(ctx, "1234")
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+	found := requests[0]
+	assert.Equal(t, jb.ID, found.JobID)
+	assert.Equal(t, req.CoordinatorAddress, found.CoordinatorAddress)
+	assert.Equal(t, req.Sender, found.Sender)
+	assert.Equal(t, req.RequestTxHash, found.RequestTxHash)
+	assert.Equal(t, "42", found.SubID)
+	assert.Equal(t, vrfcommon.V2Plus, found.VRFVersion)
+	assert.Equal(t, vrfcommon.RequestStateFulfilled, found.State)
+	assert.Empty(t, found.LastError)
+	require.NotNil(t, found.EthTxID)
+	assert.Equal(t, etx.ID, *found.EthTxID)
+	require.NotNil(t, found.EthTxState)
+	assert.Equal(t, "unconfirmed", *found.EthTxState)
+	require.NotNil(t, found.EthTxHash)
+	assert.Equal(t, etx.TxAttempts[0].Hash, *found.EthTxHash)
+
+	transitions, err := orm.FindRequestTransitions(ctx, found.ID)
+	require.NoError(t, err)
+	var states []vrfcommon.RequestState
+	for _, tr := range transitions {
+		states = append(states, tr.State)
+	}
+	assert.Equal(t, []vrfcommon.RequestState{
+		vrfcommon.RequestStateUnconfirmed,
+		vrfcommon.RequestStateInsufficientFunds,
+		vrfcommon.RequestStateEnqueued,
+		vrfcommon.RequestStateFulfilled,
+	}, states)
+	assert.Equal(t, "balance too low", transitions[1].Error)
+	assert.Equal(t, &etx.ID, transitions[3].EthTxID)
+
+	requests, err = orm.FindRequests(ctx, "1")
+	require.NoError(t, err)
+	assert.Empty(t, requests)
+
+	deleted, err := orm.DeleteRequestsOlderThan(ctx, jb.ID, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Zero(t, deleted)
+	deleted, err = orm.DeleteRequestsOlderThan(ctx, jb.ID, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	transitions, err = orm.FindRequestTransitions(ctx, found.ID)
+	require.NoError(t, err)
+	assert.Empty(t, transitions)
+}
+
+func TestParseRequestID(t *testing.T) {
+	t.Parallel()
+
+	for in, expected := range map[string]string{
+		"1234":   "1234",
+		"0x4d2":  "1234",
+		"0X04D2": "1234",
+	} {
+		id, err := vrfcommon.ParseRequestID(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, expected, id, in)
+	}
+	for _, in := range []string{"", "0x", "-1", "12ab", "0xzz"} {
+		_, err := vrfcommon.ParseRequestID(in)
+		require.Error(t, err, in)
+	}
+}
@@ -0,0 +1,185 @@
+package vrfcommon
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// ForecastWindow is how far back payments are taken into account when computing the burn rate of a subscription.
+	ForecastWindow = 24 * time.Hour
+	// forecastMinSpan is the minimum time a subscription must have been observed for before its burn rate is trusted.
+	forecastMinSpan = time.Hour
+)
+
+// SubscriptionForecast is the forecast for the LINK or native balance of a subscription.
+type SubscriptionForecast struct {
+	SubID         string
+	NativePayment bool
+	// Balance is the last observed balance, less what is reserved for in-flight fulfillments.
+	Balance    *big.Int
+	ObservedAt time.Time
+	// Payments is the number of fulfillments paid for within the forecast window.
+	Payments int
+	// AveragePayment is nil if no fulfillment was paid for within the forecast window.
+	AveragePayment *big.Int
+	// BurnRatePerHour is nil until the subscription has been observed for long enough.
+	BurnRatePerHour *big.Int
+	// TimeUntilEmpty is nil if there is no burn rate.
+	TimeUntilEmpty *time.Duration
+	// Alert is set when the balance is forecast to run out within the alert threshold,
+	// or cannot pay for a fulfillment at the average recent cost.
+	Alert error
+}
+
+// Currency returns the name of the currency of the balance.
+func (f SubscriptionForecast) Currency() string {
+	if f.NativePayment {
+		return "native"
+	}
+	return "LINK"
+}
+
+type subscriptionKey struct {
+	subID  string
+	native bool
+}
+
+type payment struct {
+	at     time.Time
+	amount *big.Int
+}
+
+type subscriptionHistory struct {
+	firstSeen  time.Time
+	balance    *big.Int
+	observedAt time.Time
+	payments   []payment
+}
+
+// SubscriptionForecaster tracks the balances of the subscriptions served by a job against the
+// payments for their recent fulfillments, and forecasts when each will run dry. It is safe for
+// concurrent use.
+type SubscriptionForecaster struct {
+	threshold time.Duration
+
+	mu   sync.Mutex
+	subs map[subscriptionKey]*subscriptionHistory
+}
+
+// NewSubscriptionForecaster returns a forecaster which alerts once a balance is forecast to run out
+// within threshold. A zero threshold disables alerts.
+func NewSubscriptionForecaster(threshold time.Duration) *SubscriptionForecaster {
+	return &SubscriptionForecaster{
+		threshold: threshold,
+		subs:      make(map[subscriptionKey]*subscriptionHistory),
+	}
+}
+
+func (f *SubscriptionForecaster) history(subID *big.Int, native bool, now time.Time) *subscriptionHistory {
+	key := subscriptionKey{subID: subID.String(), native: native}
+	h, ok := f.subs[key]
+	if !ok {
+		h = &subscriptionHistory{firstSeen: now}
+		f.subs[key] = h
+	}
+	return h
+}
+
+// ObserveBalance records the balance of a subscription, less what is reserved for in-flight fulfillments.
+func (f *SubscriptionForecaster) ObserveBalance(subID *big.Int, native bool, balance *big.Int, now time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	h := f.history(subID, native, now)
+	h.balance = new(big.Int).Set(balance)
+	h.observedAt = now
+}
+
+// ObservePayment records the payment for a fulfillment charged to a subscription.
+func (f *SubscriptionForecaster) ObservePayment(subID *big.Int, native bool, amount *big.Int, now time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	h := f.history(subID, native, now)
+	h.payments = append(h.payments, payment{at: now, amount: new(big.Int).Set(amount)})
+}
+
+// Forecasts returns the forecast for every subscription with an observed balance, ordered by
+// subscription. Payments which fell out of the forecast window are dropped, as are subscriptions
+// which were not seen within it.
+func (f *SubscriptionForecaster) Forecasts(now time.Time) []SubscriptionForecast {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	cutoff := now.Add(-ForecastWindow)
+	var forecasts []SubscriptionForecast
+	for key, h := range f.subs {
+		i := sort.Search(len(h.payments), func(i int) bool { return h.payments[i].at.After(cutoff) })
+		h.payments = h.payments[i:]
+		if len(h.payments) == 0 && (h.balance == nil || h.observedAt.Before(cutoff)) {
+			delete(f.subs, key)
+			continue
+		}
+		if h.balance == nil {
+			continue
+		}
+		forecasts = append(forecasts, f.forecast(key, h, now))
+	}
+	sort.Slice(forecasts, func(i, j int) bool {
+		if forecasts[i].SubID != forecasts[j].SubID {
+			return forecasts[i].SubID < forecasts[j].SubID
+		}
+		return !forecasts[i].NativePayment && forecasts[j].NativePayment
+	})
+	return forecasts
+}
+
+func (f *SubscriptionForecaster) forecast(key subscriptionKey, h *subscriptionHistory, now time.Time) SubscriptionForecast {
+	fc := SubscriptionForecast{
+		SubID:         key.subID,
+		NativePayment: key.native,
+		Balance:       new(big.Int).Set(h.balance),
+		ObservedAt:    h.observedAt,
+		Payments:      len(h.payments),
+	}
+
+	spent := new(big.Int)
+	for _, p := range h.payments {
+		spent.Add(spent, p.amount)
+	}
+	if len(h.payments) > 0 {
+		fc.AveragePayment = new(big.Int).Div(spent, big.NewInt(int64(len(h.payments))))
+	}
+
+	since := h.firstSeen
+	if cutoff := now.Add(-ForecastWindow); since.Before(cutoff) {
+		since = cutoff
+	}
+	if span := now.Sub(since); span >= forecastMinSpan && spent.Sign() > 0 {
+		fc.BurnRatePerHour = new(big.Int).Div(new(big.Int).Mul(spent, big.NewInt(int64(time.Hour))), big.NewInt(int64(span)))
+		untilEmpty := time.Duration(0)
+		if fc.Balance.Sign() > 0 {
+			// balance / spent * span, kept in integers and capped so that it cannot overflow.
+			d := new(big.Int).Div(new(big.Int).Mul(fc.Balance, big.NewInt(int64(span))), spent)
+			if d.IsInt64() {
+				untilEmpty = time.Duration(d.Int64())
+			} else {
+				untilEmpty = time.Duration(1<<63 - 1)
+			}
+		}
+		fc.TimeUntilEmpty = &untilEmpty
+	}
+
+	if f.threshold > 0 {
+		if fc.AveragePayment != nil && fc.Balance.Cmp(fc.AveragePayment) < 0 {
+			fc.Alert = fmt.Errorf("subscription %s has %s %s left, which is less than the average recent fulfillment cost of %s",
+				fc.SubID, fc.Balance, fc.Currency(), fc.AveragePayment)
+		} else if fc.TimeUntilEmpty != nil && *fc.TimeUntilEmpty < f.threshold {
+			fc.Alert = fmt.Errorf("subscription %s is forecast to run out of %s in %s, at %s per hour",
+				fc.SubID, fc.Currency(), fc.TimeUntilEmpty.Round(time.Second), fc.BurnRatePerHour)
+		}
+	}
+	return fc
+}
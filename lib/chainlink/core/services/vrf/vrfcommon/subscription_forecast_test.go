@@ -0,0 +1,88 @@
+package vrfcommon_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
+)
+
+func TestSubscriptionForecaster(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	subID := big.NewInt(42)
+
+	t.Run("no forecast until observed for long enough", func(t *testing.T) {
+		f := vrfcommon.NewSubscriptionForecaster(24 * time.Hour)
+		f.ObserveBalance(subID, false, big.NewInt(1000), start)
+		f.ObservePayment(subID, false, big.NewInt(10), start.Add(time.Minute))
+
+		forecasts := f.Forecasts(start.Add(10 * time.Minute))
+		require.Len(t, forecasts, 1)
+		fc := forecasts[0]
+		assert.Equal(t, "42", fc.SubID)
+		assert.Equal(t, "LINK", fc.Currency())
+		assert.Equal(t, 1, fc.Payments)
+		assert.Equal(t, big.NewInt(10), fc.AveragePayment)
+		assert.Nil(t, fc.BurnRatePerHour)
+		assert.Nil(t, fc.TimeUntilEmpty)
+		assert.NoError(t, fc.Alert)
+	})
+
+	t.Run("forecasts and alerts", func(t *testing.T) {
+		f := vrfcommon.NewSubscriptionForecaster(24 * time.Hour)
+		f.ObserveBalance(subID, true, big.NewInt(1000), start)
+		for i := 1; i <= 4; i++ {
+			f.ObservePayment(subID, true, big.NewInt(25), start.Add(time.Duration(i)*time.Hour))
+		}
+
+		forecasts := f.Forecasts(start.Add(4 * time.Hour))
+		require.Len(t, forecasts, 1)
+		fc := forecasts[0]
+		assert.Equal(t, "native", fc.Currency())
+		assert.Equal(t, big.NewInt(25), fc.BurnRatePerHour)
+		require.NotNil(t, fc.TimeUntilEmpty)
+		assert.Equal(t, 40*time.Hour, *fc.TimeUntilEmpty)
+		assert.NoError(t, fc.Alert)
+
+		f.ObserveBalance(subID, true, big.NewInt(500), start.Add(4*time.Hour))
+		fc = f.Forecasts(start.Add(4 * time.Hour))[0]
+		assert.Equal(t, 20*time.Hour, *fc.TimeUntilEmpty)
+		require.Error(t, fc.Alert)
+		assert.Contains(t, fc.Alert.Error(), "forecast to run out of native in 20h0m0s")
+
+		f.ObserveBalance(subID, true, big.NewInt(20), start.Add(4*time.Hour))
+		fc = f.Forecasts(start.Add(4 * time.Hour))[0]
+		require.Error(t, fc.Alert)
+		assert.Contains(t, fc.Alert.Error(), "less than the average recent fulfillment cost of 25")
+	})
+
+	t.Run("alerts disabled without threshold", func(t *testing.T) {
+		f := vrfcommon.NewSubscriptionForecaster(0)
+		f.ObserveBalance(subID, false, big.NewInt(0), start)
+		f.ObservePayment(subID, false, big.NewInt(10), start)
+
+		fc := f.Forecasts(start.Add(2 * time.Hour))[0]
+		require.NotNil(t, fc.TimeUntilEmpty)
+		assert.Equal(t, time.Duration(0), *fc.TimeUntilEmpty)
+		assert.NoError(t, fc.Alert)
+	})
+
+	t.Run("drops payments and subscriptions outside the window", func(t *testing.T) {
+		f := vrfcommon.NewSubscriptionForecaster(time.Hour)
+		f.ObserveBalance(subID, false, big.NewInt(1000), start)
+		f.ObservePayment(subID, false, big.NewInt(10), start)
+		f.ObserveBalance(big.NewInt(7), false, big.NewInt(1000), start.Add(vrfcommon.ForecastWindow))
+
+		forecasts := f.Forecasts(start.Add(vrfcommon.ForecastWindow + time.Hour))
+		require.Len(t, forecasts, 1)
+		assert.Equal(t, "7", forecasts[0].SubID)
+		assert.Zero(t, forecasts[0].Payments)
+		assert.Nil(t, forecasts[0].AveragePayment)
+	})
+}
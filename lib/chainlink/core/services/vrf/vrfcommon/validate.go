@@ -3,6 +3,7 @@ package vrfcommon
 import (
 	"bytes"
 	"fmt"
+	"net/url"
 	"time"
 
 	"github.com/google/uuid"
@@ -19,7 +20,7 @@ var (
 	ErrKeyNotSet = errors.New("key not set")
 )
 
-func ValidatedVRFSpec(tomlString string) (job.Job, error) {
+func ValidatedVRFSpec(tomlString string, opts ...pipeline.ParseOption) (job.Job, error) {
 	var jb = job.Job{
 		ExternalJobID: uuid.New(), // Default to generating a uuid, can be overwritten by the specified one in tomlString.
 	}
@@ -36,6 +37,9 @@ func ValidatedVRFSpec(tomlString string) (job.Job, error) {
 	if jb.Type != job.VRF {
 		return jb, errors.Errorf("unsupported type %s", jb.Type)
 	}
+	if err = jb.ResolvePipeline(opts...); err != nil {
+		return jb, err
+	}
 
 	var spec job.VRFSpec
 	err = tree.Unmarshal(&spec)
@@ -79,6 +83,20 @@ func ValidatedVRFSpec(tomlString string) (job.Job, error) {
 		return jb, fmt.Errorf("gasLanePrice must be positive, given: %s", spec.GasLanePrice.String())
 	}
 
+	if spec.SubscriptionAlertThreshold < 0 {
+		return jb, fmt.Errorf("subscriptionAlertThreshold cannot be negative, given: %s", spec.SubscriptionAlertThreshold)
+	}
+
+	if spec.SubscriptionAlertWebhookURL != "" {
+		if spec.SubscriptionAlertThreshold == 0 {
+			return jb, errors.Wrap(ErrKeyNotSet, "subscriptionAlertThreshold must be provided if subscriptionAlertWebhookURL is set")
+		}
+		u, err := url.Parse(spec.SubscriptionAlertWebhookURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return jb, fmt.Errorf("subscriptionAlertWebhookURL must be an absolute http or https URL, given: %s", spec.SubscriptionAlertWebhookURL)
+		}
+	}
+
 	var foundVRFTask bool
 	for _, t := range jb.Pipeline.Tasks {
 		if t.Type() == pipeline.TaskTypeVRF || t.Type() == pipeline.TaskTypeVRFV2 || t.Type() == pipeline.TaskTypeVRFV2Plus {
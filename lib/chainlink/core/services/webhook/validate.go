@@ -8,6 +8,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
@@ -20,7 +21,7 @@ type TOMLWebhookSpec struct {
 	ExternalInitiators []TOMLWebhookSpecExternalInitiator `toml:"externalInitiators"`
 }
 
-func ValidatedWebhookSpec(ctx context.Context, tomlString string, externalInitiatorManager ExternalInitiatorManager) (jb job.Job, err error) {
+func ValidatedWebhookSpec(ctx context.Context, tomlString string, externalInitiatorManager ExternalInitiatorManager, opts ...pipeline.ParseOption) (jb job.Job, err error) {
 	var tree *toml.Tree
 	tree, err = toml.Load(tomlString)
 	if err != nil {
@@ -30,6 +31,9 @@ func ValidatedWebhookSpec(ctx context.Context, tomlString string, externalInitia
 	if err != nil {
 		return
 	}
+	if err = jb.ResolvePipeline(opts...); err != nil {
+		return
+	}
 	if jb.Type != job.Webhook {
 		return jb, errors.Errorf("unsupported type %s", jb.Type)
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	webhookmocks "github.com/smartcontractkit/chainlink/v2/core/services/webhook/mocks"
 )
@@ -158,3 +159,28 @@ func TestValidatedWebJobSpec(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatedWebhookSpec_fragments(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	toml := `
+type              = "webhook"
+schemaVersion     = 1
+observationSource = """
+ds    [type=http method=GET url="https://chain.link/ETH-USD"];
+price [type=fragment name=parse input="$(ds)"];
+ds -> price;
+"""
+`
+	fragments := pipeline.WithFragments(func(name string) (string, bool) {
+		return `p [type=jsonparse data="$(fragment.input)" path="data,price"]`, name == "parse"
+	})
+
+	s, err := webhook.ValidatedWebhookSpec(ctx, toml, new(webhookmocks.ExternalInitiatorManager), fragments)
+	require.NoError(t, err)
+	assert.False(t, s.Pipeline.Unresolved())
+	assert.IsType(t, &pipeline.JSONParseTask{}, s.Pipeline.ByDotID("price"))
+
+	_, err = webhook.ValidatedWebhookSpec(ctx, toml, new(webhookmocks.ExternalInitiatorManager))
+	require.ErrorIs(t, err, pipeline.ErrFragmentNotFound)
+}
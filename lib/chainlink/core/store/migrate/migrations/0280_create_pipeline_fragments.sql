@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE pipeline_fragments (
+    id BIGSERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    source TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+-- the fragments a job was created from, its pipeline holds them expanded
+CREATE TABLE job_pipeline_fragments (
+    job_id INT NOT NULL REFERENCES jobs(id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    fragment_name TEXT NOT NULL,
+    PRIMARY KEY (job_id, fragment_name)
+);
+
+CREATE INDEX idx_job_pipeline_fragments_fragment_name ON job_pipeline_fragments (fragment_name);
+
+-- +goose Down
+DROP TABLE job_pipeline_fragments;
+DROP TABLE pipeline_fragments;
@@ -0,0 +1,15 @@
+-- +goose Up
+ALTER TABLE cron_specs
+    ADD COLUMN timezone text NOT NULL DEFAULT '',
+    ADD COLUMN jitter bigint NOT NULL DEFAULT 0,
+    ADD COLUMN overlap_policy text NOT NULL DEFAULT '',
+    ADD COLUMN max_catch_up_runs bigint NOT NULL DEFAULT 0,
+    ADD COLUMN last_fired_at timestamptz;
+
+-- +goose Down
+ALTER TABLE cron_specs
+    DROP COLUMN timezone,
+    DROP COLUMN jitter,
+    DROP COLUMN overlap_policy,
+    DROP COLUMN max_catch_up_runs,
+    DROP COLUMN last_fired_at;
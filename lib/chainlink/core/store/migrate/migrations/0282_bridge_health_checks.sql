@@ -0,0 +1,23 @@
+-- +goose Up
+ALTER TABLE bridge_types
+    ADD COLUMN health_check_url text,
+    ADD COLUMN health_check_interval bigint NOT NULL DEFAULT 0;
+
+CREATE TABLE bridge_health_checks (
+    id BIGSERIAL PRIMARY KEY,
+    bridge_name text NOT NULL REFERENCES bridge_types (name) ON DELETE CASCADE,
+    healthy boolean NOT NULL,
+    status_code integer NOT NULL DEFAULT 0,
+    latency bigint NOT NULL DEFAULT 0,
+    error text NOT NULL DEFAULT '',
+    checked_at timestamptz NOT NULL
+);
+
+CREATE INDEX idx_bridge_health_checks_bridge_name_checked_at ON bridge_health_checks (bridge_name, checked_at DESC);
+
+-- +goose Down
+DROP TABLE bridge_health_checks;
+
+ALTER TABLE bridge_types
+    DROP COLUMN health_check_url,
+    DROP COLUMN health_check_interval;
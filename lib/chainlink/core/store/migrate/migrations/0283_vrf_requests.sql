@@ -0,0 +1,38 @@
+-- +goose Up
+CREATE TABLE vrf_requests (
+    id BIGSERIAL PRIMARY KEY,
+    job_id integer NOT NULL REFERENCES jobs (id) ON DELETE CASCADE,
+    request_id text NOT NULL,
+    evm_chain_id numeric(78, 0) NOT NULL,
+    coordinator_address bytea NOT NULL,
+    vrf_version text NOT NULL,
+    sub_id text NOT NULL,
+    sender bytea NOT NULL,
+    request_tx_hash bytea NOT NULL,
+    request_block_number bigint NOT NULL,
+    confirmed_at_block bigint NOT NULL,
+    state text NOT NULL,
+    last_error text NOT NULL DEFAULT '',
+    eth_tx_id bigint,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    UNIQUE (job_id, request_id)
+);
+
+CREATE INDEX idx_vrf_requests_request_id ON vrf_requests (request_id);
+CREATE INDEX idx_vrf_requests_updated_at ON vrf_requests (updated_at);
+
+CREATE TABLE vrf_request_transitions (
+    id BIGSERIAL PRIMARY KEY,
+    vrf_request_id bigint NOT NULL REFERENCES vrf_requests (id) ON DELETE CASCADE,
+    state text NOT NULL,
+    error text NOT NULL DEFAULT '',
+    eth_tx_id bigint,
+    created_at timestamptz NOT NULL
+);
+
+CREATE INDEX idx_vrf_request_transitions_vrf_request_id ON vrf_request_transitions (vrf_request_id, id);
+
+-- +goose Down
+DROP TABLE vrf_request_transitions;
+DROP TABLE vrf_requests;
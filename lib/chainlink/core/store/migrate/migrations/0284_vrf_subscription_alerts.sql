@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE vrf_specs
+    ADD COLUMN subscription_alert_threshold bigint NOT NULL DEFAULT 0,
+    ADD COLUMN subscription_alert_webhook_url text NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE vrf_specs
+    DROP COLUMN subscription_alert_threshold,
+    DROP COLUMN subscription_alert_webhook_url;
@@ -0,0 +1,48 @@
+-- +goose Up
+CREATE TABLE evm.txm_transactions (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id numeric(78, 0) NOT NULL,
+    from_address bytea NOT NULL,
+    to_address bytea NOT NULL,
+    idempotency_key text,
+    nonce bigint,
+    value numeric(78, 0) NOT NULL,
+    data bytea NOT NULL,
+    specified_gas_limit bigint NOT NULL,
+    state text NOT NULL CHECK (state IN ('unstarted', 'unconfirmed', 'confirmed', 'fatal_error')),
+    is_purgeable boolean NOT NULL DEFAULT false,
+    meta jsonb,
+    subject uuid,
+    pipeline_task_run_id uuid,
+    min_confirmations bigint,
+    signal_callback boolean NOT NULL DEFAULT false,
+    callback_completed boolean NOT NULL DEFAULT false,
+    created_at timestamptz NOT NULL,
+    initial_broadcast_at timestamptz,
+    last_broadcast_at timestamptz,
+    CONSTRAINT chk_txm_transactions_nonce CHECK (state IN ('unstarted', 'fatal_error') OR nonce IS NOT NULL)
+);
+
+CREATE UNIQUE INDEX idx_txm_transactions_idempotency_key ON evm.txm_transactions (evm_chain_id, idempotency_key) WHERE idempotency_key IS NOT NULL;
+CREATE UNIQUE INDEX idx_txm_transactions_unconfirmed_nonce ON evm.txm_transactions (evm_chain_id, from_address, nonce) WHERE state = 'unconfirmed';
+CREATE INDEX idx_txm_transactions_state ON evm.txm_transactions (evm_chain_id, from_address, state, nonce);
+
+CREATE TABLE evm.txm_attempts (
+    id BIGSERIAL PRIMARY KEY,
+    tx_id bigint NOT NULL REFERENCES evm.txm_transactions (id) ON DELETE CASCADE,
+    hash bytea NOT NULL,
+    gas_price numeric(78, 0),
+    gas_tip_cap numeric(78, 0),
+    gas_fee_cap numeric(78, 0),
+    gas_limit bigint NOT NULL,
+    tx_type smallint NOT NULL,
+    signed_raw_tx bytea,
+    created_at timestamptz NOT NULL,
+    broadcast_at timestamptz
+);
+
+CREATE INDEX idx_txm_attempts_tx_id ON evm.txm_attempts (tx_id);
+
+-- +goose Down
+DROP TABLE evm.txm_attempts;
+DROP TABLE evm.txm_transactions;
@@ -0,0 +1,21 @@
+-- +goose Up
+ALTER TABLE evm.txes ADD COLUMN authorization_list jsonb;
+ALTER TABLE evm.txm_transactions ADD COLUMN authorization_list jsonb;
+
+ALTER TABLE evm.tx_attempts DROP CONSTRAINT chk_legacy_or_dynamic;
+ALTER TABLE evm.tx_attempts ADD CONSTRAINT chk_legacy_or_dynamic CHECK (
+	(tx_type = 0 AND gas_price IS NOT NULL AND gas_tip_cap IS NULL AND gas_fee_cap IS NULL)
+	OR
+	(tx_type IN (2, 4) AND gas_price IS NULL AND gas_tip_cap IS NOT NULL AND gas_fee_cap IS NOT NULL)
+);
+
+-- +goose Down
+ALTER TABLE evm.tx_attempts DROP CONSTRAINT chk_legacy_or_dynamic;
+ALTER TABLE evm.tx_attempts ADD CONSTRAINT chk_legacy_or_dynamic CHECK (
+	(tx_type = 0 AND gas_price IS NOT NULL AND gas_tip_cap IS NULL AND gas_fee_cap IS NULL)
+	OR
+	(tx_type = 2 AND gas_price IS NULL AND gas_tip_cap IS NOT NULL AND gas_fee_cap IS NOT NULL)
+);
+
+ALTER TABLE evm.txm_transactions DROP COLUMN authorization_list;
+ALTER TABLE evm.txes DROP COLUMN authorization_list;
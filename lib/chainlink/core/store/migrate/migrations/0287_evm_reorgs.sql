@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE evm.reorgs (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id numeric(78, 0) NOT NULL,
+    source text NOT NULL CHECK (source IN ('HeadTracker', 'LogPoller')),
+    depth bigint NOT NULL,
+    lca_block_number bigint NOT NULL,
+    lca_block_hash bytea NOT NULL,
+    old_head_number bigint NOT NULL,
+    old_head_hash bytea NOT NULL,
+    old_head_timestamp timestamptz NOT NULL,
+    new_head_number bigint NOT NULL,
+    new_head_hash bytea NOT NULL,
+    new_head_timestamp timestamptz NOT NULL,
+    tx_ids bigint[] NOT NULL DEFAULT '{}',
+    log_count bigint NOT NULL DEFAULT 0,
+    detected_at timestamptz NOT NULL
+);
+
+CREATE INDEX idx_evm_reorgs_chain_detected_at ON evm.reorgs (evm_chain_id, detected_at DESC);
+
+-- +goose Down
+DROP TABLE evm.reorgs;
@@ -0,0 +1,9 @@
+-- +goose Up
+CREATE TABLE encrypted_key_ring_backups (
+    id BIGSERIAL PRIMARY KEY,
+    encrypted_keys jsonb NOT NULL,
+    created_at timestamptz NOT NULL
+);
+
+-- +goose Down
+DROP TABLE encrypted_key_ring_backups;
@@ -50,9 +50,15 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest) error {
 		bt.MinimumContractPayment.Cmp(assets.NewLinkFromJuels(0)) < 0 {
 		fe.Add("MinimumContractPayment must be positive")
 	}
+	if err := bt.ValidateHealthCheck(); err != nil {
+		fe.Add(err.Error())
+	}
 	return fe.CoerceEmptyToNil()
 }
 
+// bridgeHealthChecksLimit is the number of recent health checks included when showing a bridge.
+const bridgeHealthChecksLimit = 20
+
 // BridgeTypesController manages BridgeType requests in the node.
 type BridgeTypesController struct {
 	App chainlink.Application
@@ -143,7 +149,17 @@ func (btc *BridgeTypesController) Show(c *gin.Context) {
 		return
 	}
 
-	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
+	resource := presenters.NewBridgeResource(bt)
+	if bt.HealthCheckURL != nil {
+		checks, err := btc.App.BridgeORM().BridgeHealthChecks(ctx, bt.Name, bridgeHealthChecksLimit)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		resource.SetHealthChecks(checks)
+	}
+
+	jsonAPIResponse(c, resource, "bridge")
 }
 
 // Update can change the restricted attributes for a bridge
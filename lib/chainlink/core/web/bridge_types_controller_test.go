@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
@@ -85,6 +88,26 @@ func TestValidateBridgeType(t *testing.T) {
 			},
 			models.NewJSONAPIErrorsWith("MinimumContractPayment must be positive"),
 		},
+		{
+			"valid health check",
+			bridges.BridgeTypeRequest{
+				Name:                "adapterwithhealthcheck",
+				URL:                 cltest.WebURL(t, "http://chainlink_cmc-adapter_1:8080"),
+				HealthCheckURL:      cltest.WebURL(t, "http://chainlink_cmc-adapter_1:8080/health"),
+				HealthCheckInterval: sqlutil.Interval(time.Minute),
+			},
+			nil,
+		},
+		{
+			"invalid health check interval",
+			bridges.BridgeTypeRequest{
+				Name:                "adapterwithhealthcheck",
+				URL:                 cltest.WebURL(t, "http://chainlink_cmc-adapter_1:8080"),
+				HealthCheckURL:      cltest.WebURL(t, "http://chainlink_cmc-adapter_1:8080/health"),
+				HealthCheckInterval: sqlutil.Interval(time.Second),
+			},
+			models.NewJSONAPIErrorsWith("healthCheckInterval must be at least 5s"),
+		},
 		{
 			"existing core adapter (no longer fails since core adapters no longer exist)",
 			bridges.BridgeTypeRequest{
@@ -298,6 +321,54 @@ func TestBridgeController_Show(t *testing.T) {
 	assert.Equal(t, http.StatusNotFound, resp.StatusCode, "Response should be 404")
 }
 
+func TestBridgeController_Show_HealthCheck(t *testing.T) {
+	t.Parallel()
+
+	adapter := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(adapter.Close)
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	bridgeName := testutils.RandomizeName("healthbridge")
+	body := fmt.Sprintf(`{"name": "%s", "url": "%s", "healthCheckURL": "%s/health", "healthCheckInterval": "30s"}`, bridgeName, adapter.URL, adapter.URL)
+	resp, cleanup := client.Post("/v2/bridge_types", bytes.NewBufferString(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	ctx := testutils.Context(t)
+	name := bridges.MustParseBridgeName(bridgeName)
+	require.NoError(t, app.BridgeORM().InsertBridgeHealthCheck(ctx, &bridges.BridgeHealthCheck{
+		BridgeName: name,
+		Healthy:    true,
+		StatusCode: http.StatusOK,
+		Latency:    time.Millisecond,
+		CheckedAt:  time.Now(),
+	}))
+
+	resp, cleanup = client.Get("/v2/bridge_types/" + bridgeName)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var resource presenters.BridgeResource
+	cltest.ParseJSONAPIResponse(t, resp, &resource)
+	assert.Equal(t, adapter.URL+"/health", resource.HealthCheckURL)
+	assert.Equal(t, "30s", resource.HealthCheckInterval)
+	assert.Equal(t, bridges.HealthStatusUp, resource.HealthStatus)
+	assert.Nil(t, resource.HealthDownSince)
+	require.NotEmpty(t, resource.HealthChecks)
+	assert.True(t, resource.HealthChecks[0].Healthy)
+	assert.Equal(t, http.StatusOK, resource.HealthChecks[0].StatusCode)
+
+	body = fmt.Sprintf(`{"name": "%s", "url": "%s", "healthCheckURL": "%s/health", "healthCheckInterval": "1s"}`, bridgeName, adapter.URL, adapter.URL)
+	resp, cleanup = client.Patch("/v2/bridge_types/"+bridgeName, bytes.NewBufferString(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+}
+
 func TestBridgeTypesController_Create_AdapterExistsError(t *testing.T) {
 	t.Parallel()
 
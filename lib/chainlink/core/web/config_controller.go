@@ -7,6 +7,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 
 	"github.com/gin-gonic/gin"
 )
@@ -41,6 +42,19 @@ func (cc *ConfigController) Show(c *gin.Context) {
 	jsonAPIResponse(c, ConfigV2Resource{toml}, "config")
 }
 
+// Reload reads the config files again, and applies the changes which do not require a restart
+// Example:
+//
+//	"<application>/config/reload"
+func (cc *ConfigController) Reload(c *gin.Context) {
+	report, err := cc.App.ReloadConfig()
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewConfigReloadResource(report), "configReloads")
+}
+
 type ConfigV2Resource struct {
 	Config string `json:"config"`
 }
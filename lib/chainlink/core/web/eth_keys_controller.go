@@ -13,6 +13,7 @@ import (
 	commonassets "github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
 	"github.com/smartcontractkit/chainlink-evm/pkg/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink-evm/pkg/monitor"
 	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -45,11 +46,13 @@ func createETHKeyResource(c *gin.Context, ekc *ETHKeysController, key ethkey.Key
 	ethBalance := ekc.getEthBalance(c.Request.Context(), state)
 	linkBalance := ekc.getLinkBalance(c.Request.Context(), state)
 	maxGasPrice := ekc.getKeyMaxGasPriceWei(state, key.Address)
+	balanceReport := ekc.getBalanceReport(state)
 
 	r := presenters.NewETHKeyResource(key, state,
 		ekc.setEthBalance(ethBalance),
 		ekc.setLinkBalance(linkBalance),
 		ekc.setKeyMaxGasPriceWei(maxGasPrice),
+		presenters.SetETHKeyBalanceReport(balanceReport),
 	)
 
 	return r
@@ -421,6 +424,29 @@ func (ekc *ETHKeysController) getKeyMaxGasPriceWei(state ethkey.State, keyAddres
 	return chain.Config().EVM().GasEstimator().PriceMaxKey(keyAddress)
 }
 
+// getBalanceReport returns the minimums, burn rate and token balances tracked by the balance monitor for the
+// address associated with state, or nil if the balance monitor is disabled
+func (ekc *ETHKeysController) getBalanceReport(state ethkey.State) *monitor.BalanceReport {
+	chainID := state.EVMChainID.ToInt()
+	chainService, err := ekc.app.GetRelayers().LegacyEVMChains().Get(chainID.String())
+	if err != nil {
+		if !errors.Is(errors.Cause(err), evmrelay.ErrNoChains) {
+			ekc.lggr.Errorw("Failed to get EVM Chain", "chainID", chainID, "err", err)
+		}
+		return nil
+	}
+	chain, ok := chainService.(legacyevm.Chain)
+	if !ok {
+		ekc.lggr.Errorw("EVM Chain in LOOPP mode", "chainID", chainID, "err", err)
+		return nil
+	}
+	bm := chain.BalanceMonitor()
+	if bm == nil {
+		return nil
+	}
+	return bm.GetBalanceReport(state.Address.Address())
+}
+
 // getChain is a convenience wrapper to retrieve a chain for a given request
 // and call the corresponding API response error function for 400, 404 and 500 results
 func (ekc *ETHKeysController) getChain(c *gin.Context, chainIDstr string) (chain legacyevm.Chain, ok bool) {
@@ -0,0 +1,52 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/client"
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// EVMNodeScoresController shows the consistency scores of the RPC nodes of an EVM chain.
+type EVMNodeScoresController struct {
+	App chainlink.Application
+}
+
+// Index lists the consistency scores of the primary RPC nodes of a chain, along with their quarantine reasons.
+// Example:
+//
+//	"<application>/v2/nodes/evm/scores?evmChainID=1"
+func (nsc *EVMNodeScoresController) Index(c *gin.Context) {
+	chain, err := getChain(nsc.App.GetRelayers().LegacyEVMChains(), c.Query("evmChainID"))
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) || errors.Is(err, ErrEmptyChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	scorer, ok := chain.Client().(client.NodeScorer)
+	var scores []client.NodeScore
+	if ok {
+		scores = scorer.NodeScores()
+	}
+	if scores == nil {
+		jsonAPIError(c, http.StatusBadRequest, fmt.Errorf("consistency probes are not enabled for chain %s, see EVM.NodePool.ConsistencyProbe", chain.ID()))
+		return
+	}
+
+	chainID := big.New(chain.ID())
+	resources := []presenters.EVMNodeScoreResource{}
+	for _, s := range scores {
+		resources = append(resources, presenters.NewEVMNodeScoreResource(*chainID, s))
+	}
+	jsonAPIResponse(c, resources, "evm_node_score")
+}
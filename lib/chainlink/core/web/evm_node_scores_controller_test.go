@@ -0,0 +1,50 @@
+package web_test
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/config/toml"
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+)
+
+func Test_EVMNodeScoresController_Index(t *testing.T) {
+	t.Parallel()
+
+	chainID := big.New(testutils.NewRandomEVMChainID())
+	app := cltest.NewApplicationWithConfig(t, configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM = toml.EVMConfigs{
+			{ChainID: chainID, Enabled: ptr(true), Chain: toml.Defaults(chainID)},
+		}
+	}))
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	for _, tc := range []struct {
+		name   string
+		query  string
+		status int
+		msg    string
+	}{
+		{"invalid chain", "?evmChainID=foo", http.StatusUnprocessableEntity, "invalid chain id"},
+		{"unknown chain", "?evmChainID=1", http.StatusUnprocessableEntity, "chain id does not match any local chains"},
+		{"probes disabled", "?evmChainID=" + chainID.String(), http.StatusBadRequest, "consistency probes are not enabled for chain " + chainID.String()},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, cleanup := client.Get("/v2/nodes/evm/scores" + tc.query)
+			t.Cleanup(cleanup)
+			assert.Equal(t, tc.status, resp.StatusCode)
+			b, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.Contains(t, string(b), tc.msg)
+		})
+	}
+}
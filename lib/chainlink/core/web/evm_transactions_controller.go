@@ -2,7 +2,13 @@ package web
 
 import (
 	"database/sql"
+	"io"
 	"net/http"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr/lifecycle"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
@@ -47,3 +53,91 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(*ethTxAttempt), "transaction")
 }
+
+// Events streams transaction lifecycle events as server-sent events, either for the chain given by evmChainID or for
+// every chain with lifecycle events enabled. The stream stays open until the client disconnects.
+// Example:
+//
+//	"<application>/transactions/evm/events?evmChainID=1"
+func (tc *TransactionsController) Events(c *gin.Context) {
+	legacyChains := tc.App.GetRelayers().LegacyEVMChains()
+	var chains []legacyevm.Chain
+	if chainIDstr := c.Query("evmChainID"); chainIDstr != "" {
+		chain, err := getChain(legacyChains, chainIDstr)
+		if err != nil {
+			if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMissingChainID) {
+				jsonAPIError(c, http.StatusUnprocessableEntity, err)
+				return
+			}
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		chains = append(chains, chain)
+	} else {
+		for _, chainService := range legacyChains.Slice() {
+			if chain, ok := chainService.(legacyevm.Chain); ok {
+				chains = append(chains, chain)
+			}
+		}
+	}
+
+	var subs []<-chan lifecycle.Event
+	for _, chain := range chains {
+		emitter := chain.TxLifecycleEmitter()
+		if emitter == nil {
+			continue
+		}
+		sub, unsubscribe := emitter.Subscribe()
+		defer unsubscribe()
+		subs = append(subs, sub)
+	}
+	if len(subs) == 0 {
+		jsonAPIError(c, http.StatusNotFound, errors.New("transaction lifecycle events are not enabled"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	events := make(chan lifecycle.Event)
+	var wg sync.WaitGroup
+	for _, sub := range subs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for event := range sub {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(events)
+	}()
+
+	// The stream outlives the server's write timeout.
+	if err := http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{}); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	c.Stream(func(io.Writer) bool {
+		select {
+		case <-ctx.Done():
+			return false
+		case event, ok := <-events:
+			if !ok {
+				return false
+			}
+			c.SSEvent(string(event.Type), event)
+			return true
+		}
+	})
+}
@@ -1,16 +1,23 @@
 package web_test
 
 import (
+	"bufio"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink-evm/pkg/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink-evm/pkg/gas"
+	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr/lifecycle"
 	"github.com/smartcontractkit/chainlink-evm/pkg/txmgr/txmgrtest"
 	txmgrtypes "github.com/smartcontractkit/chainlink-framework/chains/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 
@@ -130,3 +137,64 @@ func TestTransactionsController_Show_NotFound(t *testing.T) {
 	t.Cleanup(cleanup)
 	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
 }
+
+func TestTransactionsController_Events(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].Transactions.LifecycleEvents.Enabled = ptr(true)
+	})
+	app := cltest.NewApplicationWithConfigAndKey(t, cfg)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	chainService, err := app.GetRelayers().LegacyEVMChains().Get(cltest.FixtureChainID.String())
+	require.NoError(t, err)
+	emitter := chainService.(legacyevm.Chain).TxLifecycleEmitter()
+	require.NotNil(t, emitter)
+
+	client := app.NewHTTPClient(nil)
+	resp, cleanup := client.Get("/v2/transactions/evm/events?evmChainID=" + cltest.FixtureChainID.String())
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	emitter.Emit(lifecycle.Event{Type: lifecycle.EventConfirmed, TxID: 42})
+
+	scanner := bufio.NewScanner(resp.Body)
+	require.True(t, scanner.Scan())
+	assert.Equal(t, "event:confirmed", scanner.Text())
+	require.True(t, scanner.Scan())
+	data, ok := strings.CutPrefix(scanner.Text(), "data:")
+	require.True(t, ok)
+	var event lifecycle.Event
+	require.NoError(t, json.Unmarshal([]byte(data), &event))
+	assert.Equal(t, lifecycle.EventConfirmed, event.Type)
+	assert.Equal(t, int64(42), event.TxID)
+	assert.Equal(t, cltest.FixtureChainID.String(), event.ChainID)
+}
+
+func TestTransactionsController_Events_Error(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	t.Run("lifecycle events disabled", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/transactions/evm/events")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+
+	t.Run("invalid chain ID", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/transactions/evm/events?evmChainID=foo")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("unknown chain ID", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/transactions/evm/events?evmChainID=123456789")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/standardcapabilities"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
@@ -135,6 +136,47 @@ func (jc *JobsController) Create(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
 }
 
+// SimulateJobRequest represents a request to run the pipeline of a job spec (V2)
+// against canned task responses, without creating the job.
+type SimulateJobRequest struct {
+	TOML     string          `json:"toml"`
+	Fixtures json.RawMessage `json:"fixtures"`
+	Vars     map[string]any  `json:"vars"`
+}
+
+// Simulate validates a job spec and executes its pipeline in simulation mode.
+// HTTP, bridge, ethcall, ethtx and estimategaslimit tasks are answered from the
+// fixtures and nothing is persisted.
+// Example:
+// "POST <application>/jobs/simulate"
+func (jc *JobsController) Simulate(c *gin.Context) {
+	request := SimulateJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jb, status, err := jc.validateJobSpec(c.Request.Context(), request.TOML)
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+
+	fixtures, err := pipeline.ParseSimulationFixtures(request.Fixtures)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	report, err := jc.App.SimulateJobV2(c.Request.Context(), jb, request.Vars, fixtures)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobSimulationResource(jb.ExternalJobID.String(), report), "jobSimulation")
+}
+
 // Delete hard deletes a job spec.
 // Example:
 // "DELETE <application>/specs/:ID"
@@ -217,34 +259,39 @@ func (jc *JobsController) Update(c *gin.Context) {
 }
 
 func (jc *JobsController) validateJobSpec(ctx context.Context, tomlString string) (jb job.Job, statusCode int, err error) {
-	jobType, err := job.ValidateSpec(tomlString)
+	fragments, err := jc.App.PipelineFragmentORM().Lookup(ctx)
+	if err != nil {
+		return jb, http.StatusInternalServerError, errors.Wrap(err, "failed to load pipeline fragments")
+	}
+	withFragments := pipeline.WithFragments(fragments)
+	jobType, err := job.ValidateSpec(tomlString, withFragments)
 	if err != nil {
 		return jb, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to parse TOML")
 	}
 	config := jc.App.GetConfig()
 	switch jobType {
 	case job.OffchainReporting:
-		jb, err = ocr.ValidatedOracleSpecToml(config, jc.App.GetRelayers().LegacyEVMChains(), tomlString)
+		jb, err = ocr.ValidatedOracleSpecToml(config, jc.App.GetRelayers().LegacyEVMChains(), tomlString, withFragments)
 		if !config.OCR().Enabled() {
 			return jb, http.StatusNotImplemented, errors.New("The Offchain Reporting feature is disabled by configuration")
 		}
 	case job.OffchainReporting2:
-		jb, err = validate.ValidatedOracleSpecToml(ctx, config.OCR2(), config.Insecure(), tomlString, jc.App.GetLoopRegistrarConfig())
+		jb, err = validate.ValidatedOracleSpecToml(ctx, config.OCR2(), config.Insecure(), tomlString, jc.App.GetLoopRegistrarConfig(), withFragments)
 		if !config.OCR2().Enabled() {
 			return jb, http.StatusNotImplemented, errors.New("The Offchain Reporting 2 feature is disabled by configuration")
 		}
 	case job.DirectRequest:
-		jb, err = directrequest.ValidatedDirectRequestSpec(tomlString)
+		jb, err = directrequest.ValidatedDirectRequestSpec(tomlString, withFragments)
 	case job.FluxMonitor:
-		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(config.JobPipeline(), tomlString)
+		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(config.JobPipeline(), tomlString, withFragments)
 	case job.Keeper:
 		jb, err = keeper.ValidatedKeeperSpec(tomlString)
 	case job.Cron:
-		jb, err = cron.ValidatedCronSpec(tomlString)
+		jb, err = cron.ValidatedCronSpec(tomlString, withFragments)
 	case job.VRF:
-		jb, err = vrfcommon.ValidatedVRFSpec(tomlString)
+		jb, err = vrfcommon.ValidatedVRFSpec(tomlString, withFragments)
 	case job.Webhook:
-		jb, err = webhook.ValidatedWebhookSpec(ctx, tomlString, jc.App.GetExternalInitiatorManager())
+		jb, err = webhook.ValidatedWebhookSpec(ctx, tomlString, jc.App.GetExternalInitiatorManager(), withFragments)
 	case job.BlockhashStore:
 		jb, err = blockhashstore.ValidatedSpec(tomlString)
 	case job.BlockHeaderFeeder:
@@ -254,7 +301,7 @@ func (jc *JobsController) validateJobSpec(ctx context.Context, tomlString string
 	case job.Gateway:
 		jb, err = gateway.ValidatedGatewaySpec(tomlString)
 	case job.Stream:
-		jb, err = streams.ValidatedStreamSpec(tomlString)
+		jb, err = streams.ValidatedStreamSpec(tomlString, withFragments)
 	case job.Workflow:
 		jb, err = workflows.ValidatedWorkflowJobSpec(ctx, tomlString)
 	case job.StandardCapabilities:
@@ -532,6 +532,89 @@ func TestJobsController_Create_WebhookSpec(t *testing.T) {
 	require.NoError(t, err)
 }
 
+const simulateWebhookSpecTemplate = `
+type          = "webhook"
+schemaVersion = 1
+externalJobID = "%s"
+observationSource = """
+    ds          [type=http method=GET url="https://example.invalid/price"];
+    ds_parse    [type=jsonparse path="data,result"];
+    ds_multiply [type=multiply times=100];
+    ds -> ds_parse -> ds_multiply;
+"""
+`
+
+func TestJobsController_Simulate(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(nil)
+	spec := fmt.Sprintf(simulateWebhookSpecTemplate, uuid.New())
+
+	t.Run("serves the http task from fixtures", func(t *testing.T) {
+		body, err := json.Marshal(web.SimulateJobRequest{
+			TOML:     spec,
+			Fixtures: json.RawMessage(`{"tasks": {"ds": {"value": "{\"data\":{\"result\":3}}"}}}`),
+		})
+		require.NoError(t, err)
+		response, cleanup := client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+		defer cleanup()
+		require.Equal(t, http.StatusOK, response.StatusCode)
+
+		resource := presenters.JobSimulationResource{}
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		assert.Equal(t, []any{"300"}, resource.Outputs)
+		assert.Equal(t, []*string{nil}, resource.FatalErrors)
+		require.Len(t, resource.Tasks, 3)
+		assert.Equal(t, "ds", resource.Tasks[0].DotID)
+		assert.True(t, resource.Tasks[0].Mocked)
+		assert.False(t, resource.Tasks[1].Mocked)
+		assert.False(t, resource.Tasks[2].Mocked)
+
+		jobs, _, err := app.JobORM().FindJobs(testutils.Context(t), 0, 10)
+		require.NoError(t, err)
+		assert.Empty(t, jobs)
+	})
+
+	t.Run("reports a task without a fixture as a fatal error", func(t *testing.T) {
+		body, err := json.Marshal(web.SimulateJobRequest{TOML: spec})
+		require.NoError(t, err)
+		response, cleanup := client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+		defer cleanup()
+		require.Equal(t, http.StatusOK, response.StatusCode)
+
+		resource := presenters.JobSimulationResource{}
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		require.Len(t, resource.FatalErrors, 1)
+		require.NotNil(t, resource.FatalErrors[0])
+		assert.Contains(t, *resource.FatalErrors[0], "no simulation fixture")
+	})
+
+	for _, tc := range []struct {
+		name     string
+		toml     string
+		fixtures string
+		errMsg   string
+	}{
+		{"invalid spec", "type = \"webhook\"\nschemaVersion = 1\nobservationSource = \"ds [type=nope]\"", "", "unknown task type"},
+		{"invalid fixtures", spec, `{"tasks": {"ds": {"value": "1", "error": "boom"}}}`, "either value or error"},
+		{"unknown task", spec, `{"tasks": {"ds_typo": {"value": "1"}}}`, `unknown task \"ds_typo\"`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			request := web.SimulateJobRequest{TOML: tc.toml}
+			if tc.fixtures != "" {
+				request.Fixtures = json.RawMessage(tc.fixtures)
+			}
+			body, err := json.Marshal(request)
+			require.NoError(t, err)
+			response, cleanup := client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+			defer cleanup()
+			assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+			assert.Contains(t, string(cltest.ParseResponseBody(t, response)), tc.errMsg)
+		})
+	}
+}
+
 //go:embed webhook-spec-template.yml
 var webhookSpecTemplate string
 
@@ -0,0 +1,88 @@
+package web
+
+import (
+	"math/big"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// KeepersController simulates keeper and automation upkeeps.
+type KeepersController struct {
+	App chainlink.Application
+}
+
+// SimulateUpkeepRequest represents a request to simulate an upkeep against its registry.
+type SimulateUpkeepRequest struct {
+	EVMChainID string `json:"evmChainID"`
+	Registry   string `json:"registry"`
+	// UpkeepID is given in decimal or as 0x prefixed hex.
+	UpkeepID string `json:"upkeepID"`
+	// BlockNumber defaults to the latest block.
+	BlockNumber *int64 `json:"blockNumber"`
+	// From is the keeper to check and perform as on v1 registries, defaults to the first keeper.
+	From string `json:"from"`
+}
+
+// Simulate runs checkUpkeep for an upkeep at a block and, if it is eligible, simulates
+// performUpkeep with the returned perform data. Nothing is sent to the chain.
+// Example:
+// "POST <application>/keepers/simulate"
+func (kc *KeepersController) Simulate(c *gin.Context) {
+	request := SimulateUpkeepRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	chain, err := getChain(kc.App.GetRelayers().LegacyEVMChains(), request.EVMChainID)
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) || errors.Is(err, ErrEmptyChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	if !common.IsHexAddress(request.Registry) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid registry address %q", request.Registry))
+		return
+	}
+	upkeepID, ok := new(big.Int).SetString(request.UpkeepID, 0)
+	if !ok || upkeepID.Sign() < 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid upkeep ID %q", request.UpkeepID))
+		return
+	}
+	var block *big.Int
+	if request.BlockNumber != nil {
+		if *request.BlockNumber <= 0 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("block number must be positive"))
+			return
+		}
+		block = big.NewInt(*request.BlockNumber)
+	}
+	var from *common.Address
+	if request.From != "" {
+		if !common.IsHexAddress(request.From) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid from address %q", request.From))
+			return
+		}
+		addr := common.HexToAddress(request.From)
+		from = &addr
+	}
+
+	sim, err := keeper.SimulateUpkeep(c.Request.Context(), chain.Client(), common.HexToAddress(request.Registry), upkeepID, block, from)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewUpkeepSimulationResource(chain.ID().String(), sim), "upkeepSimulations")
+}
@@ -0,0 +1,62 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/config/toml"
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+)
+
+func TestKeepersController_Simulate(t *testing.T) {
+	t.Parallel()
+
+	chainID := big.New(testutils.NewRandomEVMChainID())
+	app := cltest.NewApplicationWithConfig(t, configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM = toml.EVMConfigs{
+			{ChainID: chainID, Enabled: ptr(true), Chain: toml.Defaults(chainID)},
+		}
+	}))
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	const registry = "0x27548a32b9aD5D64c5945EaE9Da5337bc3169D15"
+	zero := int64(0)
+	for _, tc := range []struct {
+		name    string
+		request web.SimulateUpkeepRequest
+		msg     string
+	}{
+		{"unknown chain", web.SimulateUpkeepRequest{EVMChainID: "1", Registry: registry, UpkeepID: "1"}, "chain id does not match any local chains"},
+		{"missing chain", web.SimulateUpkeepRequest{Registry: registry, UpkeepID: "1"}, "chainID is empty"},
+		{"invalid chain", web.SimulateUpkeepRequest{EVMChainID: "chain", Registry: registry, UpkeepID: "1"}, "invalid chain id"},
+		{"invalid registry", web.SimulateUpkeepRequest{EVMChainID: chainID.String(), Registry: "0x1234", UpkeepID: "1"}, `invalid registry address \"0x1234\"`},
+		{"missing registry", web.SimulateUpkeepRequest{EVMChainID: chainID.String(), UpkeepID: "1"}, `invalid registry address \"\"`},
+		{"invalid upkeep", web.SimulateUpkeepRequest{EVMChainID: chainID.String(), Registry: registry, UpkeepID: "upkeep"}, `invalid upkeep ID \"upkeep\"`},
+		{"negative upkeep", web.SimulateUpkeepRequest{EVMChainID: chainID.String(), Registry: registry, UpkeepID: "-1"}, `invalid upkeep ID \"-1\"`},
+		{"invalid block", web.SimulateUpkeepRequest{EVMChainID: chainID.String(), Registry: registry, UpkeepID: "0x1", BlockNumber: &zero}, "block number must be positive"},
+		{"invalid from", web.SimulateUpkeepRequest{EVMChainID: chainID.String(), Registry: registry, UpkeepID: "1", From: "keeper"}, `invalid from address \"keeper\"`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			body, err := json.Marshal(tc.request)
+			require.NoError(t, err)
+			resp, cleanup := client.Post("/v2/keepers/simulate", bytes.NewReader(body))
+			t.Cleanup(cleanup)
+			assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+			b, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.Contains(t, string(b), tc.msg)
+		})
+	}
+}
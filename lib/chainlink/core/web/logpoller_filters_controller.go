@@ -0,0 +1,121 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// LogPollerFiltersController manages the filters registered with the LogPoller of an EVM chain.
+type LogPollerFiltersController struct {
+	App chainlink.Application
+}
+
+// Index lists the filters registered with the LogPoller along with the stats of their retained logs.
+// Example:
+//
+//	"<application>/v2/logpoller/filters?evmChainID=1"
+func (lfc *LogPollerFiltersController) Index(c *gin.Context) {
+	chain, ok := lfc.getChain(c, c.Query("evmChainID"))
+	if !ok {
+		return
+	}
+
+	stats, err := chain.LogPoller().GetFilterStats(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, logPollerErrorStatus(err), err)
+		return
+	}
+
+	chainID := big.New(chain.ID())
+	resources := []presenters.LogPollerFilterResource{}
+	for _, s := range stats {
+		resources = append(resources, presenters.NewLogPollerFilterResource(*chainID, s))
+	}
+	jsonAPIResponse(c, resources, "logpoller_filter")
+}
+
+// ReplayLogPollerFilterRequest is a JSONAPI request for replaying a single LogPoller filter.
+type ReplayLogPollerFilterRequest struct {
+	EVMChainID *big.Big `json:"evmChainId"`
+	Name       string   `json:"name"`
+	FromBlock  int64    `json:"fromBlock"`
+}
+
+// Replay re-fetches the logs of a single filter from the given block, without replaying any other filter.
+// Example:
+//
+//	"<application>/v2/logpoller/filters/replay"
+func (lfc *LogPollerFiltersController) Replay(c *gin.Context) {
+	request := &ReplayLogPollerFilterRequest{}
+	if err := c.ShouldBindJSON(request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.Name == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("filter name was not provided"))
+		return
+	}
+	if request.FromBlock <= 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("block number must be positive: %v", request.FromBlock))
+		return
+	}
+
+	chain, ok := lfc.getChain(c, request.EVMChainID.String())
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := chain.LogPoller().ReplayFilter(ctx, request.Name, request.FromBlock); err != nil {
+		jsonAPIError(c, logPollerErrorStatus(err), err)
+		return
+	}
+
+	lfc.App.GetAuditLogger().Audit(audit.LogPollerFilterReplayed, map[string]any{
+		"evmChainID": chain.ID().String(),
+		"filterName": request.Name,
+		"fromBlock":  request.FromBlock,
+	})
+
+	response := ReplayResponse{
+		Message: fmt.Sprintf("Replayed filter %s from block %d", request.Name, request.FromBlock),
+		ChainID: chain.ID().String(),
+	}
+	jsonAPIResponse(c, &response, "response")
+}
+
+func (lfc *LogPollerFiltersController) getChain(c *gin.Context, chainID string) (legacyevm.Chain, bool) {
+	chain, err := getChain(lfc.App.GetRelayers().LegacyEVMChains(), chainID)
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) || errors.Is(err, ErrEmptyChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return nil, false
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return nil, false
+	}
+	return chain, true
+}
+
+func logPollerErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, logpoller.ErrDisabled):
+		return http.StatusBadRequest
+	case errors.Is(err, logpoller.ErrFilterNotRegistered):
+		return http.StatusNotFound
+	case errors.Is(err, logpoller.ErrFilterReplayInProgress):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
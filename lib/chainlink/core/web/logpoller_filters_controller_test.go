@@ -0,0 +1,73 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/config/toml"
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+)
+
+func Test_LogPollerFiltersController(t *testing.T) {
+	t.Parallel()
+
+	chainID := big.New(testutils.NewRandomEVMChainID())
+	app := cltest.NewApplicationWithConfig(t, configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM = toml.EVMConfigs{
+			{ChainID: chainID, Enabled: ptr(true), Chain: toml.Defaults(chainID)},
+		}
+		c.Feature.LogPoller = ptr(false)
+	}))
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	assertError := func(t *testing.T, resp *http.Response, status int, msg string) {
+		assert.Equal(t, status, resp.StatusCode)
+		b, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Contains(t, string(b), msg)
+	}
+
+	t.Run("index", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/logpoller/filters?evmChainID=1")
+		t.Cleanup(cleanup)
+		assertError(t, resp, http.StatusUnprocessableEntity, "chain id does not match any local chains")
+
+		resp, cleanup = client.Get("/v2/logpoller/filters?evmChainID=" + chainID.String())
+		t.Cleanup(cleanup)
+		assertError(t, resp, http.StatusBadRequest, "log poller disabled")
+	})
+
+	t.Run("replay", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			request web.ReplayLogPollerFilterRequest
+			status  int
+			msg     string
+		}{
+			{"missing name", web.ReplayLogPollerFilterRequest{EVMChainID: chainID, FromBlock: 1}, http.StatusUnprocessableEntity, "filter name was not provided"},
+			{"invalid block", web.ReplayLogPollerFilterRequest{EVMChainID: chainID, Name: "f", FromBlock: 0}, http.StatusUnprocessableEntity, "block number must be positive"},
+			{"unknown chain", web.ReplayLogPollerFilterRequest{EVMChainID: big.NewI(1), Name: "f", FromBlock: 1}, http.StatusUnprocessableEntity, "chain id does not match any local chains"},
+			{"disabled", web.ReplayLogPollerFilterRequest{EVMChainID: chainID, Name: "f", FromBlock: 1}, http.StatusBadRequest, "log poller disabled"},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				body, err := json.Marshal(tc.request)
+				require.NoError(t, err)
+				resp, cleanup := client.Post("/v2/logpoller/filters/replay", bytes.NewReader(body))
+				t.Cleanup(cleanup)
+				assertError(t, resp, tc.status, tc.msg)
+			})
+		}
+	})
+}
@@ -0,0 +1,145 @@
+package web
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// PipelineFragmentRequest is the request body to create or update a pipeline fragment.
+type PipelineFragmentRequest struct {
+	Name   string `json:"name"`
+	Source string `json:"source"`
+}
+
+// PipelineFragmentsController manages reusable pipeline fragments.
+type PipelineFragmentsController struct {
+	App chainlink.Application
+}
+
+// Index lists pipeline fragments, one page at a time.
+func (pfc *PipelineFragmentsController) Index(c *gin.Context, size, page, offset int) {
+	fragments, count, err := pfc.App.PipelineFragmentORM().Fragments(c.Request.Context(), offset, size)
+
+	var resources []presenters.PipelineFragmentResource
+	for _, f := range fragments {
+		resources = append(resources, *presenters.NewPipelineFragmentResource(f))
+	}
+
+	paginatedResponse(c, "PipelineFragments", size, page, resources, count, err)
+}
+
+// Show returns the details of a pipeline fragment.
+func (pfc *PipelineFragmentsController) Show(c *gin.Context) {
+	f, err := pfc.App.PipelineFragmentORM().FindFragment(c.Request.Context(), c.Param("Name"))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pipeline fragment not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineFragmentResource(f), "pipelineFragment")
+}
+
+// Create adds a pipeline fragment.
+func (pfc *PipelineFragmentsController) Create(c *gin.Context) {
+	ctx := c.Request.Context()
+	var request PipelineFragmentRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	orm := pfc.App.PipelineFragmentORM()
+	if err := orm.ValidateFragment(ctx, request.Name, request.Source); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	_, err := orm.FindFragment(ctx, request.Name)
+	if err == nil {
+		jsonAPIError(c, http.StatusConflict, fmt.Errorf("pipeline fragment %s already exists", request.Name))
+		return
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	f := pipeline.Fragment{Name: request.Name, Source: request.Source}
+	if err = orm.CreateFragment(ctx, &f); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	pfc.App.GetAuditLogger().Audit(audit.PipelineFragmentCreated, map[string]any{"name": f.Name})
+
+	jsonAPIResponseWithStatus(c, presenters.NewPipelineFragmentResource(f), "pipelineFragment", http.StatusCreated)
+}
+
+// Update replaces the source of a pipeline fragment and returns the jobs created
+// from it, which keep the previous version until they are recreated, and the
+// fragments which use it. The update is refused if any of those fragments
+// would no longer be valid.
+func (pfc *PipelineFragmentsController) Update(c *gin.Context) {
+	ctx := c.Request.Context()
+	var request PipelineFragmentRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	f := pipeline.Fragment{Name: c.Param("Name")}
+	usage, err := pfc.App.PipelineFragmentORM().UpdateFragment(ctx, &f, request.Source)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pipeline fragment not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	pfc.App.GetAuditLogger().Audit(audit.PipelineFragmentUpdated, map[string]any{
+		"name":              f.Name,
+		"affectedJobIDs":    usage.JobIDs,
+		"affectedFragments": usage.Fragments,
+	})
+
+	resource := presenters.NewPipelineFragmentResource(f)
+	resource.AffectedJobIDs = usage.JobIDs
+	resource.AffectedFragments = usage.Fragments
+	jsonAPIResponse(c, resource, "pipelineFragment")
+}
+
+// Destroy removes a pipeline fragment which no job was created from and no other fragment uses.
+func (pfc *PipelineFragmentsController) Destroy(c *gin.Context) {
+	name := c.Param("Name")
+	err := pfc.App.PipelineFragmentORM().DeleteFragment(c.Request.Context(), name)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pipeline fragment not found"))
+		return
+	}
+	if errors.Is(err, pipeline.ErrFragmentInUse) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	pfc.App.GetAuditLogger().Audit(audit.PipelineFragmentDeleted, map[string]any{"name": name})
+
+	jsonAPIResponseWithStatus(c, nil, "pipelineFragment", http.StatusNoContent)
+}
@@ -0,0 +1,89 @@
+package web_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestPipelineFragmentsController(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	name := testutils.RandomizeName("scale")
+
+	t.Run("create", func(t *testing.T) {
+		body := fmt.Sprintf(`{"name":%q,"source":"mul [type=multiply input=\"$(fragment.input)\" times=2]"}`, name)
+		resp, cleanup := client.Post("/v2/pipeline/fragments", bytes.NewBufferString(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusCreated)
+
+		resp, cleanup = client.Post("/v2/pipeline/fragments", bytes.NewBufferString(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusConflict)
+
+		resp, cleanup = client.Post("/v2/pipeline/fragments", bytes.NewBufferString(`{"name":"invalid","source":"a [type=nope]"}`))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+	})
+
+	t.Run("index and show", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/pipeline/fragments")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var links jsonapi.Links
+		var resources []presenters.PipelineFragmentResource
+		require.NoError(t, web.ParsePaginatedResponse(cltest.ParseResponseBody(t, resp), &resources, &links))
+		require.Len(t, resources, 1)
+		assert.Equal(t, name, resources[0].Name)
+
+		resp, cleanup = client.Get("/v2/pipeline/fragments/" + name)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		var resource presenters.PipelineFragmentResource
+		cltest.ParseJSONAPIResponse(t, resp, &resource)
+		assert.Contains(t, resource.Source, "times=2")
+
+		resp, cleanup = client.Get("/v2/pipeline/fragments/missing")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		resp, cleanup := client.Patch("/v2/pipeline/fragments/"+name, bytes.NewBufferString(`{"source":"mul [type=multiply input=\"$(fragment.input)\" times=3]"}`))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		var resource presenters.PipelineFragmentResource
+		cltest.ParseJSONAPIResponse(t, resp, &resource)
+		assert.Contains(t, resource.Source, "times=3")
+		assert.Empty(t, resource.AffectedJobIDs)
+
+		resp, cleanup = client.Patch("/v2/pipeline/fragments/missing", bytes.NewBufferString(`{"source":"a [type=memo value=1]"}`))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+
+	t.Run("destroy", func(t *testing.T) {
+		resp, cleanup := client.Delete("/v2/pipeline/fragments/" + name)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+		resp, cleanup = client.Delete("/v2/pipeline/fragments/" + name)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+}
@@ -18,6 +18,21 @@ type BridgeResource struct {
 	OutgoingToken          string       `json:"outgoingToken"`
 	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
 	CreatedAt              time.Time    `json:"createdAt"`
+	HealthCheckURL         string       `json:"healthCheckURL,omitempty"`
+	HealthCheckInterval    string       `json:"healthCheckInterval,omitempty"`
+	// The health is only provided when showing a Bridge with a health check URL
+	HealthStatus    bridges.HealthStatus        `json:"healthStatus,omitempty"`
+	HealthDownSince *time.Time                  `json:"healthDownSince,omitempty"`
+	HealthChecks    []BridgeHealthCheckResource `json:"healthChecks,omitempty"`
+}
+
+// BridgeHealthCheckResource is the result of a single health check of a Bridge.
+type BridgeHealthCheckResource struct {
+	Healthy    bool      `json:"healthy"`
+	StatusCode int       `json:"statusCode"`
+	Latency    string    `json:"latency"`
+	Error      string    `json:"error,omitempty"`
+	CheckedAt  time.Time `json:"checkedAt"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -27,7 +42,7 @@ func (r BridgeResource) GetName() string {
 
 // NewBridgeResource constructs a new BridgeResource
 func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
-	return &BridgeResource{
+	r := &BridgeResource{
 		// Uses the name as the id...Should change this to the id
 		JAID:                   NewJAID(b.Name.String()),
 		Name:                   b.Name.String(),
@@ -37,4 +52,26 @@ func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
 		MinimumContractPayment: b.MinimumContractPayment,
 		CreatedAt:              b.CreatedAt,
 	}
+	if b.HealthCheckURL != nil {
+		r.HealthCheckURL = b.HealthCheckURL.String()
+		r.HealthCheckInterval = b.HealthCheckInterval.Duration().String()
+	}
+	return r
+}
+
+// SetHealthChecks adds the health of the bridge given its latest health checks, newest first.
+func (r *BridgeResource) SetHealthChecks(checks []bridges.BridgeHealthCheck) {
+	health := bridges.NewBridgeHealth(checks)
+	r.HealthStatus = health.Status
+	r.HealthDownSince = health.DownSince
+	r.HealthChecks = make([]BridgeHealthCheckResource, 0, len(checks))
+	for _, check := range checks {
+		r.HealthChecks = append(r.HealthChecks, BridgeHealthCheckResource{
+			Healthy:    check.Healthy,
+			StatusCode: check.StatusCode,
+			Latency:    check.Latency.String(),
+			Error:      check.Error,
+			CheckedAt:  check.CheckedAt,
+		})
+	}
 }
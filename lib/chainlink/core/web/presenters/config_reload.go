@@ -0,0 +1,29 @@
+package presenters
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+)
+
+// ConfigReloadResource is a JSONAPI resource of the outcome of reloading the config.
+type ConfigReloadResource struct {
+	JAID
+	Applied  []chainlink.AppliedConfigChange  `json:"applied"`
+	Rejected []chainlink.RejectedConfigChange `json:"rejected"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ConfigReloadResource) GetName() string {
+	return "configReloads"
+}
+
+// NewConfigReloadResource returns a new ConfigReloadResource for report.
+func NewConfigReloadResource(report chainlink.ConfigReloadReport) ConfigReloadResource {
+	r := ConfigReloadResource{
+		JAID:     NewJAID("config"),
+		Applied:  []chainlink.AppliedConfigChange{},
+		Rejected: []chainlink.RejectedConfigChange{},
+	}
+	r.Applied = append(r.Applied, report.Applied...)
+	r.Rejected = append(r.Rejected, report.Rejected...)
+	return r
+}
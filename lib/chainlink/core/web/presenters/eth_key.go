@@ -5,6 +5,7 @@ import (
 
 	commonassets "github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink-evm/pkg/monitor"
 	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
 )
@@ -21,6 +22,20 @@ type ETHKeyResource struct {
 	CreatedAt      time.Time          `json:"createdAt"`
 	UpdatedAt      time.Time          `json:"updatedAt"`
 	MaxGasPriceWei *big.Big           `json:"maxGasPriceWei"`
+	// MinBalance, DaysUntilEmpty, TokenBalances and BelowMinimum are reported by the balance monitor of the chain
+	MinBalance     *assets.Eth          `json:"minBalance"`
+	DaysUntilEmpty *float64             `json:"daysUntilEmpty"`
+	TokenBalances  []ETHKeyTokenBalance `json:"tokenBalances"`
+	BelowMinimum   bool                 `json:"belowMinimum"`
+}
+
+// ETHKeyTokenBalance is the balance of an ERC-20 token tracked by the balance monitor, in whole tokens
+type ETHKeyTokenBalance struct {
+	Symbol       string  `json:"symbol"`
+	Address      string  `json:"address"`
+	Balance      string  `json:"balance"`
+	MinBalance   *string `json:"minBalance"`
+	BelowMinimum bool    `json:"belowMinimum"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -69,6 +84,32 @@ func SetETHKeyLinkBalance(linkBalance *commonassets.Link) NewETHKeyOption {
 	}
 }
 
+func SetETHKeyBalanceReport(report *monitor.BalanceReport) NewETHKeyOption {
+	return func(r *ETHKeyResource) {
+		if report == nil {
+			return
+		}
+		if report.MinBalance != nil && !report.MinBalance.IsZero() {
+			r.MinBalance = (*assets.Eth)(report.MinBalance.ToInt())
+		}
+		r.DaysUntilEmpty = report.DaysUntilEmpty
+		r.BelowMinimum = report.BelowMinimum()
+		for _, t := range report.Tokens {
+			tb := ETHKeyTokenBalance{
+				Symbol:       t.Symbol,
+				Address:      t.Address.Hex(),
+				Balance:      t.Balance.String(),
+				BelowMinimum: t.BelowMinimum(),
+			}
+			if t.MinBalance != nil {
+				minBalance := t.MinBalance.String()
+				tb.MinBalance = &minBalance
+			}
+			r.TokenBalances = append(r.TokenBalances, tb)
+		}
+	}
+}
+
 func SetETHKeyMaxGasPriceWei(maxGasPriceWei *big.Big) NewETHKeyOption {
 	return func(r *ETHKeyResource) {
 		r.MaxGasPriceWei = maxGasPriceWei
@@ -7,12 +7,14 @@ import (
 
 	commonassets "github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink-evm/pkg/assets"
+	"github.com/smartcontractkit/chainlink-evm/pkg/monitor"
 	"github.com/smartcontractkit/chainlink-evm/pkg/types"
 	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/manyminds/api2go/jsonapi"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -22,6 +24,9 @@ func TestETHKeyResource(t *testing.T) {
 		now        = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
 		addressStr = "0x2aCFF2ec69aa9945Ed84f4F281eCCF6911A3B0eD"
 		address    = common.HexToAddress(addressStr)
+
+		daysUntilEmpty = 3.5
+		minLink        = decimal.RequireFromString("10")
 	)
 	eip55address, err := types.NewEIP55Address(addressStr)
 	require.NoError(t, err)
@@ -43,6 +48,18 @@ func TestETHKeyResource(t *testing.T) {
 		SetETHKeyEthBalance(assets.NewEth(1)),
 		SetETHKeyLinkBalance(commonassets.NewLinkFromJuels(1)),
 		SetETHKeyMaxGasPriceWei(big.NewI(12345)),
+		SetETHKeyBalanceReport(&monitor.BalanceReport{
+			Address:        address,
+			EthBalance:     assets.NewEth(1),
+			MinBalance:     assets.NewWeiI(2),
+			DaysUntilEmpty: &daysUntilEmpty,
+			Tokens: []monitor.TokenBalance{{
+				Symbol:     "LINK",
+				Address:    address,
+				Balance:    decimal.RequireFromString("1.5"),
+				MinBalance: &minLink,
+			}},
+		}),
 	)
 
 	assert.Equal(t, assets.NewEth(1), r.EthBalance)
@@ -65,11 +82,21 @@ func TestETHKeyResource(t *testing.T) {
 			  "disabled":true,
 			  "createdAt":"2000-01-01T00:00:00Z",
 			  "updatedAt":"2000-01-01T00:00:00Z",
-			  "maxGasPriceWei":"12345"
+			  "maxGasPriceWei":"12345",
+			  "minBalance":"2",
+			  "daysUntilEmpty":3.5,
+			  "tokenBalances":[{
+				"symbol":"LINK",
+				"address":"%s",
+				"balance":"1.5",
+				"minBalance":"10",
+				"belowMinimum":true
+			  }],
+			  "belowMinimum":true
 		   }
 		}
 	 }
-	`, addressStr, addressStr)
+	`, addressStr, addressStr, addressStr)
 
 	assert.JSONEq(t, expected, string(b))
 
@@ -94,7 +121,11 @@ func TestETHKeyResource(t *testing.T) {
 				"disabled":true,
 				"createdAt":"2000-01-01T00:00:00Z",
 				"updatedAt":"2000-01-01T00:00:00Z",
-				"maxGasPriceWei":null
+				"maxGasPriceWei":null,
+				"minBalance":null,
+				"daysUntilEmpty":null,
+				"tokenBalances":null,
+				"belowMinimum":false
 			}
 		}
 	}`,
@@ -0,0 +1,44 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/client"
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+)
+
+// EVMNodeScoreResource is the consistency score JSONAPI resource of an EVM RPC node, identified by the node name.
+type EVMNodeScoreResource struct {
+	JAID
+	EVMChainID       big.Big    `json:"evmChainId"`
+	Score            int        `json:"score"`
+	Quarantined      bool       `json:"quarantined"`
+	QuarantineReason string     `json:"quarantineReason"`
+	Latency          string     `json:"latency"`
+	Disagreements    uint64     `json:"disagreements"`
+	LastFailure      string     `json:"lastFailure"`
+	LastProbedAt     *time.Time `json:"lastProbedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r EVMNodeScoreResource) GetName() string {
+	return "evm_node_score"
+}
+
+// NewEVMNodeScoreResource returns a new EVMNodeScoreResource for the score of a node on chain chainID.
+func NewEVMNodeScoreResource(chainID big.Big, score client.NodeScore) EVMNodeScoreResource {
+	r := EVMNodeScoreResource{
+		JAID:             NewJAID(score.Name),
+		EVMChainID:       chainID,
+		Score:            score.Score,
+		Quarantined:      score.Quarantined,
+		QuarantineReason: score.QuarantineReason,
+		Latency:          score.Latency.String(),
+		Disagreements:    score.Disagreements,
+		LastFailure:      score.LastFailure,
+	}
+	if !score.LastProbedAt.IsZero() {
+		r.LastProbedAt = &score.LastProbedAt
+	}
+	return r
+}
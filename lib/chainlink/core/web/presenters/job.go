@@ -257,45 +257,61 @@ func NewWebhookSpec(spec *job.WebhookSpec) *WebhookSpec {
 
 // CronSpec defines the spec details of a Cron Job
 type CronSpec struct {
-	CronSchedule string    `json:"schedule"`
-	CreatedAt    time.Time `json:"createdAt"`
-	UpdatedAt    time.Time `json:"updatedAt"`
-	EVMChainID   *big.Big  `json:"evmChainID"`
+	CronSchedule   string                `json:"schedule"`
+	Timezone       string                `json:"timezone"`
+	Jitter         commonconfig.Duration `json:"jitter"`
+	OverlapPolicy  job.CronOverlapPolicy `json:"overlapPolicy"`
+	MaxCatchUpRuns uint32                `json:"maxCatchUpRuns"`
+	LastFiredAt    *time.Time            `json:"lastFiredAt"`
+	CreatedAt      time.Time             `json:"createdAt"`
+	UpdatedAt      time.Time             `json:"updatedAt"`
+	EVMChainID     *big.Big              `json:"evmChainID"`
 }
 
 // NewCronSpec generates a new CronSpec from a job.CronSpec
 func NewCronSpec(spec *job.CronSpec) *CronSpec {
 	return &CronSpec{
-		CronSchedule: spec.CronSchedule,
-		CreatedAt:    spec.CreatedAt,
-		UpdatedAt:    spec.UpdatedAt,
-		EVMChainID:   spec.EVMChainID,
+		CronSchedule:   spec.CronSchedule,
+		Timezone:       spec.Timezone,
+		Jitter:         *commonconfig.MustNewDuration(spec.Jitter),
+		OverlapPolicy:  spec.OverlapPolicy,
+		MaxCatchUpRuns: spec.MaxCatchUpRuns,
+		LastFiredAt:    spec.LastFiredAt,
+		CreatedAt:      spec.CreatedAt,
+		UpdatedAt:      spec.UpdatedAt,
+		EVMChainID:     spec.EVMChainID,
 	}
 }
 
 type VRFSpec struct {
-	BatchCoordinatorAddress       *types.EIP55Address   `json:"batchCoordinatorAddress"`
-	BatchFulfillmentEnabled       bool                  `json:"batchFulfillmentEnabled"`
-	CustomRevertsPipelineEnabled  *bool                 `json:"customRevertsPipelineEnabled,omitempty"`
-	BatchFulfillmentGasMultiplier float64               `json:"batchFulfillmentGasMultiplier"`
-	CoordinatorAddress            types.EIP55Address    `json:"coordinatorAddress"`
-	PublicKey                     secp256k1.PublicKey   `json:"publicKey"`
-	FromAddresses                 []types.EIP55Address  `json:"fromAddresses"`
-	PollPeriod                    commonconfig.Duration `json:"pollPeriod"`
-	MinIncomingConfirmations      uint32                `json:"confirmations"`
-	CreatedAt                     time.Time             `json:"createdAt"`
-	UpdatedAt                     time.Time             `json:"updatedAt"`
-	EVMChainID                    *big.Big              `json:"evmChainID"`
-	ChunkSize                     uint32                `json:"chunkSize"`
-	RequestTimeout                commonconfig.Duration `json:"requestTimeout"`
-	BackoffInitialDelay           commonconfig.Duration `json:"backoffInitialDelay"`
-	BackoffMaxDelay               commonconfig.Duration `json:"backoffMaxDelay"`
-	GasLanePrice                  *assets.Wei           `json:"gasLanePrice"`
-	RequestedConfsDelay           int64                 `json:"requestedConfsDelay"`
-	VRFOwnerAddress               *types.EIP55Address   `json:"vrfOwnerAddress,omitempty"`
+	BatchCoordinatorAddress       *types.EIP55Address    `json:"batchCoordinatorAddress"`
+	BatchFulfillmentEnabled       bool                   `json:"batchFulfillmentEnabled"`
+	CustomRevertsPipelineEnabled  *bool                  `json:"customRevertsPipelineEnabled,omitempty"`
+	BatchFulfillmentGasMultiplier float64                `json:"batchFulfillmentGasMultiplier"`
+	CoordinatorAddress            types.EIP55Address     `json:"coordinatorAddress"`
+	PublicKey                     secp256k1.PublicKey    `json:"publicKey"`
+	FromAddresses                 []types.EIP55Address   `json:"fromAddresses"`
+	PollPeriod                    commonconfig.Duration  `json:"pollPeriod"`
+	MinIncomingConfirmations      uint32                 `json:"confirmations"`
+	CreatedAt                     time.Time              `json:"createdAt"`
+	UpdatedAt                     time.Time              `json:"updatedAt"`
+	EVMChainID                    *big.Big               `json:"evmChainID"`
+	ChunkSize                     uint32                 `json:"chunkSize"`
+	RequestTimeout                commonconfig.Duration  `json:"requestTimeout"`
+	BackoffInitialDelay           commonconfig.Duration  `json:"backoffInitialDelay"`
+	BackoffMaxDelay               commonconfig.Duration  `json:"backoffMaxDelay"`
+	GasLanePrice                  *assets.Wei            `json:"gasLanePrice"`
+	RequestedConfsDelay           int64                  `json:"requestedConfsDelay"`
+	VRFOwnerAddress               *types.EIP55Address    `json:"vrfOwnerAddress,omitempty"`
+	SubscriptionAlertThreshold    *commonconfig.Duration `json:"subscriptionAlertThreshold,omitempty"`
+	SubscriptionAlertWebhookURL   string                 `json:"subscriptionAlertWebhookURL,omitempty"`
 }
 
 func NewVRFSpec(spec *job.VRFSpec) *VRFSpec {
+	var subscriptionAlertThreshold *commonconfig.Duration
+	if spec.SubscriptionAlertThreshold > 0 {
+		subscriptionAlertThreshold = commonconfig.MustNewDuration(spec.SubscriptionAlertThreshold)
+	}
 	return &VRFSpec{
 		BatchCoordinatorAddress:       spec.BatchCoordinatorAddress,
 		BatchFulfillmentEnabled:       spec.BatchFulfillmentEnabled,
@@ -316,6 +332,8 @@ func NewVRFSpec(spec *job.VRFSpec) *VRFSpec {
 		GasLanePrice:                  spec.GasLanePrice,
 		RequestedConfsDelay:           spec.RequestedConfsDelay,
 		VRFOwnerAddress:               spec.VRFOwnerAddress,
+		SubscriptionAlertThreshold:    subscriptionAlertThreshold,
+		SubscriptionAlertWebhookURL:   spec.SubscriptionAlertWebhookURL,
 	}
 }
 
@@ -409,6 +409,11 @@ func TestJob(t *testing.T) {
                         },
                         "cronSpec": {
                             "schedule": "%s",
+                            "timezone": "",
+                            "jitter": "0s",
+                            "overlapPolicy": "",
+                            "maxCatchUpRuns": 0,
+                            "lastFiredAt": null,
                             "createdAt":"2000-01-01T00:00:00Z",
                             "updatedAt":"2000-01-01T00:00:00Z",
                             "evmChainID":"42"
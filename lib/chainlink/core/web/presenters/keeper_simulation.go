@@ -0,0 +1,53 @@
+package presenters
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
+)
+
+// UpkeepSimulationResource represents the outcome of simulating the check and perform
+// path of an upkeep as a JSONAPI resource.
+type UpkeepSimulationResource struct {
+	JAID
+	EVMChainID     string  `json:"evmChainID"`
+	Registry       string  `json:"registry"`
+	TypeAndVersion string  `json:"typeAndVersion"`
+	UpkeepID       string  `json:"upkeepID"`
+	BlockNumber    int64   `json:"blockNumber"`
+	From           *string `json:"from"`
+	Eligible       bool    `json:"eligible"`
+	FailureReason  string  `json:"failureReason"`
+	PerformData    string  `json:"performData"`
+	PerformSuccess *bool   `json:"performSuccess"`
+	GasEstimate    *uint64 `json:"gasEstimate"`
+	RevertReason   string  `json:"revertReason"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r UpkeepSimulationResource) GetName() string {
+	return "upkeepSimulations"
+}
+
+// NewUpkeepSimulationResource constructs a new UpkeepSimulationResource
+func NewUpkeepSimulationResource(chainID string, sim keeper.UpkeepSimulation) *UpkeepSimulationResource {
+	resource := &UpkeepSimulationResource{
+		JAID:           NewJAID(sim.UpkeepID.String()),
+		EVMChainID:     chainID,
+		Registry:       sim.Registry.Hex(),
+		TypeAndVersion: sim.TypeAndVersion,
+		UpkeepID:       sim.UpkeepID.String(),
+		BlockNumber:    sim.BlockNumber,
+		Eligible:       sim.Eligible,
+		FailureReason:  sim.FailureReason,
+		PerformData:    hexutil.Encode(sim.PerformData),
+		PerformSuccess: sim.PerformSuccess,
+		GasEstimate:    sim.GasEstimate,
+		RevertReason:   sim.RevertReason,
+	}
+	if sim.From != nil {
+		from := sim.From.Hex()
+		resource.From = &from
+	}
+	return resource
+}
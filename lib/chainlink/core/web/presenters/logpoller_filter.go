@@ -0,0 +1,45 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+)
+
+// LogPollerFilterResource is a LogPoller filter JSONAPI resource, identified by the filter name.
+type LogPollerFilterResource struct {
+	JAID
+	EVMChainID        big.Big          `json:"evmChainId"`
+	Addresses         []common.Address `json:"addresses"`
+	EventSigs         []common.Hash    `json:"eventSigs"`
+	Retention         string           `json:"retention"`
+	MaxLogsKept       uint64           `json:"maxLogsKept"`
+	LogCount          int64            `json:"logCount"`
+	OldestBlockNumber *int64           `json:"oldestBlockNumber"`
+	NewestBlockNumber *int64           `json:"newestBlockNumber"`
+	LastMatchedAt     *time.Time       `json:"lastMatchedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r LogPollerFilterResource) GetName() string {
+	return "logpoller_filter"
+}
+
+// NewLogPollerFilterResource returns a new LogPollerFilterResource for the stats of a filter on chain chainID.
+func NewLogPollerFilterResource(chainID big.Big, stats logpoller.FilterStats) LogPollerFilterResource {
+	return LogPollerFilterResource{
+		JAID:              NewJAID(stats.Name),
+		EVMChainID:        chainID,
+		Addresses:         stats.Addresses,
+		EventSigs:         stats.EventSigs,
+		Retention:         stats.Retention.String(),
+		MaxLogsKept:       stats.MaxLogsKept,
+		LogCount:          stats.LogCount,
+		OldestBlockNumber: stats.OldestBlockNumber,
+		NewestBlockNumber: stats.NewestBlockNumber,
+		LastMatchedAt:     stats.LastMatchedAt,
+	}
+}
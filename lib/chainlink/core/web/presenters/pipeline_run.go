@@ -104,3 +104,28 @@ func NewPipelineRunResources(prs []pipeline.Run, lggr logger.Logger) []PipelineR
 
 	return out
 }
+
+// JobSimulationResource represents the outcome of a simulated job pipeline run.
+type JobSimulationResource struct {
+	JAID
+	Outputs     []any                `json:"outputs"`
+	AllErrors   []*string            `json:"allErrors"`
+	FatalErrors []*string            `json:"fatalErrors"`
+	Tasks       []pipeline.TaskTrace `json:"tasks"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobSimulationResource) GetName() string {
+	return "jobSimulation"
+}
+
+// NewJobSimulationResource constructs a new JobSimulationResource, identified by the external job ID of the simulated spec.
+func NewJobSimulationResource(externalJobID string, report pipeline.SimulationReport) *JobSimulationResource {
+	return &JobSimulationResource{
+		JAID:        NewJAID(externalJobID),
+		Outputs:     report.Outputs,
+		AllErrors:   report.AllErrors,
+		FatalErrors: report.FatalErrors,
+		Tasks:       report.Tasks,
+	}
+}
@@ -0,0 +1,58 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/reorgs"
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+)
+
+// ReorgResource is a JSONAPI resource of a reorg recorded by the reorg journal of an EVM chain.
+type ReorgResource struct {
+	JAID
+	EVMChainID       big.Big     `json:"evmChainId"`
+	Source           string      `json:"source"`
+	Depth            int64       `json:"depth"`
+	LCABlockNumber   int64       `json:"lcaBlockNumber"`
+	LCABlockHash     common.Hash `json:"lcaBlockHash"`
+	OldHeadNumber    int64       `json:"oldHeadNumber"`
+	OldHeadHash      common.Hash `json:"oldHeadHash"`
+	OldHeadTimestamp time.Time   `json:"oldHeadTimestamp"`
+	NewHeadNumber    int64       `json:"newHeadNumber"`
+	NewHeadHash      common.Hash `json:"newHeadHash"`
+	NewHeadTimestamp time.Time   `json:"newHeadTimestamp"`
+	TxIDs            []int64     `json:"txIds"`
+	LogCount         int64       `json:"logCount"`
+	DetectedAt       time.Time   `json:"detectedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ReorgResource) GetName() string {
+	return "reorg"
+}
+
+// NewReorgResource returns a new ReorgResource for r.
+func NewReorgResource(r reorgs.Reorg) ReorgResource {
+	txIDs := []int64{}
+	txIDs = append(txIDs, r.TxIDs...)
+	return ReorgResource{
+		JAID:             NewJAID(strconv.FormatInt(r.ID, 10)),
+		EVMChainID:       *r.EVMChainID,
+		Source:           string(r.Source),
+		Depth:            r.Depth,
+		LCABlockNumber:   r.LCABlockNumber,
+		LCABlockHash:     r.LCABlockHash,
+		OldHeadNumber:    r.OldHeadNumber,
+		OldHeadHash:      r.OldHeadHash,
+		OldHeadTimestamp: r.OldHeadTimestamp,
+		NewHeadNumber:    r.NewHeadNumber,
+		NewHeadHash:      r.NewHeadHash,
+		NewHeadTimestamp: r.NewHeadTimestamp,
+		TxIDs:            txIDs,
+		LogCount:         r.LogCount,
+		DetectedAt:       r.DetectedAt,
+	}
+}
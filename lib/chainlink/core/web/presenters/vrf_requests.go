@@ -0,0 +1,83 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
+)
+
+// VRFRequestResource represents a VRF request, as seen by the listener of a job, as a JSONAPI resource.
+type VRFRequestResource struct {
+	JAID
+	JobID              int32                          `json:"jobID"`
+	RequestID          string                         `json:"requestID"`
+	EVMChainID         string                         `json:"evmChainID"`
+	CoordinatorAddress string                         `json:"coordinatorAddress"`
+	VRFVersion         string                         `json:"vrfVersion"`
+	SubID              string                         `json:"subID"`
+	Sender             string                         `json:"sender"`
+	RequestTxHash      string                         `json:"requestTxHash"`
+	RequestBlockNumber int64                          `json:"requestBlockNumber"`
+	ConfirmedAtBlock   int64                          `json:"confirmedAtBlock"`
+	State              string                         `json:"state"`
+	LastError          string                         `json:"lastError"`
+	EthTxID            *int64                         `json:"ethTxID"`
+	EthTxState         *string                        `json:"ethTxState"`
+	EthTxError         *string                        `json:"ethTxError"`
+	EthTxHash          *string                        `json:"ethTxHash"`
+	Transitions        []VRFRequestTransitionResource `json:"transitions"`
+	CreatedAt          time.Time                      `json:"createdAt"`
+	UpdatedAt          time.Time                      `json:"updatedAt"`
+}
+
+// VRFRequestTransitionResource is a change of state of a VRF request.
+type VRFRequestTransitionResource struct {
+	State     string    `json:"state"`
+	Error     string    `json:"error"`
+	EthTxID   *int64    `json:"ethTxID"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r VRFRequestResource) GetName() string {
+	return "vrfRequests"
+}
+
+// NewVRFRequestResource constructs a new VRFRequestResource
+func NewVRFRequestResource(r vrfcommon.Request, transitions []vrfcommon.RequestTransition) *VRFRequestResource {
+	resource := &VRFRequestResource{
+		JAID:               NewJAID(strconv.FormatInt(r.ID, 10)),
+		JobID:              r.JobID,
+		RequestID:          r.RequestID,
+		EVMChainID:         r.EVMChainID.String(),
+		CoordinatorAddress: r.CoordinatorAddress.Hex(),
+		VRFVersion:         string(r.VRFVersion),
+		SubID:              r.SubID,
+		Sender:             r.Sender.Hex(),
+		RequestTxHash:      r.RequestTxHash.Hex(),
+		RequestBlockNumber: r.RequestBlockNumber,
+		ConfirmedAtBlock:   r.ConfirmedAtBlock,
+		State:              string(r.State),
+		LastError:          r.LastError,
+		EthTxID:            r.EthTxID,
+		EthTxState:         r.EthTxState,
+		EthTxError:         r.EthTxError,
+		Transitions:        []VRFRequestTransitionResource{},
+		CreatedAt:          r.CreatedAt,
+		UpdatedAt:          r.UpdatedAt,
+	}
+	if r.EthTxHash != nil {
+		hash := r.EthTxHash.Hex()
+		resource.EthTxHash = &hash
+	}
+	for _, t := range transitions {
+		resource.Transitions = append(resource.Transitions, VRFRequestTransitionResource{
+			State:     string(t.State),
+			Error:     t.Error,
+			EthTxID:   t.EthTxID,
+			CreatedAt: t.CreatedAt,
+		})
+	}
+	return resource
+}
@@ -0,0 +1,61 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+const (
+	defaultReorgsLimit = 50
+	maxReorgsLimit     = 1000
+)
+
+// ReorgsController lists the reorgs recorded by the reorg journal of an EVM chain.
+type ReorgsController struct {
+	App chainlink.Application
+}
+
+// Index lists the latest reorgs of the chain, most recent first.
+// Example:
+//
+//	"<application>/v2/reorgs?evmChainID=1&limit=10"
+func (rc *ReorgsController) Index(c *gin.Context) {
+	limit := defaultReorgsLimit
+	if l := c.Query("limit"); l != "" {
+		var err error
+		limit, err = strconv.Atoi(l)
+		if err != nil || limit <= 0 || limit > maxReorgsLimit {
+			jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("limit must be between 1 and %d: %s", maxReorgsLimit, l))
+			return
+		}
+	}
+
+	chain, err := getChain(rc.App.GetRelayers().LegacyEVMChains(), c.Query("evmChainID"))
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) || errors.Is(err, ErrEmptyChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	found, err := chain.ReorgJournal().Reorgs(c.Request.Context(), limit)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	resources := []presenters.ReorgResource{}
+	for _, r := range found {
+		resources = append(resources, presenters.NewReorgResource(r))
+	}
+	jsonAPIResponse(c, resources, "reorg")
+}
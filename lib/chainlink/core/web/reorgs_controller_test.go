@@ -0,0 +1,59 @@
+package web_test
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/config/toml"
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func Test_ReorgsController_Index(t *testing.T) {
+	t.Parallel()
+
+	chainID := big.New(testutils.NewRandomEVMChainID())
+	app := cltest.NewApplicationWithConfig(t, configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM = toml.EVMConfigs{
+			{ChainID: chainID, Enabled: ptr(true), Chain: toml.Defaults(chainID)},
+		}
+	}))
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	for _, tc := range []struct {
+		name  string
+		query string
+		msg   string
+	}{
+		{"unknown chain", "?evmChainID=1", "chain id does not match any local chains"},
+		{"invalid limit", "?limit=0&evmChainID=" + chainID.String(), "limit must be between 1 and 1000"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, cleanup := client.Get("/v2/reorgs" + tc.query)
+			t.Cleanup(cleanup)
+			assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+			b, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.Contains(t, string(b), tc.msg)
+		})
+	}
+
+	t.Run("no reorgs", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/reorgs?limit=10&evmChainID=" + chainID.String())
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var resources []presenters.ReorgResource
+		cltest.ParseJSONAPIResponse(t, resp, &resources)
+		assert.Empty(t, resources)
+	})
+}
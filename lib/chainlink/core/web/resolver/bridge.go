@@ -9,6 +9,8 @@ import (
 // BridgeResolver resolves the Bridge type.
 type BridgeResolver struct {
 	bridge bridges.BridgeType
+	// health is only loaded when fetching a single bridge
+	health *BridgeHealthResolver
 }
 
 func NewBridge(bridge bridges.BridgeType) *BridgeResolver {
@@ -59,22 +61,131 @@ func (r *BridgeResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.bridge.CreatedAt}
 }
 
+// HealthCheckURL resolves the bridge's health check url.
+func (r *BridgeResolver) HealthCheckURL() *string {
+	if r.bridge.HealthCheckURL == nil {
+		return nil
+	}
+	u := r.bridge.HealthCheckURL.String()
+	return &u
+}
+
+// HealthCheckInterval resolves the bridge's health check interval.
+func (r *BridgeResolver) HealthCheckInterval() *string {
+	if r.bridge.HealthCheckURL == nil {
+		return nil
+	}
+	interval := r.bridge.HealthCheckInterval.Duration().String()
+	return &interval
+}
+
+// Health resolves the bridge's health, if it was loaded.
+func (r *BridgeResolver) Health() *BridgeHealthResolver {
+	return r.health
+}
+
+type BridgeHealthStatus string
+
+const (
+	BridgeHealthStatusUnknown BridgeHealthStatus = "UNKNOWN"
+	BridgeHealthStatusUp      BridgeHealthStatus = "UP"
+	BridgeHealthStatusDown    BridgeHealthStatus = "DOWN"
+)
+
+func NewBridgeHealthStatus(status bridges.HealthStatus) BridgeHealthStatus {
+	switch status {
+	case bridges.HealthStatusUp:
+		return BridgeHealthStatusUp
+	case bridges.HealthStatusDown:
+		return BridgeHealthStatusDown
+	default:
+		return BridgeHealthStatusUnknown
+	}
+}
+
+// BridgeHealthResolver resolves the BridgeHealth type.
+type BridgeHealthResolver struct {
+	health bridges.BridgeHealth
+	checks []bridges.BridgeHealthCheck
+}
+
+// NewBridgeHealth resolves the health of a bridge given its latest health checks, newest first.
+func NewBridgeHealth(checks []bridges.BridgeHealthCheck) *BridgeHealthResolver {
+	return &BridgeHealthResolver{health: bridges.NewBridgeHealth(checks), checks: checks}
+}
+
+// Status resolves the bridge's health status.
+func (r *BridgeHealthResolver) Status() BridgeHealthStatus {
+	return NewBridgeHealthStatus(r.health.Status)
+}
+
+// DownSince resolves the time the bridge went down.
+func (r *BridgeHealthResolver) DownSince() *graphql.Time {
+	if r.health.DownSince == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *r.health.DownSince}
+}
+
+// Checks resolves the bridge's latest health checks.
+func (r *BridgeHealthResolver) Checks() []*BridgeHealthCheckResolver {
+	resolvers := make([]*BridgeHealthCheckResolver, 0, len(r.checks))
+	for _, check := range r.checks {
+		resolvers = append(resolvers, &BridgeHealthCheckResolver{check: check})
+	}
+	return resolvers
+}
+
+// BridgeHealthCheckResolver resolves the BridgeHealthCheck type.
+type BridgeHealthCheckResolver struct {
+	check bridges.BridgeHealthCheck
+}
+
+// Healthy resolves whether the check succeeded.
+func (r *BridgeHealthCheckResolver) Healthy() bool {
+	return r.check.Healthy
+}
+
+// StatusCode resolves the HTTP status code of the check, 0 if there was no response.
+func (r *BridgeHealthCheckResolver) StatusCode() int32 {
+	return int32(r.check.StatusCode)
+}
+
+// Latency resolves the time the check took.
+func (r *BridgeHealthCheckResolver) Latency() string {
+	return r.check.Latency.String()
+}
+
+// Error resolves the error of a failed check.
+func (r *BridgeHealthCheckResolver) Error() *string {
+	if r.check.Error == "" {
+		return nil
+	}
+	return &r.check.Error
+}
+
+// CheckedAt resolves the time of the check.
+func (r *BridgeHealthCheckResolver) CheckedAt() graphql.Time {
+	return graphql.Time{Time: r.check.CheckedAt}
+}
+
 // BridgePayloadResolver resolves a single bridge response
 type BridgePayloadResolver struct {
 	bridge bridges.BridgeType
+	health *BridgeHealthResolver
 	NotFoundErrorUnionType
 }
 
-func NewBridgePayload(bridge bridges.BridgeType, err error) *BridgePayloadResolver {
+func NewBridgePayload(bridge bridges.BridgeType, health *BridgeHealthResolver, err error) *BridgePayloadResolver {
 	e := NotFoundErrorUnionType{err: err, message: "bridge not found"}
 
-	return &BridgePayloadResolver{bridge: bridge, NotFoundErrorUnionType: e}
+	return &BridgePayloadResolver{bridge: bridge, health: health, NotFoundErrorUnionType: e}
 }
 
 // ToBridge implements the Bridge union type of the payload
 func (r *BridgePayloadResolver) ToBridge() (*BridgeResolver, bool) {
 	if r.err == nil {
-		return NewBridge(r.bridge), true
+		return &BridgeResolver{bridge: r.bridge, health: r.health}, true
 	}
 
 	return nil, false
@@ -6,12 +6,14 @@ import (
 	"encoding/json"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
@@ -161,6 +163,100 @@ func Test_Bridge(t *testing.T) {
 	RunGQLTests(t, testCases)
 }
 
+func Test_BridgeHealth(t *testing.T) {
+	t.Parallel()
+
+	var (
+		query = `
+			query GetBridge{
+				bridge(id: "bridge1") {
+					... on Bridge {
+						name
+						healthCheckURL
+						healthCheckInterval
+						health {
+							status
+							downSince
+							checks {
+								healthy
+								statusCode
+								latency
+								error
+								checkedAt
+							}
+						}
+					}
+				}
+			}`
+
+		name = bridges.BridgeName("bridge1")
+	)
+	bridgeURL, err := url.Parse("https://external.adapter")
+	require.NoError(t, err)
+	healthCheckURL, err := url.Parse("https://external.adapter/health")
+	require.NoError(t, err)
+
+	testCases := []GQLTestCase{
+		{
+			name:          "down",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("BridgeORM").Return(f.Mocks.bridgeORM)
+				f.Mocks.bridgeORM.On("FindBridge", mock.Anything, name).Return(bridges.BridgeType{
+					Name:                name,
+					URL:                 models.WebURL(*bridgeURL),
+					HealthCheckURL:      (*models.WebURL)(healthCheckURL),
+					HealthCheckInterval: sqlutil.Interval(time.Minute),
+				}, nil)
+				f.Mocks.bridgeORM.On("BridgeHealthChecks", mock.Anything, name, 20).Return([]bridges.BridgeHealthCheck{
+					{BridgeName: name, StatusCode: 503, Latency: time.Millisecond, Error: "unexpected status 503 Service Unavailable", CheckedAt: f.Timestamp().Add(time.Minute)},
+					{BridgeName: name, StatusCode: 0, Latency: 10 * time.Second, Error: "context deadline exceeded", CheckedAt: f.Timestamp()},
+					{BridgeName: name, Healthy: true, StatusCode: 200, Latency: time.Millisecond, CheckedAt: f.Timestamp().Add(-time.Minute)},
+				}, nil)
+			},
+			query: query,
+			result: `{
+				"bridge": {
+					"name": "bridge1",
+					"healthCheckURL": "https://external.adapter/health",
+					"healthCheckInterval": "1m0s",
+					"health": {
+						"status": "DOWN",
+						"downSince": "2021-01-01T00:00:00Z",
+						"checks": [
+							{"healthy": false, "statusCode": 503, "latency": "1ms", "error": "unexpected status 503 Service Unavailable", "checkedAt": "2021-01-01T00:01:00Z"},
+							{"healthy": false, "statusCode": 0, "latency": "10s", "error": "context deadline exceeded", "checkedAt": "2021-01-01T00:00:00Z"},
+							{"healthy": true, "statusCode": 200, "latency": "1ms", "error": null, "checkedAt": "2020-12-31T23:59:00Z"}
+						]
+					}
+				}
+			}`,
+		},
+		{
+			name:          "no health check",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("BridgeORM").Return(f.Mocks.bridgeORM)
+				f.Mocks.bridgeORM.On("FindBridge", mock.Anything, name).Return(bridges.BridgeType{
+					Name: name,
+					URL:  models.WebURL(*bridgeURL),
+				}, nil)
+			},
+			query: query,
+			result: `{
+				"bridge": {
+					"name": "bridge1",
+					"healthCheckURL": null,
+					"healthCheckInterval": null,
+					"health": null
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
 func Test_CreateBridge(t *testing.T) {
 	t.Parallel()
 
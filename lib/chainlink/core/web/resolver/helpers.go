@@ -4,17 +4,23 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"net/url"
 	"strings"
 
 	"github.com/graph-gophers/graphql-go"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 )
 
 const (
+	// bridgeHealthChecksLimit is the number of recent health checks resolved for a bridge
+	bridgeHealthChecksLimit = 20
+
 	// PageDefaultOffset defines the default offset to use if none is provided
 	PageDefaultOffset = 0
 
@@ -50,6 +56,23 @@ func pageLimit(limit *int32) int {
 	return int(*limit)
 }
 
+// parseBridgeHealthCheck parses the optional health check inputs of a bridge.
+func parseBridgeHealthCheck(healthCheckURL, healthCheckInterval *string) (u models.WebURL, interval sqlutil.Interval, err error) {
+	if healthCheckURL != nil && len(*healthCheckURL) != 0 {
+		rURL, err := url.ParseRequestURI(*healthCheckURL)
+		if err != nil {
+			return u, interval, errors.Wrap(err, "invalid healthCheckURL")
+		}
+		u = models.WebURL(*rURL)
+	}
+	if healthCheckInterval != nil && len(*healthCheckInterval) != 0 {
+		if err := interval.UnmarshalText([]byte(*healthCheckInterval)); err != nil {
+			return u, interval, errors.Wrap(err, "invalid healthCheckInterval")
+		}
+	}
+	return u, interval, nil
+}
+
 // ValidateBridgeTypeUniqueness checks that a bridge has not already been created
 //
 // / This validation function should be moved into a bridge service.
@@ -85,6 +108,9 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest) error {
 		bt.MinimumContractPayment.Cmp(assets.NewLinkFromJuels(0)) < 0 {
 		return errors.New("MinimumContractPayment must be positive")
 	}
+	if err := bt.ValidateHealthCheck(); err != nil {
+		return err
+	}
 
 	return nil
 }
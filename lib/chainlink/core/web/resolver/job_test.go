@@ -354,6 +354,8 @@ func TestResolver_CreateJob(t *testing.T) {
 			name:          "success",
 			authenticated: true,
 			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("PipelineFragmentORM").Return(f.Mocks.fragmentORM)
+				f.Mocks.fragmentORM.On("Lookup", mock.Anything).Return(pipeline.FragmentLookup(nil), nil)
 				f.App.On("GetConfig").Return(f.Mocks.cfg)
 				f.App.On("AddJobV2", mock.Anything, &jb).Return(nil)
 			},
@@ -364,8 +366,12 @@ func TestResolver_CreateJob(t *testing.T) {
 		{
 			name:          "invalid TOML error",
 			authenticated: true,
-			query:         mutation,
-			variables:     invalid,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("PipelineFragmentORM").Return(f.Mocks.fragmentORM)
+				f.Mocks.fragmentORM.On("Lookup", mock.Anything).Return(pipeline.FragmentLookup(nil), nil)
+			},
+			query:     mutation,
+			variables: invalid,
 			result: `
 				{
 					"createJob": {
@@ -381,6 +387,8 @@ func TestResolver_CreateJob(t *testing.T) {
 			name:          "generic error when adding the job",
 			authenticated: true,
 			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("PipelineFragmentORM").Return(f.Mocks.fragmentORM)
+				f.Mocks.fragmentORM.On("Lookup", mock.Anything).Return(pipeline.FragmentLookup(nil), nil)
 				f.App.On("GetConfig").Return(f.Mocks.cfg)
 				f.App.On("AddJobV2", mock.Anything, &jb).Return(gError)
 			},
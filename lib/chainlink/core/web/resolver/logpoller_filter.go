@@ -0,0 +1,236 @@
+package resolver
+
+import (
+	"strconv"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/chains"
+	"github.com/smartcontractkit/chainlink-evm/pkg/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+)
+
+// LogPollerFilterResolver resolves the LogPollerFilter type.
+type LogPollerFilterResolver struct {
+	chainID big.Big
+	stats   logpoller.FilterStats
+}
+
+func NewLogPollerFilter(chainID big.Big, stats logpoller.FilterStats) *LogPollerFilterResolver {
+	return &LogPollerFilterResolver{chainID: chainID, stats: stats}
+}
+
+func NewLogPollerFilters(chainID big.Big, stats []logpoller.FilterStats) []*LogPollerFilterResolver {
+	var resolvers []*LogPollerFilterResolver
+	for _, s := range stats {
+		resolvers = append(resolvers, NewLogPollerFilter(chainID, s))
+	}
+
+	return resolvers
+}
+
+// Name resolves the unique name of the filter.
+func (r *LogPollerFilterResolver) Name() string {
+	return r.stats.Name
+}
+
+// EVMChainID resolves the chain the filter is registered on.
+func (r *LogPollerFilterResolver) EVMChainID() graphql.ID {
+	return graphql.ID(r.chainID.String())
+}
+
+// Addresses resolves the contract addresses matched by the filter.
+func (r *LogPollerFilterResolver) Addresses() []string {
+	addresses := []string{}
+	for _, a := range r.stats.Addresses {
+		addresses = append(addresses, a.Hex())
+	}
+	return addresses
+}
+
+// EventSigs resolves the event signatures matched by the filter.
+func (r *LogPollerFilterResolver) EventSigs() []string {
+	sigs := []string{}
+	for _, s := range r.stats.EventSigs {
+		sigs = append(sigs, s.Hex())
+	}
+	return sigs
+}
+
+// Retention resolves how long matching logs are retained, 0s meaning forever.
+func (r *LogPollerFilterResolver) Retention() string {
+	return r.stats.Retention.String()
+}
+
+// MaxLogsKept resolves how many matching logs are retained, 0 meaning unlimited.
+func (r *LogPollerFilterResolver) MaxLogsKept() string {
+	return strconv.FormatUint(r.stats.MaxLogsKept, 10)
+}
+
+// LogCount resolves the number of retained logs matching the filter.
+func (r *LogPollerFilterResolver) LogCount() string {
+	return strconv.FormatInt(r.stats.LogCount, 10)
+}
+
+// OldestBlockNumber resolves the block of the oldest retained log matching the filter.
+func (r *LogPollerFilterResolver) OldestBlockNumber() *string {
+	if r.stats.OldestBlockNumber == nil {
+		return nil
+	}
+	n := strconv.FormatInt(*r.stats.OldestBlockNumber, 10)
+	return &n
+}
+
+// NewestBlockNumber resolves the block of the newest retained log matching the filter.
+func (r *LogPollerFilterResolver) NewestBlockNumber() *string {
+	if r.stats.NewestBlockNumber == nil {
+		return nil
+	}
+	n := strconv.FormatInt(*r.stats.NewestBlockNumber, 10)
+	return &n
+}
+
+// LastMatchedAt resolves when the newest log matching the filter was saved.
+func (r *LogPollerFilterResolver) LastMatchedAt() *graphql.Time {
+	if r.stats.LastMatchedAt == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *r.stats.LastMatchedAt}
+}
+
+// -- LogPollerFilters Query --
+
+// LogPollerFiltersPayloadResolver resolves the filters of a chain.
+type LogPollerFiltersPayloadResolver struct {
+	chainID big.Big
+	stats   []logpoller.FilterStats
+	NotFoundErrorUnionType
+}
+
+func NewLogPollerFiltersPayload(chainID big.Big, stats []logpoller.FilterStats, err error) *LogPollerFiltersPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "chain not found", isExpectedErrorFn: func(err error) bool {
+		return errors.Is(err, chains.ErrNoSuchChainID)
+	}}
+
+	return &LogPollerFiltersPayloadResolver{chainID: chainID, stats: stats, NotFoundErrorUnionType: e}
+}
+
+// ToLogPollerFiltersSuccess implements the LogPollerFiltersSuccess union type of the payload
+func (r *LogPollerFiltersPayloadResolver) ToLogPollerFiltersSuccess() (*LogPollerFiltersSuccessResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return &LogPollerFiltersSuccessResolver{chainID: r.chainID, stats: r.stats}, true
+}
+
+// LogPollerFiltersSuccessResolver resolves the filters found for a chain.
+type LogPollerFiltersSuccessResolver struct {
+	chainID big.Big
+	stats   []logpoller.FilterStats
+}
+
+// Results resolves the filters, sorted by name.
+func (r *LogPollerFiltersSuccessResolver) Results() []*LogPollerFilterResolver {
+	return NewLogPollerFilters(r.chainID, r.stats)
+}
+
+// -- ReplayLogPollerFilter Mutation --
+
+type ReplayLogPollerFilterInput struct {
+	EVMChainID graphql.ID
+	Name       string
+	FromBlock  string
+}
+
+// ReplayLogPollerFilterPayloadResolver resolves the outcome of a filter replay.
+type ReplayLogPollerFilterPayloadResolver struct {
+	chainID big.Big
+	stats   *logpoller.FilterStats
+	// inputErrors maps an input path to a string
+	inputErrs map[string]string
+	NotFoundErrorUnionType
+}
+
+func NewReplayLogPollerFilterPayload(chainID big.Big, stats *logpoller.FilterStats, err error, inputErrs map[string]string) *ReplayLogPollerFilterPayloadResolver {
+	var e NotFoundErrorUnionType
+
+	if err != nil {
+		e = NotFoundErrorUnionType{err: err, message: err.Error(), isExpectedErrorFn: func(err error) bool {
+			return errors.Is(err, chains.ErrNoSuchChainID) || errors.Is(err, logpoller.ErrFilterNotRegistered)
+		}}
+	}
+
+	return &ReplayLogPollerFilterPayloadResolver{chainID: chainID, stats: stats, inputErrs: inputErrs, NotFoundErrorUnionType: e}
+}
+
+func (r *ReplayLogPollerFilterPayloadResolver) ToReplayLogPollerFilterSuccess() (*ReplayLogPollerFilterSuccessResolver, bool) {
+	if r.stats == nil {
+		return nil, false
+	}
+
+	return &ReplayLogPollerFilterSuccessResolver{chainID: r.chainID, stats: *r.stats}, true
+}
+
+func (r *ReplayLogPollerFilterPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs != nil {
+		var errs []*InputErrorResolver
+
+		for path, message := range r.inputErrs {
+			errs = append(errs, NewInputError(path, message))
+		}
+
+		return NewInputErrors(errs), true
+	}
+
+	return nil, false
+}
+
+func (r *ReplayLogPollerFilterPayloadResolver) ToReplayLogPollerFilterError() (*ReplayLogPollerFilterErrorResolver, bool) {
+	if r.err == nil {
+		return nil, false
+	}
+
+	if _, ok := r.ToNotFoundError(); ok {
+		return nil, false
+	}
+
+	return &ReplayLogPollerFilterErrorResolver{message: r.err.Error(), code: ErrorCodeUnprocessable}, true
+}
+
+type ReplayLogPollerFilterSuccessResolver struct {
+	chainID big.Big
+	stats   logpoller.FilterStats
+}
+
+// Filter resolves the replayed filter, with the stats of its logs after the replay.
+func (r *ReplayLogPollerFilterSuccessResolver) Filter() *LogPollerFilterResolver {
+	return NewLogPollerFilter(r.chainID, r.stats)
+}
+
+type ReplayLogPollerFilterErrorResolver struct {
+	message string
+	code    ErrorCode
+}
+
+func (r *ReplayLogPollerFilterErrorResolver) Code() ErrorCode {
+	return r.code
+}
+
+func (r *ReplayLogPollerFilterErrorResolver) Message() string {
+	return r.message
+}
+
+func (r *Resolver) getLegacyEVMChain(id string) (legacyevm.Chain, error) {
+	chainService, err := r.App.GetRelayers().LegacyEVMChains().Get(id)
+	if err != nil {
+		return nil, err
+	}
+	chain, ok := chainService.(legacyevm.Chain)
+	if !ok {
+		return nil, errors.New("not available in LOOPP mode")
+	}
+	return chain, nil
+}
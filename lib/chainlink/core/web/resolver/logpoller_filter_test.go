@@ -0,0 +1,242 @@
+package resolver
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/chains"
+	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
+	lpmocks "github.com/smartcontractkit/chainlink/v2/common/logpoller/mocks"
+)
+
+func setupLogPollerFilterMocks(f *gqlTestFramework) *lpmocks.LogPoller {
+	lp := lpmocks.NewLogPoller(f.t)
+	f.Mocks.legacyEVMChains.On("Get", "12").Return(f.Mocks.chain, nil)
+	f.Mocks.chain.On("ID").Return(big.NewInt(12))
+	f.Mocks.chain.On("LogPoller").Return(lp)
+	f.Mocks.relayerChainInterops.EVMChains = f.Mocks.legacyEVMChains
+	f.App.On("GetRelayers").Return(f.Mocks.relayerChainInterops)
+	return lp
+}
+
+func newTestFilterStats(f *gqlTestFramework) logpoller.FilterStats {
+	oldest, newest := int64(5), int64(9)
+	lastMatchedAt := f.Timestamp()
+	return logpoller.FilterStats{
+		Filter: logpoller.Filter{
+			Name:        "filter1",
+			Addresses:   []common.Address{common.HexToAddress("0x1")},
+			EventSigs:   []common.Hash{common.HexToHash("0x2")},
+			Retention:   time.Hour,
+			MaxLogsKept: 100,
+		},
+		FilterLogStats: logpoller.FilterLogStats{
+			LogCount:          3,
+			OldestBlockNumber: &oldest,
+			NewestBlockNumber: &newest,
+			LastMatchedAt:     &lastMatchedAt,
+		},
+	}
+}
+
+func TestResolver_LogPollerFilters(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetLogPollerFilters($evmChainID: ID!) {
+			logPollerFilters(evmChainID: $evmChainID) {
+				... on LogPollerFiltersSuccess {
+					results {
+						name
+						evmChainID
+						addresses
+						eventSigs
+						retention
+						maxLogsKept
+						logCount
+						oldestBlockNumber
+						newestBlockNumber
+						lastMatchedAt
+					}
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: map[string]any{"evmChainID": "12"}}, "logPollerFilters"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				lp := setupLogPollerFilterMocks(f)
+				lp.On("GetFilterStats", mock.Anything).Return([]logpoller.FilterStats{
+					newTestFilterStats(f),
+					{Filter: logpoller.Filter{Name: "filter2"}},
+				}, nil)
+			},
+			query:     query,
+			variables: map[string]any{"evmChainID": "12"},
+			result: `{
+				"logPollerFilters": {
+					"results": [{
+						"name": "filter1",
+						"evmChainID": "12",
+						"addresses": ["0x0000000000000000000000000000000000000001"],
+						"eventSigs": ["0x0000000000000000000000000000000000000000000000000000000000000002"],
+						"retention": "1h0m0s",
+						"maxLogsKept": "100",
+						"logCount": "3",
+						"oldestBlockNumber": "5",
+						"newestBlockNumber": "9",
+						"lastMatchedAt": "2021-01-01T00:00:00Z"
+					}, {
+						"name": "filter2",
+						"evmChainID": "12",
+						"addresses": [],
+						"eventSigs": [],
+						"retention": "0s",
+						"maxLogsKept": "0",
+						"logCount": "0",
+						"oldestBlockNumber": null,
+						"newestBlockNumber": null,
+						"lastMatchedAt": null
+					}]
+				}
+			}`,
+		},
+		{
+			name:          "chain not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.legacyEVMChains.On("Get", "12").Return(nil, chains.ErrNoSuchChainID)
+				f.Mocks.relayerChainInterops.EVMChains = f.Mocks.legacyEVMChains
+				f.App.On("GetRelayers").Return(f.Mocks.relayerChainInterops)
+			},
+			query:     query,
+			variables: map[string]any{"evmChainID": "12"},
+			result: `{
+				"logPollerFilters": {
+					"message": "chain not found",
+					"code": "NOT_FOUND"
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_ReplayLogPollerFilter(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation ReplayLogPollerFilter($input: ReplayLogPollerFilterInput!) {
+			replayLogPollerFilter(input: $input) {
+				... on ReplayLogPollerFilterSuccess {
+					filter {
+						name
+						logCount
+					}
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+				... on ReplayLogPollerFilterError {
+					message
+					code
+				}
+			}
+		}`
+	input := func(fromBlock string) map[string]any {
+		return map[string]any{"input": map[string]any{"evmChainID": "12", "name": "filter1", "fromBlock": fromBlock}}
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: input("5")}, "replayLogPollerFilter"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				lp := setupLogPollerFilterMocks(f)
+				lp.On("ReplayFilter", mock.Anything, "filter1", int64(5)).Return(nil)
+				lp.On("GetFilterStats", mock.Anything).Return([]logpoller.FilterStats{newTestFilterStats(f)}, nil)
+			},
+			query:     mutation,
+			variables: input("5"),
+			result: `{
+				"replayLogPollerFilter": {
+					"filter": {
+						"name": "filter1",
+						"logCount": "3"
+					}
+				}
+			}`,
+		},
+		{
+			name:          "invalid block",
+			authenticated: true,
+			query:         mutation,
+			variables:     input("-1"),
+			result: `{
+				"replayLogPollerFilter": {
+					"errors": [{
+						"path": "input/fromBlock",
+						"message": "invalid block number, must be positive",
+						"code": "INVALID_INPUT"
+					}]
+				}
+			}`,
+		},
+		{
+			name:          "filter not registered",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				lp := setupLogPollerFilterMocks(f)
+				lp.On("ReplayFilter", mock.Anything, "filter1", int64(5)).Return(logpoller.ErrFilterNotRegistered)
+			},
+			query:     mutation,
+			variables: input("5"),
+			result: `{
+				"replayLogPollerFilter": {
+					"message": "filter is not registered",
+					"code": "NOT_FOUND"
+				}
+			}`,
+		},
+		{
+			name:          "replay in progress",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				lp := setupLogPollerFilterMocks(f)
+				lp.On("ReplayFilter", mock.Anything, "filter1", int64(5)).Return(logpoller.ErrFilterReplayInProgress)
+			},
+			query:     mutation,
+			variables: input("5"),
+			result: `{
+				"replayLogPollerFilter": {
+					"message": "a filter replay is already in progress",
+					"code": "UNPROCESSABLE"
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/graph-gophers/graphql-go"
@@ -14,6 +15,9 @@ import (
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
+	"github.com/smartcontractkit/chainlink-evm/pkg/chains"
+	"github.com/smartcontractkit/chainlink-evm/pkg/logpoller"
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
 
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
@@ -38,6 +42,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/standardcapabilities"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
@@ -59,6 +64,8 @@ type createBridgeInput struct {
 	URL                    string
 	Confirmations          int32
 	MinimumContractPayment string
+	HealthCheckURL         *string
+	HealthCheckInterval    *string
 }
 
 // CreateBridge creates a new bridge.
@@ -79,12 +86,18 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 	if err := minContractPayment.UnmarshalText([]byte(args.Input.MinimumContractPayment)); err != nil {
 		return nil, err
 	}
+	healthCheckURL, healthCheckInterval, err := parseBridgeHealthCheck(args.Input.HealthCheckURL, args.Input.HealthCheckInterval)
+	if err != nil {
+		return nil, err
+	}
 
 	btr := &bridges.BridgeTypeRequest{
 		Name:                   bridges.BridgeName(args.Input.Name),
 		URL:                    webURL,
 		Confirmations:          uint32(args.Input.Confirmations),
 		MinimumContractPayment: minContractPayment,
+		HealthCheckURL:         healthCheckURL,
+		HealthCheckInterval:    healthCheckInterval,
 	}
 
 	bta, bt, err := bridges.NewBridgeType(btr)
@@ -452,6 +465,8 @@ type updateBridgeInput struct {
 	URL                    string
 	Confirmations          int32
 	MinimumContractPayment string
+	HealthCheckURL         *string
+	HealthCheckInterval    *string
 }
 
 func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
@@ -474,12 +489,18 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 	if err := minContractPayment.UnmarshalText([]byte(args.Input.MinimumContractPayment)); err != nil {
 		return nil, err
 	}
+	healthCheckURL, healthCheckInterval, err := parseBridgeHealthCheck(args.Input.HealthCheckURL, args.Input.HealthCheckInterval)
+	if err != nil {
+		return nil, err
+	}
 
 	btr := &bridges.BridgeTypeRequest{
 		Name:                   bridges.BridgeName(args.Input.Name),
 		URL:                    webURL,
 		Confirmations:          uint32(args.Input.Confirmations),
 		MinimumContractPayment: minContractPayment,
+		HealthCheckURL:         healthCheckURL,
+		HealthCheckInterval:    healthCheckInterval,
 	}
 
 	taskType, err := bridges.ParseBridgeName(string(args.ID))
@@ -689,6 +710,104 @@ func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 	return NewDeleteBridgePayload(&bt, nil), nil
 }
 
+type createPipelineFragmentInput struct {
+	Name   string
+	Source string
+}
+
+// CreatePipelineFragment creates a new pipeline fragment.
+func (r *Resolver) CreatePipelineFragment(ctx context.Context, args struct {
+	Input createPipelineFragmentInput
+}) (*CreatePipelineFragmentPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	orm := r.App.PipelineFragmentORM()
+	if err := orm.ValidateFragment(ctx, args.Input.Name, args.Input.Source); err != nil {
+		return NewCreatePipelineFragmentPayload(nil, map[string]string{"source": err.Error()}), nil
+	}
+
+	_, err := orm.FindFragment(ctx, args.Input.Name)
+	if err == nil {
+		return NewCreatePipelineFragmentPayload(nil, map[string]string{"name": "pipeline fragment already exists"}), nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	fragment := pipeline.Fragment{Name: args.Input.Name, Source: args.Input.Source}
+	if err = orm.CreateFragment(ctx, &fragment); err != nil {
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.PipelineFragmentCreated, map[string]any{"name": fragment.Name})
+
+	return NewCreatePipelineFragmentPayload(&fragment, nil), nil
+}
+
+// UpdatePipelineFragment replaces the source of a pipeline fragment. Jobs
+// created from the fragment keep the previous version until they are
+// recreated. The update is refused if any fragment using it would no longer
+// be valid.
+func (r *Resolver) UpdatePipelineFragment(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input struct {
+		Source string
+	}
+}) (*UpdatePipelineFragmentPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	fragment := pipeline.Fragment{Name: string(args.ID)}
+	usage, err := r.App.PipelineFragmentORM().UpdateFragment(ctx, &fragment, args.Input.Source)
+	if errors.Is(err, sql.ErrNoRows) {
+		return NewUpdatePipelineFragmentPayload(nil, usage, nil, err), nil
+	}
+	if err != nil {
+		return NewUpdatePipelineFragmentPayload(nil, usage, map[string]string{"source": err.Error()}, nil), nil
+	}
+
+	r.App.GetAuditLogger().Audit(audit.PipelineFragmentUpdated, map[string]any{
+		"name":              fragment.Name,
+		"affectedJobIDs":    usage.JobIDs,
+		"affectedFragments": usage.Fragments,
+	})
+
+	return NewUpdatePipelineFragmentPayload(&fragment, usage, nil, nil), nil
+}
+
+// DeletePipelineFragment deletes a pipeline fragment which no job was created from and no other fragment uses.
+func (r *Resolver) DeletePipelineFragment(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*DeletePipelineFragmentPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	orm := r.App.PipelineFragmentORM()
+	fragment, err := orm.FindFragment(ctx, string(args.ID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return NewDeletePipelineFragmentPayload(nil, err), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = orm.DeleteFragment(ctx, fragment.Name)
+	if errors.Is(err, pipeline.ErrFragmentInUse) || errors.Is(err, sql.ErrNoRows) {
+		return NewDeletePipelineFragmentPayload(nil, err), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.PipelineFragmentDeleted, map[string]any{"name": fragment.Name})
+
+	return NewDeletePipelineFragmentPayload(&fragment, nil), nil
+}
+
 func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolver, error) {
 	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
@@ -857,6 +976,58 @@ func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 	return NewCancelJobProposalSpecPayload(spec, err), nil
 }
 
+// ReplayLogPollerFilter re-fetches the logs of a single LogPoller filter from the given block.
+func (r *Resolver) ReplayLogPollerFilter(ctx context.Context, args struct {
+	Input ReplayLogPollerFilterInput
+}) (*ReplayLogPollerFilterPayloadResolver, error) {
+	if err := authenticateUserCanRun(ctx); err != nil {
+		return nil, err
+	}
+
+	fromBlock, err := strconv.ParseInt(args.Input.FromBlock, 10, 64)
+	if err != nil || fromBlock <= 0 {
+		return NewReplayLogPollerFilterPayload(big.Big{}, nil, nil, map[string]string{
+			"input/fromBlock": "invalid block number, must be positive",
+		}), nil
+	}
+
+	chain, err := r.getLegacyEVMChain(string(args.Input.EVMChainID))
+	if err != nil {
+		if errors.Is(err, chains.ErrNoSuchChainID) {
+			return NewReplayLogPollerFilterPayload(big.Big{}, nil, err, nil), nil
+		}
+		return nil, err
+	}
+	chainID := *big.New(chain.ID())
+
+	lp := chain.LogPoller()
+	if err = lp.ReplayFilter(ctx, args.Input.Name, fromBlock); err != nil {
+		if errors.Is(err, logpoller.ErrFilterNotRegistered) || errors.Is(err, logpoller.ErrFilterReplayInProgress) || errors.Is(err, logpoller.ErrDisabled) {
+			return NewReplayLogPollerFilterPayload(chainID, nil, err, nil), nil
+		}
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.LogPollerFilterReplayed, map[string]any{
+		"evmChainID": chainID.String(),
+		"filterName": args.Input.Name,
+		"fromBlock":  fromBlock,
+	})
+
+	stats, err := lp.GetFilterStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range stats {
+		if stats[i].Name == args.Input.Name {
+			return NewReplayLogPollerFilterPayload(chainID, &stats[i], nil, nil), nil
+		}
+	}
+
+	// the filter was unregistered while being replayed
+	return NewReplayLogPollerFilterPayload(chainID, nil, logpoller.ErrFilterNotRegistered, nil), nil
+}
+
 // RejectJobProposalSpec rejects the job proposal spec.
 func (r *Resolver) RejectJobProposalSpec(ctx context.Context, args struct {
 	ID graphql.ID
@@ -1066,7 +1237,12 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	jbt, err := job.ValidateSpec(args.Input.TOML)
+	fragments, err := r.App.PipelineFragmentORM().Lookup(ctx)
+	if err != nil {
+		return nil, err
+	}
+	withFragments := pipeline.WithFragments(fragments)
+	jbt, err := job.ValidateSpec(args.Input.TOML, withFragments)
 	if err != nil {
 		return NewCreateJobPayload(r.App, nil, map[string]string{
 			"TOML spec": errors.Wrap(err, "failed to parse TOML").Error(),
@@ -1077,27 +1253,27 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 	config := r.App.GetConfig()
 	switch jbt {
 	case job.OffchainReporting:
-		jb, err = ocr.ValidatedOracleSpecToml(config, r.App.GetRelayers().LegacyEVMChains(), args.Input.TOML)
+		jb, err = ocr.ValidatedOracleSpecToml(config, r.App.GetRelayers().LegacyEVMChains(), args.Input.TOML, withFragments)
 		if !config.OCR().Enabled() {
 			return nil, errors.New("The Offchain Reporting feature is disabled by configuration")
 		}
 	case job.OffchainReporting2:
-		jb, err = validate.ValidatedOracleSpecToml(ctx, r.App.GetConfig().OCR2(), r.App.GetConfig().Insecure(), args.Input.TOML, r.App.GetLoopRegistrarConfig())
+		jb, err = validate.ValidatedOracleSpecToml(ctx, r.App.GetConfig().OCR2(), r.App.GetConfig().Insecure(), args.Input.TOML, r.App.GetLoopRegistrarConfig(), withFragments)
 		if !config.OCR2().Enabled() {
 			return nil, errors.New("The Offchain Reporting 2 feature is disabled by configuration")
 		}
 	case job.DirectRequest:
-		jb, err = directrequest.ValidatedDirectRequestSpec(args.Input.TOML)
+		jb, err = directrequest.ValidatedDirectRequestSpec(args.Input.TOML, withFragments)
 	case job.FluxMonitor:
-		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(config.JobPipeline(), args.Input.TOML)
+		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(config.JobPipeline(), args.Input.TOML, withFragments)
 	case job.Keeper:
 		jb, err = keeper.ValidatedKeeperSpec(args.Input.TOML)
 	case job.Cron:
-		jb, err = cron.ValidatedCronSpec(args.Input.TOML)
+		jb, err = cron.ValidatedCronSpec(args.Input.TOML, withFragments)
 	case job.VRF:
-		jb, err = vrfcommon.ValidatedVRFSpec(args.Input.TOML)
+		jb, err = vrfcommon.ValidatedVRFSpec(args.Input.TOML, withFragments)
 	case job.Webhook:
-		jb, err = webhook.ValidatedWebhookSpec(ctx, args.Input.TOML, r.App.GetExternalInitiatorManager())
+		jb, err = webhook.ValidatedWebhookSpec(ctx, args.Input.TOML, r.App.GetExternalInitiatorManager(), withFragments)
 	case job.BlockhashStore:
 		jb, err = blockhashstore.ValidatedSpec(args.Input.TOML)
 	case job.BlockHeaderFeeder:
@@ -1111,7 +1287,7 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 	case job.StandardCapabilities:
 		jb, err = standardcapabilities.ValidatedStandardCapabilitiesSpec(args.Input.TOML)
 	case job.Stream:
-		jb, err = streams.ValidatedStreamSpec(args.Input.TOML)
+		jb, err = streams.ValidatedStreamSpec(args.Input.TOML, withFragments)
 	case job.CCIP:
 		jb, err = ccip.ValidatedCCIPSpec(args.Input.TOML)
 	default:
@@ -0,0 +1,246 @@
+package resolver
+
+import (
+	"strconv"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// PipelineFragmentResolver resolves the PipelineFragment type.
+type PipelineFragmentResolver struct {
+	fragment pipeline.Fragment
+}
+
+func NewPipelineFragment(fragment pipeline.Fragment) *PipelineFragmentResolver {
+	return &PipelineFragmentResolver{fragment: fragment}
+}
+
+func NewPipelineFragments(fragments []pipeline.Fragment) []*PipelineFragmentResolver {
+	var resolvers []*PipelineFragmentResolver
+	for _, f := range fragments {
+		resolvers = append(resolvers, NewPipelineFragment(f))
+	}
+
+	return resolvers
+}
+
+// ID resolves the fragment's name as the id, since fragments are referenced by name.
+func (r *PipelineFragmentResolver) ID() graphql.ID {
+	return graphql.ID(r.fragment.Name)
+}
+
+// Name resolves the fragment's name.
+func (r *PipelineFragmentResolver) Name() string {
+	return r.fragment.Name
+}
+
+// Source resolves the fragment's DOT source.
+func (r *PipelineFragmentResolver) Source() string {
+	return r.fragment.Source
+}
+
+// CreatedAt resolves the fragment's created at field.
+func (r *PipelineFragmentResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.fragment.CreatedAt}
+}
+
+// UpdatedAt resolves the fragment's updated at field.
+func (r *PipelineFragmentResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: r.fragment.UpdatedAt}
+}
+
+// PipelineFragmentPayloadResolver resolves a single fragment response
+type PipelineFragmentPayloadResolver struct {
+	fragment pipeline.Fragment
+	NotFoundErrorUnionType
+}
+
+func NewPipelineFragmentPayload(fragment pipeline.Fragment, err error) *PipelineFragmentPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "pipeline fragment not found"}
+
+	return &PipelineFragmentPayloadResolver{fragment: fragment, NotFoundErrorUnionType: e}
+}
+
+// ToPipelineFragment implements the PipelineFragment union type of the payload
+func (r *PipelineFragmentPayloadResolver) ToPipelineFragment() (*PipelineFragmentResolver, bool) {
+	if r.err == nil {
+		return NewPipelineFragment(r.fragment), true
+	}
+
+	return nil, false
+}
+
+// PipelineFragmentsPayloadResolver resolves a page of fragments
+type PipelineFragmentsPayloadResolver struct {
+	fragments []pipeline.Fragment
+	total     int32
+}
+
+func NewPipelineFragmentsPayload(fragments []pipeline.Fragment, total int32) *PipelineFragmentsPayloadResolver {
+	return &PipelineFragmentsPayloadResolver{
+		fragments: fragments,
+		total:     total,
+	}
+}
+
+// Results returns the fragments.
+func (r *PipelineFragmentsPayloadResolver) Results() []*PipelineFragmentResolver {
+	return NewPipelineFragments(r.fragments)
+}
+
+// Metadata returns the pagination metadata.
+func (r *PipelineFragmentsPayloadResolver) Metadata() *PaginationMetadataResolver {
+	return NewPaginationMetadata(r.total)
+}
+
+// -- CreatePipelineFragment mutation --
+
+type CreatePipelineFragmentPayloadResolver struct {
+	fragment  *pipeline.Fragment
+	inputErrs map[string]string
+}
+
+func NewCreatePipelineFragmentPayload(fragment *pipeline.Fragment, inputErrs map[string]string) *CreatePipelineFragmentPayloadResolver {
+	return &CreatePipelineFragmentPayloadResolver{fragment: fragment, inputErrs: inputErrs}
+}
+
+func (r *CreatePipelineFragmentPayloadResolver) ToCreatePipelineFragmentSuccess() (*CreatePipelineFragmentSuccessResolver, bool) {
+	if r.inputErrs != nil {
+		return nil, false
+	}
+
+	return &CreatePipelineFragmentSuccessResolver{fragment: *r.fragment}, true
+}
+
+func (r *CreatePipelineFragmentPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	return fragmentInputErrors(r.inputErrs)
+}
+
+type CreatePipelineFragmentSuccessResolver struct {
+	fragment pipeline.Fragment
+}
+
+// Fragment resolves the created fragment.
+func (r *CreatePipelineFragmentSuccessResolver) Fragment() *PipelineFragmentResolver {
+	return NewPipelineFragment(r.fragment)
+}
+
+// -- UpdatePipelineFragment mutation --
+
+type UpdatePipelineFragmentPayloadResolver struct {
+	fragment  *pipeline.Fragment
+	usage     pipeline.FragmentUsage
+	inputErrs map[string]string
+	NotFoundErrorUnionType
+}
+
+func NewUpdatePipelineFragmentPayload(fragment *pipeline.Fragment, usage pipeline.FragmentUsage, inputErrs map[string]string, err error) *UpdatePipelineFragmentPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "pipeline fragment not found"}
+
+	return &UpdatePipelineFragmentPayloadResolver{fragment: fragment, usage: usage, inputErrs: inputErrs, NotFoundErrorUnionType: e}
+}
+
+func (r *UpdatePipelineFragmentPayloadResolver) ToUpdatePipelineFragmentSuccess() (*UpdatePipelineFragmentSuccessResolver, bool) {
+	if r.fragment == nil || r.inputErrs != nil {
+		return nil, false
+	}
+
+	return &UpdatePipelineFragmentSuccessResolver{fragment: *r.fragment, usage: r.usage}, true
+}
+
+func (r *UpdatePipelineFragmentPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	return fragmentInputErrors(r.inputErrs)
+}
+
+type UpdatePipelineFragmentSuccessResolver struct {
+	fragment pipeline.Fragment
+	usage    pipeline.FragmentUsage
+}
+
+// Fragment resolves the updated fragment.
+func (r *UpdatePipelineFragmentSuccessResolver) Fragment() *PipelineFragmentResolver {
+	return NewPipelineFragment(r.fragment)
+}
+
+// AffectedJobIDs resolves the jobs created from the fragment.
+func (r *UpdatePipelineFragmentSuccessResolver) AffectedJobIDs() []graphql.ID {
+	ids := make([]graphql.ID, 0, len(r.usage.JobIDs))
+	for _, id := range r.usage.JobIDs {
+		ids = append(ids, graphql.ID(strconv.Itoa(int(id))))
+	}
+	return ids
+}
+
+// AffectedFragments resolves the fragments which use the fragment.
+func (r *UpdatePipelineFragmentSuccessResolver) AffectedFragments() []string {
+	if r.usage.Fragments == nil {
+		return []string{}
+	}
+	return r.usage.Fragments
+}
+
+func fragmentInputErrors(inputErrs map[string]string) (*InputErrorsResolver, bool) {
+	if inputErrs == nil {
+		return nil, false
+	}
+
+	var errs []*InputErrorResolver
+	for path, message := range inputErrs {
+		errs = append(errs, NewInputError(path, message))
+	}
+
+	return NewInputErrors(errs), true
+}
+
+// -- DeletePipelineFragment mutation --
+
+type DeletePipelineFragmentPayloadResolver struct {
+	fragment *pipeline.Fragment
+	NotFoundErrorUnionType
+}
+
+func NewDeletePipelineFragmentPayload(fragment *pipeline.Fragment, err error) *DeletePipelineFragmentPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "pipeline fragment not found"}
+
+	return &DeletePipelineFragmentPayloadResolver{fragment: fragment, NotFoundErrorUnionType: e}
+}
+
+func (r *DeletePipelineFragmentPayloadResolver) ToDeletePipelineFragmentSuccess() (*DeletePipelineFragmentSuccessResolver, bool) {
+	if r.fragment != nil {
+		return &DeletePipelineFragmentSuccessResolver{fragment: *r.fragment}, true
+	}
+
+	return nil, false
+}
+
+func (r *DeletePipelineFragmentPayloadResolver) ToDeletePipelineFragmentConflictError() (*DeletePipelineFragmentConflictErrorResolver, bool) {
+	if errors.Is(r.err, pipeline.ErrFragmentInUse) {
+		return &DeletePipelineFragmentConflictErrorResolver{message: r.err.Error()}, true
+	}
+
+	return nil, false
+}
+
+type DeletePipelineFragmentSuccessResolver struct {
+	fragment pipeline.Fragment
+}
+
+// Fragment resolves the deleted fragment.
+func (r *DeletePipelineFragmentSuccessResolver) Fragment() *PipelineFragmentResolver {
+	return NewPipelineFragment(r.fragment)
+}
+
+type DeletePipelineFragmentConflictErrorResolver struct {
+	message string
+}
+
+func (r *DeletePipelineFragmentConflictErrorResolver) Message() string {
+	return r.message
+}
+
+func (r *DeletePipelineFragmentConflictErrorResolver) Code() ErrorCode {
+	return ErrorCodeUnprocessable
+}
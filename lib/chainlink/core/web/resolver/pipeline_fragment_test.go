@@ -0,0 +1,262 @@
+package resolver
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func Test_PipelineFragment(t *testing.T) {
+	t.Parallel()
+
+	var (
+		query = `
+			query GetPipelineFragment {
+				pipelineFragment(id: "median3") {
+					... on PipelineFragment {
+						id
+						name
+						source
+						createdAt
+					}
+					... on NotFoundError {
+						message
+						code
+					}
+				}
+			}`
+	)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "pipelineFragment"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("PipelineFragmentORM").Return(f.Mocks.fragmentORM)
+				f.Mocks.fragmentORM.On("FindFragment", mock.Anything, "median3").Return(pipeline.Fragment{
+					Name:      "median3",
+					Source:    "answer [type=median]",
+					CreatedAt: f.Timestamp(),
+				}, nil)
+			},
+			query: query,
+			result: `{
+				"pipelineFragment": {
+					"id": "median3",
+					"name": "median3",
+					"source": "answer [type=median]",
+					"createdAt": "2021-01-01T00:00:00Z"
+				}
+			}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("PipelineFragmentORM").Return(f.Mocks.fragmentORM)
+				f.Mocks.fragmentORM.On("FindFragment", mock.Anything, "median3").Return(pipeline.Fragment{}, sql.ErrNoRows)
+			},
+			query: query,
+			result: `{
+				"pipelineFragment": {
+					"message": "pipeline fragment not found",
+					"code": "NOT_FOUND"
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func Test_UpdatePipelineFragment(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mutation = `
+			mutation updatePipelineFragment($id: ID!, $input: UpdatePipelineFragmentInput!) {
+				updatePipelineFragment(id: $id, input: $input) {
+					... on UpdatePipelineFragmentSuccess {
+						fragment {
+							name
+							source
+						}
+						affectedJobIDs
+						affectedFragments
+					}
+					... on InputErrors {
+						errors {
+							path
+							message
+							code
+						}
+					}
+					... on NotFoundError {
+						message
+						code
+					}
+				}
+			}`
+		variables = map[string]any{
+			"id":    "median3",
+			"input": map[string]any{"source": "answer [type=mean]"},
+		}
+	)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "updatePipelineFragment"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("PipelineFragmentORM").Return(f.Mocks.fragmentORM)
+				f.Mocks.fragmentORM.On("UpdateFragment", mock.Anything, mock.IsType(&pipeline.Fragment{}), "answer [type=mean]").
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*pipeline.Fragment).Source = "answer [type=mean]"
+					}).
+					Return(pipeline.FragmentUsage{JobIDs: []int32{1, 2}, Fragments: []string{"prices"}}, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `{
+				"updatePipelineFragment": {
+					"fragment": {
+						"name": "median3",
+						"source": "answer [type=mean]"
+					},
+					"affectedJobIDs": ["1", "2"],
+					"affectedFragments": ["prices"]
+				}
+			}`,
+		},
+		{
+			name:          "breaks a job",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("PipelineFragmentORM").Return(f.Mocks.fragmentORM)
+				f.Mocks.fragmentORM.On("UpdateFragment", mock.Anything, mock.IsType(&pipeline.Fragment{}), "answer [type=mean]").
+					Return(pipeline.FragmentUsage{}, errors.New("job 1 would no longer be valid"))
+			},
+			query:     mutation,
+			variables: variables,
+			result: `{
+				"updatePipelineFragment": {
+					"errors": [{
+						"path": "source",
+						"message": "job 1 would no longer be valid",
+						"code": "INVALID_INPUT"
+					}]
+				}
+			}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("PipelineFragmentORM").Return(f.Mocks.fragmentORM)
+				f.Mocks.fragmentORM.On("UpdateFragment", mock.Anything, mock.IsType(&pipeline.Fragment{}), "answer [type=mean]").
+					Return(pipeline.FragmentUsage{}, sql.ErrNoRows)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `{
+				"updatePipelineFragment": {
+					"message": "pipeline fragment not found",
+					"code": "NOT_FOUND"
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func Test_DeletePipelineFragment(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mutation = `
+			mutation deletePipelineFragment($id: ID!) {
+				deletePipelineFragment(id: $id) {
+					... on DeletePipelineFragmentSuccess {
+						fragment {
+							name
+						}
+					}
+					... on DeletePipelineFragmentConflictError {
+						message
+						code
+					}
+					... on NotFoundError {
+						message
+						code
+					}
+				}
+			}`
+		variables = map[string]any{"id": "median3"}
+		fragment  = pipeline.Fragment{Name: "median3", Source: "answer [type=median]"}
+	)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "deletePipelineFragment"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("PipelineFragmentORM").Return(f.Mocks.fragmentORM)
+				f.Mocks.fragmentORM.On("FindFragment", mock.Anything, "median3").Return(fragment, nil)
+				f.Mocks.fragmentORM.On("DeleteFragment", mock.Anything, "median3").Return(nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `{
+				"deletePipelineFragment": {
+					"fragment": {
+						"name": "median3"
+					}
+				}
+			}`,
+		},
+		{
+			name:          "in use",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("PipelineFragmentORM").Return(f.Mocks.fragmentORM)
+				f.Mocks.fragmentORM.On("FindFragment", mock.Anything, "median3").Return(fragment, nil)
+				f.Mocks.fragmentORM.On("DeleteFragment", mock.Anything, "median3").Return(pipeline.ErrFragmentInUse)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `{
+				"deletePipelineFragment": {
+					"message": "pipeline fragment is in use",
+					"code": "UNPROCESSABLE"
+				}
+			}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("PipelineFragmentORM").Return(f.Mocks.fragmentORM)
+				f.Mocks.fragmentORM.On("FindFragment", mock.Anything, "median3").Return(pipeline.Fragment{}, sql.ErrNoRows)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `{
+				"deletePipelineFragment": {
+					"message": "pipeline fragment not found",
+					"code": "NOT_FOUND"
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -15,12 +15,14 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 	"github.com/smartcontractkit/chainlink-evm/pkg/chains"
 	"github.com/smartcontractkit/chainlink-evm/pkg/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
 	evmrelay "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 )
@@ -39,13 +41,22 @@ func (r *Resolver) Bridge(ctx context.Context, args struct{ ID graphql.ID }) (*B
 	bridge, err := r.App.BridgeORM().FindBridge(ctx, name)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return NewBridgePayload(bridge, err), nil
+			return NewBridgePayload(bridge, nil, err), nil
 		}
 
 		return nil, err
 	}
 
-	return NewBridgePayload(bridge, nil), nil
+	var health *BridgeHealthResolver
+	if bridge.HealthCheckURL != nil {
+		checks, err := r.App.BridgeORM().BridgeHealthChecks(ctx, name, bridgeHealthChecksLimit)
+		if err != nil {
+			return nil, err
+		}
+		health = NewBridgeHealth(checks)
+	}
+
+	return NewBridgePayload(bridge, health, nil), nil
 }
 
 // Bridges retrieves a paginated list of bridges.
@@ -68,6 +79,44 @@ func (r *Resolver) Bridges(ctx context.Context, args struct {
 	return NewBridgesPayload(brdgs, int32(count)), nil
 }
 
+// PipelineFragment retrieves a pipeline fragment by name.
+func (r *Resolver) PipelineFragment(ctx context.Context, args struct{ ID graphql.ID }) (*PipelineFragmentPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	fragment, err := r.App.PipelineFragmentORM().FindFragment(ctx, string(args.ID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewPipelineFragmentPayload(fragment, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewPipelineFragmentPayload(fragment, nil), nil
+}
+
+// PipelineFragments retrieves a paginated list of pipeline fragments.
+func (r *Resolver) PipelineFragments(ctx context.Context, args struct {
+	Offset *int32
+	Limit  *int32
+}) (*PipelineFragmentsPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	offset := pageOffset(args.Offset)
+	limit := pageLimit(args.Limit)
+
+	fragments, count, err := r.App.PipelineFragmentORM().Fragments(ctx, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPipelineFragmentsPayload(fragments, int32(count)), nil
+}
+
 // Chain retrieves a chain by id.
 func (r *Resolver) Chain(ctx context.Context,
 	args struct {
@@ -352,6 +401,81 @@ func (r *Resolver) VRFKey(ctx context.Context, args struct {
 	return NewVRFKeyPayloadResolver(key, nil), err
 }
 
+// VRFRequest retrieves a VRF v2 or v2.5 request, once for every job which has
+// seen it. The request ID may be given in decimal or as 0x prefixed hex.
+func (r *Resolver) VRFRequest(ctx context.Context, args struct {
+	RequestID string
+}) (*VRFRequestPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	requestID, err := vrfcommon.ParseRequestID(args.RequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	orm := r.App.VRFRequestORM()
+	reqs, err := orm.FindRequests(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	if len(reqs) == 0 {
+		return NewVRFRequestPayload(orm, nil, sql.ErrNoRows), nil
+	}
+
+	return NewVRFRequestPayload(orm, reqs, nil), nil
+}
+
+// LogPollerFilters retrieves the filters registered with the LogPoller of an EVM chain, along with the stats of their logs.
+func (r *Resolver) LogPollerFilters(ctx context.Context, args struct {
+	EVMChainID graphql.ID
+}) (*LogPollerFiltersPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	chain, err := r.getLegacyEVMChain(string(args.EVMChainID))
+	if err != nil {
+		if errors.Is(err, chains.ErrNoSuchChainID) {
+			return NewLogPollerFiltersPayload(big.Big{}, nil, err), nil
+		}
+		return nil, err
+	}
+
+	stats, err := chain.LogPoller().GetFilterStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewLogPollerFiltersPayload(*big.New(chain.ID()), stats, nil), nil
+}
+
+// Reorgs retrieves the latest reorgs recorded by the reorg journal of an EVM chain, most recent first.
+func (r *Resolver) Reorgs(ctx context.Context, args struct {
+	EVMChainID graphql.ID
+	Limit      *int32
+}) (*ReorgsPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	chain, err := r.getLegacyEVMChain(string(args.EVMChainID))
+	if err != nil {
+		if errors.Is(err, chains.ErrNoSuchChainID) {
+			return NewReorgsPayload(nil, err), nil
+		}
+		return nil, err
+	}
+
+	found, err := chain.ReorgJournal().Reorgs(ctx, pageLimit(args.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewReorgsPayload(found, nil), nil
+}
+
 // JobProposal retrieves a job proposal by ID
 func (r *Resolver) JobProposal(ctx context.Context, args struct {
 	ID graphql.ID
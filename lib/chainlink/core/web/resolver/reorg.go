@@ -0,0 +1,143 @@
+package resolver
+
+import (
+	"strconv"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/chains"
+	"github.com/smartcontractkit/chainlink-evm/pkg/reorgs"
+)
+
+// ReorgResolver resolves the Reorg type.
+type ReorgResolver struct {
+	reorg reorgs.Reorg
+}
+
+func NewReorg(reorg reorgs.Reorg) *ReorgResolver {
+	return &ReorgResolver{reorg: reorg}
+}
+
+func NewReorgs(found []reorgs.Reorg) []*ReorgResolver {
+	var resolvers []*ReorgResolver
+	for _, r := range found {
+		resolvers = append(resolvers, NewReorg(r))
+	}
+
+	return resolvers
+}
+
+// ID resolves the reorg's unique identifier.
+func (r *ReorgResolver) ID() graphql.ID {
+	return int64GQLID(r.reorg.ID)
+}
+
+// EVMChainID resolves the chain which was reorged.
+func (r *ReorgResolver) EVMChainID() graphql.ID {
+	return graphql.ID(r.reorg.EVMChainID.String())
+}
+
+// Source resolves the service which detected the reorg.
+func (r *ReorgResolver) Source() string {
+	return string(r.reorg.Source)
+}
+
+// Depth resolves the number of orphaned blocks.
+func (r *ReorgResolver) Depth() string {
+	return strconv.FormatInt(r.reorg.Depth, 10)
+}
+
+// LCABlockNumber resolves the number of the latest common ancestor of the old and new chains.
+func (r *ReorgResolver) LCABlockNumber() string {
+	return strconv.FormatInt(r.reorg.LCABlockNumber, 10)
+}
+
+// LCABlockHash resolves the hash of the latest common ancestor of the old and new chains.
+func (r *ReorgResolver) LCABlockHash() string {
+	return r.reorg.LCABlockHash.Hex()
+}
+
+// OldHeadNumber resolves the number of the head of the orphaned chain.
+func (r *ReorgResolver) OldHeadNumber() string {
+	return strconv.FormatInt(r.reorg.OldHeadNumber, 10)
+}
+
+// OldHeadHash resolves the hash of the head of the orphaned chain.
+func (r *ReorgResolver) OldHeadHash() string {
+	return r.reorg.OldHeadHash.Hex()
+}
+
+// OldHeadTimestamp resolves the timestamp of the head of the orphaned chain.
+func (r *ReorgResolver) OldHeadTimestamp() graphql.Time {
+	return graphql.Time{Time: r.reorg.OldHeadTimestamp}
+}
+
+// NewHeadNumber resolves the number of the head which reorged the chain.
+func (r *ReorgResolver) NewHeadNumber() string {
+	return strconv.FormatInt(r.reorg.NewHeadNumber, 10)
+}
+
+// NewHeadHash resolves the hash of the head which reorged the chain.
+func (r *ReorgResolver) NewHeadHash() string {
+	return r.reorg.NewHeadHash.Hex()
+}
+
+// NewHeadTimestamp resolves the timestamp of the head which reorged the chain.
+func (r *ReorgResolver) NewHeadTimestamp() graphql.Time {
+	return graphql.Time{Time: r.reorg.NewHeadTimestamp}
+}
+
+// TxIDs resolves the transactions which had a receipt in one of the orphaned blocks.
+func (r *ReorgResolver) TxIDs() []graphql.ID {
+	ids := []graphql.ID{}
+	for _, id := range r.reorg.TxIDs {
+		ids = append(ids, int64GQLID(id))
+	}
+	return ids
+}
+
+// LogCount resolves the number of logs saved from the orphaned blocks.
+func (r *ReorgResolver) LogCount() string {
+	return strconv.FormatInt(r.reorg.LogCount, 10)
+}
+
+// DetectedAt resolves when the reorg was detected.
+func (r *ReorgResolver) DetectedAt() graphql.Time {
+	return graphql.Time{Time: r.reorg.DetectedAt}
+}
+
+// -- Reorgs Query --
+
+// ReorgsPayloadResolver resolves the reorgs of a chain.
+type ReorgsPayloadResolver struct {
+	reorgs []reorgs.Reorg
+	NotFoundErrorUnionType
+}
+
+func NewReorgsPayload(found []reorgs.Reorg, err error) *ReorgsPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "chain not found", isExpectedErrorFn: func(err error) bool {
+		return errors.Is(err, chains.ErrNoSuchChainID)
+	}}
+
+	return &ReorgsPayloadResolver{reorgs: found, NotFoundErrorUnionType: e}
+}
+
+// ToReorgsSuccess implements the ReorgsSuccess union type of the payload
+func (r *ReorgsPayloadResolver) ToReorgsSuccess() (*ReorgsSuccessResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return &ReorgsSuccessResolver{reorgs: r.reorgs}, true
+}
+
+// ReorgsSuccessResolver resolves the reorgs found for a chain.
+type ReorgsSuccessResolver struct {
+	reorgs []reorgs.Reorg
+}
+
+// Results resolves the reorgs, most recent first.
+func (r *ReorgsSuccessResolver) Results() []*ReorgResolver {
+	return NewReorgs(r.reorgs)
+}
@@ -0,0 +1,123 @@
+package resolver
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-evm/pkg/chains"
+	"github.com/smartcontractkit/chainlink-evm/pkg/reorgs"
+	reorgsmocks "github.com/smartcontractkit/chainlink-evm/pkg/reorgs/mocks"
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+)
+
+func TestResolver_Reorgs(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetReorgs($evmChainID: ID!, $limit: Int) {
+			reorgs(evmChainID: $evmChainID, limit: $limit) {
+				... on ReorgsSuccess {
+					results {
+						id
+						evmChainID
+						source
+						depth
+						lcaBlockNumber
+						lcaBlockHash
+						oldHeadNumber
+						oldHeadHash
+						oldHeadTimestamp
+						newHeadNumber
+						newHeadHash
+						newHeadTimestamp
+						txIDs
+						logCount
+						detectedAt
+					}
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+	variables := map[string]any{"evmChainID": "12", "limit": 5}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: variables}, "reorgs"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				journal := reorgsmocks.NewJournal(f.t)
+				f.Mocks.legacyEVMChains.On("Get", "12").Return(f.Mocks.chain, nil)
+				f.Mocks.chain.On("ReorgJournal").Return(journal)
+				f.Mocks.relayerChainInterops.EVMChains = f.Mocks.legacyEVMChains
+				f.App.On("GetRelayers").Return(f.Mocks.relayerChainInterops)
+				journal.On("Reorgs", mock.Anything, 5).Return([]reorgs.Reorg{{
+					ID:               3,
+					EVMChainID:       ubig.New(big.NewInt(12)),
+					Source:           reorgs.SourceHeadTracker,
+					Depth:            2,
+					LCABlockNumber:   10,
+					LCABlockHash:     common.HexToHash("0xa"),
+					OldHeadNumber:    12,
+					OldHeadHash:      common.HexToHash("0xc"),
+					OldHeadTimestamp: f.Timestamp(),
+					NewHeadNumber:    13,
+					NewHeadHash:      common.HexToHash("0xd"),
+					NewHeadTimestamp: f.Timestamp(),
+					TxIDs:            []int64{7},
+					LogCount:         4,
+					DetectedAt:       f.Timestamp(),
+				}}, nil)
+			},
+			query:     query,
+			variables: variables,
+			result: `{
+				"reorgs": {
+					"results": [{
+						"id": "3",
+						"evmChainID": "12",
+						"source": "HeadTracker",
+						"depth": "2",
+						"lcaBlockNumber": "10",
+						"lcaBlockHash": "0x000000000000000000000000000000000000000000000000000000000000000a",
+						"oldHeadNumber": "12",
+						"oldHeadHash": "0x000000000000000000000000000000000000000000000000000000000000000c",
+						"oldHeadTimestamp": "2021-01-01T00:00:00Z",
+						"newHeadNumber": "13",
+						"newHeadHash": "0x000000000000000000000000000000000000000000000000000000000000000d",
+						"newHeadTimestamp": "2021-01-01T00:00:00Z",
+						"txIDs": ["7"],
+						"logCount": "4",
+						"detectedAt": "2021-01-01T00:00:00Z"
+					}]
+				}
+			}`,
+		},
+		{
+			name:          "chain not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.legacyEVMChains.On("Get", "12").Return(nil, chains.ErrNoSuchChainID)
+				f.Mocks.relayerChainInterops.EVMChains = f.Mocks.legacyEVMChains
+				f.App.On("GetRelayers").Return(f.Mocks.relayerChainInterops)
+			},
+			query:     query,
+			variables: variables,
+			result: `{
+				"reorgs": {
+					"message": "chain not found",
+					"code": "NOT_FOUND"
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
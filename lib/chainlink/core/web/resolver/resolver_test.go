@@ -26,6 +26,7 @@ import (
 	jobORMMocks "github.com/smartcontractkit/chainlink/v2/core/services/job/mocks"
 	keystoreMocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
 	pipelineMocks "github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
+	vrfMocks "github.com/smartcontractkit/chainlink/v2/core/services/vrf/mocks"
 	webhookmocks "github.com/smartcontractkit/chainlink/v2/core/services/webhook/mocks"
 	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
 	authProviderMocks "github.com/smartcontractkit/chainlink/v2/core/sessions/mocks"
@@ -40,6 +41,8 @@ type mocks struct {
 	jobORM               *jobORMMocks.ORM
 	authProvider         *authProviderMocks.AuthenticationProvider
 	pipelineORM          *pipelineMocks.ORM
+	fragmentORM          *pipelineMocks.FragmentORM
+	vrfRequestORM        *vrfMocks.RequestORM
 	feedsSvc             *feedsMocks.Service
 	cfg                  *chainlinkMocks.GeneralConfig
 	scfg                 *evmConfigMocks.ChainScopedConfig
@@ -102,6 +105,8 @@ func setupFramework(t *testing.T) *gqlTestFramework {
 		feedsSvc:             feedsMocks.NewService(t),
 		authProvider:         authProviderMocks.NewAuthenticationProvider(t),
 		pipelineORM:          pipelineMocks.NewORM(t),
+		fragmentORM:          pipelineMocks.NewFragmentORM(t),
+		vrfRequestORM:        vrfMocks.NewRequestORM(t),
 		cfg:                  chainlinkMocks.NewGeneralConfig(t),
 		scfg:                 evmConfigMocks.NewChainScopedConfig(t),
 		ocr:                  keystoreMocks.NewOCR(t),
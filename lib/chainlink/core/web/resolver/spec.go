@@ -164,6 +164,37 @@ func (r *CronSpecResolver) Schedule() string {
 	return r.spec.CronSchedule
 }
 
+// Timezone resolves the time zone the schedule is evaluated in.
+func (r *CronSpecResolver) Timezone() string {
+	return r.spec.Timezone
+}
+
+// Jitter resolves the maximum random delay of each run.
+func (r *CronSpecResolver) Jitter() string {
+	return r.spec.Jitter.String()
+}
+
+// OverlapPolicy resolves what happens when a run is due while the previous one is in progress.
+func (r *CronSpecResolver) OverlapPolicy() string {
+	if r.spec.OverlapPolicy == "" {
+		return string(job.CronOverlapAllow)
+	}
+	return string(r.spec.OverlapPolicy)
+}
+
+// MaxCatchUpRuns resolves the number of missed runs which are caught up on start.
+func (r *CronSpecResolver) MaxCatchUpRuns() int32 {
+	return int32(r.spec.MaxCatchUpRuns)
+}
+
+// LastFiredAt resolves the last time the job was due.
+func (r *CronSpecResolver) LastFiredAt() *graphql.Time {
+	if r.spec.LastFiredAt == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *r.spec.LastFiredAt}
+}
+
 // EVMChainID resolves the spec's evm chain id.
 func (r *CronSpecResolver) EVMChainID() *string {
 	if r.spec.EVMChainID == nil {
@@ -733,6 +764,19 @@ func (r *VRFSpecResolver) VRFOwnerAddress() *string {
 	return &vrfOwnerAddress
 }
 
+// SubscriptionAlertThreshold resolves the spec's subscription alert threshold.
+func (r *VRFSpecResolver) SubscriptionAlertThreshold() string {
+	return r.spec.SubscriptionAlertThreshold.String()
+}
+
+// SubscriptionAlertWebhookURL resolves the spec's subscription alert webhook URL.
+func (r *VRFSpecResolver) SubscriptionAlertWebhookURL() *string {
+	if r.spec.SubscriptionAlertWebhookURL == "" {
+		return nil
+	}
+	return &r.spec.SubscriptionAlertWebhookURL
+}
+
 type WebhookSpecResolver struct {
 	spec job.WebhookSpec
 }
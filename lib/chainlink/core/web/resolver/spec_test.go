@@ -43,9 +43,13 @@ func TestResolver_CronSpec(t *testing.T) {
 				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, id).Return(job.Job{
 					Type: job.Cron,
 					CronSpec: &job.CronSpec{
-						CronSchedule: "CRON_TZ=UTC 0 0 1 1 *",
-						EVMChainID:   ubig.NewI(42),
-						CreatedAt:    f.Timestamp(),
+						CronSchedule:   "0 0 1 1 *",
+						Timezone:       "UTC",
+						Jitter:         30 * time.Second,
+						OverlapPolicy:  job.CronOverlapSkip,
+						MaxCatchUpRuns: 2,
+						EVMChainID:     ubig.NewI(42),
+						CreatedAt:      f.Timestamp(),
 					},
 				}, nil)
 			},
@@ -57,6 +61,11 @@ func TestResolver_CronSpec(t *testing.T) {
 								__typename
 								... on CronSpec {
 									schedule
+									timezone
+									jitter
+									overlapPolicy
+									maxCatchUpRuns
+									lastFiredAt
 									evmChainID
 									createdAt
 								}
@@ -70,7 +79,12 @@ func TestResolver_CronSpec(t *testing.T) {
 					"job": {
 						"spec": {
 							"__typename": "CronSpec",
-							"schedule": "CRON_TZ=UTC 0 0 1 1 *",
+							"schedule": "0 0 1 1 *",
+							"timezone": "UTC",
+							"jitter": "30s",
+							"overlapPolicy": "skip",
+							"maxCatchUpRuns": 2,
+							"lastFiredAt": null,
 							"evmChainID": "42",
 							"createdAt": "2021-01-01T00:00:00Z"
 						}
@@ -620,6 +634,8 @@ func TestResolver_VRFSpec(t *testing.T) {
 						BackoffInitialDelay:           time.Minute,
 						BackoffMaxDelay:               time.Hour,
 						GasLanePrice:                  assets.GWei(200),
+						SubscriptionAlertThreshold:    6 * time.Hour,
+						SubscriptionAlertWebhookURL:   "https://alerts.example.com/vrf",
 					},
 				}, nil)
 			},
@@ -647,6 +663,8 @@ func TestResolver_VRFSpec(t *testing.T) {
 									backoffInitialDelay
 									backoffMaxDelay
 									gasLanePrice
+									subscriptionAlertThreshold
+									subscriptionAlertWebhookURL
 								}
 							}
 						}
@@ -674,7 +692,9 @@ func TestResolver_VRFSpec(t *testing.T) {
 							"chunkSize": 25,
 							"backoffInitialDelay": "1m0s",
 							"backoffMaxDelay": "1h0m0s",
-							"gasLanePrice": "200 gwei"
+							"gasLanePrice": "200 gwei",
+							"subscriptionAlertThreshold": "6h0m0s",
+							"subscriptionAlertWebhookURL": "https://alerts.example.com/vrf"
 						}
 					}
 				}
@@ -0,0 +1,210 @@
+package resolver
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
+)
+
+// VRFRequestResolver resolves the VRFRequest type.
+type VRFRequestResolver struct {
+	orm vrfcommon.RequestORM
+	req vrfcommon.Request
+}
+
+func NewVRFRequest(orm vrfcommon.RequestORM, req vrfcommon.Request) *VRFRequestResolver {
+	return &VRFRequestResolver{orm: orm, req: req}
+}
+
+func NewVRFRequests(orm vrfcommon.RequestORM, reqs []vrfcommon.Request) []*VRFRequestResolver {
+	var resolvers []*VRFRequestResolver
+	for _, req := range reqs {
+		resolvers = append(resolvers, NewVRFRequest(orm, req))
+	}
+
+	return resolvers
+}
+
+// ID resolves the id of the request as seen by the job, not the request ID.
+func (r *VRFRequestResolver) ID() graphql.ID {
+	return int64GQLID(r.req.ID)
+}
+
+// JobID resolves the id of the job which has seen the request.
+func (r *VRFRequestResolver) JobID() graphql.ID {
+	return int32GQLID(r.req.JobID)
+}
+
+// RequestID resolves the on chain request ID, in decimal.
+func (r *VRFRequestResolver) RequestID() string {
+	return r.req.RequestID
+}
+
+// EVMChainID resolves the chain of the coordinator.
+func (r *VRFRequestResolver) EVMChainID() graphql.ID {
+	return graphql.ID(r.req.EVMChainID.String())
+}
+
+// CoordinatorAddress resolves the address of the coordinator which emitted the request.
+func (r *VRFRequestResolver) CoordinatorAddress() string {
+	return r.req.CoordinatorAddress.Hex()
+}
+
+// VRFVersion resolves the version of the coordinator.
+func (r *VRFRequestResolver) VRFVersion() string {
+	return string(r.req.VRFVersion)
+}
+
+// SubID resolves the subscription paying for the request.
+func (r *VRFRequestResolver) SubID() string {
+	return r.req.SubID
+}
+
+// Sender resolves the consumer which made the request.
+func (r *VRFRequestResolver) Sender() string {
+	return r.req.Sender.Hex()
+}
+
+// RequestTxHash resolves the hash of the transaction which made the request.
+func (r *VRFRequestResolver) RequestTxHash() string {
+	return r.req.RequestTxHash.Hex()
+}
+
+// RequestBlockNumber resolves the block of the request.
+func (r *VRFRequestResolver) RequestBlockNumber() string {
+	return strconv.FormatInt(r.req.RequestBlockNumber, 10)
+}
+
+// ConfirmedAtBlock resolves the block from which the request can be fulfilled.
+func (r *VRFRequestResolver) ConfirmedAtBlock() string {
+	return strconv.FormatInt(r.req.ConfirmedAtBlock, 10)
+}
+
+// State resolves the last recorded state of the request.
+func (r *VRFRequestResolver) State() string {
+	return string(r.req.State)
+}
+
+// LastError resolves the error which caused the last state, if any.
+func (r *VRFRequestResolver) LastError() string {
+	return r.req.LastError
+}
+
+// EthTxID resolves the id of the fulfillment transaction.
+func (r *VRFRequestResolver) EthTxID() *graphql.ID {
+	if r.req.EthTxID == nil {
+		return nil
+	}
+	id := int64GQLID(*r.req.EthTxID)
+	return &id
+}
+
+// EthTxState resolves the state of the fulfillment transaction.
+func (r *VRFRequestResolver) EthTxState() *string {
+	return r.req.EthTxState
+}
+
+// EthTxError resolves the error of the fulfillment transaction.
+func (r *VRFRequestResolver) EthTxError() *string {
+	return r.req.EthTxError
+}
+
+// EthTxHash resolves the hash of the fulfillment transaction.
+func (r *VRFRequestResolver) EthTxHash() *string {
+	if r.req.EthTxHash == nil {
+		return nil
+	}
+	hash := r.req.EthTxHash.Hex()
+	return &hash
+}
+
+// Transitions resolves the recorded changes of state of the request, oldest first.
+func (r *VRFRequestResolver) Transitions(ctx context.Context) ([]*VRFRequestTransitionResolver, error) {
+	transitions, err := r.orm.FindRequestTransitions(ctx, r.req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var resolvers []*VRFRequestTransitionResolver
+	for _, t := range transitions {
+		resolvers = append(resolvers, &VRFRequestTransitionResolver{transition: t})
+	}
+	return resolvers, nil
+}
+
+// CreatedAt resolves when the request was first seen.
+func (r *VRFRequestResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.req.CreatedAt}
+}
+
+// UpdatedAt resolves when the state of the request last changed.
+func (r *VRFRequestResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: r.req.UpdatedAt}
+}
+
+// VRFRequestTransitionResolver resolves the VRFRequestTransition type.
+type VRFRequestTransitionResolver struct {
+	transition vrfcommon.RequestTransition
+}
+
+// State resolves the state entered.
+func (r *VRFRequestTransitionResolver) State() string {
+	return string(r.transition.State)
+}
+
+// Error resolves the error which caused the transition, if any.
+func (r *VRFRequestTransitionResolver) Error() string {
+	return r.transition.Error
+}
+
+// EthTxID resolves the fulfillment transaction at the time of the transition.
+func (r *VRFRequestTransitionResolver) EthTxID() *graphql.ID {
+	if r.transition.EthTxID == nil {
+		return nil
+	}
+	id := int64GQLID(*r.transition.EthTxID)
+	return &id
+}
+
+// CreatedAt resolves when the transition happened.
+func (r *VRFRequestTransitionResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.transition.CreatedAt}
+}
+
+// -- VRFRequest Query --
+
+// VRFRequestSuccessResolver resolves the requests found for a request ID.
+type VRFRequestSuccessResolver struct {
+	orm  vrfcommon.RequestORM
+	reqs []vrfcommon.Request
+}
+
+// Results resolves the request once for every job which has seen it.
+func (r *VRFRequestSuccessResolver) Results() []*VRFRequestResolver {
+	return NewVRFRequests(r.orm, r.reqs)
+}
+
+// VRFRequestPayloadResolver resolves a single request response
+type VRFRequestPayloadResolver struct {
+	orm  vrfcommon.RequestORM
+	reqs []vrfcommon.Request
+	NotFoundErrorUnionType
+}
+
+func NewVRFRequestPayload(orm vrfcommon.RequestORM, reqs []vrfcommon.Request, err error) *VRFRequestPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "VRF request not found"}
+
+	return &VRFRequestPayloadResolver{orm: orm, reqs: reqs, NotFoundErrorUnionType: e}
+}
+
+// ToVRFRequestSuccess implements the VRFRequestSuccess union type of the payload
+func (r *VRFRequestPayloadResolver) ToVRFRequestSuccess() (*VRFRequestSuccessResolver, bool) {
+	if r.err == nil {
+		return &VRFRequestSuccessResolver{orm: r.orm, reqs: r.reqs}, true
+	}
+
+	return nil, false
+}
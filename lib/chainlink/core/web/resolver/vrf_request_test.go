@@ -0,0 +1,117 @@
+package resolver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
+)
+
+func Test_VRFRequest(t *testing.T) {
+	t.Parallel()
+
+	var (
+		query = `
+			query GetVRFRequest {
+				vrfRequest(requestID: "0x4d2") {
+					... on VRFRequestSuccess {
+						results {
+							id
+							jobID
+							requestID
+							evmChainID
+							vrfVersion
+							subID
+							state
+							lastError
+							ethTxID
+							ethTxState
+							transitions {
+								state
+								error
+								ethTxID
+							}
+						}
+					}
+					... on NotFoundError {
+						message
+						code
+					}
+				}
+			}`
+		txID    = int64(11)
+		txState = "unconfirmed"
+	)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "vrfRequest"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("VRFRequestORM").Return(f.Mocks.vrfRequestORM)
+				f.Mocks.vrfRequestORM.On("FindRequests", mock.Anything, "1234").Return([]vrfcommon.Request{{
+					ID:                 5,
+					JobID:              3,
+					RequestID:          "1234",
+					EVMChainID:         *ubig.NewI(1),
+					CoordinatorAddress: common.HexToAddress("0x1"),
+					VRFVersion:         vrfcommon.V2Plus,
+					SubID:              "42",
+					State:              vrfcommon.RequestStateEnqueued,
+					EthTxID:            &txID,
+					EthTxState:         &txState,
+					CreatedAt:          f.Timestamp(),
+					UpdatedAt:          f.Timestamp(),
+				}}, nil)
+				f.Mocks.vrfRequestORM.On("FindRequestTransitions", mock.Anything, int64(5)).Return([]vrfcommon.RequestTransition{
+					{State: vrfcommon.RequestStateInsufficientFunds, Error: "balance too low", CreatedAt: f.Timestamp()},
+					{State: vrfcommon.RequestStateEnqueued, EthTxID: &txID, CreatedAt: f.Timestamp()},
+				}, nil)
+			},
+			query: query,
+			result: `{
+				"vrfRequest": {
+					"results": [{
+						"id": "5",
+						"jobID": "3",
+						"requestID": "1234",
+						"evmChainID": "1",
+						"vrfVersion": "V2Plus",
+						"subID": "42",
+						"state": "enqueued",
+						"lastError": "",
+						"ethTxID": "11",
+						"ethTxState": "unconfirmed",
+						"transitions": [
+							{"state": "insufficient_funds", "error": "balance too low", "ethTxID": null},
+							{"state": "enqueued", "error": "", "ethTxID": "11"}
+						]
+					}]
+				}
+			}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("VRFRequestORM").Return(f.Mocks.vrfRequestORM)
+				f.Mocks.vrfRequestORM.On("FindRequests", mock.Anything, "1234").Return(nil, nil)
+			},
+			query: query,
+			result: `{
+				"vrfRequest": {
+					"message": "VRF request not found",
+					"code": "NOT_FOUND"
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -75,13 +75,12 @@ func NewRouter(app chainlink.Application, prometheus *ginprom.Prometheus) (*gin.
 	}
 	engine.Use(helmet.Default())
 
-	rl := config.WebServer().RateLimit()
 	api := engine.Group(
 		"/",
-		rateLimiter(
-			rl.AuthenticatedPeriod(),
-			rl.Authenticated(),
-		),
+		reloadableRateLimiter(func() (time.Duration, int64) {
+			rl := config.WebServer().RateLimit()
+			return rl.AuthenticatedPeriod(), rl.Authenticated()
+		}),
 		sessions.Sessions(auth.SessionName, sessionStore),
 	)
 
@@ -143,6 +142,15 @@ func rateLimiter(period time.Duration, limit int64) gin.HandlerFunc {
 	return mgin.NewMiddleware(limiter.New(store, rate))
 }
 
+// reloadableRateLimiter is like rateLimiter, but reads the rate on every request, so that it follows config reloads.
+func reloadableRateLimiter(rate func() (period time.Duration, limit int64)) gin.HandlerFunc {
+	store := memory.NewStore()
+	return func(c *gin.Context) {
+		period, limit := rate()
+		mgin.NewMiddleware(limiter.New(store, limiter.Rate{Period: period, Limit: limit}))(c)
+	}
+}
+
 // secureOptions configure security options for the secure middleware, mostly
 // for TLS redirection
 func secureOptions(tlsRedirect bool, tlsHost string, devWebServer bool) secure.Options {
@@ -209,11 +217,10 @@ func ginHandlerFromHTTP(h http.HandlerFunc) gin.HandlerFunc {
 
 func sessionRoutes(app chainlink.Application, r *gin.RouterGroup) {
 	config := app.GetConfig()
-	rl := config.WebServer().RateLimit()
-	unauth := r.Group("/", rateLimiter(
-		rl.UnauthenticatedPeriod(),
-		rl.Unauthenticated(),
-	))
+	unauth := r.Group("/", reloadableRateLimiter(func() (time.Duration, int64) {
+		rl := config.WebServer().RateLimit()
+		return rl.UnauthenticatedPeriod(), rl.Unauthenticated()
+	}))
 	sc := NewSessionsController(app)
 	unauth.POST("/sessions", sc.Create)
 	auth := r.Group("/", auth.Authenticate(app.AuthenticationProvider(), auth.AuthenticateBySession))
@@ -283,6 +290,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		cc := ConfigController{app}
 		authv2.GET("/config", cc.Show)
 		authv2.GET("/config/v2", cc.Show)
+		authv2.POST("/config/reload", auth.RequiresAdminRole(cc.Reload))
 
 		tas := TxAttemptsController{app}
 		authv2.GET("/tx_attempts", paginatedRequest(tas.Index))
@@ -290,6 +298,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 
 		txs := TransactionsController{app}
 		authv2.GET("/transactions/evm", paginatedRequest(txs.Index))
+		authv2.GET("/transactions/evm/events", txs.Events)
 		authv2.GET("/transactions/evm/:TxHash", txs.Show)
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
@@ -298,6 +307,13 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/replay_from_block/:number", auth.RequiresRunRole(rc.ReplayFromBlock))
 		lcaC := LCAController{app}
 		authv2.GET("/find_lca", auth.RequiresRunRole(lcaC.FindLCA))
+		lpfC := LogPollerFiltersController{app}
+		authv2.GET("/logpoller/filters", lpfC.Index)
+		authv2.POST("/logpoller/filters/replay", auth.RequiresRunRole(lpfC.Replay))
+		reorgsC := ReorgsController{app}
+		authv2.GET("/reorgs", reorgsC.Index)
+		kc := KeepersController{app}
+		authv2.POST("/keepers/simulate", auth.RequiresRunRole(kc.Simulate))
 
 		if build.IsDev() {
 			capContr := CapabilityController{app}
@@ -379,6 +395,9 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/keys/vrf/import", auth.RequiresAdminRole(vrfkc.Import))
 		authv2.POST("/keys/vrf/export/:keyID", auth.RequiresAdminRole(vrfkc.Export))
 
+		vrfrc := VRFRequestsController{app}
+		authv2.GET("/vrf/requests/:requestID", vrfrc.Show)
+
 		wfkc := WorkflowKeysController{app}
 		authv2.GET("/keys/workflow", wfkc.Index)
 
@@ -389,6 +408,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
 		authv2.GET("/jobs/:ID", jc.Show)
 		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
+		authv2.POST("/jobs/simulate", auth.RequiresRunRole(jc.Simulate))
 		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
 		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
 
@@ -404,6 +424,13 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		// PipelineJobSpecErrorsController
 		authv2.DELETE("/pipeline/job_spec_errors/:ID", auth.RequiresEditRole(psec.Destroy))
 
+		pfc := PipelineFragmentsController{app}
+		authv2.GET("/pipeline/fragments", paginatedRequest(pfc.Index))
+		authv2.POST("/pipeline/fragments", auth.RequiresEditRole(pfc.Create))
+		authv2.GET("/pipeline/fragments/:Name", pfc.Show)
+		authv2.PATCH("/pipeline/fragments/:Name", auth.RequiresEditRole(pfc.Update))
+		authv2.DELETE("/pipeline/fragments/:Name", auth.RequiresEditRole(pfc.Destroy))
+
 		lgc := LogController{app}
 		authv2.GET("/log", lgc.Get)
 		authv2.PATCH("/log", auth.RequiresAdminRole(lgc.Patch))
@@ -432,6 +459,9 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/nodes/evm/forwarders/track", auth.RequiresEditRole(efc.Track))
 		authv2.DELETE("/nodes/evm/forwarders/:fwdID", auth.RequiresEditRole(efc.Delete))
 
+		nsc := EVMNodeScoresController{app}
+		authv2.GET("/nodes/evm/scores", nsc.Index)
+
 		buildInfo := BuildInfoController{app}
 		authv2.GET("/build_info", buildInfo.Show)
 
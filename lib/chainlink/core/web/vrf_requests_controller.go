@@ -0,0 +1,53 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// VRFRequestsController shows the lifecycle of VRF v2 and v2.5 requests.
+type VRFRequestsController struct {
+	App chainlink.Application
+}
+
+// Show returns a VRF request, with its state transitions, once for every job which has seen it.
+// The request ID may be given in decimal or as 0x prefixed hex.
+// Example:
+// "<application>/vrf/requests/:requestID"
+func (vrc *VRFRequestsController) Show(c *gin.Context) {
+	ctx := c.Request.Context()
+	requestID, err := vrfcommon.ParseRequestID(c.Param("requestID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	orm := vrc.App.VRFRequestORM()
+	requests, err := orm.FindRequests(ctx, requestID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if len(requests) == 0 {
+		jsonAPIError(c, http.StatusNotFound, errors.New("VRF request not found"))
+		return
+	}
+
+	resources := []presenters.VRFRequestResource{}
+	for _, r := range requests {
+		transitions, err := orm.FindRequestTransitions(ctx, r.ID)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		resources = append(resources, *presenters.NewVRFRequestResource(r, transitions))
+	}
+
+	jsonAPIResponse(c, resources, "vrfRequests")
+}
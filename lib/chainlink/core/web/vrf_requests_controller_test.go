@@ -0,0 +1,69 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ubig "github.com/smartcontractkit/chainlink-evm/pkg/utils/big"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestVRFRequestsController_Show(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(nil)
+
+	jb, _ := cltest.MustInsertWebhookSpec(t, app.GetDB())
+	orm := app.VRFRequestORM()
+	require.NoError(t, orm.CreateRequest(ctx, &vrfcommon.Request{
+		JobID:              jb.ID,
+		RequestID:          "1234",
+		EVMChainID:         *ubig.New(testutils.FixtureChainID),
+		CoordinatorAddress: testutils.NewAddress(),
+		VRFVersion:         vrfcommon.V2,
+		SubID:              "7",
+		Sender:             testutils.NewAddress(),
+		RequestTxHash:      testutils.Random32Byte(),
+		RequestBlockNumber: 10,
+		ConfirmedAtBlock:   13,
+		State:              vrfcommon.RequestStateUnconfirmed,
+	}))
+	require.NoError(t, orm.UpdateRequestState(ctx, jb.ID, "1234", vrfcommon.RequestStateInsufficientFunds, "balance too low", nil))
+
+	for _, id := range []string{"1234", "0x4d2"} {
+		resp, cleanup := client.Get("/v2/vrf/requests/" + id)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var resources []presenters.VRFRequestResource
+		cltest.ParseJSONAPIResponse(t, resp, &resources)
+		require.Len(t, resources, 1)
+		r := resources[0]
+		assert.Equal(t, jb.ID, r.JobID)
+		assert.Equal(t, "1234", r.RequestID)
+		assert.Equal(t, "7", r.SubID)
+		assert.Equal(t, string(vrfcommon.RequestStateInsufficientFunds), r.State)
+		assert.Equal(t, "balance too low", r.LastError)
+		assert.Nil(t, r.EthTxID)
+		require.Len(t, r.Transitions, 2)
+		assert.Equal(t, string(vrfcommon.RequestStateUnconfirmed), r.Transitions[0].State)
+	}
+
+	resp, cleanup := client.Get("/v2/vrf/requests/1")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+	resp, cleanup = client.Get("/v2/vrf/requests/nope")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}